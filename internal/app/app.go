@@ -4,15 +4,22 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/config"
 	"github.com/kevholditch/vigilant/internal/controllers"
+	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"github.com/muesli/termenv"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
 )
 
 // App represents the main application
@@ -21,83 +28,566 @@ type App struct {
 	width                int
 	height               int
 	theme                *theme.Theme
-	currentController    controllers.Controller
+	navigation           *controllers.NavigationStack
 	headerController     *controllers.HeaderController
 	commandBarController *controllers.CommandBarController
 	controllerRegistry   *controllers.ControllerRegistry
+
+	// readOnly disables actions that change the cluster or expose sensitive data, such as revealing secret values
+	readOnly bool
+
+	// contextName is the kubeconfig context in use, which selects the per-context overrides in the config file
+	contextName string
+	// settings are the config file settings in effect for the context
+	settings      config.Settings
+	configWatcher *config.Watcher
+	// configErr is the error from the last reload of the config file; the previous settings stay in effect while it is set
+	configErr error
+
+	// themes are the built-in themes and those loaded from themesDir. The chosen theme is copied into theme, which
+	// every view shares, so switching themes restyles them all on the next render.
+	themes    *theme.Registry
+	themesDir string
+	// detectedProfile is the color profile of the terminal, used when the config leaves the profile to auto
+	detectedProfile termenv.Profile
+	// commandErr is the error from the last command run from the command bar, shown until the bar is next opened
+	commandErr error
+	// showHelp shows the help overlay, listing the keys of the current view, in place of the view
+	showHelp bool
+
+	// listening records the controllers whose update channels are being drained
+	listening map[controllers.Controller]bool
+
+	// clusters are the clusters the pod and deployment views show together, chosen with ":clusters"; empty to show
+	// the current context only
+	clusters []models.Cluster
+	// clusterClients caches the client of each context chosen with ":clusters", so choosing it again reuses the client
+	clusterClients map[string]*kubernetes.Clientset
+	// kubeContexts are the contexts of the kubeconfig, which ":clusters" offers
+	kubeContexts []string
 }
 
 // NewApp creates a new application instance
 func NewApp() *App {
-	clientset, err := newClientSet()
+	clientset, contextName, err := models.NewClientSet()
 	if err != nil {
 		log.Fatal(fmt.Sprintf("error creating Kubernetes client: %v", err))
 	}
 
-	// Create theme
-	theme := theme.NewDefaultTheme()
+	configPath, err := config.Path()
+	if err != nil {
+		log.Fatal(fmt.Sprintf("error locating config file: %v", err))
+	}
+	themesDir, err := config.ThemesDir()
+	if err != nil {
+		log.Fatal(fmt.Sprintf("error locating themes: %v", err))
+	}
 
+	// Create theme; it is replaced by the configured theme once the config is loaded
 	app := &App{
-		clientset: clientset,
-		theme:     theme,
+		clientset:       clientset,
+		theme:           theme.NewDefaultTheme(),
+		navigation:      controllers.NewNavigationStack(),
+		listening:       make(map[controllers.Controller]bool),
+		readOnly:        readOnlyFromEnv(),
+		themesDir:       themesDir,
+		detectedProfile: theme.DetectColorProfile(),
+		clusterClients:  map[string]*kubernetes.Clientset{contextName: clientset},
+	}
+	if app.kubeContexts, err = models.KubeContexts(); err != nil {
+		log.Printf("error listing kubeconfig contexts: %v", err)
+	}
+
+	// The config is validated against the registered resources, so the registry is built first
+	app.buildRegistry()
+	cfg, err := config.Load(configPath, app.controllerRegistry.GetAvailableResources())
+	if err != nil {
+		log.Fatal(err)
+	}
+	app.contextName = contextName
+	app.configWatcher = config.NewWatcher(configPath, configPollInterval)
+
+	settings := cfg.Resolve(contextName)
+	if err := app.loadThemes(settings.ColorProfile); err != nil {
+		log.Fatal(err)
+	}
+	if err := app.setTheme(settings.Theme); err != nil {
+		log.Fatal(fmt.Sprintf("error applying theme from config %s: %v", configPath, err))
+	}
+	if err := controllers.ApplyKeyBindings(settings.Keys); err != nil {
+		log.Fatal(fmt.Sprintf("error applying key bindings from config %s: %v", configPath, err))
+	}
+	if err := views.SetColumnLayouts(settings.Columns); err != nil {
+		log.Fatal(fmt.Sprintf("error applying columns from config %s: %v", configPath, err))
 	}
 
 	// Initialize the controllers
-	app.initializeControllers()
+	app.initializeControllers(settings)
 
 	return app
 }
 
-func newClientSet() (*kubernetes.Clientset, error) {
+// readOnlyFromEnv reports whether read-only mode is enabled by the VIGILANT_READ_ONLY environment variable
+func readOnlyFromEnv() bool {
+	readOnly, err := strconv.ParseBool(os.Getenv("VIGILANT_READ_ONLY"))
+	return err == nil && readOnly
+}
 
-	userHomeDir, err := os.UserHomeDir()
+// initializeControllers sets up the controllers, starting at the view the settings choose
+func (a *App) initializeControllers(settings config.Settings) {
+	a.headerController = controllers.NewHeaderController(a.theme, a.clientset)
+	availableResources := a.controllerRegistry.GetAvailableResources()
+	a.commandBarController = controllers.NewCommandBarController(a.clientset, a.theme, "", availableResources, a.handleViewSwitch)
+	a.addThemeCommand()
+	a.addSplitCommand()
+
+	a.applySettings(settings)
+	a.controllerRegistry.SetNamespace(settings.Namespace)
+	a.headerController.SetNamespace(settings.Namespace)
+	if controller, exists := a.controllerRegistry.GetController(settings.StartupResource); exists {
+		a.navigation.Reset(controller, settings.StartupResource)
+	}
+}
+
+// applySettings makes the config file settings take effect for views opened from now on
+func (a *App) applySettings(settings config.Settings) {
+	a.settings = settings
+	a.readOnly = readOnlyFromEnv() || settings.ReadOnly
+	controllers.SetLogOptions(controllers.LogOptions{
+		TailLines:  settings.Logs.TailLines,
+		Timestamps: settings.Logs.Timestamps,
+	})
+	a.addClustersCommand()
+}
+
+// reloadSettings applies settings from a changed config file. The startup resource only applies at startup.
+// Cached controllers were built for the previous namespace, read-only mode and columns, so when any of them changes
+// they are released and the current view is rebuilt.
+func (a *App) reloadSettings(settings config.Settings) tea.Cmd {
+	previous := a.settings
+	a.applySettings(settings)
+	if settings.Namespace == previous.Namespace && settings.ReadOnly == previous.ReadOnly && reflect.DeepEqual(settings.Columns, previous.Columns) {
+		return nil
+	}
+
+	// A namespace chosen from the namespaces view is kept unless the config changes the namespace
+	namespace := a.controllerRegistry.Namespace()
+	if settings.Namespace != previous.Namespace {
+		namespace = settings.Namespace
+	}
+	for _, stale := range a.controllerRegistry.SetNamespace(namespace) {
+		a.release(stale)
+	}
+	a.headerController.SetNamespace(namespace)
+	resource := settings.StartupResource
+	if breadcrumbs := a.navigation.Breadcrumbs(); len(breadcrumbs) > 0 {
+		resource = breadcrumbs[0]
+	}
+	return a.switchView(resource)
+}
+
+// loadThemes loads the built-in themes and the theme files, built for the named color profile.
+// Themes from valid files are available even when an error is returned for others.
+func (a *App) loadThemes(colorProfile string) error {
+	profile, err := theme.ParseColorProfile(colorProfile, a.detectedProfile)
 	if err != nil {
-		return nil, fmt.Errorf("error getting user home dir: %v", err)
+		return err
 	}
-	kubeConfigPath := filepath.Join(userHomeDir, ".kube", "config")
+	lipgloss.SetColorProfile(profile)
+	a.themes = theme.NewRegistry(profile)
+	return a.themes.LoadDir(a.themesDir)
+}
 
-	config, err := clientcmd.BuildConfigFromFlags("", kubeConfigPath)
+// setTheme switches every view to the named theme. The current theme is kept if there is no such theme.
+func (a *App) setTheme(name string) error {
+	selected, err := a.themes.Get(name)
 	if err != nil {
-		return nil, fmt.Errorf("error getting Kubernetes config: %v", err)
+		return err
 	}
+	*a.theme = *selected
+	return nil
+}
+
+// applyThemeSettings reloads the themes and switches to the configured theme when the theme or color profile
+// settings differ from previous
+func (a *App) applyThemeSettings(settings, previous config.Settings) error {
+	if settings.Theme == previous.Theme && settings.ColorProfile == previous.ColorProfile {
+		return nil
+	}
+	if err := a.loadThemes(settings.ColorProfile); err != nil {
+		return err
+	}
+	a.addThemeCommand()
+	return a.setTheme(settings.Theme)
+}
 
-	clientset, err := kubernetes.NewForConfig(config)
+// addThemeCommand registers the ":theme <name>" command, offering the names of the loaded themes
+func (a *App) addThemeCommand() {
+	a.commandBarController.AddCommand("theme", a.themes.Names(), func(name string) tea.Cmd {
+		return func() tea.Msg {
+			return switchThemeMsg{name: name}
+		}
+	})
+}
+
+// switchThemeMsg requests that the views be switched to the named theme
+type switchThemeMsg struct {
+	name string
+}
+
+// switchTheme reloads the theme files, so that new and edited themes can be chosen without restarting,
+// then switches to the named theme
+func (a *App) switchTheme(name string) {
+	loadErr := a.loadThemes(a.settings.ColorProfile)
+	a.addThemeCommand()
+	if err := a.setTheme(name); err != nil {
+		a.commandErr = err
+		return
+	}
+	// The theme may have been chosen despite problems with other theme files, which are still worth reporting
+	a.commandErr = loadErr
+}
+
+// addClustersCommand registers the ":clusters <selection>" command, offering the configured cluster groups, the
+// kubeconfig contexts and off
+func (a *App) addClustersCommand() {
+	arguments := make([]string, 0, len(a.settings.ClusterGroups)+len(a.kubeContexts)+1)
+	for group := range a.settings.ClusterGroups {
+		arguments = append(arguments, group)
+	}
+	sort.Strings(arguments)
+	arguments = append(append(arguments, a.kubeContexts...), config.ClusterGroupOff)
+	a.commandBarController.AddCommand("clusters", arguments, func(selection string) tea.Cmd {
+		return func() tea.Msg {
+			return selectClustersMsg{selection: selection}
+		}
+	})
+}
+
+// selectClustersMsg requests that the pod and deployment views show the clusters of a selection
+type selectClustersMsg struct {
+	selection string
+}
+
+// selectClusters switches the pod and deployment views to show the clusters chosen with ":clusters": a cluster group,
+// contexts separated by commas, or off to show the current context only. Each cluster gets its own client and watch.
+func (a *App) selectClusters(selection string) tea.Cmd {
+	// The kubeconfig is read again so that contexts added since startup can be chosen
+	if contexts, err := models.KubeContexts(); err == nil {
+		a.kubeContexts = contexts
+		a.addClustersCommand()
+	}
+	contexts, err := a.resolveClusters(selection)
 	if err != nil {
-		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+		a.commandErr = err
+		return nil
 	}
+	clusters := make([]models.Cluster, 0, len(contexts))
+	for _, name := range contexts {
+		clientset, err := a.clusterClient(name)
+		if err != nil {
+			a.commandErr = err
+			return nil
+		}
+		clusters = append(clusters, models.Cluster{Name: name, Clientset: clientset})
+	}
+	a.clusters = clusters
+
+	// Cached views were built for the previous clusters, so they are stopped and rebuilt on demand
+	for _, stale := range a.controllerRegistry.Release() {
+		a.release(stale)
+	}
+	resource := a.settings.StartupResource
+	if breadcrumbs := a.navigation.Breadcrumbs(); len(breadcrumbs) > 0 {
+		resource = breadcrumbs[0]
+	}
+	if len(clusters) > 0 && resource != "pods" && resource != "deployments" {
+		resource = "pods"
+	}
+	return a.switchView(resource)
+}
 
+// resolveClusters returns the contexts a ":clusters" selection names, or none for off
+func (a *App) resolveClusters(selection string) ([]string, error) {
+	if selection == config.ClusterGroupOff {
+		return nil, nil
+	}
+	contexts, isGroup := a.settings.ClusterGroups[selection]
+	if !isGroup {
+		contexts = strings.Split(selection, ",")
+	}
+
+	var resolved []string
+	seen := make(map[string]bool)
+	for _, name := range contexts {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		if !slices.Contains(a.kubeContexts, name) {
+			if isGroup {
+				return nil, fmt.Errorf("cluster group %s: context %q is not in the kubeconfig", selection, name)
+			}
+			return nil, fmt.Errorf("unknown cluster %q (expected a cluster group, kubeconfig contexts separated by commas, or %s)", name, config.ClusterGroupOff)
+		}
+		seen[name] = true
+		resolved = append(resolved, name)
+	}
+	if len(resolved) == 0 {
+		return nil, fmt.Errorf("no clusters chosen (expected a cluster group, kubeconfig contexts separated by commas, or %s)", config.ClusterGroupOff)
+	}
+	return resolved, nil
+}
+
+// clusterClient returns the client of a kubeconfig context, creating it the first time the context is chosen
+func (a *App) clusterClient(context string) (*kubernetes.Clientset, error) {
+	if clientset, ok := a.clusterClients[context]; ok {
+		return clientset, nil
+	}
+	clientset, err := models.NewClientSetForContext(context)
+	if err != nil {
+		return nil, err
+	}
+	a.clusterClients[context] = clientset
 	return clientset, nil
 }
 
-// initializeControllers sets up the controllers
-func (a *App) initializeControllers() {
-	a.buildRegistry()
-	if controller, exists := a.controllerRegistry.GetController("pods"); exists {
-		a.currentController = controller
+// splitOff is the ":split" argument that closes the split
+const splitOff = "off"
+
+// addSplitCommand registers the ":split <view>" command, which shows the describe or logs view of the selected row
+// beside the list, or closes the split
+func (a *App) addSplitCommand() {
+	arguments := []string{string(controllers.ActionDescribe), string(controllers.ActionLogs), splitOff}
+	a.commandBarController.AddCommand("split", arguments, func(view string) tea.Cmd {
+		return func() tea.Msg {
+			return splitViewMsg{view: view}
+		}
+	})
+}
+
+// splitViewMsg requests that the current list be split with the named view of its selected row beside it
+type splitViewMsg struct {
+	view string
+}
+
+// splitView splits the current list, pushing a split that shows the view of its selected row beside it. When the
+// current view is already a split its detail pane switches to the view, and off closes it.
+func (a *App) splitView(view string) tea.Cmd {
+	current := a.currentController()
+	split, isSplit := current.(*controllers.SplitController)
+	if view == splitOff {
+		if isSplit {
+			return controllers.PopView()
+		}
+		return nil
 	}
-	a.headerController = controllers.NewHeaderController(a.theme, a.clientset)
-	availableResources := a.controllerRegistry.GetAvailableResources()
-	a.commandBarController = controllers.NewCommandBarController(a.clientset, a.theme, "", availableResources, a.handleViewSwitch)
+
+	list, ok := current.(controllers.DetailController)
+	if isSplit {
+		list, ok = split.List(), true
+	}
+	if !ok {
+		a.commandErr = fmt.Errorf("this view cannot be split; split a pod or deployment list")
+		return nil
+	}
+	action := controllers.Action(view)
+	if !slices.Contains(list.Details(), action) {
+		details := make([]string, len(list.Details()))
+		for i, detail := range list.Details() {
+			details[i] = string(detail)
+		}
+		a.commandErr = fmt.Errorf("cannot show %s beside this list (expected one of: %s)", view, strings.Join(details, ", "))
+		return nil
+	}
+
+	if isSplit {
+		split.SetAction(action)
+		return nil
+	}
+	return controllers.PushView(controllers.NewSplitController(list, action, a.theme, views.SplitHorizontal), "split")
+}
+
+// configPollInterval is how often the config file is checked for changes
+const configPollInterval = 2 * time.Second
+
+// configChangedMsg carries the config file after it changed on disk
+type configChangedMsg struct {
+	config *config.Config
+	err    error
 }
 
+// waitForConfigChange returns a command that blocks until the config file changes, then loads it
+func (a *App) waitForConfigChange() tea.Cmd {
+	watcher := a.configWatcher
+	resources := a.controllerRegistry.GetAvailableResources()
+	return func() tea.Msg {
+		watcher.Wait()
+		cfg, err := config.Load(watcher.Path(), resources)
+		return configChangedMsg{config: cfg, err: err}
+	}
+}
+
+// Resources returns the resources vigilant has views for, which the config file is validated against
+func Resources() []string {
+	a := &App{theme: theme.NewDefaultTheme()}
+	a.buildRegistry()
+	return a.controllerRegistry.GetAvailableResources()
+}
+
+// buildRegistry registers a controller factory for each resource view
 func (a *App) buildRegistry() {
 	a.controllerRegistry = controllers.NewControllerRegistry(a.clientset, a.theme)
-	a.controllerRegistry.Register("pods", func(clientset *kubernetes.Clientset, theme *controllers.Theme) controllers.Controller {
-		return controllers.NewPodController(clientset, theme, "")
+	// The pod and deployment views merge the clusters chosen with ":clusters" when there are any
+	a.controllerRegistry.Register("pods", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		if len(a.clusters) > 0 {
+			return controllers.NewMultiClusterPodListController(a.clusters, theme, namespace)
+		}
+		return controllers.NewScopedPodListController(clientset, theme, "", controllers.PodListScope{Namespace: namespace})
+	})
+	a.controllerRegistry.Register("deployments", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		if len(a.clusters) > 0 {
+			return controllers.NewMultiClusterDeploymentListController(a.clusters, theme, namespace)
+		}
+		return controllers.NewNamespacedDeploymentListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("nodes", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNodeListController(clientset, theme, "", a.readOnly)
 	})
-	a.controllerRegistry.Register("deployments", func(clientset *kubernetes.Clientset, theme *controllers.Theme) controllers.Controller {
-		return controllers.NewDeploymentController(clientset, theme, "")
+	a.controllerRegistry.Register("statefulsets", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedStatefulSetListController(clientset, theme, "", namespace)
 	})
+	a.controllerRegistry.Register("daemonsets", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedDaemonSetListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("jobs", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedJobListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("cronjobs", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedCronJobListController(clientset, theme, "", namespace, a.readOnly)
+	})
+	a.controllerRegistry.Register("services", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedServiceListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("ingresses", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedIngressListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("configmaps", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedConfigMapListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("secrets", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedSecretListController(clientset, theme, "", namespace, a.readOnly)
+	})
+	a.controllerRegistry.Register("pvc", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedPersistentVolumeClaimListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("pv", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewPersistentVolumeListController(clientset, theme, "")
+	})
+	a.controllerRegistry.Register("namespaces", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespaceListController(clientset, theme, "", namespace)
+	})
+	a.controllerRegistry.Register("hpa", func(clientset *kubernetes.Clientset, theme *controllers.Theme, namespace string) controllers.Controller {
+		return controllers.NewNamespacedHorizontalPodAutoscalerListController(clientset, theme, "", namespace)
+	})
+}
+
+// currentController returns the controller on top of the navigation stack
+func (a *App) currentController() controllers.Controller {
+	return a.navigation.Current()
 }
 
-// handleViewSwitch handles switching between different views
+// release stops a controller that has left the navigation stack and forgets its update channel
+func (a *App) release(controller controllers.Controller) {
+	if stoppable, ok := controller.(controllers.StoppableController); ok {
+		stoppable.Stop()
+	}
+	delete(a.listening, controller)
+}
+
+// switchView replaces the navigation stack with the controller registered for resource
+func (a *App) switchView(resource string) tea.Cmd {
+	controller, exists := a.controllerRegistry.GetController(resource)
+	if !exists {
+		return nil
+	}
+	for _, discarded := range a.navigation.Reset(controller, resource) {
+		a.release(discarded)
+	}
+	return a.listen(controller)
+}
+
+// switchViewMsg requests that the current controller be switched to the one registered for resource
+type switchViewMsg struct {
+	resource string
+}
+
+// handleViewSwitch requests switching to the view for the given resource
 func (a *App) handleViewSwitch(resource string) tea.Cmd {
 	return func() tea.Msg {
-		if controller, exists := a.controllerRegistry.GetController(resource); exists {
-			a.currentController = controller
+		return switchViewMsg{resource: resource}
+	}
+}
+
+// controllerMsg wraps a message received from a controller's update channel
+// so that it can be routed back to that controller on the update loop
+type controllerMsg struct {
+	controller controllers.UpdateableController
+	msg        tea.Msg
+}
+
+// commandResultMsg wraps the result of a command issued by a controller, so that it reaches that controller even if
+// the user has navigated to another view by the time it arrives
+type commandResultMsg struct {
+	controller controllers.Controller
+	msg        tea.Msg
+}
+
+// routeResults tags the results of a controller's command with the controller. Batches are tagged command by
+// command, and quitting is left as it is, so Bubble Tea still acts on them.
+func routeResults(controller controllers.Controller, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil || controller == nil {
+		return cmd
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case nil, tea.QuitMsg:
+			return msg
+		case tea.BatchMsg:
+			routed := make(tea.BatchMsg, len(msg))
+			for i, batched := range msg {
+				routed[i] = routeResults(controller, batched)
+			}
+			return routed
+		default:
+			return commandResultMsg{controller: controller, msg: msg}
 		}
+	}
+}
+
+// listen starts draining the update channel of the controller, if it has one and is not already being drained
+func (a *App) listen(controller controllers.Controller) tea.Cmd {
+	updateableController, ok := controller.(controllers.UpdateableController)
+	if !ok || a.listening[controller] {
 		return nil
 	}
+	a.listening[controller] = true
+	return waitForUpdate(updateableController)
+}
+
+// waitForUpdate returns a command that blocks until the controller's update channel yields a message
+func waitForUpdate(controller controllers.UpdateableController) tea.Cmd {
+	updateChan := controller.GetUpdateChannel()
+	if updateChan == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg, ok := <-updateChan
+		if !ok {
+			return nil
+		}
+		return controllerMsg{controller: controller, msg: msg}
+	}
 }
 
 // tickMsg is sent periodically to check for updates
@@ -114,7 +604,7 @@ func tick() tea.Cmd {
 // Run starts the application
 func (a *App) Run() error {
 	fmt.Println("Starting Vigilant...")
-	fmt.Println("Press 'q' to quit, ':' to open command bar, arrow keys to navigate")
+	fmt.Println("Press 'q' to quit, ':' to open command bar, '?' for help, arrow keys to navigate")
 
 	// Create the bubble tea program
 	p := tea.NewProgram(
@@ -130,52 +620,140 @@ func (a *App) Run() error {
 
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
-	return tick()
+	return tea.Batch(tick(), a.listen(a.currentController()), a.waitForConfigChange())
 }
 
 // Update handles messages and updates the application state
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if result, ok := msg.(commandResultMsg); ok {
+		return a.update(result.msg, result.controller)
+	}
+	return a.update(msg, nil)
+}
+
+// update handles a message. Messages the App does not act on go to the controller whose command produced them,
+// or the current controller when origin is nil.
+func (a *App) update(msg tea.Msg, origin controllers.Controller) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "q", "ctrl+c":
+		// ctrl+c always quits, whatever the keys are remapped to
+		if msg.String() == "ctrl+c" {
 			return a, tea.Quit
-		case ":":
+		}
+
+		// Any key closes the help overlay
+		if a.showHelp {
+			a.showHelp = false
+			return a, nil
+		}
+
+		// The command bar gets every key while it is open, so that keys bound to actions can be typed into it
+		if a.commandBarController.IsActive() {
+			return a, a.commandBarController.HandleKey(msg)
+		}
+
+		// So does a dialog open in the current view, such as the column chooser, which closes on esc
+		if modal, ok := a.currentController().(controllers.ModalController); ok && modal.Modal() {
+			return a, routeResults(modal, modal.HandleKey(msg))
+		}
+
+		switch controllers.GlobalKeys.Action(msg) {
+		case controllers.ActionQuit:
+			return a, tea.Quit
+		case controllers.ActionCommand:
 			// Activate command bar
+			a.commandErr = nil
 			a.commandBarController.Activate()
 			return a, nil
-		default:
-			// Check if command bar is active first
-			if a.commandBarController.IsActive() {
-				return a, a.commandBarController.HandleKey(msg)
+		case controllers.ActionHelp:
+			a.showHelp = true
+			return a, nil
+		case controllers.ActionBack:
+			// Back returns one level up the navigation stack
+			if a.navigation.Depth() > 1 {
+				return a, controllers.PopView()
 			}
+			return a, nil
+		}
 
-			// Delegate to the current controller
-			if a.currentController != nil {
-				return a, a.currentController.HandleKey(msg)
-			}
+		// Delegate to the current controller
+		if current := a.currentController(); current != nil {
+			return a, routeResults(current, current.HandleKey(msg))
 		}
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
 		a.height = msg.Height
-	case controllers.UpdateMsg:
-		// Update received, trigger a re-render
-		return a, tick()
-	case tickMsg:
-		// Check if we need to update the view
-		if a.currentController != nil {
-			// Try to get update channel from the current controller
-			if updateableController, ok := a.currentController.(controllers.UpdateableController); ok {
-				updateChan := updateableController.GetUpdateChannel()
-				if updateChan != nil {
-					// Force a re-render by calling Render
-					headerHeight := a.headerController.GetHeight()
-					commandBarHeight := a.getCommandBarHeight()
-					a.currentController.Render(a.width, a.height-headerHeight-commandBarHeight)
-				}
+	case switchViewMsg:
+		return a, a.switchView(msg.resource)
+	case controllers.SetNamespaceMsg:
+		// Cached controllers watch the old scope, so they are stopped and rebuilt on demand for the new one.
+		// The old root is among them; the children above it are released when the stack is reset.
+		for _, stale := range a.controllerRegistry.SetNamespace(msg.Namespace) {
+			a.release(stale)
+		}
+		a.headerController.SetNamespace(msg.Namespace)
+		return a, a.switchView("pods")
+	case configChangedMsg:
+		// An invalid config is reported and the previous settings stay in effect until it is fixed
+		if msg.err != nil {
+			log.Printf("error reloading config: %v", msg.err)
+			a.configErr = msg.err
+			return a, a.waitForConfigChange()
+		}
+		settings := msg.config.Resolve(a.contextName)
+		if err := controllers.ApplyKeyBindings(settings.Keys); err != nil {
+			log.Printf("error applying key bindings from config: %v", err)
+			a.configErr = err
+			return a, a.waitForConfigChange()
+		}
+		if err := views.SetColumnLayouts(settings.Columns); err != nil {
+			log.Printf("error applying columns from config: %v", err)
+			a.configErr = err
+			return a, a.waitForConfigChange()
+		}
+		if err := a.applyThemeSettings(settings, a.settings); err != nil {
+			log.Printf("error applying theme from config: %v", err)
+			a.configErr = err
+			return a, a.waitForConfigChange()
+		}
+		a.configErr = nil
+		return a, tea.Batch(a.reloadSettings(settings), a.waitForConfigChange())
+	case switchThemeMsg:
+		a.switchTheme(msg.name)
+	case selectClustersMsg:
+		return a, a.selectClusters(msg.selection)
+	case splitViewMsg:
+		return a, a.splitView(msg.view)
+	case controllers.PushViewMsg:
+		a.navigation.Push(msg.Controller, msg.Title)
+		return a, a.listen(msg.Controller)
+	case controllers.PopViewMsg:
+		// The parent controller was kept on the stack, so its selection and scroll state are intact
+		if popped, ok := a.navigation.Pop(); ok {
+			a.release(popped)
+		}
+	case controllerMsg:
+		// Apply the background update and keep draining the channel it came from. Views following the list's
+		// selection are told, as the update may have moved or removed the selected row
+		cmds := []tea.Cmd{routeResults(msg.controller, msg.controller.Update(msg.msg)), waitForUpdate(msg.controller)}
+		for _, controller := range a.navigation.Controllers() {
+			if follower, ok := controller.(controllers.ListFollower); ok {
+				cmds = append(cmds, routeResults(controller, follower.ListUpdated(msg.controller)))
 			}
 		}
+		return a, tea.Batch(cmds...)
+	case tickMsg:
+		// Periodic re-render keeps relative ages fresh
 		return a, tick()
+	default:
+		// Results of commands are routed back to the controller that issued them
+		target := origin
+		if target == nil {
+			target = a.currentController()
+		}
+		if target != nil {
+			return a, routeResults(target, target.Update(msg))
+		}
 	}
 	return a, nil
 }
@@ -186,26 +764,46 @@ func (a *App) View() string {
 		return "Loading..."
 	}
 
+	current := a.currentController()
+
 	var viewText string
-	if a.currentController != nil {
-		viewText = a.currentController.ActionText()
+	if current != nil {
+		viewText = current.ActionText()
 	} else {
 		viewText = "No controller available"
 	}
 
-	header := a.headerController.Render(a.width, viewText)
+	// A multi-cluster view shows the health of each of its clusters in the header
+	var clusterHealth []models.ClusterHealth
+	if multiCluster, ok := current.(controllers.ClusterHealthController); ok {
+		clusterHealth = multiCluster.ClusterHealth()
+	}
+	a.headerController.SetClusters(clusterHealth)
+
+	header := a.headerController.Render(a.width, viewText, a.navigation.Breadcrumbs())
 	headerHeight := a.headerController.GetHeight()
 
 	// Render command bar
 	commandBar := a.commandBarController.Render(a.width, 0)
 	commandBarHeight := a.getCommandBarHeight()
 
+	// Render the errors from the last config reload and command, if they failed
+	errors := a.renderErrors()
+	errorsHeight := 0
+	if errors != "" {
+		errorsHeight = lipgloss.Height(errors)
+	}
+
 	// Calculate available height for main content
-	viewDisplayHeight := a.height - headerHeight - commandBarHeight
+	viewDisplayHeight := a.height - headerHeight - commandBarHeight - errorsHeight
 
 	var viewContent string
-	if a.currentController != nil {
-		viewContent = a.currentController.Render(a.width, viewDisplayHeight)
+	if a.showHelp {
+		helpView := views.NewHelpView(a.helpSections(current), a.theme)
+		helpView.SetSize(a.width, viewDisplayHeight)
+		viewContent = helpView.Render()
+	} else if current != nil {
+		viewContent = current.Render(a.width, viewDisplayHeight)
 	} else {
 		viewContent = "No controller available"
 	}
@@ -216,11 +814,45 @@ func (a *App) View() string {
 	if commandBar != "" {
 		components = append(components, commandBar)
 	}
+	if errors != "" {
+		components = append(components, errors)
+	}
 	components = append(components, viewContent)
 
 	return lipgloss.JoinVertical(lipgloss.Left, components...)
 }
 
+// helpSections returns the sections of the help overlay: the keys of the current view, when it has a key map,
+// followed by the global keys
+func (a *App) helpSections(current controllers.Controller) []views.HelpSection {
+	var sections []views.HelpSection
+	if keyMapped, ok := current.(controllers.KeyMapController); ok {
+		sections = append(sections, keyMapped.KeyMap().HelpSection())
+	}
+	if helped, ok := current.(controllers.HelpController); ok {
+		sections = append(sections, helped.HelpSections()...)
+	}
+	return append(sections, controllers.GlobalKeys.HelpSection())
+}
+
+// renderErrors renders the errors from the last config reload and command, or "" when neither failed
+func (a *App) renderErrors() string {
+	var lines []string
+	if a.configErr != nil {
+		lines = append(lines, fmt.Sprintf("%v (previous settings still in effect)", a.configErr))
+	}
+	if a.commandErr != nil {
+		lines = append(lines, a.commandErr.Error())
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(a.theme.Error).
+		Width(a.width).
+		Render(strings.Join(lines, "\n"))
+}
+
 // getCommandBarHeight returns the height of the command bar
 func (a *App) getCommandBarHeight() int {
 	if a.commandBarController.IsActive() {
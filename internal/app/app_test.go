@@ -0,0 +1,86 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/controllers"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingController records the messages passed to Update
+type recordingController struct {
+	received []tea.Msg
+}
+
+func (c *recordingController) HandleKey(msg tea.KeyMsg) tea.Cmd { return nil }
+func (c *recordingController) Render(width, height int) string  { return "" }
+func (c *recordingController) ActionText() string               { return "" }
+func (c *recordingController) Update(msg tea.Msg) tea.Cmd {
+	c.received = append(c.received, msg)
+	return nil
+}
+
+// recordingFollower records the lists it is told were updated
+type recordingFollower struct {
+	recordingController
+	updated []controllers.Controller
+}
+
+func (c *recordingFollower) ListUpdated(list controllers.Controller) tea.Cmd {
+	c.updated = append(c.updated, list)
+	return nil
+}
+
+// updatingController is a recordingController with an update channel
+type updatingController struct {
+	recordingController
+}
+
+func (c *updatingController) GetUpdateChannel() <-chan tea.Msg { return nil }
+
+type loadedMsg struct{}
+
+func TestRouteResults(t *testing.T) {
+	t.Run("should_deliver_a_result_to_the_controller_that_asked_for_it", func(t *testing.T) {
+		origin, other := &recordingController{}, &recordingController{}
+		a := &App{navigation: controllers.NewNavigationStack()}
+		a.navigation.Push(origin, "pods")
+
+		msg := routeResults(origin, func() tea.Msg { return loadedMsg{} })()
+		a.navigation.Push(other, "describe")
+		a.Update(msg)
+
+		assert.Equal(t, []tea.Msg{loadedMsg{}}, origin.received)
+		assert.Empty(t, other.received)
+	})
+
+	t.Run("should_route_each_command_of_a_batch", func(t *testing.T) {
+		origin := &recordingController{}
+		batch := routeResults(origin, tea.Batch(func() tea.Msg { return loadedMsg{} }, func() tea.Msg { return loadedMsg{} }))()
+
+		if assert.IsType(t, tea.BatchMsg{}, batch) {
+			for _, cmd := range batch.(tea.BatchMsg) {
+				assert.Equal(t, commandResultMsg{controller: origin, msg: loadedMsg{}}, cmd())
+			}
+		}
+	})
+
+	t.Run("should_leave_quitting_to_bubble_tea", func(t *testing.T) {
+		assert.Equal(t, tea.QuitMsg{}, routeResults(&recordingController{}, tea.Quit)())
+	})
+}
+
+func TestListFollowers(t *testing.T) {
+	t.Run("should_tell_followers_of_a_background_update_to_the_list", func(t *testing.T) {
+		list, follower := &updatingController{}, &recordingFollower{}
+		a := &App{navigation: controllers.NewNavigationStack()}
+		a.navigation.Push(list, "pods")
+		a.navigation.Push(follower, "split")
+
+		a.Update(controllerMsg{controller: list, msg: loadedMsg{}})
+
+		assert.Equal(t, []tea.Msg{loadedMsg{}}, list.received)
+		assert.Equal(t, []controllers.Controller{list}, follower.updated)
+	})
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/views"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// describer is a describe view that can render its content without the TUI
+type describer interface {
+	SetSize(width, height int)
+	Content() string
+}
+
+// newDescribeCommand creates the command describing a pod, deployment or node as its describe view does
+func newDescribeCommand(resources []string) *cobra.Command {
+	var scope namespaceScope
+	command := &cobra.Command{
+		Use:   "describe RESOURCE NAME",
+		Short: "Describe a pod, deployment or node with the health summary the TUI shows",
+		Example: `  vigilant describe pod web-7d4b9c-x2x8z
+  vigilant describe deployment web -n payments
+  vigilant describe node worker-1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource, err := resolveResource(args[0])
+			if err != nil {
+				return err
+			}
+			name := args[1]
+
+			s, err := newSession(resources)
+			if err != nil {
+				return err
+			}
+			var view describer
+			switch resource {
+			case resourcePods:
+				namespace, err := findNamespace(s, resource, name, s.namespace(scope))
+				if err != nil {
+					return err
+				}
+				pod, err := models.GetPod(s.clientset, namespace, name)
+				if err != nil {
+					return err
+				}
+				view = views.NewDescribePodView(pod, s.theme)
+			case resourceDeployments:
+				namespace, err := findNamespace(s, resource, name, s.namespace(scope))
+				if err != nil {
+					return err
+				}
+				deployment, err := models.GetDeployment(s.clientset, namespace, name)
+				if err != nil {
+					return err
+				}
+				view = views.NewDescribeDeploymentView(deployment, s.theme)
+			default:
+				node, err := models.GetNode(s.clientset, name)
+				if err != nil {
+					return err
+				}
+				view = views.NewDescribeNodeView(node, s.theme)
+			}
+			return printDescription(cmd.OutOrStdout(), view, outputWidth())
+		},
+	}
+	scope.addFlags(command.Flags())
+	return command
+}
+
+// findNamespace returns the namespace of the named pod or deployment. With no namespace chosen it is looked up across
+// all namespaces, and must be the only one of that name.
+func findNamespace(s *session, resource, name, namespace string) (string, error) {
+	if namespace != "" {
+		return namespace, nil
+	}
+
+	options := metav1.ListOptions{FieldSelector: "metadata.name=" + name}
+	var namespaces []string
+	switch resource {
+	case resourcePods:
+		pods, err := s.clientset.CoreV1().Pods("").List(context.TODO(), options)
+		if err != nil {
+			return "", fmt.Errorf("could not find pod %s: %w", name, err)
+		}
+		for _, pod := range pods.Items {
+			namespaces = append(namespaces, pod.Namespace)
+		}
+	default:
+		deployments, err := s.clientset.AppsV1().Deployments("").List(context.TODO(), options)
+		if err != nil {
+			return "", fmt.Errorf("could not find deployment %s: %w", name, err)
+		}
+		for _, deployment := range deployments.Items {
+			namespaces = append(namespaces, deployment.Namespace)
+		}
+	}
+	return pickNamespace(strings.TrimSuffix(resource, "s"), name, namespaces)
+}
+
+// pickNamespace returns the only namespace an object of the name was found in
+func pickNamespace(kind, name string, namespaces []string) (string, error) {
+	switch len(namespaces) {
+	case 0:
+		return "", fmt.Errorf("%s %s not found in any namespace", kind, name)
+	case 1:
+		return namespaces[0], nil
+	default:
+		return "", fmt.Errorf("%s %s found in namespaces %s; choose one with --namespace", kind, name, strings.Join(namespaces, ", "))
+	}
+}
+
+// printDescription renders a describe view at the width of the output and writes it
+func printDescription(out io.Writer, view describer, width int) error {
+	view.SetSize(width, 1)
+	content := strings.TrimRight(view.Content(), "\n")
+	_, err := io.WriteString(out, content+"\n")
+	return err
+}
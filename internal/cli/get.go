@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/views"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newGetCommand creates the command listing pods, deployments or nodes
+func newGetCommand(resources []string) *cobra.Command {
+	var (
+		output   string
+		selector string
+		scope    namespaceScope
+	)
+	command := &cobra.Command{
+		Use:   "get RESOURCE",
+		Short: "List pods, deployments or nodes with the statuses the TUI shows",
+		Example: `  vigilant get pods
+  vigilant get pods -n payments -l app=web -o json
+  vigilant get deployments -A -o yaml
+  vigilant get nodes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource, err := resolveResource(args[0])
+			if err != nil {
+				return err
+			}
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+
+			s, err := newSession(resources)
+			if err != nil {
+				return err
+			}
+			options := metav1.ListOptions{LabelSelector: selector}
+			switch resource {
+			case resourcePods:
+				pods, err := listPods(s, s.namespace(scope), options)
+				if err != nil {
+					return err
+				}
+				return printPods(cmd.OutOrStdout(), output, pods)
+			case resourceDeployments:
+				deployments, err := listDeployments(s, s.namespace(scope), options)
+				if err != nil {
+					return err
+				}
+				return printDeployments(cmd.OutOrStdout(), output, deployments)
+			default:
+				nodes, err := listNodes(s, options)
+				if err != nil {
+					return err
+				}
+				return printNodes(cmd.OutOrStdout(), output, nodes)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format: table, json or yaml")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Label selector to filter on, such as app=web")
+	scope.addFlags(command.Flags())
+	return command
+}
+
+// listPods lists the pods in a namespace, or all namespaces when it is empty
+func listPods(s *session, namespace string, options metav1.ListOptions) ([]models.Pod, error) {
+	podList, err := s.clientset.CoreV1().Pods(namespace).List(context.TODO(), options)
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods: %w", err)
+	}
+	pods := make([]models.Pod, 0, len(podList.Items))
+	for _, pod := range podList.Items {
+		pods = append(pods, models.ToPodModel(pod))
+	}
+	return pods, nil
+}
+
+// listDeployments lists the deployments in a namespace, or all namespaces when it is empty
+func listDeployments(s *session, namespace string, options metav1.ListOptions) ([]models.Deployment, error) {
+	deploymentList, err := s.clientset.AppsV1().Deployments(namespace).List(context.TODO(), options)
+	if err != nil {
+		return nil, fmt.Errorf("could not list deployments: %w", err)
+	}
+	deployments := make([]models.Deployment, 0, len(deploymentList.Items))
+	for _, deployment := range deploymentList.Items {
+		deployments = append(deployments, models.ToDeploymentModel(deployment))
+	}
+	return deployments, nil
+}
+
+// listNodes lists the nodes with the number of pods scheduled on each
+func listNodes(s *session, options metav1.ListOptions) ([]models.Node, error) {
+	nodeList, err := s.clientset.CoreV1().Nodes().List(context.TODO(), options)
+	if err != nil {
+		return nil, fmt.Errorf("could not list nodes: %w", err)
+	}
+	podCounts, err := models.CountPodsByNode(s.clientset)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]models.Node, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		nodes = append(nodes, models.ToNodeModel(node, podCounts[node.Name]))
+	}
+	return nodes, nil
+}
+
+// printPods writes pods in the configured pod columns, or as records in JSON or YAML
+func printPods(out io.Writer, output string, pods []models.Pod) error {
+	if output == outputTable {
+		_, err := io.WriteString(out, views.PodTable(pods))
+		return err
+	}
+	records := make([]podRecord, 0, len(pods))
+	for _, pod := range pods {
+		records = append(records, toPodRecord(pod))
+	}
+	return writeRecords(out, output, records)
+}
+
+// printDeployments writes deployments in the configured deployment columns, or as records in JSON or YAML
+func printDeployments(out io.Writer, output string, deployments []models.Deployment) error {
+	if output == outputTable {
+		_, err := io.WriteString(out, views.DeploymentTable(deployments))
+		return err
+	}
+	records := make([]deploymentRecord, 0, len(deployments))
+	for _, deployment := range deployments {
+		records = append(records, toDeploymentRecord(deployment))
+	}
+	return writeRecords(out, output, records)
+}
+
+// printNodes writes nodes in the configured node columns, or as records in JSON or YAML
+func printNodes(out io.Writer, output string, nodes []models.Node) error {
+	if output == outputTable {
+		_, err := io.WriteString(out, views.NodeTable(nodes))
+		return err
+	}
+	records := make([]nodeRecord, 0, len(nodes))
+	for _, node := range nodes {
+		records = append(records, toNodeRecord(node))
+	}
+	return writeRecords(out, output, records)
+}
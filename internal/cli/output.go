@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats of the get command
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// validateOutput checks that an output format is supported
+func validateOutput(output string) error {
+	switch output {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q (expected one of: %s, %s, %s)", output, outputTable, outputJSON, outputYAML)
+}
+
+// recordList wraps records as kubectl wraps lists, so that scripts read them from .items
+type recordList[T any] struct {
+	Items []T `json:"items"`
+}
+
+// writeRecords writes records as JSON or YAML
+func writeRecords[T any](out io.Writer, output string, records []T) error {
+	list := recordList[T]{Items: records}
+	var data []byte
+	var err error
+	if output == outputYAML {
+		data, err = yaml.Marshal(list)
+	} else {
+		data, err = json.MarshalIndent(list, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return fmt.Errorf("could not format output as %s: %w", output, err)
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// podRecord is a pod as the get command writes it in JSON and YAML, with the status computed as the pod list shows it
+type podRecord struct {
+	Name           string    `json:"name"`
+	Namespace      string    `json:"namespace"`
+	Status         string    `json:"status"`
+	Phase          string    `json:"phase"`
+	Ready          string    `json:"ready"`
+	Restarts       int       `json:"restarts"`
+	Created        time.Time `json:"created"`
+	Age            string    `json:"age"`
+	IP             string    `json:"ip,omitempty"`
+	Node           string    `json:"node,omitempty"`
+	Images         []string  `json:"images"`
+	QOSClass       string    `json:"qosClass,omitempty"`
+	NominatedNode  string    `json:"nominatedNode,omitempty"`
+	ReadinessGates string    `json:"readinessGates,omitempty"`
+}
+
+// toPodRecord converts a pod model to its record
+func toPodRecord(p models.Pod) podRecord {
+	record := podRecord{
+		Name:           p.Name,
+		Namespace:      p.Namespace,
+		Status:         p.Status,
+		Phase:          p.Phase,
+		Ready:          p.Ready,
+		Restarts:       p.Restarts,
+		Age:            p.FormatAge(),
+		IP:             p.IP,
+		Node:           p.Node,
+		Images:         p.Images,
+		QOSClass:       p.QOSClass,
+		NominatedNode:  p.NominatedNode,
+		ReadinessGates: p.ReadinessGates,
+	}
+	if p.Object != nil {
+		record.Created = p.Object.CreationTimestamp.Time.UTC()
+	}
+	return record
+}
+
+// deploymentRecord is a deployment as the get command writes it in JSON and YAML
+type deploymentRecord struct {
+	Name       string    `json:"name"`
+	Namespace  string    `json:"namespace"`
+	Status     string    `json:"status"`
+	Ready      string    `json:"ready"`
+	UpToDate   int       `json:"upToDate"`
+	Available  int       `json:"available"`
+	Created    time.Time `json:"created"`
+	Age        string    `json:"age"`
+	Strategy   string    `json:"strategy"`
+	Image      string    `json:"image"`
+	Containers []string  `json:"containers"`
+	Selector   string    `json:"selector"`
+}
+
+// toDeploymentRecord converts a deployment model to its record
+func toDeploymentRecord(d models.Deployment) deploymentRecord {
+	record := deploymentRecord{
+		Name:       d.Name,
+		Namespace:  d.Namespace,
+		Status:     d.Status,
+		Ready:      d.Ready,
+		UpToDate:   d.UpToDate,
+		Available:  d.Available,
+		Age:        d.FormatAge(),
+		Strategy:   d.Strategy,
+		Image:      d.Image,
+		Containers: d.Containers,
+		Selector:   d.Selector,
+	}
+	if d.Object != nil {
+		record.Created = d.Object.CreationTimestamp.Time.UTC()
+	}
+	return record
+}
+
+// nodeRecord is a node as the get command writes it in JSON and YAML
+type nodeRecord struct {
+	Name              string    `json:"name"`
+	Status            string    `json:"status"`
+	Unschedulable     bool      `json:"unschedulable"`
+	Pressure          []string  `json:"pressure"`
+	Roles             []string  `json:"roles"`
+	Version           string    `json:"version"`
+	Created           time.Time `json:"created"`
+	Age               string    `json:"age"`
+	CPUAllocatable    string    `json:"cpuAllocatable"`
+	MemoryAllocatable string    `json:"memoryAllocatable"`
+	PodCapacity       string    `json:"podCapacity"`
+	Pods              int       `json:"pods"`
+	InternalIP        string    `json:"internalIP,omitempty"`
+	OSImage           string    `json:"osImage"`
+	ContainerRuntime  string    `json:"containerRuntime"`
+	Taints            []string  `json:"taints"`
+}
+
+// toNodeRecord converts a node model to its record
+func toNodeRecord(n models.Node) nodeRecord {
+	record := nodeRecord{
+		Name:              n.Name,
+		Status:            n.Status,
+		Unschedulable:     n.Unschedulable,
+		Pressure:          nonNil(n.Pressure),
+		Roles:             nonNil(n.Roles),
+		Version:           n.Version,
+		Age:               n.FormatAge(),
+		CPUAllocatable:    n.CPUAllocatable,
+		MemoryAllocatable: n.MemoryAllocatable,
+		PodCapacity:       n.PodCapacity,
+		Pods:              n.Pods,
+		InternalIP:        n.InternalIP,
+		OSImage:           n.OSImage,
+		ContainerRuntime:  n.Runtime,
+		Taints:            nonNil(n.Taints),
+	}
+	if n.Object != nil {
+		record.Created = n.Object.CreationTimestamp.Time.UTC()
+	}
+	return record
+}
+
+// nonNil returns values, or an empty list in its place, so that lists are written as [] rather than null
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
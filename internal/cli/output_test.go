@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestOutput(t *testing.T) {
+	created := metav1.NewTime(time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC))
+	pod := models.ToPodModel(v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "shop", CreationTimestamp: created},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "web", Image: "nginx:1.27"}}},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:         "web",
+				RestartCount: 4,
+				State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	})
+	pods := []models.Pod{pod}
+
+	t.Run("should_write_a_plain_table_with_the_computed_status", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printPods(&out, outputTable, pods))
+
+		lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		require.Len(t, lines, 2)
+		assert.True(t, strings.HasPrefix(lines[0], "NAME"), lines[0])
+		assert.Contains(t, lines[1], "web-1")
+		assert.Contains(t, lines[1], "CrashLoopBackOff")
+		assert.NotContains(t, out.String(), "\x1b[", "tables are written without colors")
+		for _, line := range lines {
+			assert.Equal(t, strings.TrimRight(line, " "), line)
+		}
+	})
+
+	t.Run("should_write_json_items", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printPods(&out, outputJSON, pods))
+
+		var list recordList[podRecord]
+		require.NoError(t, json.Unmarshal(out.Bytes(), &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "web-1", list.Items[0].Name)
+		assert.Equal(t, "shop", list.Items[0].Namespace)
+		assert.Equal(t, "CrashLoopBackOff", list.Items[0].Status)
+		assert.Equal(t, "Running", list.Items[0].Phase)
+		assert.Equal(t, "0/1", list.Items[0].Ready)
+		assert.Equal(t, 4, list.Items[0].Restarts)
+		assert.Equal(t, created.Time, list.Items[0].Created)
+	})
+
+	t.Run("should_write_yaml_items", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printPods(&out, outputYAML, pods))
+		assert.True(t, strings.HasPrefix(out.String(), "items:\n- "), out.String())
+
+		var list recordList[podRecord]
+		require.NoError(t, yaml.Unmarshal(out.Bytes(), &list))
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "CrashLoopBackOff", list.Items[0].Status)
+	})
+
+	t.Run("should_write_empty_lists_as_lists", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printNodes(&out, outputJSON, nil))
+		assert.JSONEq(t, `{"items": []}`, out.String())
+
+		out.Reset()
+		require.NoError(t, printNodes(&out, outputJSON, []models.Node{{Name: "worker-1", Status: "Ready"}}))
+		assert.Contains(t, out.String(), `"taints": []`)
+	})
+
+	t.Run("should_reject_unknown_output_formats", func(t *testing.T) {
+		assert.NoError(t, validateOutput(outputYAML))
+		assert.EqualError(t, validateOutput("wide"), `unknown output format "wide" (expected one of: table, json, yaml)`)
+	})
+
+	t.Run("should_pick_the_only_namespace_a_name_is_found_in", func(t *testing.T) {
+		namespace, err := pickNamespace("pod", "web-1", []string{"shop"})
+		require.NoError(t, err)
+		assert.Equal(t, "shop", namespace)
+
+		_, err = pickNamespace("pod", "web-1", nil)
+		assert.EqualError(t, err, "pod web-1 not found in any namespace")
+
+		_, err = pickNamespace("pod", "web-1", []string{"shop", "staging"})
+		assert.EqualError(t, err, "pod web-1 found in namespaces shop, staging; choose one with --namespace")
+	})
+}
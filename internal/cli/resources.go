@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// Resources the commands support, named as the command bar names their views
+const (
+	resourcePods        = "pods"
+	resourceDeployments = "deployments"
+	resourceNodes       = "nodes"
+)
+
+// resourceAliases maps the names a resource can be given on the command line, as kubectl accepts them
+var resourceAliases = map[string]string{
+	"pods": resourcePods, "pod": resourcePods, "po": resourcePods,
+	"deployments": resourceDeployments, "deployment": resourceDeployments, "deploy": resourceDeployments,
+	"nodes": resourceNodes, "node": resourceNodes, "no": resourceNodes,
+}
+
+// resolveResource returns the resource a command line name refers to
+func resolveResource(name string) (string, error) {
+	if resource, ok := resourceAliases[name]; ok {
+		return resource, nil
+	}
+	return "", fmt.Errorf("unknown resource %q (expected one of: %s, %s, %s)", name, resourcePods, resourceDeployments, resourceNodes)
+}
+
+// namespaceScope is the namespace chosen by the --namespace and --all-namespaces flags
+type namespaceScope struct {
+	namespace     string
+	allNamespaces bool
+}
+
+// addFlags adds the namespace flags to a command's flags
+func (s *namespaceScope) addFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&s.namespace, "namespace", "n", "", "Namespace to use; defaults to the configured namespace, or all namespaces")
+	flags.BoolVarP(&s.allNamespaces, "all-namespaces", "A", false, "Use all namespaces, even when one is configured")
+}
@@ -0,0 +1,22 @@
+// Package cli is the command line of vigilant: without a command it starts the TUI, and its commands print the same
+// views of the cluster as plain text, JSON or YAML for scripts and CI.
+package cli
+
+import "github.com/spf13/cobra"
+
+// NewRootCommand creates the vigilant command, which starts the TUI with runTUI when no command is given. resources
+// are the views the TUI registers, which the config file is validated against.
+func NewRootCommand(runTUI func() error, resources []string) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "vigilant",
+		Short: "A terminal UI for Kubernetes; run without a command to start it",
+		Args:  cobra.NoArgs,
+		// Errors such as an unreachable cluster are not usage errors, so they are reported without the usage
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTUI()
+		},
+	}
+	root.AddCommand(newGetCommand(resources), newDescribeCommand(resources))
+	return root
+}
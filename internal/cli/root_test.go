@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRootCommand(t *testing.T) {
+	// newRoot builds the root command, counting how often it starts the TUI
+	newRoot := func(started *int, args ...string) (*bytes.Buffer, error) {
+		root := NewRootCommand(func() error {
+			*started++
+			return nil
+		}, nil)
+		var out bytes.Buffer
+		root.SetOut(&out)
+		root.SetErr(&out)
+		root.SetArgs(args)
+		return &out, root.Execute()
+	}
+
+	t.Run("should_start_the_tui_without_a_command", func(t *testing.T) {
+		started := 0
+		_, err := newRoot(&started)
+		require.NoError(t, err)
+		assert.Equal(t, 1, started)
+	})
+
+	t.Run("should_report_unknown_commands", func(t *testing.T) {
+		started := 0
+		_, err := newRoot(&started, "delete", "pods")
+		assert.ErrorContains(t, err, `unknown command "delete" for "vigilant"`)
+		assert.Zero(t, started)
+	})
+
+	t.Run("should_check_arguments_and_flags_before_connecting", func(t *testing.T) {
+		started := 0
+		_, err := newRoot(&started, "get")
+		assert.EqualError(t, err, "accepts 1 arg(s), received 0")
+
+		_, err = newRoot(&started, "get", "pods", "--watch")
+		assert.EqualError(t, err, "unknown flag: --watch")
+
+		_, err = newRoot(&started, "describe", "pod")
+		assert.EqualError(t, err, "accepts 2 arg(s), received 1")
+		assert.Zero(t, started)
+	})
+
+	t.Run("should_print_usage_for_help", func(t *testing.T) {
+		started := 0
+		out, err := newRoot(&started, "get", "--help")
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "vigilant get RESOURCE")
+		assert.Contains(t, out.String(), "-o, --output string")
+		assert.Zero(t, started)
+	})
+
+	t.Run("should_resolve_resource_aliases", func(t *testing.T) {
+		for name, expected := range map[string]string{"po": resourcePods, "deploy": resourceDeployments, "node": resourceNodes} {
+			resource, err := resolveResource(name)
+			require.NoError(t, err)
+			assert.Equal(t, expected, resource)
+		}
+
+		_, err := resolveResource("secrets")
+		assert.EqualError(t, err, `unknown resource "secrets" (expected one of: pods, deployments, nodes)`)
+	})
+}
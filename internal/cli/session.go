@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/config"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"golang.org/x/term"
+	"k8s.io/client-go/kubernetes"
+)
+
+// defaultWidth is the width descriptions are rendered at when the output is not a terminal
+const defaultWidth = 100
+
+// session is the client, settings and theme a command runs with, loaded from the kubeconfig and config file as the
+// TUI loads them
+type session struct {
+	clientset *kubernetes.Clientset
+	settings  config.Settings
+	theme     *theme.Theme
+}
+
+// newSession connects to the current kubeconfig context and applies the config file's settings for it
+func newSession(resources []string) (*session, error) {
+	clientset, contextName, err := models.NewClientSet()
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+
+	configPath, err := config.Path()
+	if err != nil {
+		return nil, fmt.Errorf("error locating config file: %v", err)
+	}
+	cfg, err := config.Load(configPath, resources)
+	if err != nil {
+		return nil, err
+	}
+	settings := cfg.Resolve(contextName)
+	if err := views.SetColumnLayouts(settings.Columns); err != nil {
+		return nil, fmt.Errorf("error applying columns from config %s: %v", configPath, err)
+	}
+
+	selected, err := loadTheme(settings)
+	if err != nil {
+		return nil, fmt.Errorf("error applying theme from config %s: %v", configPath, err)
+	}
+	return &session{clientset: clientset, settings: settings, theme: selected}, nil
+}
+
+// loadTheme loads the configured theme for the color profile of the output, which has no color when it is piped
+func loadTheme(settings config.Settings) (*theme.Theme, error) {
+	profile, err := theme.ParseColorProfile(settings.ColorProfile, theme.DetectColorProfile())
+	if err != nil {
+		return nil, err
+	}
+	lipgloss.SetColorProfile(profile)
+
+	themesDir, err := config.ThemesDir()
+	if err != nil {
+		return nil, err
+	}
+	registry := theme.NewRegistry(profile)
+	if err := registry.LoadDir(themesDir); err != nil {
+		return nil, err
+	}
+	return registry.Get(settings.Theme)
+}
+
+// namespace returns the namespace a command is scoped to: the one chosen by flags, or else the configured one.
+// Empty means all namespaces.
+func (s *session) namespace(scope namespaceScope) string {
+	switch {
+	case scope.allNamespaces:
+		return ""
+	case scope.namespace != "":
+		return scope.namespace
+	default:
+		return s.settings.Namespace
+	}
+}
+
+// outputWidth returns the width of the terminal output is written to, or defaultWidth when it is not a terminal
+func outputWidth() int {
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return defaultWidth
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kevholditch/vigilant/internal/expression"
+)
+
+// Column is a column of a resource list view. A built-in column is named by its header; a custom column also sets
+// one of Label, Annotation, JSONPath or CEL to fill its cells from the resource. In the config file a built-in column can
+// be written as just its name.
+type Column struct {
+	// Name is the header of the column, matched case-insensitively against the built-in columns
+	Name string `json:"name"`
+	// Width fixes the width of the column, truncating longer values; 0 sizes it to its contents
+	Width int `json:"width,omitempty"`
+	// Label fills the cells with the value of the label with this key
+	Label string `json:"label,omitempty"`
+	// Annotation fills the cells with the value of the annotation with this key
+	Annotation string `json:"annotation,omitempty"`
+	// JSONPath fills the cells with the result of a kubectl JSONPath expression, such as .spec.containers[*].image
+	JSONPath string `json:"jsonPath,omitempty"`
+	// CEL fills the cells with the result of a CEL expression, such as metadata.labels['team']
+	CEL string `json:"cel,omitempty"`
+}
+
+// UnmarshalJSON accepts a column written as its name as well as a column object
+func (c *Column) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*c = Column{Name: name}
+		return nil
+	}
+
+	// The alias drops this method, so the object is decoded field by field; unknown fields are rejected as
+	// elsewhere in the config
+	type column Column
+	var decoded column
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&decoded); err != nil {
+		return err
+	}
+	*c = Column(decoded)
+	return nil
+}
+
+// Custom reports whether the column is filled from a label, annotation or expression
+func (c Column) Custom() bool {
+	return c.Label != "" || c.Annotation != "" || c.JSONPath != "" || c.CEL != ""
+}
+
+// Expression returns the language and source of the expression filling the column, if it has one
+func (c Column) Expression() (expression.Language, string, bool) {
+	switch {
+	case c.JSONPath != "":
+		return expression.JSONPath, c.JSONPath, true
+	case c.CEL != "":
+		return expression.CEL, c.CEL, true
+	}
+	return "", "", false
+}
+
+// validateColumns checks that column sets are keyed by resource and list each column once. Whether a built-in
+// column exists is checked when the columns are applied, as the columns are defined by the views.
+func validateColumns(field string, columns map[string][]Column, resources []string) []string {
+	var problems []string
+	for _, resource := range sortedKeys(columns) {
+		resourceField := fmt.Sprintf("%s.%s", field, resource)
+		if !contains(resources, resource) {
+			problems = append(problems, fmt.Sprintf("%s: unknown resource %q (expected one of: %s)", resourceField, resource, strings.Join(sortedCopy(resources), ", ")))
+			continue
+		}
+		if len(columns[resource]) == 0 {
+			problems = append(problems, fmt.Sprintf("%s: must list at least one column", resourceField))
+		}
+		seen := make(map[string]bool)
+		for i, column := range columns[resource] {
+			columnField := fmt.Sprintf("%s[%d]", resourceField, i)
+			name := strings.ToUpper(strings.TrimSpace(column.Name))
+			switch {
+			case name == "":
+				problems = append(problems, fmt.Sprintf("%s: column name must not be empty", columnField))
+			case seen[name]:
+				problems = append(problems, fmt.Sprintf("%s: column %q is listed more than once", columnField, column.Name))
+			}
+			seen[name] = true
+			problems = append(problems, validateColumn(columnField, column)...)
+		}
+	}
+	return problems
+}
+
+// validateColumn checks the width and source of a column
+func validateColumn(field string, column Column) []string {
+	var problems []string
+	if column.Width < 0 {
+		problems = append(problems, fmt.Sprintf("%s.width: must be 0 (sized to its contents) or a positive number of characters, got %d", field, column.Width))
+	}
+
+	sources := 0
+	for _, source := range []string{column.Label, column.Annotation, column.JSONPath, column.CEL} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		problems = append(problems, fmt.Sprintf("%s: set only one of label, annotation, jsonPath and cel", field))
+		return problems
+	}
+
+	if language, source, ok := column.Expression(); ok {
+		if _, err := expression.Compile(language, source); err != nil {
+			problems = append(problems, fmt.Sprintf("%s.%s: %v", field, language, err))
+		}
+	}
+	return problems
+}
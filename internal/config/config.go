@@ -0,0 +1,306 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kevholditch/vigilant/internal/theme"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultStartupResource is the resource view shown at startup when the config does not choose one
+const DefaultStartupResource = "pods"
+
+// ClusterGroupOff is the cluster selection that leaves the multi-cluster views, so it cannot name a cluster group
+const ClusterGroupOff = "off"
+
+// DefaultColorProfile detects the color profile of the terminal
+const DefaultColorProfile = "auto"
+
+// Config is the contents of the vigilant config file
+type Config struct {
+	// StartupResource is the resource view shown at startup, such as "pods" or "deployments"
+	StartupResource string `json:"startupResource,omitempty"`
+	// Namespace is the namespace the resource views are scoped to at startup; empty for all namespaces
+	Namespace string `json:"namespace,omitempty"`
+	// ReadOnly disables actions that change the cluster or expose sensitive data
+	ReadOnly bool      `json:"readOnly,omitempty"`
+	Logs     LogConfig `json:"logs,omitempty"`
+	// Theme names a built-in theme or one from the themes directory
+	Theme string `json:"theme,omitempty"`
+	// ColorProfile overrides the detected terminal color profile: auto, truecolor, 256, 16 or none
+	ColorProfile string `json:"colorProfile,omitempty"`
+	// Columns maps a resource to the columns its list view shows, in order
+	Columns map[string][]Column `json:"columns,omitempty"`
+	// Keys remaps the keys of actions, keyed by view and then action. The views and actions are checked when the
+	// bindings are applied, as they are defined by the controllers rather than the config.
+	Keys map[string]map[string][]string `json:"keys,omitempty"`
+	// ClusterGroups names groups of kubeconfig contexts that the multi-cluster views show together, such as the
+	// regional clusters running the same service
+	ClusterGroups map[string][]string `json:"clusterGroups,omitempty"`
+	// Contexts overrides settings for kubeconfig contexts, keyed by context name
+	Contexts map[string]Overrides `json:"contexts,omitempty"`
+}
+
+// LogConfig controls how pod logs are fetched
+type LogConfig struct {
+	// TailLines is the number of most recent lines fetched; 0 fetches the whole log
+	TailLines int64 `json:"tailLines,omitempty"`
+	// Timestamps prefixes each line with the time it was written
+	Timestamps bool `json:"timestamps,omitempty"`
+}
+
+// Overrides are the settings of one kubeconfig context that differ from the top-level config.
+// Unset fields keep the top-level value; a logs block replaces the top-level logs block as a whole.
+type Overrides struct {
+	StartupResource string `json:"startupResource,omitempty"`
+	// Namespace is a pointer so that an empty string can override a top-level namespace with all namespaces
+	Namespace *string             `json:"namespace,omitempty"`
+	ReadOnly  *bool               `json:"readOnly,omitempty"`
+	Logs      *LogConfig          `json:"logs,omitempty"`
+	Columns   map[string][]Column `json:"columns,omitempty"`
+	Theme     string              `json:"theme,omitempty"`
+}
+
+// Settings are the effective settings for one kubeconfig context
+type Settings struct {
+	StartupResource string
+	Namespace       string
+	ReadOnly        bool
+	Logs            LogConfig
+	Columns         map[string][]Column
+	Theme           string
+	ColorProfile    string
+	Keys            map[string]map[string][]string
+	ClusterGroups   map[string][]string
+}
+
+// ValidationError lists every problem found in a config file, so they can all be fixed in one pass
+type ValidationError struct {
+	Path     string
+	Problems []string
+}
+
+// Error formats the problems one per line under the file they were found in
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid config %s:\n  - %s", e.Path, strings.Join(e.Problems, "\n  - "))
+}
+
+// Default returns the config used when there is no config file
+func Default() *Config {
+	return &Config{StartupResource: DefaultStartupResource, Theme: theme.DefaultThemeName, ColorProfile: DefaultColorProfile}
+}
+
+// Load reads and validates the config file at path against the resources vigilant has views for.
+// A missing file is not an error; the default config is returned.
+func Load(path string, resources []string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Default(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read config %s: %w", path, err)
+	}
+	return Parse(path, data, resources)
+}
+
+// Parse decodes and validates config file contents. Unknown keys are rejected so that typos do not go unnoticed.
+func Parse(path string, data []byte, resources []string) (*Config, error) {
+	cfg := Default()
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, &ValidationError{Path: path, Problems: []string{cleanDecodeError(err)}}
+	}
+	if problems := cfg.validate(resources); len(problems) > 0 {
+		return nil, &ValidationError{Path: path, Problems: problems}
+	}
+	return cfg, nil
+}
+
+// cleanDecodeError strips the layers of decoder prefixes from a YAML decoding error
+func cleanDecodeError(err error) string {
+	message := err.Error()
+	for _, prefix := range []string{"error converting YAML to JSON: ", "error unmarshaling JSON: ", "while decoding JSON: ", "json: "} {
+		message = strings.TrimPrefix(message, prefix)
+	}
+	return message
+}
+
+// validate checks the config against its schema and returns a description of each problem, prefixed with its field
+func (c *Config) validate(resources []string) []string {
+	var problems []string
+	problems = append(problems, validateStartupResource("startupResource", c.StartupResource, resources)...)
+	problems = append(problems, validateNamespace("namespace", c.Namespace)...)
+	problems = append(problems, validateLogs("logs", c.Logs)...)
+	problems = append(problems, validateColumns("columns", c.Columns, resources)...)
+	problems = append(problems, validateTheme("theme", c.Theme)...)
+	problems = append(problems, validateClusterGroups("clusterGroups", c.ClusterGroups)...)
+	if !contains(theme.ColorProfiles, c.ColorProfile) {
+		problems = append(problems, fmt.Sprintf("colorProfile: unknown color profile %q (expected one of: %s)", c.ColorProfile, strings.Join(theme.ColorProfiles, ", ")))
+	}
+
+	for _, name := range sortedKeys(c.Contexts) {
+		overrides := c.Contexts[name]
+		field := fmt.Sprintf("contexts.%s", name)
+		if overrides.StartupResource != "" {
+			problems = append(problems, validateStartupResource(field+".startupResource", overrides.StartupResource, resources)...)
+		}
+		if overrides.Namespace != nil {
+			problems = append(problems, validateNamespace(field+".namespace", *overrides.Namespace)...)
+		}
+		if overrides.Logs != nil {
+			problems = append(problems, validateLogs(field+".logs", *overrides.Logs)...)
+		}
+		problems = append(problems, validateColumns(field+".columns", overrides.Columns, resources)...)
+		if overrides.Theme != "" {
+			problems = append(problems, validateTheme(field+".theme", overrides.Theme)...)
+		}
+	}
+	return problems
+}
+
+// validateStartupResource checks that a startup resource names a resource view
+func validateStartupResource(field, resource string, resources []string) []string {
+	if !contains(resources, resource) {
+		return []string{fmt.Sprintf("%s: unknown resource %q (expected one of: %s)", field, resource, strings.Join(sortedCopy(resources), ", "))}
+	}
+	return nil
+}
+
+// validateNamespace checks that a namespace, when set, is a valid namespace name
+func validateNamespace(field, namespace string) []string {
+	if namespace == "" {
+		return nil
+	}
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return []string{fmt.Sprintf("%s: %q is not a valid namespace name: %s", field, namespace, strings.Join(errs, "; "))}
+	}
+	return nil
+}
+
+// validateTheme checks that a theme is named. Whether the theme exists is checked when it is applied, as themes are
+// loaded from files that can change independently of the config.
+func validateTheme(field, name string) []string {
+	if strings.TrimSpace(name) == "" {
+		return []string{fmt.Sprintf("%s: must not be empty", field)}
+	}
+	return nil
+}
+
+// validateClusterGroups checks that each cluster group has a name that can be typed in the command bar and lists
+// each of its contexts once. Whether the contexts exist is checked when a group is chosen, as the kubeconfig can
+// change independently of the config.
+func validateClusterGroups(field string, groups map[string][]string) []string {
+	var problems []string
+	for _, name := range sortedKeys(groups) {
+		groupField := fmt.Sprintf("%s.%s", field, name)
+		switch {
+		case strings.TrimSpace(name) == "":
+			problems = append(problems, fmt.Sprintf("%s: group name must not be empty", field))
+			continue
+		case strings.ContainsAny(name, ", \t"):
+			problems = append(problems, fmt.Sprintf("%s: group name must not contain commas or spaces", groupField))
+		case name == ClusterGroupOff:
+			problems = append(problems, fmt.Sprintf("%s: %q is reserved for leaving the multi-cluster views", groupField, ClusterGroupOff))
+		}
+
+		contexts := groups[name]
+		if len(contexts) == 0 {
+			problems = append(problems, fmt.Sprintf("%s: must list at least one context", groupField))
+		}
+		seen := make(map[string]bool, len(contexts))
+		for i, context := range contexts {
+			switch {
+			case strings.TrimSpace(context) == "":
+				problems = append(problems, fmt.Sprintf("%s[%d]: context must not be empty", groupField, i))
+			case seen[context]:
+				problems = append(problems, fmt.Sprintf("%s[%d]: context %q is listed more than once", groupField, i, context))
+			}
+			seen[context] = true
+		}
+	}
+	return problems
+}
+
+// validateLogs checks the log settings
+func validateLogs(field string, logs LogConfig) []string {
+	if logs.TailLines < 0 {
+		return []string{fmt.Sprintf("%s.tailLines: must be 0 (the whole log) or a positive number of lines, got %d", field, logs.TailLines)}
+	}
+	return nil
+}
+
+// Resolve returns the effective settings for the kubeconfig context, applying its overrides to the top-level config
+func (c *Config) Resolve(context string) Settings {
+	settings := Settings{
+		StartupResource: c.StartupResource,
+		Namespace:       c.Namespace,
+		ReadOnly:        c.ReadOnly,
+		Logs:            c.Logs,
+		Columns:         make(map[string][]Column),
+		Theme:           c.Theme,
+		ColorProfile:    c.ColorProfile,
+		Keys:            c.Keys,
+		ClusterGroups:   c.ClusterGroups,
+	}
+	for resource, columns := range c.Columns {
+		settings.Columns[resource] = columns
+	}
+	if settings.StartupResource == "" {
+		settings.StartupResource = DefaultStartupResource
+	}
+
+	overrides, ok := c.Contexts[context]
+	if !ok {
+		return settings
+	}
+	if overrides.StartupResource != "" {
+		settings.StartupResource = overrides.StartupResource
+	}
+	if overrides.Namespace != nil {
+		settings.Namespace = *overrides.Namespace
+	}
+	if overrides.ReadOnly != nil {
+		settings.ReadOnly = *overrides.ReadOnly
+	}
+	if overrides.Logs != nil {
+		settings.Logs = *overrides.Logs
+	}
+	if overrides.Theme != "" {
+		settings.Theme = overrides.Theme
+	}
+	for resource, columns := range overrides.Columns {
+		settings.Columns[resource] = columns
+	}
+	return settings
+}
+
+// contains reports whether values includes value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedCopy returns a sorted copy of values
+func sortedCopy(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// sortedKeys returns the keys of a map in sorted order, so problems are reported in a stable order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,339 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var resources = []string{"pods", "deployments", "nodes", "secrets"}
+
+func TestConfig(t *testing.T) {
+	t.Run("should_return_defaults_when_the_file_does_not_exist", func(t *testing.T) {
+		cfg, err := Load(filepath.Join(t.TempDir(), "config.yaml"), resources)
+		require.NoError(t, err)
+
+		settings := cfg.Resolve("any-context")
+		assert.Equal(t, "pods", settings.StartupResource)
+		assert.Equal(t, "", settings.Namespace)
+		assert.False(t, settings.ReadOnly)
+		assert.Equal(t, LogConfig{}, settings.Logs)
+	})
+
+	t.Run("should_load_settings_from_yaml", func(t *testing.T) {
+		path := writeConfig(t, `
+startupResource: deployments
+namespace: payments
+readOnly: true
+logs:
+  tailLines: 500
+  timestamps: true
+columns:
+  pods: [NAME, STATUS, NODE]
+keys:
+  pods:
+    logs: [L]
+`)
+		cfg, err := Load(path, resources)
+		require.NoError(t, err)
+
+		settings := cfg.Resolve("")
+		assert.Equal(t, "deployments", settings.StartupResource)
+		assert.Equal(t, "payments", settings.Namespace)
+		assert.True(t, settings.ReadOnly)
+		assert.Equal(t, LogConfig{TailLines: 500, Timestamps: true}, settings.Logs)
+		assert.Equal(t, []Column{{Name: "NAME"}, {Name: "STATUS"}, {Name: "NODE"}}, settings.Columns["pods"])
+		assert.Equal(t, map[string][]string{"logs": {"L"}}, settings.Keys["pods"])
+	})
+
+	t.Run("should_apply_overrides_for_the_current_context", func(t *testing.T) {
+		path := writeConfig(t, `
+namespace: payments
+logs:
+  tailLines: 500
+columns:
+  pods: [NAME, STATUS]
+contexts:
+  prod:
+    startupResource: nodes
+    namespace: ""
+    readOnly: true
+    logs:
+      timestamps: true
+    columns:
+      deployments: [NAME, READY]
+`)
+		cfg, err := Load(path, resources)
+		require.NoError(t, err)
+
+		prod := cfg.Resolve("prod")
+		assert.Equal(t, "nodes", prod.StartupResource)
+		assert.Equal(t, "", prod.Namespace)
+		assert.True(t, prod.ReadOnly)
+		assert.Equal(t, LogConfig{Timestamps: true}, prod.Logs)
+		assert.Equal(t, []Column{{Name: "NAME"}, {Name: "STATUS"}}, prod.Columns["pods"])
+		assert.Equal(t, []Column{{Name: "NAME"}, {Name: "READY"}}, prod.Columns["deployments"])
+
+		dev := cfg.Resolve("dev")
+		assert.Equal(t, "pods", dev.StartupResource)
+		assert.Equal(t, "payments", dev.Namespace)
+		assert.False(t, dev.ReadOnly)
+		assert.Equal(t, LogConfig{TailLines: 500}, dev.Logs)
+		assert.NotContains(t, dev.Columns, "deployments")
+	})
+
+	t.Run("should_resolve_the_theme_for_the_current_context", func(t *testing.T) {
+		path := writeConfig(t, `
+theme: solarized
+colorProfile: "256"
+contexts:
+  prod:
+    theme: high-contrast
+`)
+		cfg, err := Load(path, resources)
+		require.NoError(t, err)
+
+		assert.Equal(t, "high-contrast", cfg.Resolve("prod").Theme)
+		assert.Equal(t, "solarized", cfg.Resolve("dev").Theme)
+		assert.Equal(t, "256", cfg.Resolve("prod").ColorProfile)
+		assert.Equal(t, "cyberpunk", Default().Resolve("dev").Theme)
+		assert.Equal(t, "auto", Default().Resolve("dev").ColorProfile)
+	})
+
+	t.Run("should_reject_unknown_color_profiles", func(t *testing.T) {
+		path := writeConfig(t, `
+theme: ""
+colorProfile: mono
+`)
+		_, err := Load(path, resources)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			`theme: must not be empty`,
+			`colorProfile: unknown color profile "mono" (expected one of: auto, truecolor, 256, 16, none)`,
+		}, validationErr.Problems)
+	})
+
+	t.Run("should_reject_unknown_keys", func(t *testing.T) {
+		path := writeConfig(t, `
+startupResource: pods
+startupView: nodes
+`)
+		_, err := Load(path, resources)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), path)
+		assert.Contains(t, err.Error(), `unknown field "startupView"`)
+	})
+
+	t.Run("should_reject_values_of_the_wrong_type", func(t *testing.T) {
+		path := writeConfig(t, `
+logs:
+  tailLines: lots
+`)
+		_, err := Load(path, resources)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tailLines")
+	})
+
+	t.Run("should_report_every_invalid_field", func(t *testing.T) {
+		path := writeConfig(t, `
+startupResource: widgets
+namespace: Not_A_Namespace
+logs:
+  tailLines: -1
+columns:
+  pods: [NAME, name, ""]
+  widgets: [NAME]
+contexts:
+  prod:
+    startupResource: gadgets
+`)
+		_, err := Load(path, resources)
+		require.Error(t, err)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			`startupResource: unknown resource "widgets" (expected one of: deployments, nodes, pods, secrets)`,
+			`namespace: "Not_A_Namespace" is not a valid namespace name: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+			`logs.tailLines: must be 0 (the whole log) or a positive number of lines, got -1`,
+			`columns.pods[1]: column "name" is listed more than once`,
+			`columns.pods[2]: column name must not be empty`,
+			`columns.widgets: unknown resource "widgets" (expected one of: deployments, nodes, pods, secrets)`,
+			`contexts.prod.startupResource: unknown resource "gadgets" (expected one of: deployments, nodes, pods, secrets)`,
+		}, validationErr.Problems)
+	})
+
+	t.Run("should_load_column_objects_with_widths_and_custom_sources", func(t *testing.T) {
+		path := writeConfig(t, `
+columns:
+  pods:
+    - NAME
+    - name: IMAGE
+      width: 40
+    - name: APP
+      label: app.kubernetes.io/name
+    - name: OWNER
+      annotation: example.com/owner
+    - name: PRIORITY
+      jsonPath: .spec.priorityClassName
+    - name: TEAM
+      cel: "metadata.labels['team']"
+`)
+		cfg, err := Load(path, resources)
+		require.NoError(t, err)
+
+		columns := cfg.Resolve("").Columns["pods"]
+		assert.Equal(t, []Column{
+			{Name: "NAME"},
+			{Name: "IMAGE", Width: 40},
+			{Name: "APP", Label: "app.kubernetes.io/name"},
+			{Name: "OWNER", Annotation: "example.com/owner"},
+			{Name: "PRIORITY", JSONPath: ".spec.priorityClassName"},
+			{Name: "TEAM", CEL: "metadata.labels['team']"},
+		}, columns)
+		assert.False(t, columns[1].Custom())
+		assert.True(t, columns[2].Custom())
+		language, source, ok := columns[5].Expression()
+		assert.True(t, ok)
+		assert.Equal(t, expression.CEL, language)
+		assert.Equal(t, "metadata.labels['team']", source)
+	})
+
+	t.Run("should_report_invalid_columns", func(t *testing.T) {
+		path := writeConfig(t, `
+columns:
+  pods:
+    - name: IMAGE
+      width: -1
+    - name: APP
+      label: app
+      annotation: app
+    - name: BROKEN
+      jsonPath: "{.spec.containers[0"
+    - name: UNCHECKED
+      cel: "size(spec.containers) >"
+`)
+		_, err := Load(path, resources)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Len(t, validationErr.Problems, 4)
+		assert.Equal(t, `columns.pods[0].width: must be 0 (sized to its contents) or a positive number of characters, got -1`, validationErr.Problems[0])
+		assert.Equal(t, `columns.pods[1]: set only one of label, annotation, jsonPath and cel`, validationErr.Problems[1])
+		assert.Contains(t, validationErr.Problems[2], `columns.pods[2].jsonPath: `)
+		assert.Contains(t, validationErr.Problems[3], `columns.pods[3].cel: `)
+	})
+
+	t.Run("should_reject_unknown_column_fields", func(t *testing.T) {
+		path := writeConfig(t, `
+columns:
+  pods:
+    - name: APP
+      labl: app
+`)
+		_, err := Load(path, resources)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown field "labl"`)
+	})
+
+	t.Run("should_load_cluster_groups", func(t *testing.T) {
+		path := writeConfig(t, `
+clusterGroups:
+  regions: [eu-west, us-east, ap-south, sa-east]
+`)
+		cfg, err := Load(path, resources)
+		require.NoError(t, err)
+
+		settings := cfg.Resolve("eu-west")
+		assert.Equal(t, map[string][]string{"regions": {"eu-west", "us-east", "ap-south", "sa-east"}}, settings.ClusterGroups)
+	})
+
+	t.Run("should_report_invalid_cluster_groups", func(t *testing.T) {
+		path := writeConfig(t, `
+clusterGroups:
+  "eu west": [eu-west]
+  empty: []
+  "off": [eu-west]
+  regions: [eu-west, "", eu-west]
+`)
+		_, err := Load(path, resources)
+		require.Error(t, err)
+
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			`clusterGroups.empty: must list at least one context`,
+			`clusterGroups.eu west: group name must not contain commas or spaces`,
+			`clusterGroups.off: "off" is reserved for leaving the multi-cluster views`,
+			`clusterGroups.regions[1]: context must not be empty`,
+			`clusterGroups.regions[2]: context "eu-west" is listed more than once`,
+		}, validationErr.Problems)
+	})
+
+	t.Run("should_resolve_the_path_from_the_environment", func(t *testing.T) {
+		t.Setenv("VIGILANT_CONFIG", "")
+		t.Setenv("XDG_CONFIG_HOME", "/etc/xdg-home")
+		path, err := Path()
+		require.NoError(t, err)
+		assert.Equal(t, "/etc/xdg-home/vigilant/config.yaml", path)
+
+		t.Setenv("XDG_CONFIG_HOME", "")
+		t.Setenv("HOME", "/home/someone")
+		path, err = Path()
+		require.NoError(t, err)
+		assert.Equal(t, "/home/someone/.config/vigilant/config.yaml", path)
+
+		t.Setenv("VIGILANT_CONFIG", "/tmp/vigilant.yaml")
+		path, err = Path()
+		require.NoError(t, err)
+		assert.Equal(t, "/tmp/vigilant.yaml", path)
+	})
+}
+
+func TestWatcher(t *testing.T) {
+	t.Run("should_return_when_the_file_is_created", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		watcher := NewWatcher(path, 10*time.Millisecond)
+
+		require.NoError(t, os.WriteFile(path, []byte("readOnly: true\n"), 0o644))
+		waitForChange(t, watcher)
+	})
+
+	t.Run("should_return_when_the_file_is_changed", func(t *testing.T) {
+		path := writeConfig(t, "readOnly: true\n")
+		watcher := NewWatcher(path, 10*time.Millisecond)
+
+		require.NoError(t, os.WriteFile(path, []byte("readOnly: false\n"), 0o644))
+		waitForChange(t, watcher)
+	})
+}
+
+// writeConfig writes a config file with the contents to a temporary directory and returns its path
+func writeConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+	return path
+}
+
+// waitForChange fails the test if the watcher does not see a change within a second
+func waitForChange(t *testing.T, watcher *Watcher) {
+	t.Helper()
+	changed := make(chan struct{})
+	go func() {
+		watcher.Wait()
+		close(changed)
+	}()
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("watcher did not see the change")
+	}
+}
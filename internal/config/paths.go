@@ -0,0 +1,43 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Path returns the location of the config file. VIGILANT_CONFIG names the file directly; otherwise it is
+// config.yaml in the config directory.
+func Path() (string, error) {
+	if path := os.Getenv("VIGILANT_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "config.yaml"), nil
+}
+
+// ThemesDir returns the directory theme files are loaded from, themes in the config directory
+func ThemesDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "themes"), nil
+}
+
+// Dir returns the config directory, vigilant under $XDG_CONFIG_HOME, which defaults to ~/.config as the XDG base
+// directory spec describes
+func Dir() (string, error) {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" || !filepath.IsAbs(configHome) {
+		// The spec says relative values are invalid and should be ignored
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, "vigilant"), nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// Watcher detects changes to the config file by polling its modification time and size.
+// Polling also notices the file being created or removed, and works across editors that replace the file on save.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	modTime  time.Time
+	size     int64
+	exists   bool
+}
+
+// NewWatcher creates a watcher for the file at path, treating its current state as unchanged
+func NewWatcher(path string, interval time.Duration) *Watcher {
+	w := &Watcher{path: path, interval: interval}
+	w.modTime, w.size, w.exists = w.stat()
+	return w
+}
+
+// Path returns the path of the watched file
+func (w *Watcher) Path() string {
+	return w.path
+}
+
+// Wait blocks until the file has changed since the last call, then returns
+func (w *Watcher) Wait() {
+	for {
+		time.Sleep(w.interval)
+		modTime, size, exists := w.stat()
+		if exists != w.exists || size != w.size || !modTime.Equal(w.modTime) {
+			w.modTime, w.size, w.exists = modTime, size, exists
+			return
+		}
+	}
+}
+
+// stat returns the modification time and size of the file and whether it exists
+func (w *Watcher) stat() (time.Time, int64, bool) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+	return info.ModTime(), info.Size(), true
+}
@@ -7,8 +7,16 @@ import (
 
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -136,6 +144,236 @@ func (cb *ClusterBuilder) WithPod(name, namespace string) *ClusterBuilder {
 	return cb
 }
 
+// WithoutPod deletes the pod with the given name and namespace
+func (cb *ClusterBuilder) WithoutPod(name, namespace string) *ClusterBuilder {
+	err := cb.clientset.CoreV1().Pods(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithPodOnNode creates a pod with the given name and namespace scheduled on the given node
+func (cb *ClusterBuilder) WithPodOnNode(name, namespace, nodeName string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: "c", Image: "busybox"}},
+		},
+	}
+	_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithResourcedPod creates a pod whose single container requests and is limited to the given cpu and memory quantities
+func (cb *ClusterBuilder) WithResourcedPod(name, namespace, cpuRequest, memoryRequest, cpuLimit, memoryLimit string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "app",
+				Image: "busybox",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpuRequest),
+						corev1.ResourceMemory: resource.MustParse(memoryRequest),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpuLimit),
+						corev1.ResourceMemory: resource.MustParse(memoryLimit),
+					},
+				},
+			}},
+		},
+	}
+	_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithOwnedPodOnNode creates a pod on the given node controlled by an owner of the given kind, such as a DaemonSet
+func (cb *ClusterBuilder) WithOwnedPodOnNode(name, namespace, nodeName, ownerKind, ownerName string, volumes ...corev1.Volume) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	isController := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       ownerKind,
+				Name:       ownerName,
+				UID:        types.UID(ownerName + "-uid"),
+				Controller: &isController,
+			}},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: "c", Image: "busybox"}},
+			Volumes:    volumes,
+		},
+	}
+	_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithService creates a ClusterIP service selecting pods by the given labels and exposing port 80
+func (cb *ClusterBuilder) WithService(name, namespace string, selector map[string]string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: selector,
+			Ports: []corev1.ServicePort{{
+				Name:       "http",
+				Protocol:   corev1.ProtocolTCP,
+				Port:       80,
+				TargetPort: intstr.FromInt32(8080),
+			}},
+		},
+	}
+	_, err := cb.clientset.CoreV1().Services(namespace).Create(context.TODO(), service, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithServiceEndpoint publishes an EndpointSlice for the service with a single address backed by the named pod,
+// as the endpoint slice controller would
+func (cb *ClusterBuilder) WithServiceEndpoint(serviceName, namespace, address, podName string, ready bool) *ClusterBuilder {
+	port := int32(8080)
+	protocol := corev1.ProtocolTCP
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName + "-" + podName,
+			Namespace: namespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: serviceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{{
+			Addresses:  []string{address},
+			Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+			TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: podName, Namespace: namespace},
+		}},
+		Ports: []discoveryv1.EndpointPort{{Port: &port, Protocol: &protocol}},
+	}
+	_, err := cb.clientset.DiscoveryV1().EndpointSlices(namespace).Create(context.TODO(), slice, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithIngressPath routes host/path of the named ingress to a service port, creating the ingress on first use
+func (cb *ClusterBuilder) WithIngressPath(ingressName, namespace, host, path, serviceName string, servicePort int32) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	pathType := networkingv1.PathTypePrefix
+	ingressPath := networkingv1.HTTPIngressPath{
+		Path:     path,
+		PathType: &pathType,
+		Backend: networkingv1.IngressBackend{
+			Service: &networkingv1.IngressServiceBackend{
+				Name: serviceName,
+				Port: networkingv1.ServiceBackendPort{Number: servicePort},
+			},
+		},
+	}
+
+	ingresses := cb.clientset.NetworkingV1().Ingresses(namespace)
+	ingress, err := ingresses.Get(context.TODO(), ingressName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ingress = &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ingressName,
+				Namespace: namespace,
+			},
+		}
+	} else {
+		require.NoError(cb.t, err)
+	}
+
+	added := false
+	for i := range ingress.Spec.Rules {
+		if ingress.Spec.Rules[i].Host == host {
+			ingress.Spec.Rules[i].HTTP.Paths = append(ingress.Spec.Rules[i].HTTP.Paths, ingressPath)
+			added = true
+		}
+	}
+	if !added {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{
+			Host: host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{ingressPath}},
+			},
+		})
+	}
+
+	if ingress.ResourceVersion == "" {
+		_, err = ingresses.Create(context.TODO(), ingress, metav1.CreateOptions{})
+	} else {
+		_, err = ingresses.Update(context.TODO(), ingress, metav1.UpdateOptions{})
+	}
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithConfigMap creates a config map with the given data
+func (cb *ClusterBuilder) WithConfigMap(name, namespace string, data map[string]string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: data,
+	}
+	_, err := cb.clientset.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithSecret creates an Opaque secret with the given data
+func (cb *ClusterBuilder) WithSecret(name, namespace string, data map[string]string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: data,
+	}
+	_, err := cb.clientset.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithNodeConditions replaces the status conditions of the named node
+func (cb *ClusterBuilder) WithNodeConditions(nodeName string, conditions ...corev1.NodeCondition) *ClusterBuilder {
+	node, err := cb.clientset.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+	node.Status.Conditions = conditions
+	_, err = cb.clientset.CoreV1().Nodes().UpdateStatus(context.TODO(), node, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
 // WithDeployment creates a deployment with the given name and namespace
 func (cb *ClusterBuilder) WithDeployment(name, namespace string) *ClusterBuilder {
 	// Create namespace if it doesn't exist
@@ -182,6 +420,570 @@ func (cb *ClusterBuilder) WithDeployment(name, namespace string) *ClusterBuilder
 	return cb
 }
 
+// WithDeploymentPods creates a ReplicaSet controlled by the named deployment and count pods controlled by that ReplicaSet,
+// standing in for the deployment and replicaset controllers which envtest does not run
+func (cb *ClusterBuilder) WithDeploymentPods(deploymentName, namespace, templateHash string, count int) *ClusterBuilder {
+	deployment, err := cb.clientset.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+
+	podLabels := map[string]string{
+		"app":                                  deploymentName,
+		appsv1.DefaultDeploymentUniqueLabelKey: templateHash,
+	}
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%s", deploymentName, templateHash),
+			Namespace:       namespace,
+			Labels:          podLabels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: int32Ptr(int32(count)),
+			Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec:       deployment.Spec.Template.Spec,
+			},
+		},
+	}
+	replicaSet, err = cb.clientset.AppsV1().ReplicaSets(namespace).Create(context.TODO(), replicaSet, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+
+	for i := 1; i <= count; i++ {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            fmt.Sprintf("%s-%d", replicaSet.Name, i),
+				Namespace:       namespace,
+				Labels:          podLabels,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(replicaSet, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+			},
+			Spec: deployment.Spec.Template.Spec,
+		}
+		_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+		require.NoError(cb.t, err)
+	}
+	return cb
+}
+
+// WithStatefulSet creates a stateful set of the given replicas whose rolling updates are held back by partition,
+// with a volume claim template named data
+func (cb *ClusterBuilder) WithStatefulSet(name, namespace string, replicas, partition int32) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	podLabels := map[string]string{"app": name}
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    int32Ptr(replicas),
+			ServiceName: name,
+			Selector:    &metav1.LabelSelector{MatchLabels: podLabels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "app", Image: "postgres:16"}},
+				},
+			},
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: int32Ptr(partition)},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: "data"},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+			}},
+		},
+	}
+	_, err := cb.clientset.AppsV1().StatefulSets(namespace).Create(context.TODO(), statefulSet, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithStatefulSetPod creates the pod of the given ordinal of the named stateful set at a revision,
+// standing in for the stateful set controller which envtest does not run
+func (cb *ClusterBuilder) WithStatefulSetPod(statefulSetName, namespace string, ordinal int, revision string) *ClusterBuilder {
+	statefulSet, err := cb.clientset.AppsV1().StatefulSets(namespace).Get(context.TODO(), statefulSetName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+
+	podLabels := map[string]string{
+		"app":                           statefulSetName,
+		appsv1.StatefulSetRevisionLabel: revision,
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%d", statefulSetName, ordinal),
+			Namespace:       namespace,
+			Labels:          podLabels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(statefulSet, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))},
+		},
+		Spec: statefulSet.Spec.Template.Spec,
+	}
+	_, err = cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithDaemonSet creates a daemon set whose pods run on the nodes matching nodeSelector
+func (cb *ClusterBuilder) WithDaemonSet(name, namespace string, nodeSelector map[string]string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	podLabels := map[string]string{"app": name}
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+				Spec: corev1.PodSpec{
+					NodeSelector: nodeSelector,
+					Containers:   []corev1.Container{{Name: "agent", Image: "fluent-bit:3"}},
+				},
+			},
+		},
+	}
+	_, err := cb.clientset.AppsV1().DaemonSets(namespace).Create(context.TODO(), daemonSet, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithDaemonSetPod creates a pod of the named daemon set on the given node,
+// standing in for the daemon set controller which envtest does not run
+func (cb *ClusterBuilder) WithDaemonSetPod(daemonSetName, namespace, nodeName string) *ClusterBuilder {
+	daemonSet, err := cb.clientset.AppsV1().DaemonSets(namespace).Get(context.TODO(), daemonSetName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            daemonSetName + "-" + nodeName,
+			Namespace:       namespace,
+			Labels:          daemonSet.Spec.Template.Labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(daemonSet, appsv1.SchemeGroupVersion.WithKind("DaemonSet"))},
+		},
+		Spec: daemonSet.Spec.Template.Spec,
+	}
+	pod.Spec.NodeName = nodeName
+	_, err = cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithJob creates a job that runs completions pods, retrying failed pods up to backoffLimit times
+func (cb *ClusterBuilder) WithJob(name, namespace string, completions, backoffLimit int32) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: batchv1.JobSpec{
+			Completions:  int32Ptr(completions),
+			BackoffLimit: int32Ptr(backoffLimit),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{{Name: "task", Image: "busybox"}},
+				},
+			},
+		},
+	}
+	_, err := cb.clientset.BatchV1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithFailedJobPod creates a pod of the named job whose container exited with the given reason and exit code,
+// standing in for the job controller and kubelet which envtest does not run
+func (cb *ClusterBuilder) WithFailedJobPod(jobName, namespace, podName, reason string, exitCode int32) *ClusterBuilder {
+	job, err := cb.clientset.BatchV1().Jobs(namespace).Get(context.TODO(), jobName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            podName,
+			Namespace:       namespace,
+			Labels:          job.Spec.Template.Labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job"))},
+		},
+		Spec: job.Spec.Template.Spec,
+	}
+	created, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+
+	created.Status.Phase = corev1.PodFailed
+	created.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:  "task",
+		Image: "busybox",
+		State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: reason, ExitCode: exitCode},
+		},
+	}}
+	_, err = cb.clientset.CoreV1().Pods(namespace).UpdateStatus(context.TODO(), created, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithCronJob creates a cron job running a busybox job on the given schedule
+func (cb *ClusterBuilder) WithCronJob(name, namespace, schedule string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: schedule,
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyOnFailure,
+							Containers:    []corev1.Container{{Name: "task", Image: "busybox"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	_, err := cb.clientset.BatchV1().CronJobs(namespace).Create(context.TODO(), cronJob, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithoutCronJob deletes a cron job, leaving the jobs it created behind as envtest runs no garbage collector
+func (cb *ClusterBuilder) WithoutCronJob(name, namespace string) *ClusterBuilder {
+	err := cb.clientset.BatchV1().CronJobs(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithCronJobJob creates a job controlled by the named cron job from its job template, as the cron job controller would
+func (cb *ClusterBuilder) WithCronJobJob(cronJobName, namespace, jobName string) *ClusterBuilder {
+	cronJob, err := cb.clientset.BatchV1().CronJobs(namespace).Get(context.TODO(), cronJobName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            jobName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+	_, err = cb.clientset.BatchV1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithPodUsingConfig creates a pod that reads its environment from the named config map and mounts the named secret
+func (cb *ClusterBuilder) WithPodUsingConfig(name, namespace, configMapName, secretName string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "c",
+				Image: "busybox",
+				EnvFrom: []corev1.EnvFromSource{{
+					ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: configMapName}},
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "credentials",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+				},
+			}},
+		},
+	}
+	_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithPersistentVolume creates an available host path persistent volume of the given capacity and storage class
+func (cb *ClusterBuilder) WithPersistentVolume(name, capacity, storageClass string) *ClusterBuilder {
+	volume := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              storageClass,
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/data/" + name},
+			},
+		},
+	}
+	created, err := cb.clientset.CoreV1().PersistentVolumes().Create(context.TODO(), volume, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+
+	created.Status.Phase = corev1.VolumeAvailable
+	_, err = cb.clientset.CoreV1().PersistentVolumes().UpdateStatus(context.TODO(), created, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithPersistentVolumeClaim creates a claim requesting 1Gi. Given a volume name the claim and volume are bound
+// to each other, standing in for the persistent volume controller which envtest does not run; otherwise the
+// claim is left pending.
+func (cb *ClusterBuilder) WithPersistentVolumeClaim(name, namespace, volumeName string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+			VolumeName: volumeName,
+		},
+	}
+	created, err := cb.clientset.CoreV1().PersistentVolumeClaims(namespace).Create(context.TODO(), claim, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+
+	if volumeName == "" {
+		created.Status.Phase = corev1.ClaimPending
+		_, err = cb.clientset.CoreV1().PersistentVolumeClaims(namespace).UpdateStatus(context.TODO(), created, metav1.UpdateOptions{})
+		require.NoError(cb.t, err)
+		return cb
+	}
+
+	volume, err := cb.clientset.CoreV1().PersistentVolumes().Get(context.TODO(), volumeName, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+	volume.Spec.ClaimRef = &corev1.ObjectReference{Kind: "PersistentVolumeClaim", Namespace: namespace, Name: name, UID: created.UID}
+	volume, err = cb.clientset.CoreV1().PersistentVolumes().Update(context.TODO(), volume, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	volume.Status.Phase = corev1.VolumeBound
+	_, err = cb.clientset.CoreV1().PersistentVolumes().UpdateStatus(context.TODO(), volume, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+
+	created.Status.Phase = corev1.ClaimBound
+	created.Status.AccessModes = volume.Spec.AccessModes
+	created.Status.Capacity = volume.Spec.Capacity
+	_, err = cb.clientset.CoreV1().PersistentVolumeClaims(namespace).UpdateStatus(context.TODO(), created, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithPodMountingClaim creates a pod mounting the named claim as its "data" volume
+func (cb *ClusterBuilder) WithPodMountingClaim(name, namespace, claimName string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "c", Image: "busybox"}},
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			}},
+		},
+	}
+	_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithClaimEvent records a warning event against the named claim, as a provisioner would while it is pending
+func (cb *ClusterBuilder) WithClaimEvent(claimName, namespace, reason, message string) *ClusterBuilder {
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: claimName + "-",
+			Namespace:    namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "PersistentVolumeClaim",
+			Namespace: namespace,
+			Name:      claimName,
+		},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        message,
+		Source:         corev1.EventSource{Component: "persistentvolume-controller"},
+		Count:          1,
+		FirstTimestamp: metav1.Now(),
+		LastTimestamp:  metav1.Now(),
+	}
+	_, err := cb.clientset.CoreV1().Events(namespace).Create(context.TODO(), event, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithHorizontalPodAutoscaler creates an autoscaler scaling the named deployment on average cpu utilization
+func (cb *ClusterBuilder) WithHorizontalPodAutoscaler(name, namespace, deploymentName string, minReplicas, maxReplicas, cpuUtilization int32) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	autoscaler := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: deploymentName},
+			MinReplicas:    int32Ptr(minReplicas),
+			MaxReplicas:    maxReplicas,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: int32Ptr(cpuUtilization)},
+				},
+			}},
+		},
+	}
+	_, err := cb.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Create(context.TODO(), autoscaler, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithHorizontalPodAutoscalerStatus records the replica counts, observed cpu utilization and conditions of the named
+// autoscaler, standing in for the autoscaler controller which envtest does not run
+func (cb *ClusterBuilder) WithHorizontalPodAutoscalerStatus(name, namespace string, currentReplicas, desiredReplicas, cpuUtilization int32, conditions ...autoscalingv2.HorizontalPodAutoscalerCondition) *ClusterBuilder {
+	autoscaler, err := cb.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+
+	for i := range conditions {
+		conditions[i].LastTransitionTime = metav1.Now()
+	}
+	autoscaler.Status = autoscalingv2.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: currentReplicas,
+		DesiredReplicas: desiredReplicas,
+		CurrentMetrics: []autoscalingv2.MetricStatus{{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricStatus{
+				Name:    corev1.ResourceCPU,
+				Current: autoscalingv2.MetricValueStatus{AverageUtilization: int32Ptr(cpuUtilization)},
+			},
+		}},
+		Conditions: conditions,
+	}
+	_, err = cb.clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).UpdateStatus(context.TODO(), autoscaler, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithLabelledPod creates a pod with the given name, namespace and labels
+func (cb *ClusterBuilder) WithLabelledPod(name, namespace string, podLabels map[string]string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    podLabels,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "c", Image: "busybox"}},
+		},
+	}
+	_, err := cb.clientset.CoreV1().Pods(namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithPodInPhase creates a pod and records the given phase, standing in for the kubelet which envtest does not run
+func (cb *ClusterBuilder) WithPodInPhase(name, namespace string, phase corev1.PodPhase) *ClusterBuilder {
+	return cb.WithPodOnNodeInPhase(name, namespace, "", phase)
+}
+
+// WithPodOnNodeInPhase creates a pod scheduled on a node and records the given phase
+func (cb *ClusterBuilder) WithPodOnNodeInPhase(name, namespace, nodeName string, phase corev1.PodPhase) *ClusterBuilder {
+	cb.WithPodOnNode(name, namespace, nodeName)
+
+	pod, err := cb.clientset.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	require.NoError(cb.t, err)
+	pod.Status.Phase = phase
+	_, err = cb.clientset.CoreV1().Pods(namespace).UpdateStatus(context.TODO(), pod, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithResourceQuota creates a resource quota with the given hard limits and records the given usage,
+// standing in for the quota controller which envtest does not run
+func (cb *ClusterBuilder) WithResourceQuota(name, namespace string, hard, used map[string]string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	toResourceList := func(quantities map[string]string) corev1.ResourceList {
+		list := corev1.ResourceList{}
+		for resourceName, quantity := range quantities {
+			list[corev1.ResourceName(resourceName)] = resource.MustParse(quantity)
+		}
+		return list
+	}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: toResourceList(hard),
+		},
+	}
+	created, err := cb.clientset.CoreV1().ResourceQuotas(namespace).Create(context.TODO(), quota, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+
+	created.Status = corev1.ResourceQuotaStatus{
+		Hard: toResourceList(hard),
+		Used: toResourceList(used),
+	}
+	_, err = cb.clientset.CoreV1().ResourceQuotas(namespace).UpdateStatus(context.TODO(), created, metav1.UpdateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithFinalizedConfigMap creates a config map carrying the given finalizer, so deleting its namespace leaves it behind
+func (cb *ClusterBuilder) WithFinalizedConfigMap(name, namespace, finalizer string) *ClusterBuilder {
+	cb.WithNamespace(namespace)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Finalizers: []string{finalizer},
+		},
+	}
+	_, err := cb.clientset.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configMap, metav1.CreateOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
+// WithTerminatingNamespace deletes the named namespace. Envtest runs no namespace controller to clear the
+// "kubernetes" finalizer, so the namespace stays Terminating.
+func (cb *ClusterBuilder) WithTerminatingNamespace(name string) *ClusterBuilder {
+	cb.WithNamespace(name)
+
+	err := cb.clientset.CoreV1().Namespaces().Delete(context.TODO(), name, metav1.DeleteOptions{})
+	require.NoError(cb.t, err)
+	return cb
+}
+
 // int32Ptr returns a pointer to an int32
 func int32Ptr(i int32) *int32 {
 	return &i
@@ -0,0 +1,473 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterRetryInterval is how long a multi-cluster view waits before listing an unreachable cluster again
+const clusterRetryInterval = 5 * time.Second
+
+// clusterResource lists and watches one kind of resource in a cluster, converting its objects to rows
+type clusterResource[T any] struct {
+	// kind names the resource in logs and errors, such as "pods"
+	kind string
+	// list lists the objects in the namespace, empty for all namespaces, and returns the list's resource version
+	list func(ctx context.Context, clientset *kubernetes.Clientset, namespace string) ([]runtime.Object, string, error)
+	// watch watches the objects in the namespace from a resource version
+	watch func(ctx context.Context, clientset *kubernetes.Clientset, namespace, resourceVersion string) (watch.Interface, error)
+	// convert converts an object listed or watched in the named cluster to its row and the row's key, such as
+	// namespace/name. It returns false for objects that are not of the resource's type.
+	convert func(cluster string, object runtime.Object) (string, T, bool)
+}
+
+// listWatcher is the list and watch of a typed client, such as clientset.CoreV1().Pods(namespace)
+type listWatcher[L runtime.Object] interface {
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// typedResource builds a clusterResource from a typed client of objects of type O and their conversion to rows.
+// Objects are listed and watched with options and keyed by namespace/name, or by name when not namespaced.
+func typedResource[L runtime.Object, O any, T any](kind string, options metav1.ListOptions, client func(clientset *kubernetes.Clientset, namespace string) listWatcher[L], convert func(cluster string, object *O) T) clusterResource[T] {
+	return clusterResource[T]{
+		kind: kind,
+		list: func(ctx context.Context, clientset *kubernetes.Clientset, namespace string) ([]runtime.Object, string, error) {
+			list, err := client(clientset, namespace).List(ctx, options)
+			if err != nil {
+				return nil, "", err
+			}
+			objects, err := meta.ExtractList(list)
+			if err != nil {
+				return nil, "", err
+			}
+			listMeta, err := meta.ListAccessor(list)
+			if err != nil {
+				return nil, "", err
+			}
+			return objects, listMeta.GetResourceVersion(), nil
+		},
+		watch: func(ctx context.Context, clientset *kubernetes.Clientset, namespace, resourceVersion string) (watch.Interface, error) {
+			watchOptions := options
+			watchOptions.ResourceVersion = resourceVersion
+			return client(clientset, namespace).Watch(ctx, watchOptions)
+		},
+		convert: func(cluster string, object runtime.Object) (string, T, bool) {
+			typed, ok := any(object).(*O)
+			if !ok {
+				var row T
+				return "", row, false
+			}
+			objectMeta, err := meta.Accessor(object)
+			if err != nil {
+				var row T
+				return "", row, false
+			}
+			key := objectMeta.GetName()
+			if objectMeta.GetNamespace() != "" {
+				key = objectMeta.GetNamespace() + "/" + key
+			}
+			return key, convert(cluster, typed), true
+		},
+	}
+}
+
+// keyedRow is a row and its key within its cluster
+type keyedRow[T any] struct {
+	key string
+	row T
+}
+
+// clusterRow is a row, its key within its cluster and the cluster's name
+type clusterRow[T any] struct {
+	cluster string
+	key     string
+	row     T
+}
+
+// clusterListedMsg carries every row listed from a cluster to the update loop, replacing the cluster's rows
+type clusterListedMsg[T any] struct {
+	cluster string
+	rows    []keyedRow[T]
+}
+
+// clusterEventMsg carries a single watch event from a cluster to the update loop
+type clusterEventMsg[T any] struct {
+	cluster   string
+	eventType watch.EventType
+	key       string
+	row       T
+}
+
+// clusterHealthMsg carries a change in the connection health of a cluster to the update loop
+type clusterHealthMsg struct {
+	health models.ClusterHealth
+}
+
+// clustersRefreshedMsg carries the results of listing every cluster again to the update loop
+type clustersRefreshedMsg[T any] struct {
+	listed []clusterListedMsg[T]
+	failed []models.ClusterHealth
+}
+
+// clusterWatches lists and watches a resource in several clusters at once, each with its own client and watch, and
+// merges their rows into one list. A cluster whose list or watch fails is reported unreachable and retried, keeping
+// the rows last listed from it. The single-cluster views use it with one cluster.
+type clusterWatches[T any] struct {
+	clusters  []models.Cluster
+	namespace string
+	resource  clusterResource[T]
+	// resourceVersions holds the resource version of each cluster listed before the watches started, which its
+	// watch starts from instead of listing again
+	resourceVersions map[string]string
+
+	// rows holds the rows of each cluster by cluster name, keyed by their key within the cluster
+	rows   map[string]*utils.OrderedMap[T]
+	health map[string]models.ClusterHealth
+
+	// Message channel carrying the events of every cluster to the update loop
+	updateChan chan tea.Msg
+	wg         sync.WaitGroup
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// newClusterWatches creates the watches of a resource in clusters, restricted to namespace; empty for all namespaces.
+// Every cluster is connecting until its first list returns, so that a slow cluster does not hold up the others.
+func newClusterWatches[T any](clusters []models.Cluster, namespace string, resource clusterResource[T]) *clusterWatches[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &clusterWatches[T]{
+		clusters:         clusters,
+		namespace:        namespace,
+		resource:         resource,
+		resourceVersions: make(map[string]string, len(clusters)),
+		rows:             make(map[string]*utils.OrderedMap[T], len(clusters)),
+		health:           make(map[string]models.ClusterHealth, len(clusters)),
+		updateChan:       make(chan tea.Msg, updateChannelSize),
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+	for _, cluster := range clusters {
+		w.rows[cluster.Name] = utils.NewOrderedMap[T]()
+		w.health[cluster.Name] = models.ClusterHealth{Name: cluster.Name, State: models.ClusterConnecting, Since: time.Now()}
+	}
+	return w
+}
+
+// newClusterWatch creates the watch of a resource in a single cluster, restricted to namespace; empty for all
+// namespaces. The cluster is listed straight away so that a view opens with its rows.
+func newClusterWatch[T any](clientset *kubernetes.Clientset, clusterName, namespace string, resource clusterResource[T]) *clusterWatches[T] {
+	w := newClusterWatches([]models.Cluster{{Name: clusterName, Clientset: clientset}}, namespace, resource)
+	w.listNow()
+	return w
+}
+
+// listNow lists every cluster on the calling goroutine, so that their watches start from these lists. A cluster
+// whose list fails is reported unreachable and listed again by its watch.
+func (w *clusterWatches[T]) listNow() {
+	for _, cluster := range w.clusters {
+		msg, resourceVersion, err := w.listRows(w.ctx, cluster)
+		if err != nil {
+			debugLogger.Printf("error listing %s in cluster %s: %v", w.resource.kind, cluster.Name, err)
+			w.setHealth(models.ClusterHealth{Name: cluster.Name, State: models.ClusterUnreachable, Err: err.Error(), Since: time.Now()})
+			continue
+		}
+		w.update(msg)
+		w.resourceVersions[cluster.Name] = resourceVersion
+	}
+}
+
+// poll sends the result of fetch to the update loop every interval until the watches are stopped, skipping nil
+// results. It must be called before start, as the update channel is closed once every sender has returned.
+func (w *clusterWatches[T]) poll(interval time.Duration, fetch func(ctx context.Context) tea.Msg) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-ticker.C:
+				if msg := fetch(w.ctx); msg != nil && !sendMsg(w.ctx, w.updateChan, msg) {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// start starts a watch goroutine for each cluster. The update channel is closed once they have all returned, as
+// they are its only senders.
+func (w *clusterWatches[T]) start() {
+	for _, cluster := range w.clusters {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.watchCluster(cluster)
+		}()
+	}
+	go func() {
+		w.wg.Wait()
+		close(w.updateChan)
+	}()
+}
+
+// watchCluster lists and then watches a cluster until the watches are stopped, listing again whenever the watch
+// ends and retrying after clusterRetryInterval when either fails
+func (w *clusterWatches[T]) watchCluster(cluster models.Cluster) {
+	resourceVersion := w.resourceVersions[cluster.Name]
+	for {
+		var err error
+		if resourceVersion == "" {
+			resourceVersion, err = w.listCluster(cluster)
+		}
+		if err == nil {
+			err = w.watchEvents(cluster, resourceVersion)
+		}
+		resourceVersion = ""
+		if w.ctx.Err() != nil {
+			debugLogger.Printf("%s watch of cluster %s stopped by context cancellation", w.resource.kind, cluster.Name)
+			return
+		}
+		if err == nil {
+			// Watches time out and the resource version expires; both are resumed by listing again
+			continue
+		}
+
+		debugLogger.Printf("error watching %s in cluster %s: %v", w.resource.kind, cluster.Name, err)
+		health := models.ClusterHealth{Name: cluster.Name, State: models.ClusterUnreachable, Err: err.Error(), Since: time.Now()}
+		if !sendMsg(w.ctx, w.updateChan, clusterHealthMsg{health: health}) {
+			return
+		}
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(clusterRetryInterval):
+		}
+	}
+}
+
+// listCluster lists the rows of a cluster, sends them to the update loop and returns the list's resource version
+func (w *clusterWatches[T]) listCluster(cluster models.Cluster) (string, error) {
+	msg, resourceVersion, err := w.listRows(w.ctx, cluster)
+	if err != nil {
+		return "", err
+	}
+	if !sendMsg(w.ctx, w.updateChan, msg) {
+		return "", w.ctx.Err()
+	}
+	return resourceVersion, nil
+}
+
+// listRows lists the rows of a cluster and returns them with the list's resource version
+func (w *clusterWatches[T]) listRows(ctx context.Context, cluster models.Cluster) (clusterListedMsg[T], string, error) {
+	objects, resourceVersion, err := w.resource.list(ctx, cluster.Clientset, w.namespace)
+	if err != nil {
+		return clusterListedMsg[T]{}, "", fmt.Errorf("could not list %s: %w", w.resource.kind, err)
+	}
+	msg := clusterListedMsg[T]{cluster: cluster.Name, rows: make([]keyedRow[T], 0, len(objects))}
+	for _, object := range objects {
+		if key, row, ok := w.resource.convert(cluster.Name, object); ok {
+			msg.rows = append(msg.rows, keyedRow[T]{key: key, row: row})
+		}
+	}
+	return msg, resourceVersion, nil
+}
+
+// refresh lists every cluster again off the update loop and delivers the results as a clustersRefreshedMsg
+func (w *clusterWatches[T]) refresh() tea.Cmd {
+	return func() tea.Msg {
+		var refreshed clustersRefreshedMsg[T]
+		for _, cluster := range w.clusters {
+			msg, _, err := w.listRows(w.ctx, cluster)
+			if err != nil {
+				refreshed.failed = append(refreshed.failed, models.ClusterHealth{Name: cluster.Name, State: models.ClusterUnreachable, Err: err.Error(), Since: time.Now()})
+				continue
+			}
+			refreshed.listed = append(refreshed.listed, msg)
+		}
+		return refreshed
+	}
+}
+
+// watchEvents sends the watch events of a cluster to the update loop until the watch ends. It returns nil when the
+// watch ends in a way that listing again recovers from.
+func (w *clusterWatches[T]) watchEvents(cluster models.Cluster, resourceVersion string) error {
+	watcher, err := w.resource.watch(w.ctx, cluster.Clientset, w.namespace, resourceVersion)
+	if err != nil {
+		return fmt.Errorf("could not watch %s: %w", w.resource.kind, err)
+	}
+	defer watcher.Stop()
+
+	debugLogger.Printf("Started watching %s in cluster %s from resource version: %s", w.resource.kind, cluster.Name, resourceVersion)
+	for {
+		select {
+		case <-w.ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				debugLogger.Printf("%s watch channel of cluster %s closed", w.resource.kind, cluster.Name)
+				return nil
+			}
+			if event.Type == watch.Error {
+				err := apierrors.FromObject(event.Object)
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					return nil
+				}
+				return fmt.Errorf("%s watch failed: %w", w.resource.kind, err)
+			}
+			key, row, ok := w.resource.convert(cluster.Name, event.Object)
+			if !ok {
+				debugLogger.Printf("unexpected object type in watch event: %T", event.Object)
+				continue
+			}
+
+			// Hand the event to the update loop rather than touching state from this goroutine
+			msg := clusterEventMsg[T]{cluster: cluster.Name, eventType: event.Type, key: key, row: row}
+			if !sendMsg(w.ctx, w.updateChan, msg) {
+				return nil
+			}
+		}
+	}
+}
+
+// update applies a message from the watches on the update loop and reports whether it was one of theirs
+func (w *clusterWatches[T]) update(msg tea.Msg) bool {
+	switch msg := msg.(type) {
+	case clusterListedMsg[T]:
+		w.replace(msg.cluster, msg.rows)
+		w.setHealth(models.ClusterHealth{Name: msg.cluster, State: models.ClusterConnected, Since: time.Now()})
+	case clusterEventMsg[T]:
+		rows, ok := w.rows[msg.cluster]
+		if !ok {
+			return true
+		}
+		switch msg.eventType {
+		case watch.Added, watch.Modified:
+			rows.Set(msg.key, msg.row)
+		case watch.Deleted:
+			rows.Delete(msg.key)
+		}
+	case clusterHealthMsg:
+		w.setHealth(msg.health)
+	case clustersRefreshedMsg[T]:
+		for _, listed := range msg.listed {
+			w.update(listed)
+		}
+		for _, health := range msg.failed {
+			w.setHealth(health)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// replace replaces the rows of a cluster with those listed from it
+func (w *clusterWatches[T]) replace(cluster string, rows []keyedRow[T]) {
+	clusterRows, ok := w.rows[cluster]
+	if !ok {
+		return
+	}
+	clusterRows.Clear()
+	for _, row := range rows {
+		clusterRows.Set(row.key, row.row)
+	}
+}
+
+// setHealth records the health of a cluster, keeping the time it entered its state when it is unchanged
+func (w *clusterWatches[T]) setHealth(health models.ClusterHealth) {
+	if previous, ok := w.health[health.Name]; ok && previous.State == health.State {
+		health.Since = previous.Since
+	}
+	w.health[health.Name] = health
+}
+
+// list returns the rows of every cluster ordered by their key and then their cluster's name, so that the rows of
+// the same name in each cluster sort together
+func (w *clusterWatches[T]) list() []T {
+	var merged []clusterRow[T]
+	for cluster, rows := range w.rows {
+		for _, key := range rows.Keys() {
+			row, _ := rows.Get(key)
+			merged = append(merged, clusterRow[T]{cluster: cluster, key: key, row: row})
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].key != merged[j].key {
+			return merged[i].key < merged[j].key
+		}
+		return merged[i].cluster < merged[j].cluster
+	})
+
+	list := make([]T, len(merged))
+	for i, row := range merged {
+		list[i] = row.row
+	}
+	return list
+}
+
+// row returns the row of a cluster by its key within the cluster
+func (w *clusterWatches[T]) row(cluster, key string) (T, bool) {
+	rows, ok := w.rows[cluster]
+	if !ok {
+		var row T
+		return row, false
+	}
+	return rows.Get(key)
+}
+
+// clusterHealth returns the connection health of each cluster, in the order the clusters were chosen
+func (w *clusterWatches[T]) clusterHealth() []models.ClusterHealth {
+	health := make([]models.ClusterHealth, 0, len(w.clusters))
+	for _, cluster := range w.clusters {
+		health = append(health, w.health[cluster.Name])
+	}
+	return health
+}
+
+// clientset returns the client of the named cluster, or nil when it is not one of the clusters
+func (w *clusterWatches[T]) clientset(name string) *kubernetes.Clientset {
+	for _, cluster := range w.clusters {
+		if cluster.Name == name {
+			return cluster.Clientset
+		}
+	}
+	return nil
+}
+
+// unreachable describes the clusters that cannot be reached and why, or returns "" when every cluster is reachable
+func (w *clusterWatches[T]) unreachable() string {
+	var problems []string
+	for _, health := range w.clusterHealth() {
+		if health.State == models.ClusterUnreachable {
+			problems = append(problems, fmt.Sprintf("%s unreachable for %s: %s", health.Name, models.FormatDuration(time.Since(health.Since)), health.Err))
+		}
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("✕ %s (retrying every %s)", strings.Join(problems, "; "), clusterRetryInterval)
+}
+
+// stop stops the watch goroutines of every cluster
+func (w *clusterWatches[T]) stop() {
+	w.cancel()
+}
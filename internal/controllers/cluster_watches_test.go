@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestClusterWatches(t *testing.T) {
+	// newWatches creates the pod watches of clusters without starting them, so messages are fed in by hand
+	newWatches := func(names ...string) *clusterWatches[models.Pod] {
+		clusters := make([]models.Cluster, len(names))
+		for i, name := range names {
+			clusters[i] = models.Cluster{Name: name}
+		}
+		return newClusterWatches(clusters, "", clusterPods)
+	}
+	listed := func(cluster string, names ...string) clusterListedMsg[models.Pod] {
+		msg := clusterListedMsg[models.Pod]{cluster: cluster}
+		for _, name := range names {
+			msg.rows = append(msg.rows, keyedRow[models.Pod]{key: "shop/" + name, row: models.Pod{Name: name, Namespace: "shop", Cluster: cluster}})
+		}
+		return msg
+	}
+	rows := func(w *clusterWatches[models.Pod]) []string {
+		var names []string
+		for _, pod := range w.list() {
+			names = append(names, pod.Name+"@"+pod.Cluster)
+		}
+		return names
+	}
+
+	t.Run("should_start_every_cluster_connecting", func(t *testing.T) {
+		w := newWatches("us-east", "eu-west")
+
+		health := w.clusterHealth()
+		require.Len(t, health, 2)
+		assert.Equal(t, "us-east", health[0].Name)
+		assert.Equal(t, "eu-west", health[1].Name)
+		assert.Equal(t, models.ClusterConnecting, health[0].State)
+		assert.Equal(t, models.ClusterConnecting, health[1].State)
+		assert.Empty(t, w.unreachable())
+	})
+
+	t.Run("should_merge_the_rows_of_every_cluster_by_name_then_cluster", func(t *testing.T) {
+		w := newWatches("us-east", "eu-west")
+
+		assert.True(t, w.update(listed("us-east", "web", "api")))
+		assert.True(t, w.update(listed("eu-west", "web")))
+
+		assert.Equal(t, []string{"api@us-east", "web@eu-west", "web@us-east"}, rows(w))
+		for _, health := range w.clusterHealth() {
+			assert.Equal(t, models.ClusterConnected, health.State)
+		}
+	})
+
+	t.Run("should_apply_watch_events_to_their_cluster_only", func(t *testing.T) {
+		w := newWatches("us-east", "eu-west")
+		w.update(listed("us-east", "web"))
+		w.update(listed("eu-west", "web"))
+
+		w.update(clusterEventMsg[models.Pod]{cluster: "eu-west", eventType: watch.Added, key: "shop/api", row: models.Pod{Name: "api", Cluster: "eu-west"}})
+		w.update(clusterEventMsg[models.Pod]{cluster: "us-east", eventType: watch.Deleted, key: "shop/web", row: models.Pod{Name: "web", Cluster: "us-east"}})
+
+		assert.Equal(t, []string{"api@eu-west", "web@eu-west"}, rows(w))
+	})
+
+	t.Run("should_replace_only_the_rows_of_a_cluster_listed_again", func(t *testing.T) {
+		w := newWatches("us-east", "eu-west")
+		w.update(listed("us-east", "web", "api"))
+		w.update(listed("eu-west", "web"))
+
+		w.update(listed("us-east", "worker"))
+
+		assert.Equal(t, []string{"web@eu-west", "worker@us-east"}, rows(w))
+	})
+
+	t.Run("should_keep_apart_clusters_whose_names_contain_spaces", func(t *testing.T) {
+		w := newWatches("east", "prod east")
+		w.update(listed("east", "web"))
+		w.update(listed("prod east", "web"))
+
+		w.update(listed("east"))
+		w.update(clusterEventMsg[models.Pod]{cluster: "east", eventType: watch.Deleted, key: "shop/web", row: models.Pod{Name: "web", Cluster: "east"}})
+
+		assert.Equal(t, []string{"web@prod east"}, rows(w))
+	})
+
+	t.Run("should_keep_the_rows_of_an_unreachable_cluster_and_report_why", func(t *testing.T) {
+		w := newWatches("us-east", "eu-west")
+		w.update(listed("us-east", "web"))
+		w.update(listed("eu-west", "web"))
+
+		since := time.Now().Add(-time.Minute)
+		w.update(clusterHealthMsg{health: models.ClusterHealth{Name: "eu-west", State: models.ClusterUnreachable, Err: "connection refused", Since: since}})
+		w.update(clusterHealthMsg{health: models.ClusterHealth{Name: "eu-west", State: models.ClusterUnreachable, Err: "connection refused", Since: time.Now()}})
+
+		assert.Equal(t, []string{"web@eu-west", "web@us-east"}, rows(w))
+		assert.Equal(t, since, w.clusterHealth()[1].Since, "a cluster still unreachable keeps the time it became unreachable")
+		assert.Contains(t, w.unreachable(), "eu-west unreachable for 1m")
+		assert.Contains(t, w.unreachable(), "connection refused (retrying every 5s)")
+
+		w.update(listed("eu-west", "web"))
+		assert.Equal(t, models.ClusterConnected, w.clusterHealth()[1].State)
+		assert.Empty(t, w.unreachable())
+	})
+
+	t.Run("should_apply_refreshed_lists_and_failures", func(t *testing.T) {
+		w := newWatches("us-east", "eu-west")
+		w.update(listed("us-east", "web"))
+		w.update(listed("eu-west", "web"))
+
+		w.update(clustersRefreshedMsg[models.Pod]{
+			listed: []clusterListedMsg[models.Pod]{listed("us-east", "api")},
+			failed: []models.ClusterHealth{{Name: "eu-west", State: models.ClusterUnreachable, Err: "timeout", Since: time.Now()}},
+		})
+
+		assert.Equal(t, []string{"api@us-east", "web@eu-west"}, rows(w))
+		assert.Equal(t, models.ClusterUnreachable, w.clusterHealth()[1].State)
+	})
+
+	t.Run("should_ignore_messages_of_other_views", func(t *testing.T) {
+		w := newWatches("us-east")
+
+		assert.False(t, w.update(clusterListedMsg[models.Deployment]{cluster: "us-east"}))
+		assert.False(t, w.update("unrelated"))
+	})
+}
+
+// endingWatchServer serves config maps in the shop namespace, ending the first watch after one event so that the
+// watch has to list again. Each list returns the config maps named in lists, in turn.
+func endingWatchServer(t *testing.T, lists ...[]string) *kubernetes.Clientset {
+	var mu sync.Mutex
+	listed, watched := 0, 0
+	configMap := func(name string) map[string]interface{} {
+		return map[string]interface{}{"kind": "ConfigMap", "apiVersion": "v1", "metadata": map[string]string{"name": name, "namespace": "shop"}}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if watching := r.URL.Query().Get("watch"); watching != "true" && watching != "1" {
+			items := []interface{}{}
+			for _, name := range lists[min(listed, len(lists)-1)] {
+				items = append(items, configMap(name))
+			}
+			listed++
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+				"kind": "ConfigMapList", "apiVersion": "v1", "metadata": map[string]string{"resourceVersion": strconv.Itoa(listed)}, "items": items,
+			}))
+			return
+		}
+		watched++
+		if watched > 1 {
+			mu.Unlock()
+			<-r.Context().Done()
+			mu.Lock()
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"type": "ADDED", "object": configMap("watched")}))
+	}))
+	t.Cleanup(server.Close)
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return clientset
+}
+
+func TestClusterWatch(t *testing.T) {
+	names := func(configMaps []models.ConfigMap) []string {
+		var names []string
+		for _, configMap := range configMaps {
+			names = append(names, configMap.Name)
+		}
+		return names
+	}
+
+	t.Run("should_list_before_starting_and_list_again_when_the_watch_ends", func(t *testing.T) {
+		w := newClusterWatch(endingWatchServer(t, []string{"settings"}, []string{"settings", "flags"}), "test-cluster", "shop", configMapResource)
+		defer w.stop()
+		assert.Equal(t, []string{"settings"}, names(w.list()))
+
+		w.start()
+		timeout := time.After(5 * time.Second)
+		for !assert.ObjectsAreEqual([]string{"flags", "settings"}, names(w.list())) {
+			select {
+			case msg := <-w.updateChan:
+				w.update(msg)
+			case <-timeout:
+				t.Fatalf("expected the watch to list again, got %v", names(w.list()))
+			}
+		}
+	})
+}
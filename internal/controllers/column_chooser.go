@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/views"
+)
+
+// columnChooserKeys are the key bindings of the column chooser, which gets every key while it is open
+var columnChooserKeys = registerModalKeyMap("columns", "Column chooser",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionToggle, Keys: []string{" ", "enter"}, Help: "Show or hide"},
+	Binding{Action: ActionMoveUp, Keys: []string{"K", "shift+up"}, Help: "Move left"},
+	Binding{Action: ActionMoveDown, Keys: []string{"J", "shift+down"}, Help: "Move right"},
+	Binding{Action: ActionWiden, Keys: []string{"+", "right", "l"}, Help: "Widen"},
+	Binding{Action: ActionNarrow, Keys: []string{"-", "left", "h"}, Help: "Narrow"},
+	Binding{Action: ActionClose, Keys: []string{"esc", "C", "q"}, Help: "Close"},
+)
+
+// columnChooserView is a list view whose columns can be chosen with the column chooser
+type columnChooserView interface {
+	OpenColumnChooser() *views.ColumnChooserView
+	CloseColumnChooser()
+	ColumnChooser() *views.ColumnChooserView
+}
+
+// openColumnChooser opens the column chooser of a list view, listing its keys
+func openColumnChooser(view columnChooserView) tea.Cmd {
+	chooser := view.OpenColumnChooser()
+	chooser.SetKeyHelp(columnChooserKeys.HelpSection().Entries)
+	return nil
+}
+
+// columnHelpSections are the help sections of list views with configurable columns
+func columnHelpSections() []views.HelpSection {
+	return []views.HelpSection{views.ColumnExpressionHelp}
+}
+
+// handleColumnChooserKey handles a key pressed while the column chooser of a list view is open
+func handleColumnChooserKey(view columnChooserView, msg tea.KeyMsg) tea.Cmd {
+	chooser := view.ColumnChooser()
+	switch columnChooserKeys.Action(msg) {
+	case ActionUp:
+		chooser.SelectPrev()
+	case ActionDown:
+		chooser.SelectNext()
+	case ActionToggle:
+		chooser.Toggle()
+	case ActionMoveUp:
+		chooser.MoveUp()
+	case ActionMoveDown:
+		chooser.MoveDown()
+	case ActionWiden:
+		chooser.Widen()
+	case ActionNarrow:
+		chooser.Narrow()
+	case ActionClose:
+		view.CloseColumnChooser()
+	}
+	return nil
+}
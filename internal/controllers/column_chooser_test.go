@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/config"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestColumnChooser(t *testing.T) {
+	// Every test starts from, and restores, the default columns
+	resetColumnLayouts := func(t *testing.T) {
+		t.Helper()
+		require.NoError(t, views.SetColumnLayouts(nil))
+		t.Cleanup(func() { require.NoError(t, views.SetColumnLayouts(nil)) })
+	}
+
+	pod := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web-1",
+			Namespace:   "shop",
+			Labels:      map[string]string{"app": "web"},
+			Annotations: map[string]string{"owner": "payments"},
+		},
+		Spec: v1.PodSpec{
+			PriorityClassName: "critical",
+			Containers:        []v1.Container{{Name: "web", Image: "nginx:1.27"}},
+		},
+		Status: v1.PodStatus{QOSClass: v1.PodQOSBestEffort},
+	}
+
+	renderPods := func(pods ...v1.Pod) (*views.PodListView, string) {
+		rows := make([]models.Pod, len(pods))
+		for i, p := range pods {
+			rows[i] = models.ToPodModel(p)
+		}
+		view := views.NewPodListView(rows, theme.NewDefaultTheme(), "test")
+		view.SetSize(200, 20)
+		return view, view.Render()
+	}
+
+	t.Run("should_show_the_default_columns", func(t *testing.T) {
+		resetColumnLayouts(t)
+
+		_, output := renderPods(pod)
+		assert.Contains(t, output, "RESTARTS")
+		assert.NotContains(t, output, "IMAGE")
+	})
+
+	t.Run("should_show_the_configured_and_custom_columns_in_order", func(t *testing.T) {
+		resetColumnLayouts(t)
+
+		require.NoError(t, views.SetColumnLayouts(map[string][]config.Column{
+			"pods": {
+				{Name: "name"},
+				{Name: "IMAGE"},
+				{Name: "QOS"},
+				{Name: "App", Label: "app"},
+				{Name: "Owner", Annotation: "owner"},
+				{Name: "Priority", JSONPath: ".spec.priorityClassName"},
+				{Name: "Node Selector", JSONPath: ".spec.nodeSelector.disk"},
+			},
+		}))
+
+		_, output := renderPods(pod)
+		assert.NotContains(t, output, "RESTARTS")
+		header := output[strings.Index(output, "NAME"):]
+		assert.Less(t, strings.Index(header, "NAME"), strings.Index(header, "IMAGE"))
+		assert.Less(t, strings.Index(header, "IMAGE"), strings.Index(header, "PRIORITY"))
+		for _, value := range []string{"nginx:1.27", "BestEffort", "web", "payments", "critical", "<none>"} {
+			assert.Contains(t, output, value)
+		}
+	})
+
+	t.Run("should_show_the_errors_of_failing_expressions_in_their_cells", func(t *testing.T) {
+		resetColumnLayouts(t)
+
+		require.NoError(t, views.SetColumnLayouts(map[string][]config.Column{
+			"pods": {
+				{Name: "NAME"},
+				{Name: "Team", CEL: "metadata.labels['team']"},
+				{Name: "Images", CEL: "spec.containers.map(c, c.image).join(' ')"},
+			},
+		}))
+
+		_, output := renderPods(pod)
+		assert.Contains(t, output, "error: no such key: team")
+		assert.Contains(t, output, "nginx:1.27")
+	})
+
+	t.Run("should_configure_the_columns_of_every_list_view", func(t *testing.T) {
+		resetColumnLayouts(t)
+
+		resources := []string{"configmaps", "cronjobs", "daemonsets", "deployments", "hpa", "ingresses", "jobs", "namespaces", "nodes", "pods", "pv", "pvc", "secrets", "services", "statefulsets"}
+		assert.Equal(t, resources, views.ConfigurableColumnResources())
+
+		require.NoError(t, views.SetColumnLayouts(map[string][]config.Column{
+			"services": {{Name: "NAME"}, {Name: "SESSION-AFFINITY"}, {Name: "App", Label: "app"}},
+			"secrets": {
+				{Name: "NAME"},
+				{Name: "Values", CEL: "has(object.data) ? 'shown' : 'hidden'"},
+				{Name: "Annotations", JSONPath: ".metadata.annotations"},
+			},
+		}))
+
+		services := views.NewServiceListView([]models.Service{models.ToServiceModel(v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop", Labels: map[string]string{"app": "storefront"}},
+			Spec:       v1.ServiceSpec{SessionAffinity: v1.ServiceAffinityClientIP},
+		})}, theme.NewDefaultTheme(), "test")
+		services.SetSize(200, 20)
+		output := services.Render()
+		assert.NotContains(t, output, "CLUSTER-IP")
+		for _, value := range []string{"SESSION-AFFINITY", "ClientIP", "APP", "storefront"} {
+			assert.Contains(t, output, value)
+		}
+
+		// Custom columns cannot read the values of a secret, which are only shown when revealed
+		// nor the copy of them kubectl apply keeps in an annotation
+		secrets := views.NewSecretListView([]models.Secret{models.ToSecretModel(v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "shop", Annotations: map[string]string{
+				"owner": "payments",
+				"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"v1","kind":"Secret","stringData":{"password":"hunter2"}}`,
+			}},
+			Data: map[string][]byte{"password": []byte("hunter2")},
+		})}, theme.NewDefaultTheme(), "test")
+		secrets.SetSize(300, 20)
+		output = secrets.Render()
+		assert.Contains(t, output, "hidden")
+		assert.Contains(t, output, "payments")
+		assert.NotContains(t, output, "hunter2")
+	})
+
+	t.Run("should_report_columns_that_do_not_fit_their_view_and_keep_the_previous_columns", func(t *testing.T) {
+		resetColumnLayouts(t)
+
+		err := views.SetColumnLayouts(map[string][]config.Column{
+			"pods":    {{Name: "NAME"}, {Name: "COLOUR"}},
+			"widgets": {{Name: "NAME"}},
+		})
+
+		var columnErr *views.ColumnError
+		require.ErrorAs(t, err, &columnErr)
+		require.Len(t, columnErr.Problems, 2)
+		assert.Contains(t, columnErr.Problems[0], `columns.pods[1]: unknown column "COLOUR"`)
+		assert.Equal(t, "columns.widgets: columns are not configurable for widgets (expected one of: "+strings.Join(views.ConfigurableColumnResources(), ", ")+")", columnErr.Problems[1])
+
+		_, output := renderPods(pod)
+		assert.Contains(t, output, "RESTARTS")
+	})
+
+	t.Run("should_show_reorder_and_resize_columns_from_the_chooser", func(t *testing.T) {
+		resetColumnLayouts(t)
+		require.NoError(t, views.SetColumnLayouts(map[string][]config.Column{
+			"pods": {{Name: "NAME"}, {Name: "STATUS"}},
+		}))
+
+		view, _ := renderPods(pod)
+		assert.Nil(t, openColumnChooser(view))
+		require.NotNil(t, view.ColumnChooser())
+		assert.Contains(t, view.Render(), "Columns")
+
+		// STATUS moves ahead of NAME, and the hidden NAMESPACE column after them is shown
+		for _, key := range []tea.KeyMsg{keyMsg("j"), keyMsg("K"), keyMsg("j"), keyMsg("j"), keyMsg(" ")} {
+			handleColumnChooserKey(view, key)
+		}
+		assert.Equal(t, 2, view.ColumnChooser().Selected())
+
+		// NAME is narrowed back to its contents after being widened
+		handleColumnChooserKey(view, keyMsg("k"))
+		handleColumnChooserKey(view, keyMsg("+"))
+		name, width, shown := view.ColumnChooser().Columns().Entry(1)
+		assert.Equal(t, "NAME", name)
+		assert.Equal(t, 5, width)
+		assert.True(t, shown)
+		handleColumnChooserKey(view, keyMsg("-"))
+		_, width, _ = view.ColumnChooser().Columns().Entry(1)
+		assert.Equal(t, 0, width)
+
+		handleColumnChooserKey(view, tea.KeyMsg{Type: tea.KeyEsc})
+		assert.Nil(t, view.ColumnChooser())
+
+		output := view.Render()
+		header := output[strings.Index(output, "STATUS"):]
+		assert.Contains(t, header, "NAME")
+		assert.Contains(t, header, "NAMESPACE")
+		assert.Less(t, strings.Index(header, "NAME "), strings.Index(header, "NAMESPACE"))
+	})
+
+	t.Run("should_keep_the_last_column_shown", func(t *testing.T) {
+		resetColumnLayouts(t)
+		require.NoError(t, views.SetColumnLayouts(map[string][]config.Column{
+			"pods": {{Name: "NAME"}},
+		}))
+
+		view, _ := renderPods(pod)
+		openColumnChooser(view)
+		handleColumnChooserKey(view, keyMsg(" "))
+		_, _, shown := view.ColumnChooser().Columns().Entry(0)
+		assert.True(t, shown)
+	})
+}
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kevholditch/vigilant/internal/theme"
 	"github.com/kevholditch/vigilant/internal/views"
@@ -18,6 +20,8 @@ type CommandBarController struct {
 	availableResources []string
 	// Callback for switching views
 	onSwitchView func(string) tea.Cmd
+	// commands maps command names to the functions that run them with their argument
+	commands map[string]func(arg string) tea.Cmd
 }
 
 // NewCommandBarController creates a new command bar controller
@@ -29,9 +33,18 @@ func NewCommandBarController(clientset *kubernetes.Clientset, theme *theme.Theme
 		clusterName:        clusterName,
 		availableResources: availableResources,
 		onSwitchView:       onSwitchView,
+		commands:           make(map[string]func(arg string) tea.Cmd),
 	}
 }
 
+// AddCommand registers a command typed as "<name> <argument>", such as "theme dark". Arguments are offered as
+// completions once the name is typed, and run is called with the argument when the command is executed.
+// Adding a command again replaces its arguments and function.
+func (cbc *CommandBarController) AddCommand(name string, arguments []string, run func(arg string) tea.Cmd) {
+	cbc.commands[name] = run
+	cbc.commandBarView.SetCommand(name, arguments)
+}
+
 // HandleKey handles key press events for the command bar
 func (cbc *CommandBarController) HandleKey(msg tea.KeyMsg) tea.Cmd {
 	if !cbc.commandBarView.IsActive() {
@@ -117,6 +130,13 @@ func (cbc *CommandBarController) executeCommand() tea.Cmd {
 	// Deactivate command bar
 	cbc.commandBarView.Deactivate()
 
+	// Commands take an argument after their name; anything else names a view
+	if name, arg, ok := strings.Cut(strings.TrimSpace(input), " "); ok {
+		if run, exists := cbc.commands[name]; exists {
+			return run(strings.TrimSpace(arg))
+		}
+	}
+
 	// Execute the view switch command
 	if cbc.onSwitchView != nil {
 		return cbc.onSwitchView(input)
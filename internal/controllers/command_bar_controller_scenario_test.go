@@ -12,6 +12,9 @@ type CommandBarControllerScenario struct {
 	controller         *CommandBarController
 	theme              *theme.Theme
 	availableResources []string
+	commands           map[string][]string
+	// ranCommands records the commands run, as "<name> <argument>"
+	ranCommands []string
 }
 
 func NewCommandBarControllerScenario(t *testing.T) *CommandBarControllerScenario {
@@ -27,6 +30,14 @@ func (s *CommandBarControllerScenario) WithAvailableResources(resources ...strin
 	return s
 }
 
+func (s *CommandBarControllerScenario) WithCommand(name string, arguments ...string) *CommandBarControllerScenario {
+	if s.commands == nil {
+		s.commands = make(map[string][]string)
+	}
+	s.commands[name] = arguments
+	return s
+}
+
 func (s *CommandBarControllerScenario) Given() *CommandBarControllerScenario { return s }
 func (s *CommandBarControllerScenario) When() *CommandBarControllerScenario  { return s }
 func (s *CommandBarControllerScenario) Then() *CommandBarControllerScenario  { return s }
@@ -34,6 +45,13 @@ func (s *CommandBarControllerScenario) and() *CommandBarControllerScenario   { r
 
 func (s *CommandBarControllerScenario) the_command_bar_controller_is_instantiated() *CommandBarControllerScenario {
 	s.controller = NewCommandBarController(nil, s.theme, "", s.availableResources, nil)
+	for name, arguments := range s.commands {
+		name := name
+		s.controller.AddCommand(name, arguments, func(arg string) tea.Cmd {
+			s.ranCommands = append(s.ranCommands, name+" "+arg)
+			return nil
+		})
+	}
 	return s
 }
 
@@ -130,6 +148,18 @@ func (s *CommandBarControllerScenario) the_selected_suggestion_should_be(assertF
 	return s
 }
 
+func (s *CommandBarControllerScenario) the_user_types_text(text string) *CommandBarControllerScenario {
+	for _, char := range text {
+		s.the_user_types(char)
+	}
+	return s
+}
+
+func (s *CommandBarControllerScenario) the_commands_run_should_be(assertFn func([]string)) *CommandBarControllerScenario {
+	assertFn(s.ranCommands)
+	return s
+}
+
 func (s *CommandBarControllerScenario) Cleanup() {
 	// No cleanup needed for command bar controller as it doesn't have external resources
 }
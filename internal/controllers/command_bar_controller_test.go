@@ -230,4 +230,45 @@ func TestCommandBarController(t *testing.T) {
 			assert.Equal(t, []string{"pods"}, suggestions)
 		})
 	})
+
+	t.Run("should_complete_and_run_commands_with_arguments", func(t *testing.T) {
+		s := NewCommandBarControllerScenario(t).
+			WithAvailableResources("pods", "deployments").
+			WithCommand("theme", "dark", "light", "high-contrast")
+		defer s.Cleanup()
+		s.Given().
+			the_command_bar_controller_is_instantiated().
+			the_command_bar_is_activated().
+			When().
+			the_user_types_text("th").
+			Then().
+			the_suggestions_should_be(func(suggestions []string) {
+				assert.Equal(t, []string{"theme"}, suggestions)
+			}).
+			When().
+			the_user_presses_tab().
+			Then().
+			the_input_should_be(func(input string) {
+				assert.Equal(t, "theme ", input)
+			}).
+			the_suggestions_should_be(func(suggestions []string) {
+				assert.Equal(t, []string{"theme dark", "theme light", "theme high-contrast"}, suggestions)
+			}).
+			When().
+			the_user_types_text("h").
+			Then().
+			the_suggestions_should_be(func(suggestions []string) {
+				assert.Equal(t, []string{"theme high-contrast"}, suggestions)
+			}).
+			When().
+			the_user_presses_tab().
+			the_user_presses_enter().
+			Then().
+			the_commands_run_should_be(func(commands []string) {
+				assert.Equal(t, []string{"theme high-contrast"}, commands)
+			}).
+			the_command_bar_should_be_active(func(active bool) {
+				assert.False(t, active)
+			})
+	})
 }
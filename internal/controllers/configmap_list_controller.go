@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// configMapResource lists and watches config maps for the config map list
+var configMapResource = typedResource("configmaps", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*corev1.ConfigMapList] {
+		return clientset.CoreV1().ConfigMaps(namespace)
+	},
+	func(_ string, configMap *corev1.ConfigMap) models.ConfigMap {
+		return models.ToConfigMapModel(*configMap)
+	})
+
+// ConfigMapListController handles input for the config map list view
+type ConfigMapListController struct {
+	configMapView *views.ConfigMapListView
+	clientset     *kubernetes.Clientset
+	theme         *theme.Theme
+	clusterName   string
+	width         int
+	height        int
+
+	// watches lists and watches the config maps, listing again whenever the watch ends
+	watches *clusterWatches[models.ConfigMap]
+}
+
+// NewConfigMapListController creates a new config map list controller
+func NewConfigMapListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *ConfigMapListController {
+	return NewNamespacedConfigMapListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedConfigMapListController creates a new config map list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedConfigMapListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *ConfigMapListController {
+	controller := &ConfigMapListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, configMapResource),
+	}
+
+	// Create the view with initial config maps
+	configMapView := views.NewConfigMapListView(controller.getConfigMapsList(), theme, clusterName)
+	controller.configMapView = configMapView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *ConfigMapListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the config map list view with current config maps
+func (c *ConfigMapListController) updateView() {
+	c.configMapView.UpdateConfigMaps(c.getConfigMapsList())
+}
+
+// getConfigMapsList returns the current config maps as a slice in consistent order
+func (c *ConfigMapListController) getConfigMapsList() []models.ConfigMap {
+	return c.watches.list()
+}
+
+// configMapListKeys are the key bindings of the config map list
+var configMapListKeys = registerKeyMap("configmaps", "Config map list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected config map"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected config map"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the config map list view
+func (c *ConfigMapListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.configMapView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.configMapView, msg)
+	}
+
+	switch configMapListKeys.Action(msg) {
+	case ActionUp:
+		c.configMapView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.configMapView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedConfigMap()
+	case ActionXRay:
+		return c.xraySelectedConfigMap()
+	case ActionRefresh:
+		// Refresh config maps
+		return c.refreshConfigMaps()
+	case ActionColumns:
+		return openColumnChooser(c.configMapView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the config map list, which the help overlay lists
+func (c *ConfigMapListController) KeyMap() *KeyMap {
+	return configMapListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *ConfigMapListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *ConfigMapListController) Modal() bool {
+	return c.configMapView.ColumnChooser() != nil
+}
+
+// describeSelectedConfigMap pushes the describe view for the selected config map
+func (c *ConfigMapListController) describeSelectedConfigMap() tea.Cmd {
+	selectedConfigMap := c.configMapView.GetSelected()
+	if selectedConfigMap == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeConfigMapController(c.clientset, c.theme, selectedConfigMap.Name, selectedConfigMap.Namespace)
+	return PushView(describeCtrl, selectedConfigMap.Namespace+"/"+selectedConfigMap.Name)
+}
+
+// xraySelectedConfigMap pushes the x-ray view of the selected config map
+func (c *ConfigMapListController) xraySelectedConfigMap() tea.Cmd {
+	selectedConfigMap := c.configMapView.GetSelected()
+	if selectedConfigMap == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "ConfigMap", Namespace: selectedConfigMap.Namespace, Name: selectedConfigMap.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *ConfigMapListController) ActionText() string {
+	return "Listing config maps"
+}
+
+// Render returns the rendered config map list view
+func (c *ConfigMapListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.configMapView.SetSize(width, height)
+	return c.configMapView.Render()
+}
+
+// refreshConfigMaps lists config maps again off the update loop, replacing those listed before
+func (c *ConfigMapListController) refreshConfigMaps() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetConfigMaps returns the current list of config maps
+func (c *ConfigMapListController) GetConfigMaps() []models.ConfigMap {
+	return c.getConfigMapsList()
+}
+
+// GetUpdateChannel returns the channel carrying config map watch events
+func (c *ConfigMapListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *ConfigMapListController) Stop() {
+	c.watches.stop()
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type ConfigMapListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *ConfigMapListController
+	pushed     PushViewMsg
+}
+
+func NewConfigMapListControllerScenario(t *testing.T) *ConfigMapListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &ConfigMapListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *ConfigMapListControllerScenario) Given() *ConfigMapListControllerScenario { return s }
+func (s *ConfigMapListControllerScenario) When() *ConfigMapListControllerScenario  { return s }
+func (s *ConfigMapListControllerScenario) Then() *ConfigMapListControllerScenario  { return s }
+func (s *ConfigMapListControllerScenario) and() *ConfigMapListControllerScenario   { return s }
+
+func (s *ConfigMapListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *ConfigMapListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *ConfigMapListControllerScenario) the_config_map_list_controller_is_instantiated() *ConfigMapListControllerScenario {
+	s.controller = NewConfigMapListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *ConfigMapListControllerScenario) the_user_presses(msg tea.KeyMsg) *ConfigMapListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *ConfigMapListControllerScenario) the_config_map_list_should_be(assertFn func([]models.ConfigMap)) *ConfigMapListControllerScenario {
+	assertFn(s.controller.GetConfigMaps())
+	return s
+}
+
+func (s *ConfigMapListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *ConfigMapListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *ConfigMapListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
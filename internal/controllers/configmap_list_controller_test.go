@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigMapListController(t *testing.T) {
+	t.Run("should_list_config_maps_with_their_key_count", func(t *testing.T) {
+		s := NewConfigMapListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithConfigMap("settings", "apps", map[string]string{"log.level": "debug", "app.yaml": "port: 8080"})
+			}).
+			When().
+			the_config_map_list_controller_is_instantiated().
+			Then().
+			the_config_map_list_should_be(func(configMaps []models.ConfigMap) {
+				var settings *models.ConfigMap
+				for i := range configMaps {
+					if configMaps[i].Namespace == "apps" && configMaps[i].Name == "settings" {
+						settings = &configMaps[i]
+					}
+				}
+				if assert.NotNil(t, settings) {
+					assert.Equal(t, 2, settings.KeyCount())
+				}
+			})
+	})
+
+	t.Run("should_describe_the_selected_config_map", func(t *testing.T) {
+		s := NewConfigMapListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithConfigMap("settings", "apps", map[string]string{"log.level": "debug"})
+			}).
+			the_config_map_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				_, ok := pushed.Controller.(*DescribeConfigMapController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
 )
 
 // Controller defines the interface for handling view-specific input and rendering
@@ -9,6 +10,9 @@ type Controller interface {
 	// HandleKey handles key press events and returns a command
 	HandleKey(msg tea.KeyMsg) tea.Cmd
 
+	// Update applies a non-key message on the update loop and returns a command
+	Update(msg tea.Msg) tea.Cmd
+
 	// Render returns the rendered view content
 	Render(width, height int) string
 
@@ -16,10 +20,52 @@ type Controller interface {
 	ActionText() string
 }
 
+// ListFollower is a controller following the selection of a list below it on the navigation stack, such as a split.
+// The list's background updates go straight to the list, so the follower is told of each one as it may move or
+// remove the selected row.
+type ListFollower interface {
+	ListUpdated(list Controller) tea.Cmd
+}
+
 // UpdateableController extends Controller with update functionality
 type UpdateableController interface {
 	Controller
 
-	// GetUpdateChannel returns a channel for receiving update messages
+	// GetUpdateChannel returns a channel of messages produced by background work such as watches.
+	// Messages received on it must be passed back to Update on the update loop.
 	GetUpdateChannel() <-chan tea.Msg
 }
+
+// StoppableController extends Controller with the release of background resources such as watches.
+// Stop is called when the controller is popped off the navigation stack.
+type StoppableController interface {
+	Controller
+
+	// Stop releases the controller's background resources
+	Stop()
+}
+
+// ClusterHealthController extends Controller for views that show several clusters at once, whose connection health
+// the header shows in place of the current cluster's details
+type ClusterHealthController interface {
+	Controller
+
+	// ClusterHealth returns the connection health of each cluster the view shows
+	ClusterHealth() []models.ClusterHealth
+}
+
+// DetailController extends Controller for lists whose selected row has views, such as its description, that a split
+// can show beside the list and keep following the selection
+type DetailController interface {
+	Controller
+
+	// Details returns the actions whose views of the selected row can be shown beside the list, such as ActionLogs
+	Details() []Action
+
+	// Selection returns a key identifying the selected row, or "" when nothing is selected
+	Selection() string
+
+	// Detail creates the view of the selected row for one of the actions from Details, or returns nil when nothing
+	// is selected
+	Detail(action Action) Controller
+}
@@ -7,12 +7,17 @@ import (
 
 type Theme = theme.Theme
 
+// ControllerFactory creates the root controller for a resource. Namespaced resources are restricted to
+// namespace; an empty namespace means all namespaces.
+type ControllerFactory func(clientset *kubernetes.Clientset, theme *Theme, namespace string) Controller
+
 // ControllerRegistry manages controller lifecycle with caching
 type ControllerRegistry struct {
 	clientset *kubernetes.Clientset
 	theme     *Theme
+	namespace string // active namespace scope passed to factories; empty for all namespaces
 	cache     map[string]Controller
-	factories map[string]func(*kubernetes.Clientset, *Theme) Controller
+	factories map[string]ControllerFactory
 }
 
 // NewControllerRegistry creates a new registry
@@ -21,12 +26,12 @@ func NewControllerRegistry(clientset *kubernetes.Clientset, theme *Theme) *Contr
 		clientset: clientset,
 		theme:     theme,
 		cache:     make(map[string]Controller),
-		factories: make(map[string]func(*kubernetes.Clientset, *Theme) Controller),
+		factories: make(map[string]ControllerFactory),
 	}
 }
 
 // Register adds a controller factory to the registry
-func (r *ControllerRegistry) Register(resource string, factory func(*kubernetes.Clientset, *Theme) Controller) {
+func (r *ControllerRegistry) Register(resource string, factory ControllerFactory) {
 	r.factories[resource] = factory
 }
 
@@ -39,7 +44,7 @@ func (r *ControllerRegistry) GetController(resource string) (Controller, bool) {
 
 	// Create new controller if factory exists
 	if factory, exists := r.factories[resource]; exists {
-		controller := factory(r.clientset, r.theme)
+		controller := factory(r.clientset, r.theme, r.namespace)
 		r.cache[resource] = controller
 		return controller, true
 	}
@@ -56,6 +61,28 @@ func (r *ControllerRegistry) GetAvailableResources() []string {
 	return resources
 }
 
+// Namespace returns the active namespace scope, empty for all namespaces
+func (r *ControllerRegistry) Namespace() string {
+	return r.namespace
+}
+
+// SetNamespace changes the active namespace scope. Cached controllers were created for the previous
+// scope, so they are dropped from the cache and returned for the caller to stop.
+func (r *ControllerRegistry) SetNamespace(namespace string) []Controller {
+	r.namespace = namespace
+	return r.Release()
+}
+
+// Release drops every cached controller, so that each is rebuilt on demand, and returns them for the caller to stop
+func (r *ControllerRegistry) Release() []Controller {
+	discarded := make([]Controller, 0, len(r.cache))
+	for _, controller := range r.cache {
+		discarded = append(discarded, controller)
+	}
+	r.ClearCache()
+	return discarded
+}
+
 // ClearCache clears the controller cache (useful for testing or memory management)
 func (r *ControllerRegistry) ClearCache() {
 	r.cache = make(map[string]Controller)
@@ -0,0 +1,316 @@
+package controllers
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// cronJobResource lists and watches cron jobs for the cron job list
+var cronJobResource = typedResource("cronjobs", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*batchv1.CronJobList] {
+		return clientset.BatchV1().CronJobs(namespace)
+	},
+	func(_ string, cronJob *batchv1.CronJob) models.CronJob {
+		return models.ToCronJobModel(*cronJob)
+	})
+
+// CronJobListController handles input for the cron job list view
+type CronJobListController struct {
+	cronJobView *views.CronJobListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	// readOnly disables triggering and suspending cron jobs
+	readOnly bool
+	width    int
+	height   int
+
+	// watches lists and watches the cron jobs, listing again whenever the watch ends
+	watches *clusterWatches[models.CronJob]
+}
+
+// NewCronJobListController creates a new cron job list controller. In read-only mode cron jobs cannot be triggered or suspended.
+func NewCronJobListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, readOnly bool) *CronJobListController {
+	return NewNamespacedCronJobListController(clientset, theme, clusterName, "", readOnly)
+}
+
+// NewNamespacedCronJobListController creates a new cron job list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedCronJobListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string, readOnly bool) *CronJobListController {
+	controller := &CronJobListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		readOnly:    readOnly,
+		watches:     newClusterWatch(clientset, clusterName, namespace, cronJobResource),
+	}
+
+	// Create the view with initial cron jobs
+	cronJobView := views.NewCronJobListView(controller.getCronJobsList(), theme, clusterName)
+	controller.cronJobView = cronJobView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *CronJobListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+		return nil
+	}
+	switch msg := msg.(type) {
+	case cronJobTriggeredMsg:
+		if msg.err != nil {
+			debugLogger.Printf("error triggering cron job %s: %v", msg.cronJobName, msg.err)
+		}
+		c.cronJobView.SetStatusMessage(triggerStatusMessage(msg))
+	case cronJobSuspendedMsg:
+		if msg.err != nil {
+			debugLogger.Printf("error patching cron job %s: %v", msg.cronJobName, msg.err)
+		}
+		c.cronJobView.SetStatusMessage(suspendStatusMessage(msg))
+	}
+	return nil
+}
+
+// updateView updates the cron job list view with current cron jobs
+func (c *CronJobListController) updateView() {
+	c.cronJobView.UpdateCronJobs(c.getCronJobsList())
+}
+
+// getCronJobsList returns the current cron jobs as a slice in consistent order
+func (c *CronJobListController) getCronJobsList() []models.CronJob {
+	return c.watches.list()
+}
+
+// cronJobListKeys are the key bindings of the cron job list
+var cronJobListKeys = registerKeyMap("cronjobs", "Cron job list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected cron job"},
+	Binding{Action: ActionTrigger, Keys: []string{"t"}, Help: "Trigger a job from the selected cron job"},
+	Binding{Action: ActionSuspend, Keys: []string{"s"}, Help: "Suspend or resume the selected cron job"},
+	Binding{Action: ActionLogs, Keys: []string{"l"}, Help: "Show the logs of the latest job"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected cron job"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the cron job list view
+func (c *CronJobListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.cronJobView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.cronJobView, msg)
+	}
+
+	switch cronJobListKeys.Action(msg) {
+	case ActionUp:
+		c.cronJobView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.cronJobView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedCronJob()
+	case ActionTrigger:
+		return c.triggerSelectedCronJob()
+	case ActionSuspend:
+		return c.toggleSelectedCronJobSuspend()
+	case ActionLogs:
+		return c.openSelectedCronJobLogs()
+	case ActionXRay:
+		return c.xraySelectedCronJob()
+	case ActionRefresh:
+		// Refresh cron jobs
+		return c.refreshCronJobs()
+	case ActionColumns:
+		return openColumnChooser(c.cronJobView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the cron job list, which the help overlay lists
+func (c *CronJobListController) KeyMap() *KeyMap {
+	return cronJobListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *CronJobListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *CronJobListController) Modal() bool {
+	return c.cronJobView.ColumnChooser() != nil
+}
+
+// describeSelectedCronJob pushes the describe view for the selected cron job
+func (c *CronJobListController) describeSelectedCronJob() tea.Cmd {
+	selectedCronJob := c.cronJobView.GetSelected()
+	if selectedCronJob == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeCronJobController(c.clientset, c.theme, selectedCronJob.Name, selectedCronJob.Namespace, c.readOnly)
+	return PushView(describeCtrl, selectedCronJob.Namespace+"/"+selectedCronJob.Name)
+}
+
+// triggerSelectedCronJob creates a job from the selected cron job's template off the update loop
+func (c *CronJobListController) triggerSelectedCronJob() tea.Cmd {
+	if c.readOnly {
+		c.cronJobView.SetStatusMessage("Triggering cron jobs is disabled in read-only mode")
+		return nil
+	}
+	selectedCronJob := c.cronJobView.GetSelected()
+	if selectedCronJob == nil {
+		return nil
+	}
+	return triggerCronJob(c.clientset, selectedCronJob.Namespace, selectedCronJob.Name)
+}
+
+// toggleSelectedCronJobSuspend suspends the selected cron job, or resumes it if it is suspended.
+// The cron job watch picks up the change, so the result message only reports the outcome.
+func (c *CronJobListController) toggleSelectedCronJobSuspend() tea.Cmd {
+	if c.readOnly {
+		c.cronJobView.SetStatusMessage("Suspending cron jobs is disabled in read-only mode")
+		return nil
+	}
+	selectedCronJob := c.cronJobView.GetSelected()
+	if selectedCronJob == nil {
+		return nil
+	}
+	return setCronJobSuspend(c.clientset, selectedCronJob.Namespace, selectedCronJob.Name, !selectedCronJob.Suspend)
+}
+
+// openSelectedCronJobLogs pushes the logs of the most recent pod of the selected cron job's latest job
+func (c *CronJobListController) openSelectedCronJobLogs() tea.Cmd {
+	selectedCronJob := c.cronJobView.GetSelected()
+	if selectedCronJob == nil {
+		return nil
+	}
+	cmd, err := openCronJobLatestLogs(c.clientset, c.theme, selectedCronJob.Namespace, selectedCronJob.Name)
+	if err != nil {
+		debugLogger.Printf("error finding latest job pod: %v", err)
+		c.cronJobView.SetStatusMessage(fmt.Sprintf("No logs to show: %v", err))
+		return nil
+	}
+	return cmd
+}
+
+// cronJobTriggeredMsg carries the result of triggering a cron job to the update loop
+type cronJobTriggeredMsg struct {
+	namespace   string
+	cronJobName string
+	jobName     string
+	err         error
+}
+
+// triggerCronJob returns a command that creates a job from a cron job's template and reports a cronJobTriggeredMsg
+func triggerCronJob(clientset *kubernetes.Clientset, namespace, cronJobName string) tea.Cmd {
+	return func() tea.Msg {
+		jobName, err := models.TriggerCronJob(clientset, namespace, cronJobName)
+		return cronJobTriggeredMsg{namespace: namespace, cronJobName: cronJobName, jobName: jobName, err: err}
+	}
+}
+
+// triggerStatusMessage describes the outcome of a trigger for the status bar
+func triggerStatusMessage(msg cronJobTriggeredMsg) string {
+	if msg.err != nil {
+		return fmt.Sprintf("Cron job %s could not be triggered: %v", msg.cronJobName, msg.err)
+	}
+	return fmt.Sprintf("Cron job %s triggered, created job %s", msg.cronJobName, msg.jobName)
+}
+
+// cronJobSuspendedMsg carries the result of suspending or resuming a cron job to the update loop
+type cronJobSuspendedMsg struct {
+	namespace   string
+	cronJobName string
+	suspend     bool
+	err         error
+}
+
+// setCronJobSuspend returns a command that patches spec.suspend and reports a cronJobSuspendedMsg
+func setCronJobSuspend(clientset *kubernetes.Clientset, namespace, cronJobName string, suspend bool) tea.Cmd {
+	return func() tea.Msg {
+		err := models.SetCronJobSuspend(clientset, namespace, cronJobName, suspend)
+		return cronJobSuspendedMsg{namespace: namespace, cronJobName: cronJobName, suspend: suspend, err: err}
+	}
+}
+
+// suspendStatusMessage describes the outcome of a suspend or resume for the status bar
+func suspendStatusMessage(msg cronJobSuspendedMsg) string {
+	action := "suspended"
+	if !msg.suspend {
+		action = "resumed"
+	}
+	if msg.err != nil {
+		return fmt.Sprintf("Cron job %s could not be %s: %v", msg.cronJobName, action, msg.err)
+	}
+	return fmt.Sprintf("Cron job %s %s", msg.cronJobName, action)
+}
+
+// openCronJobLatestLogs finds the most recent pod of a cron job's latest job and returns a command pushing its logs
+func openCronJobLatestLogs(clientset *kubernetes.Clientset, theme *theme.Theme, namespace, cronJobName string) (tea.Cmd, error) {
+	_, podName, err := models.GetCronJobLatestPod(clientset, namespace, cronJobName)
+	if err != nil {
+		return nil, err
+	}
+	logCtrl := NewPodLogController(NewKubernetesLogFetcher(clientset), theme, podName, namespace)
+	return PushView(logCtrl, namespace+"/"+podName+" logs"), nil
+}
+
+// xraySelectedCronJob pushes the x-ray view of the selected cron job
+func (c *CronJobListController) xraySelectedCronJob() tea.Cmd {
+	selectedCronJob := c.cronJobView.GetSelected()
+	if selectedCronJob == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "CronJob", Namespace: selectedCronJob.Namespace, Name: selectedCronJob.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *CronJobListController) ActionText() string {
+	return "Listing cron jobs"
+}
+
+// Render returns the rendered cron job list view
+func (c *CronJobListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.cronJobView.SetSize(width, height)
+	return c.cronJobView.Render()
+}
+
+// refreshCronJobs lists cron jobs again off the update loop, replacing those listed before
+func (c *CronJobListController) refreshCronJobs() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetStatusMessage returns the message shown in the status bar (for testing)
+func (c *CronJobListController) GetStatusMessage() string {
+	return c.cronJobView.StatusMessage()
+}
+
+// GetCronJobs returns the current list of cron jobs
+func (c *CronJobListController) GetCronJobs() []models.CronJob {
+	return c.getCronJobsList()
+}
+
+// GetUpdateChannel returns the channel carrying cron job watch events
+func (c *CronJobListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *CronJobListController) Stop() {
+	c.watches.stop()
+}
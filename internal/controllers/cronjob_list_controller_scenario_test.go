@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/stretchr/testify/require"
+)
+
+type CronJobListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *CronJobListController
+	pushed     PushViewMsg
+}
+
+func NewCronJobListControllerScenario(t *testing.T) *CronJobListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &CronJobListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *CronJobListControllerScenario) Given() *CronJobListControllerScenario { return s }
+func (s *CronJobListControllerScenario) When() *CronJobListControllerScenario  { return s }
+func (s *CronJobListControllerScenario) Then() *CronJobListControllerScenario  { return s }
+func (s *CronJobListControllerScenario) and() *CronJobListControllerScenario   { return s }
+
+func (s *CronJobListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *CronJobListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *CronJobListControllerScenario) the_cron_job_list_controller_is_instantiated() *CronJobListControllerScenario {
+	s.controller = NewCronJobListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster", false)
+	return s
+}
+
+func (s *CronJobListControllerScenario) the_user_presses(msg tea.KeyMsg) *CronJobListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	result := cmd()
+	if pushed, ok := result.(PushViewMsg); ok {
+		s.pushed = pushed
+		return s
+	}
+	// Deliver the action's result to the update loop
+	s.controller.Update(result)
+	return s
+}
+
+func (s *CronJobListControllerScenario) the_status_message_should_be(assertFn func(string)) *CronJobListControllerScenario {
+	assertFn(s.controller.GetStatusMessage())
+	return s
+}
+
+func (s *CronJobListControllerScenario) the_jobs_of_the_cron_job_should_be(name, namespace string, assertFn func([]models.Job)) *CronJobListControllerScenario {
+	cronJob, err := models.GetCronJob(s.builder.GetClientset(), namespace, name)
+	require.NoError(s.t, err)
+	jobs, err := models.GetCronJobJobs(s.builder.GetClientset(), cronJob)
+	require.NoError(s.t, err)
+	assertFn(jobs)
+	return s
+}
+
+func (s *CronJobListControllerScenario) the_cron_job_list_should_be(assertFn func([]models.CronJob)) *CronJobListControllerScenario {
+	assertFn(s.controller.GetCronJobs())
+	return s
+}
+
+func (s *CronJobListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *CronJobListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *CronJobListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
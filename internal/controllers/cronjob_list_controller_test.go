@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronJobListController(t *testing.T) {
+	t.Run("should_list_cron_jobs_with_their_schedule", func(t *testing.T) {
+		s := NewCronJobListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("backup", "default", "0 3 * * *")
+			}).
+			When().
+			the_cron_job_list_controller_is_instantiated().
+			Then().
+			the_cron_job_list_should_be(func(cronJobs []models.CronJob) {
+				if assert.Len(t, cronJobs, 1) {
+					backup := cronJobs[0]
+					assert.Equal(t, "0 3 * * *", backup.Schedule)
+					assert.Equal(t, "At 03:00 every day", backup.FormatSchedule())
+					assert.False(t, backup.Suspend)
+					assert.Equal(t, "<none>", backup.FormatLastSchedule())
+				}
+			})
+	})
+
+	t.Run("should_trigger_the_selected_cron_job_now", func(t *testing.T) {
+		s := NewCronJobListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("backup", "default", "0 3 * * *")
+			}).
+			the_cron_job_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")}).
+			Then().
+			the_jobs_of_the_cron_job_should_be("backup", "default", func(jobs []models.Job) {
+				if assert.Len(t, jobs, 1) {
+					assert.True(t, strings.HasPrefix(jobs[0].Name, "backup-manual-"))
+					assert.Equal(t, "backup", jobs[0].CronJob)
+				}
+			}).
+			the_status_message_should_be(func(message string) {
+				assert.Contains(t, message, "Cron job backup triggered, created job backup-manual-")
+			})
+	})
+
+	t.Run("should_suspend_the_selected_cron_job", func(t *testing.T) {
+		s := NewCronJobListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("backup", "default", "0 3 * * *")
+			}).
+			the_cron_job_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}).
+			Then().
+			the_status_message_should_be(func(message string) {
+				assert.Equal(t, "Cron job backup suspended", message)
+			})
+	})
+}
@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// daemonSetResource lists and watches daemon sets for the daemon set list
+var daemonSetResource = typedResource("daemonsets", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*appsv1.DaemonSetList] {
+		return clientset.AppsV1().DaemonSets(namespace)
+	},
+	func(_ string, daemonSet *appsv1.DaemonSet) models.DaemonSet {
+		return models.ToDaemonSetModel(*daemonSet)
+	})
+
+// DaemonSetListController handles input for the daemon set list view
+type DaemonSetListController struct {
+	daemonSetView *views.DaemonSetListView
+	clientset     *kubernetes.Clientset
+	theme         *theme.Theme
+	clusterName   string
+	width         int
+	height        int
+
+	// watches lists and watches the daemon sets, listing again whenever the watch ends
+	watches *clusterWatches[models.DaemonSet]
+}
+
+// NewDaemonSetListController creates a new daemon set list controller
+func NewDaemonSetListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *DaemonSetListController {
+	return NewNamespacedDaemonSetListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedDaemonSetListController creates a new daemon set list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedDaemonSetListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *DaemonSetListController {
+	controller := &DaemonSetListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, daemonSetResource),
+	}
+
+	// Create the view with initial daemon sets
+	daemonSetView := views.NewDaemonSetListView(controller.getDaemonSetsList(), theme, clusterName)
+	controller.daemonSetView = daemonSetView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *DaemonSetListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the daemon set list view with current daemon sets
+func (c *DaemonSetListController) updateView() {
+	c.daemonSetView.UpdateDaemonSets(c.getDaemonSetsList())
+}
+
+// getDaemonSetsList returns the current daemon sets as a slice in consistent order
+func (c *DaemonSetListController) getDaemonSetsList() []models.DaemonSet {
+	return c.watches.list()
+}
+
+// daemonSetListKeys are the key bindings of the daemon set list
+var daemonSetListKeys = registerKeyMap("daemonsets", "Daemon set list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected daemon set"},
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods of the selected daemon set"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected daemon set"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the daemon set list view
+func (c *DaemonSetListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.daemonSetView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.daemonSetView, msg)
+	}
+
+	switch daemonSetListKeys.Action(msg) {
+	case ActionUp:
+		c.daemonSetView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.daemonSetView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedDaemonSet()
+	case ActionPods:
+		return c.openSelectedDaemonSetPods()
+	case ActionXRay:
+		return c.xraySelectedDaemonSet()
+	case ActionRefresh:
+		// Refresh daemon sets
+		return c.refreshDaemonSets()
+	case ActionColumns:
+		return openColumnChooser(c.daemonSetView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the daemon set list, which the help overlay lists
+func (c *DaemonSetListController) KeyMap() *KeyMap {
+	return daemonSetListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *DaemonSetListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *DaemonSetListController) Modal() bool {
+	return c.daemonSetView.ColumnChooser() != nil
+}
+
+// describeSelectedDaemonSet pushes the describe view for the selected daemon set
+func (c *DaemonSetListController) describeSelectedDaemonSet() tea.Cmd {
+	selectedDaemonSet := c.daemonSetView.GetSelected()
+	if selectedDaemonSet == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeDaemonSetController(c.clientset, c.theme, selectedDaemonSet.Name, selectedDaemonSet.Namespace)
+	return PushView(describeCtrl, selectedDaemonSet.Namespace+"/"+selectedDaemonSet.Name)
+}
+
+// openSelectedDaemonSetPods pushes a pod list scoped to the pods of the selected daemon set
+func (c *DaemonSetListController) openSelectedDaemonSetPods() tea.Cmd {
+	selectedDaemonSet := c.daemonSetView.GetSelected()
+	if selectedDaemonSet == nil || selectedDaemonSet.Selector == "" {
+		return nil
+	}
+	return PushView(newDaemonSetPodListController(c.clientset, c.theme, c.clusterName, selectedDaemonSet), selectedDaemonSet.Namespace+"/"+selectedDaemonSet.Name+" pods")
+}
+
+// newDaemonSetPodListController creates a pod list scoped to the pods of a daemon set
+func newDaemonSetPodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, daemonSet *models.DaemonSet) *PodListController {
+	return NewScopedPodListController(clientset, theme, clusterName, PodListScope{
+		Namespace:     daemonSet.Namespace,
+		LabelSelector: daemonSet.Selector,
+		Description:   "daemon set " + daemonSet.Namespace + "/" + daemonSet.Name,
+	})
+}
+
+// xraySelectedDaemonSet pushes the x-ray view of the selected daemon set
+func (c *DaemonSetListController) xraySelectedDaemonSet() tea.Cmd {
+	selectedDaemonSet := c.daemonSetView.GetSelected()
+	if selectedDaemonSet == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "DaemonSet", Namespace: selectedDaemonSet.Namespace, Name: selectedDaemonSet.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DaemonSetListController) ActionText() string {
+	return "Listing daemon sets"
+}
+
+// Render returns the rendered daemon set list view
+func (c *DaemonSetListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.daemonSetView.SetSize(width, height)
+	return c.daemonSetView.Render()
+}
+
+// refreshDaemonSets lists daemon sets again off the update loop, replacing those listed before
+func (c *DaemonSetListController) refreshDaemonSets() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetDaemonSets returns the current list of daemon sets
+func (c *DaemonSetListController) GetDaemonSets() []models.DaemonSet {
+	return c.getDaemonSetsList()
+}
+
+// GetUpdateChannel returns the channel carrying daemon set watch events
+func (c *DaemonSetListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *DaemonSetListController) Stop() {
+	c.watches.stop()
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DaemonSetListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DaemonSetListController
+	pushed     PushViewMsg
+}
+
+func NewDaemonSetListControllerScenario(t *testing.T) *DaemonSetListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DaemonSetListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DaemonSetListControllerScenario) Given() *DaemonSetListControllerScenario { return s }
+func (s *DaemonSetListControllerScenario) When() *DaemonSetListControllerScenario  { return s }
+func (s *DaemonSetListControllerScenario) Then() *DaemonSetListControllerScenario  { return s }
+func (s *DaemonSetListControllerScenario) and() *DaemonSetListControllerScenario   { return s }
+
+func (s *DaemonSetListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DaemonSetListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DaemonSetListControllerScenario) the_daemon_set_list_controller_is_instantiated() *DaemonSetListControllerScenario {
+	s.controller = NewDaemonSetListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *DaemonSetListControllerScenario) the_user_presses(msg tea.KeyMsg) *DaemonSetListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *DaemonSetListControllerScenario) the_daemon_set_list_should_be(assertFn func([]models.DaemonSet)) *DaemonSetListControllerScenario {
+	assertFn(s.controller.GetDaemonSets())
+	return s
+}
+
+func (s *DaemonSetListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DaemonSetListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DaemonSetListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaemonSetListController(t *testing.T) {
+	t.Run("should_list_daemon_sets_with_their_node_selector", func(t *testing.T) {
+		s := NewDaemonSetListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDaemonSet("log-agent", "logging", map[string]string{"kubernetes.io/os": "linux"})
+			}).
+			When().
+			the_daemon_set_list_controller_is_instantiated().
+			Then().
+			the_daemon_set_list_should_be(func(daemonSets []models.DaemonSet) {
+				if assert.Len(t, daemonSets, 1) {
+					agent := daemonSets[0]
+					assert.Equal(t, "log-agent", agent.Name)
+					assert.Equal(t, "kubernetes.io/os=linux", agent.FormatNodeSelector())
+					assert.Equal(t, "RollingUpdate", agent.UpdateStrategy)
+				}
+			})
+	})
+
+	t.Run("should_drill_down_to_the_daemon_set_pods", func(t *testing.T) {
+		s := NewDaemonSetListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(2).
+					WithDaemonSet("log-agent", "logging", nil).
+					WithDaemonSetPod("log-agent", "logging", "worker-node-1").
+					WithDaemonSetPod("log-agent", "logging", "worker-node-2")
+			}).
+			the_daemon_set_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "logging/log-agent pods", pushed.Title)
+				podList, ok := pushed.Controller.(*PodListController)
+				if assert.True(t, ok) {
+					assert.Len(t, podList.GetPods(), 2)
+				}
+			})
+	})
+}
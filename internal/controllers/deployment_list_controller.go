@@ -1,157 +1,74 @@
 package controllers
 
 import (
-	"context"
-
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/kevholditch/vigilant/internal/theme"
-	"github.com/kevholditch/vigilant/internal/utils"
 	"github.com/kevholditch/vigilant/internal/views"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 )
 
+// deploymentResource lists and watches deployments for the deployment list
+var deploymentResource = typedResource("deployments", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*appsv1.DeploymentList] {
+		return clientset.AppsV1().Deployments(namespace)
+	},
+	func(_ string, deployment *appsv1.Deployment) models.Deployment {
+		return models.ToDeploymentModel(*deployment)
+	})
+
 // DeploymentListController handles input for the deployment list view
 type DeploymentListController struct {
-	deploymentView       *views.DeploymentListView
-	onDescribeDeployment func(*views.DeploymentListView) tea.Cmd
-	clientset            *kubernetes.Clientset
-	theme                *theme.Theme
-	clusterName          string
-	width                int
-	height               int
-
-	// Watch-related fields
-	deployments     *utils.OrderedMap[models.Deployment] // ordered collection of deployments
-	watchStarted    bool
-	resourceVersion string // store resource version here
-	needsUpdate     bool   // Flag to indicate if view needs updating
-
-	// Message channel for updates
-	updateChan chan tea.Msg
-
-	ctx    context.Context
-	cancel context.CancelFunc
+	deploymentView *views.DeploymentListView
+	clientset      *kubernetes.Clientset
+	theme          *theme.Theme
+	clusterName    string
+	width          int
+	height         int
+
+	// watches lists and watches the deployments, listing again whenever the watch ends
+	watches *clusterWatches[models.Deployment]
 }
 
 // NewDeploymentListController creates a new deployment list controller
-func NewDeploymentListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, onDescribeDeployment func(*views.DeploymentListView) tea.Cmd) *DeploymentListController {
-	ctx, cancel := context.WithCancel(context.Background())
+func NewDeploymentListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *DeploymentListController {
+	return NewNamespacedDeploymentListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedDeploymentListController creates a new deployment list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedDeploymentListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *DeploymentListController {
 	controller := &DeploymentListController{
-		onDescribeDeployment: onDescribeDeployment,
-		clientset:            clientset,
-		theme:                theme,
-		clusterName:          clusterName,
-		deployments:          utils.NewOrderedMap[models.Deployment](),
-		updateChan:           make(chan tea.Msg),
-		ctx:                  ctx,
-		cancel:               cancel,
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, deploymentResource),
 	}
 
-	// Initialize with initial deployment list
-	controller.initializeDeployments()
-
 	// Create the view with initial deployments
 	deploymentView := views.NewDeploymentListView(controller.getDeploymentsList(), theme, clusterName)
 	controller.deploymentView = deploymentView
 
 	// Start watching for changes
-	controller.startWatch()
+	controller.watches.start()
 
 	return controller
 }
 
-// initializeDeployments fetches initial deployments and populates the map
-func (c *DeploymentListController) initializeDeployments() {
-	deploymentList, err := c.clientset.AppsV1().Deployments("").List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		debugLogger.Printf("error getting initial deployments: %v", err)
-		return
-	}
-
-	// Clear existing data
-	c.deployments.Clear()
-
-	// Add deployments in a consistent order (sorted by namespace, then name)
-	for _, k8sDeployment := range deploymentList.Items {
-		key := k8sDeployment.Namespace + "/" + k8sDeployment.Name
-		c.deployments.Set(key, models.ToDeploymentModel(k8sDeployment))
-	}
-
-	c.resourceVersion = deploymentList.ResourceVersion
-}
-
-// startWatch starts watching for deployment changes
-func (c *DeploymentListController) startWatch() {
-	if c.watchStarted {
-		return
-	}
-
-	go func() {
-		c.watchDeployments()
-	}()
-
-	c.watchStarted = true
-}
-
-// watchDeployments watches for deployment changes and updates the local state
-func (c *DeploymentListController) watchDeployments() {
-	watcher, err := c.clientset.AppsV1().Deployments("").Watch(c.ctx, metav1.ListOptions{
-		ResourceVersion: c.resourceVersion,
-	})
-	if err != nil {
-		debugLogger.Printf("error starting deployment watch: %v", err)
-		return
-	}
-	defer watcher.Stop()
-
-	debugLogger.Printf("Started watching deployments from resource version: %s", c.resourceVersion)
-
-	for {
-		select {
-		case <-c.ctx.Done():
-			debugLogger.Printf("Deployment watch stopped by context cancellation")
-			return
-		case event, ok := <-watcher.ResultChan():
-			if !ok {
-				debugLogger.Printf("Deployment watch channel closed")
-				return
-			}
-			deployment, ok := event.Object.(*appsv1.Deployment)
-			if !ok {
-				debugLogger.Printf("unexpected object type in watch event: %T", event.Object)
-				continue
-			}
-
-			key := deployment.Namespace + "/" + deployment.Name
-
-			switch event.Type {
-			case watch.Added:
-				c.deployments.Set(key, models.ToDeploymentModel(*deployment))
-				debugLogger.Printf("Deployment added: %s", key)
-			case watch.Modified:
-				c.deployments.Set(key, models.ToDeploymentModel(*deployment))
-				debugLogger.Printf("Deployment modified: %s", key)
-			case watch.Deleted:
-				c.deployments.Delete(key)
-				debugLogger.Printf("Deployment deleted: %s", key)
-			}
-			c.needsUpdate = true
-
-			// Send update message to trigger re-render
-			SendUpdate(c.updateChan)
-			debugLogger.Printf("Sent UpdateMsg for %s event", event.Type)
-		}
+// Update applies lists, watch events and refresh results on the update loop
+func (c *DeploymentListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
 	}
+	return nil
 }
 
 // updateView updates the deployment list view with current deployments
 func (c *DeploymentListController) updateView() {
 	deployments := c.getDeploymentsList()
-	debugLogger.Printf("[updateView] Controller deployment map count: %d", c.deployments.Len())
+	debugLogger.Printf("[updateView] Controller deployment count: %d", len(deployments))
 	if len(deployments) > 0 {
 		debugLogger.Printf("[updateView] First 3 deployments in controller: %v", deploymentNamesPreview(deployments, 3))
 	}
@@ -164,28 +81,138 @@ func (c *DeploymentListController) updateView() {
 
 // getDeploymentsList returns the current deployments as a slice in consistent order
 func (c *DeploymentListController) getDeploymentsList() []models.Deployment {
-	return c.deployments.Values()
+	return c.watches.list()
 }
 
+// deploymentListKeys are the key bindings of the deployment list
+var deploymentListKeys = registerKeyMap("deployments", "Deployment list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected deployment"},
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods of the selected deployment"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected deployment"},
+	columnsBinding,
+	refreshBinding,
+)
+
 // HandleKey handles key press events for the deployment list view
 func (c *DeploymentListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
-	switch msg.String() {
-	case "up", "k":
+	if c.deploymentView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.deploymentView, msg)
+	}
+
+	switch deploymentListKeys.Action(msg) {
+	case ActionUp:
 		c.deploymentView.SelectPrev()
 		return nil
-	case "down", "j":
+	case ActionDown:
 		c.deploymentView.SelectNext()
 		return nil
-	case "d":
-		return c.onDescribeDeployment(c.deploymentView)
-	case "r":
+	case ActionDescribe:
+		return c.describeSelectedDeployment()
+	case ActionPods:
+		return c.openSelectedDeploymentPods()
+	case ActionXRay:
+		return c.xraySelectedDeployment()
+	case ActionRefresh:
 		// Refresh deployments
 		return c.refreshDeployments()
+	case ActionColumns:
+		return openColumnChooser(c.deploymentView)
 	default:
 		return nil
 	}
 }
 
+// KeyMap returns the key bindings of the deployment list, which the help overlay lists
+func (c *DeploymentListController) KeyMap() *KeyMap {
+	return deploymentListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *DeploymentListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *DeploymentListController) Modal() bool {
+	return c.deploymentView.ColumnChooser() != nil
+}
+
+// describeSelectedDeployment pushes the describe view for the selected deployment
+func (c *DeploymentListController) describeSelectedDeployment() tea.Cmd {
+	selectedDeployment := c.deploymentView.GetSelected()
+	if selectedDeployment == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeDeploymentController(c.clientset, c.theme, selectedDeployment.Name, selectedDeployment.Namespace)
+	return PushView(describeCtrl, selectedDeployment.Namespace+"/"+selectedDeployment.Name)
+}
+
+// Details returns the views of the selected deployment a split can show beside the list
+func (c *DeploymentListController) Details() []Action {
+	return deploymentDetails
+}
+
+// Selection identifies the selected deployment, or returns "" when there is none
+func (c *DeploymentListController) Selection() string {
+	return deploymentSelection(c.deploymentView.GetSelected())
+}
+
+// Detail creates the describe view of the selected deployment, for a split to show beside the list
+func (c *DeploymentListController) Detail(action Action) Controller {
+	return deploymentDetail(c.clientset, c.theme, c.deploymentView.GetSelected(), action)
+}
+
+// deploymentDetails are the views of a deployment a split can show beside a deployment list
+var deploymentDetails = []Action{ActionDescribe}
+
+// deploymentSelection identifies a selected deployment by its cluster, namespace and name, or returns "" for no
+// deployment
+func deploymentSelection(deployment *models.Deployment) string {
+	if deployment == nil {
+		return ""
+	}
+	return deployment.Cluster + "/" + deployment.Namespace + "/" + deployment.Name
+}
+
+// deploymentDetail creates the describe view of a deployment read with clientset, or returns nil for no deployment
+func deploymentDetail(clientset *kubernetes.Clientset, theme *theme.Theme, deployment *models.Deployment, action Action) Controller {
+	if deployment == nil || action != ActionDescribe {
+		return nil
+	}
+	return NewDescribeDeploymentController(clientset, theme, deployment.Name, deployment.Namespace)
+}
+
+// openSelectedDeploymentPods pushes a pod list scoped to the pods of the selected deployment
+func (c *DeploymentListController) openSelectedDeploymentPods() tea.Cmd {
+	selectedDeployment := c.deploymentView.GetSelected()
+	if selectedDeployment == nil {
+		return nil
+	}
+	selector, err := models.GetDeploymentPodSelector(c.clientset, selectedDeployment.Namespace, selectedDeployment.Name)
+	if err != nil {
+		debugLogger.Printf("error resolving pods of deployment: %v", err)
+		return nil
+	}
+	qualifiedName := selectedDeployment.Namespace + "/" + selectedDeployment.Name
+	podListCtrl := NewScopedPodListController(c.clientset, c.theme, c.clusterName, PodListScope{
+		Namespace:     selectedDeployment.Namespace,
+		LabelSelector: selector,
+		Description:   "deployment " + qualifiedName,
+	})
+	return PushView(podListCtrl, qualifiedName+" pods")
+}
+
+// xraySelectedDeployment pushes the x-ray view of the selected deployment
+func (c *DeploymentListController) xraySelectedDeployment() tea.Cmd {
+	selectedDeployment := c.deploymentView.GetSelected()
+	if selectedDeployment == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "Deployment", Namespace: selectedDeployment.Namespace, Name: selectedDeployment.Name})
+}
+
 // ActionText returns the text to describe the action the controller is performing for the header bar
 func (c *DeploymentListController) ActionText() string {
 	return "Listing deployments"
@@ -196,23 +223,12 @@ func (c *DeploymentListController) Render(width, height int) string {
 	c.width = width
 	c.height = height
 	c.deploymentView.SetSize(width, height)
-
-	// Update view if needed
-	if c.needsUpdate {
-		c.updateView()
-		c.needsUpdate = false
-	}
-
 	return c.deploymentView.Render()
 }
 
-// refreshDeployments refreshes the deployment list
+// refreshDeployments lists deployments again off the update loop, replacing those listed before
 func (c *DeploymentListController) refreshDeployments() tea.Cmd {
-	return func() tea.Msg {
-		c.initializeDeployments()
-		c.updateView()
-		return nil
-	}
+	return c.watches.refresh()
 }
 
 // GetDeployments returns the current list of deployments
@@ -220,16 +236,14 @@ func (c *DeploymentListController) GetDeployments() []models.Deployment {
 	return c.getDeploymentsList()
 }
 
-// GetUpdateChannel returns the update channel
+// GetUpdateChannel returns the channel carrying deployment watch events
 func (c *DeploymentListController) GetUpdateChannel() <-chan tea.Msg {
-	return c.updateChan
+	return c.watches.updateChan
 }
 
 // Stop stops the controller and cleans up resources
 func (c *DeploymentListController) Stop() {
-	if c.cancel != nil {
-		c.cancel()
-	}
+	c.watches.stop()
 }
 
 // deploymentNamesPreview returns a preview of deployment names for debugging
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/kevholditch/vigilant/internal/theme"
 )
@@ -13,6 +14,7 @@ type DeploymentListControllerScenario struct {
 	builder        *ClusterBuilder
 	controller     *DeploymentListController
 	deploymentView *models.Deployment
+	pushed         PushViewMsg
 }
 
 func NewDeploymentListControllerScenario(t *testing.T) *DeploymentListControllerScenario {
@@ -35,7 +37,7 @@ func (s *DeploymentListControllerScenario) ConfigureCluster(configFn func(*Clust
 
 func (s *DeploymentListControllerScenario) the_deployment_list_controller_is_instantiated() *DeploymentListControllerScenario {
 	theme := theme.NewDefaultTheme()
-	s.controller = NewDeploymentListController(s.builder.GetClientset(), theme, "test-cluster", nil)
+	s.controller = NewDeploymentListController(s.builder.GetClientset(), theme, "test-cluster")
 	return s
 }
 
@@ -68,7 +70,7 @@ func (s *DeploymentListControllerScenario) the_selected_deployment_should_be(ass
 func (s *DeploymentListControllerScenario) refresh_deployments() *DeploymentListControllerScenario {
 	cmd := s.controller.refreshDeployments()
 	if cmd != nil {
-		cmd() // simulate running the command
+		s.controller.Update(cmd()) // simulate the update loop running the command and applying its result
 	}
 	return s
 }
@@ -77,22 +79,39 @@ func (s *DeploymentListControllerScenario) a_new_deployment_is_added_to_cluster(
 	// Add the deployment to the cluster
 	s.builder.WithDeployment(name, namespace)
 
-	// Wait for the watch to detect the change by polling until the deployment appears
-	// This is deterministic because we're waiting for a specific condition
-	maxAttempts := 10
-	for i := 0; i < maxAttempts; i++ {
-		deployments := s.controller.GetDeployments()
-		for _, deployment := range deployments {
+	// Apply watch events on the test goroutine, as the App update loop would, until the deployment appears
+	timeout := time.After(5 * time.Second)
+	for {
+		for _, deployment := range s.controller.GetDeployments() {
 			if deployment.Name == name && deployment.Namespace == namespace {
 				return s // Deployment found, test can continue
 			}
 		}
-		// Small delay between attempts
-		time.Sleep(50 * time.Millisecond)
+		select {
+		case msg := <-s.controller.GetUpdateChannel():
+			s.controller.Update(msg)
+		case <-timeout:
+			s.t.Errorf("Deployment %s in namespace %s was not detected by watch", name, namespace)
+			return s
+		}
+	}
+}
+
+func (s *DeploymentListControllerScenario) the_user_presses_enter() *DeploymentListControllerScenario {
+	cmd := s.controller.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		s.t.Fatalf("expected enter to push a view")
 	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected enter to push a view")
+	}
+	s.pushed = pushed
+	return s
+}
 
-	// If we get here, the deployment wasn't detected - this will cause the test to fail
-	s.t.Errorf("Deployment %s in namespace %s was not detected by watch after %d attempts", name, namespace, maxAttempts)
+func (s *DeploymentListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DeploymentListControllerScenario {
+	assertFn(s.pushed)
 	return s
 }
 
@@ -100,6 +119,9 @@ func (s *DeploymentListControllerScenario) Cleanup() {
 	if s.controller != nil {
 		s.controller.Stop()
 	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
 	if s.builder != nil {
 		s.builder.Cleanup()
 	}
@@ -107,4 +107,33 @@ func TestDeploymentListController(t *testing.T) {
 				assert.ElementsMatch(t, []string{"deployment-a", "deployment-b"}, []string{deployments[0].Name, deployments[1].Name})
 			})
 	})
+
+	t.Run("should_drill_down_to_the_pods_of_the_selected_deployment", func(t *testing.T) {
+		s := NewDeploymentListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "ns1").
+					WithDeploymentPods("web", "ns1", "abc123", 2).
+					WithPod("unrelated", "ns1").
+					// Matches the deployment's labels but is not owned through its ReplicaSets
+					WithLabelledPod("impostor", "ns1", map[string]string{"app": "web"})
+			}).
+			the_deployment_list_controller_is_instantiated().
+			When().
+			the_user_presses_enter().
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "ns1/web pods", pushed.Title)
+				podList, ok := pushed.Controller.(*PodListController)
+				if assert.True(t, ok) {
+					assert.Equal(t, "Viewing pods of deployment ns1/web", podList.ActionText())
+					var names []string
+					for _, pod := range podList.GetPods() {
+						names = append(names, pod.Name)
+					}
+					assert.ElementsMatch(t, []string{"web-abc123-1", "web-abc123-2"}, names)
+				}
+			})
+	})
 }
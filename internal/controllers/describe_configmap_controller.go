@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeConfigMapController handles input for the describe config map view
+type DescribeConfigMapController struct {
+	describeConfigMapView *views.DescribeConfigMapView
+	configMap             *models.ConfigMap
+	clientset             *kubernetes.Clientset
+	theme                 *theme.Theme
+	configMapName         string
+	namespace             string
+	width                 int
+	height                int
+}
+
+// NewDescribeConfigMapController creates a new describe config map controller
+func NewDescribeConfigMapController(clientset *kubernetes.Clientset, theme *theme.Theme, configMapName, namespace string) *DescribeConfigMapController {
+	configMap, err := models.GetConfigMap(clientset, namespace, configMapName)
+	if err != nil {
+		log.Printf("error getting config map details: %v", err)
+		// Create a placeholder config map for error case
+		configMap = &models.ConfigMap{
+			Name:      configMapName,
+			Namespace: namespace,
+		}
+	}
+
+	return &DescribeConfigMapController{
+		describeConfigMapView: views.NewDescribeConfigMapView(configMap, theme),
+		configMap:             configMap,
+		clientset:             clientset,
+		theme:                 theme,
+		configMapName:         configMapName,
+		namespace:             namespace,
+	}
+}
+
+// describeConfigMapKeys are the key bindings of the describe config map view
+var describeConfigMapKeys = registerKeyMap("describe-configmap", "Describe config map",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionView, Keys: []string{"enter"}, Help: "View the selected value"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe config map view
+func (c *DescribeConfigMapController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeConfigMapKeys.Action(msg) {
+	case ActionUp:
+		c.describeConfigMapView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.describeConfigMapView.SelectNext()
+		return nil
+	case ActionView:
+		return c.viewSelectedValue()
+	case ActionRefresh:
+		// Refresh config map details
+		return c.refreshConfigMap()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe config map view, which the help overlay lists
+func (c *DescribeConfigMapController) KeyMap() *KeyMap {
+	return describeConfigMapKeys
+}
+
+// viewSelectedValue pushes the value viewer for the selected key
+func (c *DescribeConfigMapController) viewSelectedValue() tea.Cmd {
+	key := c.describeConfigMapView.GetSelectedKey()
+	if key == nil {
+		return nil
+	}
+	value := c.configMap.Data[key.Name]
+	if key.Binary {
+		value = fmt.Sprintf("<binary data, %d bytes>", key.Size)
+	}
+	return PushView(NewValueViewerController(c.theme, key.Name, value), key.Name)
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeConfigMapController) ActionText() string {
+	return fmt.Sprintf("Describing config map %s", c.configMapName)
+}
+
+// Render returns the rendered describe config map view
+func (c *DescribeConfigMapController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeConfigMapView.SetSize(width, height)
+	return c.describeConfigMapView.Render()
+}
+
+// configMapDescribedMsg carries refreshed config map details to the update loop
+type configMapDescribedMsg struct {
+	configMap *models.ConfigMap
+	err       error
+}
+
+// Update applies refreshed config map details on the update loop
+func (c *DescribeConfigMapController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case configMapDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing config map details: %v", msg.err)
+			return nil
+		}
+		if msg.configMap.Name == c.configMapName && msg.configMap.Namespace == c.namespace {
+			c.configMap = msg.configMap
+			c.describeConfigMapView.UpdateConfigMap(msg.configMap)
+		}
+	}
+	return nil
+}
+
+// refreshConfigMap fetches the config map details off the update loop and delivers them as a configMapDescribedMsg
+func (c *DescribeConfigMapController) refreshConfigMap() tea.Cmd {
+	clientset, namespace, configMapName := c.clientset, c.namespace, c.configMapName
+	return func() tea.Msg {
+		configMap, err := models.GetConfigMap(clientset, namespace, configMapName)
+		return configMapDescribedMsg{configMap: configMap, err: err}
+	}
+}
+
+// GetKeys returns the keys of the described config map (for testing)
+func (c *DescribeConfigMapController) GetKeys() []models.DataKey {
+	return c.describeConfigMapView.Keys()
+}
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeConfigMapControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeConfigMapController
+	pushed     PushViewMsg
+}
+
+func NewDescribeConfigMapControllerScenario(t *testing.T) *DescribeConfigMapControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeConfigMapControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeConfigMapControllerScenario) Given() *DescribeConfigMapControllerScenario { return s }
+func (s *DescribeConfigMapControllerScenario) When() *DescribeConfigMapControllerScenario  { return s }
+func (s *DescribeConfigMapControllerScenario) Then() *DescribeConfigMapControllerScenario  { return s }
+func (s *DescribeConfigMapControllerScenario) and() *DescribeConfigMapControllerScenario   { return s }
+
+func (s *DescribeConfigMapControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeConfigMapControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeConfigMapControllerScenario) the_describe_config_map_controller_is_instantiated(name, namespace string) *DescribeConfigMapControllerScenario {
+	s.controller = NewDescribeConfigMapController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeConfigMapControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeConfigMapControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeConfigMapControllerScenario) the_keys_should_be(assertFn func([]models.DataKey)) *DescribeConfigMapControllerScenario {
+	assertFn(s.controller.GetKeys())
+	return s
+}
+
+func (s *DescribeConfigMapControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeConfigMapControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeConfigMapControllerScenario) Cleanup() {
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
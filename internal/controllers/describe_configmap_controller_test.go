@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeConfigMapController(t *testing.T) {
+	t.Run("should_show_keys_sorted_with_their_sizes", func(t *testing.T) {
+		s := NewDescribeConfigMapControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithConfigMap("settings", "default", map[string]string{"log.level": "debug", "app.yaml": "port: 8080\nhost: 0.0.0.0"})
+			}).
+			When().
+			the_describe_config_map_controller_is_instantiated("settings", "default").
+			Then().
+			the_keys_should_be(func(keys []models.DataKey) {
+				assert.Equal(t, []models.DataKey{
+					{Name: "app.yaml", Size: 24},
+					{Name: "log.level", Size: 5},
+				}, keys)
+			})
+	})
+
+	t.Run("should_open_the_selected_value_in_the_viewer", func(t *testing.T) {
+		s := NewDescribeConfigMapControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithConfigMap("settings", "default", map[string]string{"log.level": "debug", "app.yaml": "port: 8080\nhost: 0.0.0.0"})
+			}).
+			the_describe_config_map_controller_is_instantiated("settings", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "app.yaml", pushed.Title)
+				viewer, ok := pushed.Controller.(*ValueViewerController)
+				if assert.True(t, ok) {
+					assert.Equal(t, "port: 8080\nhost: 0.0.0.0", viewer.GetValue())
+				}
+			})
+	})
+}
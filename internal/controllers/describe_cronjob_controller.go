@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeCronJobController handles input for the describe cron job view
+type DescribeCronJobController struct {
+	describeCronJobView *views.DescribeCronJobView
+	clientset           *kubernetes.Clientset
+	theme               *theme.Theme
+	cronJobName         string
+	namespace           string
+	// readOnly disables triggering and suspending the cron job
+	readOnly bool
+	width    int
+	height   int
+}
+
+// NewDescribeCronJobController creates a new describe cron job controller. In read-only mode the cron job cannot be
+// triggered or suspended.
+func NewDescribeCronJobController(clientset *kubernetes.Clientset, theme *theme.Theme, cronJobName, namespace string, readOnly bool) *DescribeCronJobController {
+	msg := describeCronJob(clientset, namespace, cronJobName)
+	if msg.err != nil {
+		log.Printf("error getting cron job details: %v", msg.err)
+		// Create a placeholder cron job for error case
+		msg.cronJob = &models.CronJob{
+			Name:      cronJobName,
+			Namespace: namespace,
+		}
+	}
+
+	describeCronJobView := views.NewDescribeCronJobView(msg.cronJob, msg.jobs, theme)
+
+	return &DescribeCronJobController{
+		describeCronJobView: describeCronJobView,
+		clientset:           clientset,
+		theme:               theme,
+		cronJobName:         cronJobName,
+		namespace:           namespace,
+		readOnly:            readOnly,
+	}
+}
+
+// describeCronJobKeys are the key bindings of the describe cron job view
+var describeCronJobKeys = registerKeyMap("describe-cronjob", "Describe cron job",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionTrigger, Keys: []string{"t"}, Help: "Trigger a job from the cron job"},
+	Binding{Action: ActionSuspend, Keys: []string{"s"}, Help: "Suspend or resume the cron job"},
+	Binding{Action: ActionLogs, Keys: []string{"l"}, Help: "Show the logs of the latest job"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe cron job view
+func (c *DescribeCronJobController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeCronJobKeys.Action(msg) {
+	case ActionUp:
+		c.describeCronJobView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeCronJobView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeCronJobView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeCronJobView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeCronJobView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeCronJobView.ScrollToBottom()
+		return nil
+	case ActionTrigger:
+		if c.readOnly {
+			c.describeCronJobView.SetStatusMessage("Triggering cron jobs is disabled in read-only mode")
+			return nil
+		}
+		return triggerCronJob(c.clientset, c.namespace, c.cronJobName)
+	case ActionSuspend:
+		if c.readOnly {
+			c.describeCronJobView.SetStatusMessage("Suspending cron jobs is disabled in read-only mode")
+			return nil
+		}
+		cronJob := c.describeCronJobView.CronJob()
+		return setCronJobSuspend(c.clientset, c.namespace, c.cronJobName, cronJob == nil || !cronJob.Suspend)
+	case ActionLogs:
+		return c.openLatestJobLogs()
+	case ActionRefresh:
+		// Refresh cron job details
+		return c.refreshCronJob()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe cron job view, which the help overlay lists
+func (c *DescribeCronJobController) KeyMap() *KeyMap {
+	return describeCronJobKeys
+}
+
+// openLatestJobLogs pushes the logs of the most recent pod of the cron job's latest job
+func (c *DescribeCronJobController) openLatestJobLogs() tea.Cmd {
+	cmd, err := openCronJobLatestLogs(c.clientset, c.theme, c.namespace, c.cronJobName)
+	if err != nil {
+		log.Printf("error finding latest job pod: %v", err)
+		c.describeCronJobView.SetStatusMessage(fmt.Sprintf("No logs to show: %v", err))
+		return nil
+	}
+	return cmd
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeCronJobController) ActionText() string {
+	return fmt.Sprintf("Describing cron job %s", c.cronJobName)
+}
+
+// Render returns the rendered describe cron job view
+func (c *DescribeCronJobController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeCronJobView.SetSize(width, height)
+	return c.describeCronJobView.Render()
+}
+
+// cronJobDescribedMsg carries refreshed cron job details and its jobs to the update loop
+type cronJobDescribedMsg struct {
+	cronJob *models.CronJob
+	jobs    []models.Job
+	err     error
+}
+
+// Update applies refreshed cron job details and action results on the update loop.
+// A successful trigger or suspend refreshes the description to show its effect.
+func (c *DescribeCronJobController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case cronJobDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing cron job details: %v", msg.err)
+			return nil
+		}
+		if msg.cronJob.Name == c.cronJobName && msg.cronJob.Namespace == c.namespace {
+			c.describeCronJobView.UpdateCronJob(msg.cronJob, msg.jobs)
+		}
+	case cronJobTriggeredMsg:
+		if msg.cronJobName != c.cronJobName || msg.namespace != c.namespace {
+			return nil
+		}
+		c.describeCronJobView.SetStatusMessage(triggerStatusMessage(msg))
+		if msg.err == nil {
+			return c.refreshCronJob()
+		}
+	case cronJobSuspendedMsg:
+		if msg.cronJobName != c.cronJobName || msg.namespace != c.namespace {
+			return nil
+		}
+		c.describeCronJobView.SetStatusMessage(suspendStatusMessage(msg))
+		if msg.err == nil {
+			return c.refreshCronJob()
+		}
+	}
+	return nil
+}
+
+// refreshCronJob fetches the cron job details off the update loop and delivers them as a cronJobDescribedMsg
+func (c *DescribeCronJobController) refreshCronJob() tea.Cmd {
+	clientset, namespace, cronJobName := c.clientset, c.namespace, c.cronJobName
+	return func() tea.Msg {
+		return describeCronJob(clientset, namespace, cronJobName)
+	}
+}
+
+// describeCronJob fetches a cron job and the jobs it created
+func describeCronJob(clientset *kubernetes.Clientset, namespace, cronJobName string) cronJobDescribedMsg {
+	cronJob, err := models.GetCronJob(clientset, namespace, cronJobName)
+	if err != nil {
+		return cronJobDescribedMsg{err: err}
+	}
+	jobs, err := models.GetCronJobJobs(clientset, cronJob)
+	if err != nil {
+		return cronJobDescribedMsg{err: err}
+	}
+	return cronJobDescribedMsg{cronJob: cronJob, jobs: jobs}
+}
+
+// GetCronJob returns the described cron job (for testing)
+func (c *DescribeCronJobController) GetCronJob() *models.CronJob {
+	return c.describeCronJobView.CronJob()
+}
+
+// GetJobs returns the jobs the described cron job created, newest first (for testing)
+func (c *DescribeCronJobController) GetJobs() []models.Job {
+	return c.describeCronJobView.Jobs()
+}
+
+// GetStatusMessage returns the message shown in the status bar (for testing)
+func (c *DescribeCronJobController) GetStatusMessage() string {
+	return c.describeCronJobView.StatusMessage()
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeCronJobControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeCronJobController
+	pushed     PushViewMsg
+}
+
+func NewDescribeCronJobControllerScenario(t *testing.T) *DescribeCronJobControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeCronJobControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeCronJobControllerScenario) Given() *DescribeCronJobControllerScenario { return s }
+func (s *DescribeCronJobControllerScenario) When() *DescribeCronJobControllerScenario  { return s }
+func (s *DescribeCronJobControllerScenario) Then() *DescribeCronJobControllerScenario  { return s }
+func (s *DescribeCronJobControllerScenario) and() *DescribeCronJobControllerScenario   { return s }
+
+func (s *DescribeCronJobControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeCronJobControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) the_describe_cron_job_controller_is_instantiated(name, namespace string) *DescribeCronJobControllerScenario {
+	s.controller = NewDescribeCronJobController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace, false)
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeCronJobControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	result := cmd()
+	if pushed, ok := result.(PushViewMsg); ok {
+		s.pushed = pushed
+		return s
+	}
+	// Deliver the action's result to the update loop, along with the refresh it triggers
+	for result != nil {
+		next := s.controller.Update(result)
+		if next == nil {
+			break
+		}
+		result = next()
+	}
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) the_cron_job_should_be(assertFn func(*models.CronJob)) *DescribeCronJobControllerScenario {
+	assertFn(s.controller.GetCronJob())
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) the_jobs_should_be(assertFn func([]models.Job)) *DescribeCronJobControllerScenario {
+	assertFn(s.controller.GetJobs())
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) the_status_message_should_be(assertFn func(string)) *DescribeCronJobControllerScenario {
+	assertFn(s.controller.GetStatusMessage())
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeCronJobControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeCronJobControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
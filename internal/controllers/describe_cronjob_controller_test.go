@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeCronJobController(t *testing.T) {
+	t.Run("should_render_the_schedule_and_its_next_runs", func(t *testing.T) {
+		s := NewDescribeCronJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("report", "default", "30 2 * * 1-5")
+			}).
+			When().
+			the_describe_cron_job_controller_is_instantiated("report", "default").
+			Then().
+			the_cron_job_should_be(func(cronJob *models.CronJob) {
+				assert.Equal(t, "At 02:30 on Monday through Friday", cronJob.FormatSchedule())
+
+				from := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC) // a Friday
+				assert.Equal(t, []time.Time{
+					time.Date(2026, time.October, 19, 2, 30, 0, 0, time.UTC),
+					time.Date(2026, time.October, 20, 2, 30, 0, 0, time.UTC),
+					time.Date(2026, time.October, 21, 2, 30, 0, 0, time.UTC),
+				}, cronJob.NextRuns(from, 3))
+			})
+	})
+
+	t.Run("should_leave_out_the_jobs_of_an_earlier_cron_job_of_the_same_name", func(t *testing.T) {
+		s := NewDescribeCronJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("report", "default", "30 2 * * 1-5").
+					WithCronJobJob("report", "default", "report-old").
+					WithoutCronJob("report", "default").
+					WithCronJob("report", "default", "30 2 * * 1-5").
+					WithCronJobJob("report", "default", "report-new")
+			}).
+			When().
+			the_describe_cron_job_controller_is_instantiated("report", "default").
+			Then().
+			the_jobs_should_be(func(jobs []models.Job) {
+				if assert.Len(t, jobs, 1) {
+					assert.Equal(t, "report-new", jobs[0].Name)
+				}
+			})
+	})
+
+	t.Run("should_show_the_job_created_by_a_trigger", func(t *testing.T) {
+		s := NewDescribeCronJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("report", "default", "30 2 * * 1-5")
+			}).
+			the_describe_cron_job_controller_is_instantiated("report", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")}).
+			Then().
+			the_jobs_should_be(func(jobs []models.Job) {
+				if assert.Len(t, jobs, 1) {
+					assert.Contains(t, jobs[0].Name, "report-manual-")
+				}
+			})
+	})
+
+	t.Run("should_suspend_and_resume_the_cron_job", func(t *testing.T) {
+		s := NewDescribeCronJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("report", "default", "30 2 * * 1-5")
+			}).
+			the_describe_cron_job_controller_is_instantiated("report", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}).
+			Then().
+			the_cron_job_should_be(func(cronJob *models.CronJob) {
+				assert.True(t, cronJob.Suspend)
+				assert.Empty(t, cronJob.NextRuns(time.Now(), 3), "a suspended cron job should not run")
+			}).
+			and().
+			the_status_message_should_be(func(message string) {
+				assert.Equal(t, "Cron job report suspended", message)
+			}).
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}).
+			Then().
+			the_cron_job_should_be(func(cronJob *models.CronJob) {
+				assert.False(t, cronJob.Suspend)
+			})
+	})
+
+	t.Run("should_explain_when_no_job_has_run_yet", func(t *testing.T) {
+		s := NewDescribeCronJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("report", "default", "30 2 * * 1-5")
+			}).
+			the_describe_cron_job_controller_is_instantiated("report", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")}).
+			Then().
+			the_status_message_should_be(func(message string) {
+				assert.Equal(t, "No logs to show: cron job report in namespace default has not created any jobs", message)
+			})
+	})
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeDaemonSetController handles input for the describe daemon set view
+type DescribeDaemonSetController struct {
+	describeDaemonSetView *views.DescribeDaemonSetView
+	clientset             *kubernetes.Clientset
+	theme                 *theme.Theme
+	daemonSetName         string
+	namespace             string
+	width                 int
+	height                int
+}
+
+// NewDescribeDaemonSetController creates a new describe daemon set controller
+func NewDescribeDaemonSetController(clientset *kubernetes.Clientset, theme *theme.Theme, daemonSetName, namespace string) *DescribeDaemonSetController {
+	msg := describeDaemonSet(clientset, namespace, daemonSetName)
+	if msg.err != nil {
+		log.Printf("error getting daemon set details: %v", msg.err)
+		// Create a placeholder daemon set for error case
+		msg.daemonSet = &models.DaemonSet{
+			Name:      daemonSetName,
+			Namespace: namespace,
+		}
+	}
+
+	describeDaemonSetView := views.NewDescribeDaemonSetView(msg.daemonSet, msg.nodesWithoutPod, theme)
+
+	return &DescribeDaemonSetController{
+		describeDaemonSetView: describeDaemonSetView,
+		clientset:             clientset,
+		theme:                 theme,
+		daemonSetName:         daemonSetName,
+		namespace:             namespace,
+	}
+}
+
+// describeDaemonSetKeys are the key bindings of the describe daemon set view
+var describeDaemonSetKeys = registerKeyMap("describe-daemonset", "Describe daemon set",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionPods, Keys: []string{"p"}, Help: "Show the pods of the daemon set"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe daemon set view
+func (c *DescribeDaemonSetController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeDaemonSetKeys.Action(msg) {
+	case ActionUp:
+		c.describeDaemonSetView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeDaemonSetView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeDaemonSetView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeDaemonSetView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeDaemonSetView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeDaemonSetView.ScrollToBottom()
+		return nil
+	case ActionPods:
+		return c.openDaemonSetPods()
+	case ActionRefresh:
+		// Refresh daemon set details
+		return c.refreshDaemonSet()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe daemon set view, which the help overlay lists
+func (c *DescribeDaemonSetController) KeyMap() *KeyMap {
+	return describeDaemonSetKeys
+}
+
+// openDaemonSetPods pushes a pod list scoped to the pods of the daemon set
+func (c *DescribeDaemonSetController) openDaemonSetPods() tea.Cmd {
+	daemonSet, err := models.GetDaemonSet(c.clientset, c.namespace, c.daemonSetName)
+	if err != nil {
+		log.Printf("error getting daemon set details: %v", err)
+		return nil
+	}
+	if daemonSet.Selector == "" {
+		return nil
+	}
+	return PushView(newDaemonSetPodListController(c.clientset, c.theme, "", daemonSet), daemonSet.Namespace+"/"+daemonSet.Name+" pods")
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeDaemonSetController) ActionText() string {
+	return fmt.Sprintf("Describing daemon set %s", c.daemonSetName)
+}
+
+// Render returns the rendered describe daemon set view
+func (c *DescribeDaemonSetController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeDaemonSetView.SetSize(width, height)
+	return c.describeDaemonSetView.Render()
+}
+
+// daemonSetDescribedMsg carries refreshed daemon set details and the nodes lacking a daemon pod to the update loop
+type daemonSetDescribedMsg struct {
+	daemonSet       *models.DaemonSet
+	nodesWithoutPod []string
+	err             error
+}
+
+// Update applies refreshed daemon set details on the update loop
+func (c *DescribeDaemonSetController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case daemonSetDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing daemon set details: %v", msg.err)
+			return nil
+		}
+		if msg.daemonSet.Name == c.daemonSetName && msg.daemonSet.Namespace == c.namespace {
+			c.describeDaemonSetView.UpdateDaemonSet(msg.daemonSet, msg.nodesWithoutPod)
+		}
+	}
+	return nil
+}
+
+// refreshDaemonSet fetches the daemon set details off the update loop and delivers them as a daemonSetDescribedMsg
+func (c *DescribeDaemonSetController) refreshDaemonSet() tea.Cmd {
+	clientset, namespace, daemonSetName := c.clientset, c.namespace, c.daemonSetName
+	return func() tea.Msg {
+		return describeDaemonSet(clientset, namespace, daemonSetName)
+	}
+}
+
+// describeDaemonSet fetches a daemon set and the eligible nodes that have no daemon pod
+func describeDaemonSet(clientset *kubernetes.Clientset, namespace, daemonSetName string) daemonSetDescribedMsg {
+	daemonSet, err := models.GetDaemonSet(clientset, namespace, daemonSetName)
+	if err != nil {
+		return daemonSetDescribedMsg{err: err}
+	}
+	nodesWithoutPod, err := models.GetDaemonSetNodesWithoutPod(clientset, namespace, daemonSetName)
+	if err != nil {
+		return daemonSetDescribedMsg{err: err}
+	}
+	return daemonSetDescribedMsg{daemonSet: daemonSet, nodesWithoutPod: nodesWithoutPod}
+}
+
+// GetNodesWithoutPod returns the eligible nodes lacking a pod of the described daemon set (for testing)
+func (c *DescribeDaemonSetController) GetNodesWithoutPod() []string {
+	return c.describeDaemonSetView.NodesWithoutPod()
+}
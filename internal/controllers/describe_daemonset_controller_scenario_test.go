@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeDaemonSetControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeDaemonSetController
+	pushed     PushViewMsg
+}
+
+func NewDescribeDaemonSetControllerScenario(t *testing.T) *DescribeDaemonSetControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeDaemonSetControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeDaemonSetControllerScenario) Given() *DescribeDaemonSetControllerScenario { return s }
+func (s *DescribeDaemonSetControllerScenario) When() *DescribeDaemonSetControllerScenario  { return s }
+func (s *DescribeDaemonSetControllerScenario) Then() *DescribeDaemonSetControllerScenario  { return s }
+func (s *DescribeDaemonSetControllerScenario) and() *DescribeDaemonSetControllerScenario   { return s }
+
+func (s *DescribeDaemonSetControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeDaemonSetControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeDaemonSetControllerScenario) the_describe_daemon_set_controller_is_instantiated(name, namespace string) *DescribeDaemonSetControllerScenario {
+	s.controller = NewDescribeDaemonSetController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeDaemonSetControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeDaemonSetControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeDaemonSetControllerScenario) the_nodes_without_a_pod_should_be(assertFn func([]string)) *DescribeDaemonSetControllerScenario {
+	assertFn(s.controller.GetNodesWithoutPod())
+	return s
+}
+
+func (s *DescribeDaemonSetControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeDaemonSetControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeDaemonSetControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeDaemonSetController(t *testing.T) {
+	t.Run("should_list_the_nodes_lacking_a_daemon_pod", func(t *testing.T) {
+		s := NewDescribeDaemonSetControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(3).
+					WithDaemonSet("log-agent", "default", nil).
+					WithDaemonSetPod("log-agent", "default", "worker-node-2")
+			}).
+			When().
+			the_describe_daemon_set_controller_is_instantiated("log-agent", "default").
+			Then().
+			the_nodes_without_a_pod_should_be(func(nodes []string) {
+				assert.Equal(t, []string{"worker-node-1", "worker-node-3"}, nodes)
+			})
+	})
+
+	t.Run("should_only_expect_pods_on_nodes_matching_the_node_selector", func(t *testing.T) {
+		s := NewDescribeDaemonSetControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(2).
+					WithControlPlaneNodes(1).
+					WithDaemonSet("etcd-backup", "default", map[string]string{"node-role.kubernetes.io/control-plane": ""})
+			}).
+			When().
+			the_describe_daemon_set_controller_is_instantiated("etcd-backup", "default").
+			Then().
+			the_nodes_without_a_pod_should_be(func(nodes []string) {
+				assert.Equal(t, []string{"control-plane-node-1"}, nodes)
+			})
+	})
+
+	t.Run("should_drill_down_to_the_daemon_set_pods", func(t *testing.T) {
+		s := NewDescribeDaemonSetControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithDaemonSet("log-agent", "default", nil).
+					WithDaemonSetPod("log-agent", "default", "worker-node-1")
+			}).
+			the_describe_daemon_set_controller_is_instantiated("log-agent", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "default/log-agent pods", pushed.Title)
+				_, ok := pushed.Controller.(*PodListController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -14,7 +14,6 @@ import (
 // DescribeDeploymentController handles input for the describe deployment view
 type DescribeDeploymentController struct {
 	describeDeploymentView *views.DescribeDeploymentView
-	onBack                 func() tea.Cmd
 	clientset              *kubernetes.Clientset
 	theme                  *theme.Theme
 	deploymentName         string
@@ -24,7 +23,7 @@ type DescribeDeploymentController struct {
 }
 
 // NewDescribeDeploymentController creates a new describe deployment controller
-func NewDescribeDeploymentController(clientset *kubernetes.Clientset, theme *theme.Theme, deploymentName, namespace string, onBack func() tea.Cmd) *DescribeDeploymentController {
+func NewDescribeDeploymentController(clientset *kubernetes.Clientset, theme *theme.Theme, deploymentName, namespace string) *DescribeDeploymentController {
 	// Fetch deployment details
 	deployment, err := models.GetDeployment(clientset, namespace, deploymentName)
 	if err != nil {
@@ -41,7 +40,6 @@ func NewDescribeDeploymentController(clientset *kubernetes.Clientset, theme *the
 
 	return &DescribeDeploymentController{
 		describeDeploymentView: describeDeploymentView,
-		onBack:                 onBack,
 		clientset:              clientset,
 		theme:                  theme,
 		deploymentName:         deploymentName,
@@ -49,30 +47,39 @@ func NewDescribeDeploymentController(clientset *kubernetes.Clientset, theme *the
 	}
 }
 
+// describeDeploymentKeys are the key bindings of the describe deployment view
+var describeDeploymentKeys = registerKeyMap("describe-deployment", "Describe deployment",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	refreshBinding,
+)
+
 // HandleKey handles key press events for the describe deployment view
 func (c *DescribeDeploymentController) HandleKey(msg tea.KeyMsg) tea.Cmd {
-	switch msg.String() {
-	case "up", "k":
+	switch describeDeploymentKeys.Action(msg) {
+	case ActionUp:
 		c.describeDeploymentView.ScrollUp()
 		return nil
-	case "down", "j":
+	case ActionDown:
 		c.describeDeploymentView.ScrollDown()
 		return nil
-	case "pgup", "ctrl+u":
+	case ActionPageUp:
 		c.describeDeploymentView.ScrollPageUp()
 		return nil
-	case "pgdown", "ctrl+d":
+	case ActionPageDown:
 		c.describeDeploymentView.ScrollPageDown()
 		return nil
-	case "g":
+	case ActionTop:
 		c.describeDeploymentView.ScrollToTop()
 		return nil
-	case "G":
+	case ActionBottom:
 		c.describeDeploymentView.ScrollToBottom()
 		return nil
-	case "esc":
-		return c.onBack()
-	case "r":
+	case ActionRefresh:
 		// Refresh deployment details
 		return c.refreshDeployment()
 	default:
@@ -80,6 +87,11 @@ func (c *DescribeDeploymentController) HandleKey(msg tea.KeyMsg) tea.Cmd {
 	}
 }
 
+// KeyMap returns the key bindings of the describe deployment view, which the help overlay lists
+func (c *DescribeDeploymentController) KeyMap() *KeyMap {
+	return describeDeploymentKeys
+}
+
 // ActionText returns the text to describe the action the controller is performing for the header bar
 func (c *DescribeDeploymentController) ActionText() string {
 	return fmt.Sprintf("Describing deployment %s", c.deploymentName)
@@ -93,17 +105,31 @@ func (c *DescribeDeploymentController) Render(width, height int) string {
 	return c.describeDeploymentView.Render()
 }
 
-// refreshDeployment refreshes the deployment details
+// deploymentDescribedMsg carries refreshed deployment details to the update loop
+type deploymentDescribedMsg struct {
+	deployment *models.Deployment
+}
+
+// Update applies refreshed deployment details on the update loop
+func (c *DescribeDeploymentController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case deploymentDescribedMsg:
+		if msg.deployment.Name == c.deploymentName && msg.deployment.Namespace == c.namespace {
+			c.describeDeploymentView.UpdateDeployment(msg.deployment)
+		}
+	}
+	return nil
+}
+
+// refreshDeployment fetches the deployment details off the update loop and delivers them as a deploymentDescribedMsg
 func (c *DescribeDeploymentController) refreshDeployment() tea.Cmd {
+	clientset, namespace, deploymentName := c.clientset, c.namespace, c.deploymentName
 	return func() tea.Msg {
-		deployment, err := models.GetDeployment(c.clientset, c.namespace, c.deploymentName)
+		deployment, err := models.GetDeployment(clientset, namespace, deploymentName)
 		if err != nil {
 			log.Printf("error refreshing deployment details: %v", err)
 			return nil
 		}
-
-		// Update the describe deployment view with new data
-		c.describeDeploymentView.UpdateDeployment(deployment)
-		return nil
+		return deploymentDescribedMsg{deployment: deployment}
 	}
 }
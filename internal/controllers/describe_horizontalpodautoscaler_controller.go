@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeHorizontalPodAutoscalerController handles input for the describe horizontal pod autoscaler view
+type DescribeHorizontalPodAutoscalerController struct {
+	describeHorizontalPodAutoscalerView *views.DescribeHorizontalPodAutoscalerView
+	clientset                           *kubernetes.Clientset
+	theme                               *theme.Theme
+	autoscalerName                      string
+	namespace                           string
+	width                               int
+	height                              int
+}
+
+// NewDescribeHorizontalPodAutoscalerController creates a new describe horizontal pod autoscaler controller
+func NewDescribeHorizontalPodAutoscalerController(clientset *kubernetes.Clientset, theme *theme.Theme, autoscalerName, namespace string) *DescribeHorizontalPodAutoscalerController {
+	msg := describeHorizontalPodAutoscaler(clientset, namespace, autoscalerName)
+	if msg.err != nil {
+		log.Printf("error getting horizontal pod autoscaler details: %v", msg.err)
+		// Create a placeholder autoscaler for error case
+		msg.autoscaler = &models.HorizontalPodAutoscaler{
+			Name:      autoscalerName,
+			Namespace: namespace,
+		}
+	}
+
+	describeView := views.NewDescribeHorizontalPodAutoscalerView(msg.autoscaler, theme)
+
+	return &DescribeHorizontalPodAutoscalerController{
+		describeHorizontalPodAutoscalerView: describeView,
+		clientset:                           clientset,
+		theme:                               theme,
+		autoscalerName:                      autoscalerName,
+		namespace:                           namespace,
+	}
+}
+
+// describeHorizontalPodAutoscalerKeys are the key bindings of the describe horizontal pod autoscaler view
+var describeHorizontalPodAutoscalerKeys = registerKeyMap("describe-hpa", "Describe horizontal pod autoscaler",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionTarget, Keys: []string{"t"}, Help: "Describe the scale target"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe horizontal pod autoscaler view
+func (c *DescribeHorizontalPodAutoscalerController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeHorizontalPodAutoscalerKeys.Action(msg) {
+	case ActionUp:
+		c.describeHorizontalPodAutoscalerView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeHorizontalPodAutoscalerView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeHorizontalPodAutoscalerView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeHorizontalPodAutoscalerView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeHorizontalPodAutoscalerView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeHorizontalPodAutoscalerView.ScrollToBottom()
+		return nil
+	case ActionTarget:
+		autoscaler := c.describeHorizontalPodAutoscalerView.HorizontalPodAutoscaler()
+		if autoscaler == nil || autoscaler.TargetName == "" {
+			return nil
+		}
+		return describeScaleTarget(c.clientset, c.theme, autoscaler)
+	case ActionRefresh:
+		// Refresh horizontal pod autoscaler details
+		return c.refreshHorizontalPodAutoscaler()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe horizontal pod autoscaler view, which the help overlay lists
+func (c *DescribeHorizontalPodAutoscalerController) KeyMap() *KeyMap {
+	return describeHorizontalPodAutoscalerKeys
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeHorizontalPodAutoscalerController) ActionText() string {
+	return fmt.Sprintf("Describing horizontal pod autoscaler %s", c.autoscalerName)
+}
+
+// Render returns the rendered describe horizontal pod autoscaler view
+func (c *DescribeHorizontalPodAutoscalerController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeHorizontalPodAutoscalerView.SetSize(width, height)
+	return c.describeHorizontalPodAutoscalerView.Render()
+}
+
+// horizontalPodAutoscalerDescribedMsg carries refreshed horizontal pod autoscaler details to the update loop
+type horizontalPodAutoscalerDescribedMsg struct {
+	autoscaler *models.HorizontalPodAutoscaler
+	err        error
+}
+
+// Update applies refreshed horizontal pod autoscaler details on the update loop
+func (c *DescribeHorizontalPodAutoscalerController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case horizontalPodAutoscalerDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing horizontal pod autoscaler details: %v", msg.err)
+			return nil
+		}
+		if msg.autoscaler.Name == c.autoscalerName && msg.autoscaler.Namespace == c.namespace {
+			c.describeHorizontalPodAutoscalerView.UpdateHorizontalPodAutoscaler(msg.autoscaler)
+		}
+	}
+	return nil
+}
+
+// refreshHorizontalPodAutoscaler fetches the autoscaler details off the update loop and delivers them as a horizontalPodAutoscalerDescribedMsg
+func (c *DescribeHorizontalPodAutoscalerController) refreshHorizontalPodAutoscaler() tea.Cmd {
+	clientset, namespace, autoscalerName := c.clientset, c.namespace, c.autoscalerName
+	return func() tea.Msg {
+		return describeHorizontalPodAutoscaler(clientset, namespace, autoscalerName)
+	}
+}
+
+// describeHorizontalPodAutoscaler fetches a horizontal pod autoscaler
+func describeHorizontalPodAutoscaler(clientset *kubernetes.Clientset, namespace, autoscalerName string) horizontalPodAutoscalerDescribedMsg {
+	autoscaler, err := models.GetHorizontalPodAutoscaler(clientset, namespace, autoscalerName)
+	if err != nil {
+		return horizontalPodAutoscalerDescribedMsg{err: err}
+	}
+	return horizontalPodAutoscalerDescribedMsg{autoscaler: autoscaler}
+}
+
+// GetHorizontalPodAutoscaler returns the described horizontal pod autoscaler (for testing)
+func (c *DescribeHorizontalPodAutoscalerController) GetHorizontalPodAutoscaler() *models.HorizontalPodAutoscaler {
+	return c.describeHorizontalPodAutoscalerView.HorizontalPodAutoscaler()
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeHorizontalPodAutoscalerControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeHorizontalPodAutoscalerController
+	pushed     PushViewMsg
+}
+
+func NewDescribeHorizontalPodAutoscalerControllerScenario(t *testing.T) *DescribeHorizontalPodAutoscalerControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeHorizontalPodAutoscalerControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) Given() *DescribeHorizontalPodAutoscalerControllerScenario {
+	return s
+}
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) When() *DescribeHorizontalPodAutoscalerControllerScenario {
+	return s
+}
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) Then() *DescribeHorizontalPodAutoscalerControllerScenario {
+	return s
+}
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) and() *DescribeHorizontalPodAutoscalerControllerScenario {
+	return s
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeHorizontalPodAutoscalerControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) the_describe_horizontal_pod_autoscaler_controller_is_instantiated(name, namespace string) *DescribeHorizontalPodAutoscalerControllerScenario {
+	s.controller = NewDescribeHorizontalPodAutoscalerController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeHorizontalPodAutoscalerControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) the_autoscaler_should_be(assertFn func(*models.HorizontalPodAutoscaler)) *DescribeHorizontalPodAutoscalerControllerScenario {
+	assertFn(s.controller.GetHorizontalPodAutoscaler())
+	return s
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeHorizontalPodAutoscalerControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeHorizontalPodAutoscalerControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDescribeHorizontalPodAutoscalerController(t *testing.T) {
+	t.Run("should_describe_the_autoscaler_conditions", func(t *testing.T) {
+		s := NewDescribeHorizontalPodAutoscalerControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "apps").
+					WithHorizontalPodAutoscaler("web", "apps", "web", 2, 4, 80).
+					WithHorizontalPodAutoscalerStatus("web", "apps", 4, 4, 150,
+						autoscalingv2.HorizontalPodAutoscalerCondition{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue, Reason: "ReadyForNewScale"},
+						autoscalingv2.HorizontalPodAutoscalerCondition{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionTrue, Reason: "ValidMetricFound"},
+						autoscalingv2.HorizontalPodAutoscalerCondition{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionTrue, Reason: "TooManyReplicas", Message: "the desired replica count is more than the maximum replica count"})
+			}).
+			When().
+			the_describe_horizontal_pod_autoscaler_controller_is_instantiated("web", "apps").
+			Then().
+			the_autoscaler_should_be(func(autoscaler *models.HorizontalPodAutoscaler) {
+				if assert.Len(t, autoscaler.Conditions, 3) {
+					assert.Equal(t, "TooManyReplicas", autoscaler.Condition("ScalingLimited").Reason)
+				}
+				assert.True(t, autoscaler.IsScalingLimited())
+				assert.False(t, autoscaler.IsUnhealthy())
+				if assert.Len(t, autoscaler.Metrics, 1) {
+					assert.Equal(t, models.AutoscalerMetric{Type: "Resource", Name: "cpu", Current: "150%", Target: "80%"}, autoscaler.Metrics[0])
+				}
+			})
+	})
+
+	t.Run("should_flag_an_autoscaler_unable_to_compute_replicas", func(t *testing.T) {
+		s := NewDescribeHorizontalPodAutoscalerControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithHorizontalPodAutoscaler("web", "apps", "web", 1, 3, 80).
+					WithHorizontalPodAutoscalerStatus("web", "apps", 1, 1, 0,
+						autoscalingv2.HorizontalPodAutoscalerCondition{Type: autoscalingv2.ScalingActive, Status: corev1.ConditionFalse, Reason: "FailedGetResourceMetric"})
+			}).
+			When().
+			the_describe_horizontal_pod_autoscaler_controller_is_instantiated("web", "apps").
+			Then().
+			the_autoscaler_should_be(func(autoscaler *models.HorizontalPodAutoscaler) {
+				assert.True(t, autoscaler.IsUnhealthy())
+			})
+	})
+
+	t.Run("should_jump_to_the_scaled_deployment", func(t *testing.T) {
+		s := NewDescribeHorizontalPodAutoscalerControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "apps").
+					WithHorizontalPodAutoscaler("web", "apps", "web", 2, 10, 80)
+			}).
+			the_describe_horizontal_pod_autoscaler_controller_is_instantiated("web", "apps").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/web", pushed.Title)
+				_, ok := pushed.Controller.(*DescribeDeploymentController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeIngressController handles input for the describe ingress view
+type DescribeIngressController struct {
+	describeIngressView *views.DescribeIngressView
+	clientset           *kubernetes.Clientset
+	theme               *theme.Theme
+	ingressName         string
+	namespace           string
+	width               int
+	height              int
+}
+
+// NewDescribeIngressController creates a new describe ingress controller
+func NewDescribeIngressController(clientset *kubernetes.Clientset, theme *theme.Theme, ingressName, namespace string) *DescribeIngressController {
+	msg := describeIngress(clientset, namespace, ingressName)
+	if msg.err != nil {
+		log.Printf("error getting ingress details: %v", msg.err)
+		// Create a placeholder ingress for error case
+		msg.ingress = &models.Ingress{
+			Name:      ingressName,
+			Namespace: namespace,
+		}
+	}
+
+	describeIngressView := views.NewDescribeIngressView(msg.ingress, msg.health, theme)
+
+	return &DescribeIngressController{
+		describeIngressView: describeIngressView,
+		clientset:           clientset,
+		theme:               theme,
+		ingressName:         ingressName,
+		namespace:           namespace,
+	}
+}
+
+// describeIngressKeys are the key bindings of the describe ingress view
+var describeIngressKeys = registerKeyMap("describe-ingress", "Describe ingress",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"enter"}, Help: "Describe the selected backend service"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe ingress view
+func (c *DescribeIngressController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeIngressKeys.Action(msg) {
+	case ActionUp:
+		c.describeIngressView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.describeIngressView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedBackend()
+	case ActionRefresh:
+		// Refresh ingress details and backend health
+		return c.refreshIngress()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe ingress view, which the help overlay lists
+func (c *DescribeIngressController) KeyMap() *KeyMap {
+	return describeIngressKeys
+}
+
+// describeSelectedBackend pushes the describe view for the service behind the selected backend
+func (c *DescribeIngressController) describeSelectedBackend() tea.Cmd {
+	backend := c.describeIngressView.GetSelectedBackend()
+	if backend == nil || backend.ServiceName == "" {
+		return nil
+	}
+	describeCtrl := NewDescribeServiceController(c.clientset, c.theme, backend.ServiceName, c.namespace)
+	return PushView(describeCtrl, c.namespace+"/"+backend.ServiceName)
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeIngressController) ActionText() string {
+	return fmt.Sprintf("Describing ingress %s", c.ingressName)
+}
+
+// Render returns the rendered describe ingress view
+func (c *DescribeIngressController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeIngressView.SetSize(width, height)
+	return c.describeIngressView.Render()
+}
+
+// ingressDescribedMsg carries refreshed ingress details and backend health to the update loop
+type ingressDescribedMsg struct {
+	ingress *models.Ingress
+	health  map[string]models.BackendHealth
+	err     error
+}
+
+// Update applies refreshed ingress details on the update loop
+func (c *DescribeIngressController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ingressDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing ingress details: %v", msg.err)
+			return nil
+		}
+		if msg.ingress.Name == c.ingressName && msg.ingress.Namespace == c.namespace {
+			c.describeIngressView.UpdateIngress(msg.ingress, msg.health)
+		}
+	}
+	return nil
+}
+
+// refreshIngress fetches the ingress details off the update loop and delivers them as an ingressDescribedMsg
+func (c *DescribeIngressController) refreshIngress() tea.Cmd {
+	clientset, namespace, ingressName := c.clientset, c.namespace, c.ingressName
+	return func() tea.Msg {
+		return describeIngress(clientset, namespace, ingressName)
+	}
+}
+
+// describeIngress fetches an ingress and checks the services behind its backends
+func describeIngress(clientset *kubernetes.Clientset, namespace, ingressName string) ingressDescribedMsg {
+	ingress, err := models.GetIngress(clientset, namespace, ingressName)
+	if err != nil {
+		return ingressDescribedMsg{err: err}
+	}
+	health, err := models.CheckIngressBackends(clientset, ingress)
+	if err != nil {
+		return ingressDescribedMsg{err: err}
+	}
+	return ingressDescribedMsg{ingress: ingress, health: health}
+}
+
+// GetBackendHealth returns the health of the services behind the ingress backends, keyed by service name (for testing)
+func (c *DescribeIngressController) GetBackendHealth() map[string]models.BackendHealth {
+	return c.describeIngressView.Health()
+}
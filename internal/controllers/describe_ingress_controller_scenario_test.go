@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeIngressControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeIngressController
+	pushed     PushViewMsg
+}
+
+func NewDescribeIngressControllerScenario(t *testing.T) *DescribeIngressControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeIngressControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeIngressControllerScenario) Given() *DescribeIngressControllerScenario { return s }
+func (s *DescribeIngressControllerScenario) When() *DescribeIngressControllerScenario  { return s }
+func (s *DescribeIngressControllerScenario) Then() *DescribeIngressControllerScenario  { return s }
+func (s *DescribeIngressControllerScenario) and() *DescribeIngressControllerScenario   { return s }
+
+func (s *DescribeIngressControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeIngressControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeIngressControllerScenario) the_describe_ingress_controller_is_instantiated(name, namespace string) *DescribeIngressControllerScenario {
+	s.controller = NewDescribeIngressController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeIngressControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeIngressControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeIngressControllerScenario) the_backend_health_should_be(assertFn func(map[string]models.BackendHealth)) *DescribeIngressControllerScenario {
+	assertFn(s.controller.GetBackendHealth())
+	return s
+}
+
+func (s *DescribeIngressControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeIngressControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeIngressControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeIngressController(t *testing.T) {
+	t.Run("should_check_the_service_behind_each_backend", func(t *testing.T) {
+		s := NewDescribeIngressControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web"}).
+					WithServiceEndpoint("web", "default", "10.0.0.1", "web-1", true).
+					WithService("api", "default", map[string]string{"app": "api"}).
+					WithIngressPath("site", "default", "example.com", "/", "web", 80).
+					WithIngressPath("site", "default", "example.com", "/api", "api", 80).
+					WithIngressPath("site", "default", "example.com", "/old", "legacy", 80)
+			}).
+			When().
+			the_describe_ingress_controller_is_instantiated("site", "default").
+			Then().
+			the_backend_health_should_be(func(health map[string]models.BackendHealth) {
+				assert.Equal(t, models.BackendHealth{ServiceExists: true, ReadyEndpoints: 1}, health["web"])
+				assert.True(t, health["web"].Healthy())
+				assert.Equal(t, models.BackendHealth{ServiceExists: true}, health["api"])
+				assert.False(t, health["api"].Healthy())
+				assert.Equal(t, models.BackendHealth{}, health["legacy"])
+				assert.False(t, health["legacy"].Healthy())
+			})
+	})
+
+	t.Run("should_drill_down_from_a_backend_to_its_service", func(t *testing.T) {
+		s := NewDescribeIngressControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web"}).
+					WithService("api", "default", map[string]string{"app": "api"}).
+					WithIngressPath("site", "default", "example.com", "/", "web", 80).
+					WithIngressPath("site", "default", "example.com", "/api", "api", 80)
+			}).
+			the_describe_ingress_controller_is_instantiated("site", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyDown}).
+			and().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "default/api", pushed.Title)
+				_, ok := pushed.Controller.(*DescribeServiceController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeJobController handles input for the describe job view
+type DescribeJobController struct {
+	describeJobView *views.DescribeJobView
+	clientset       *kubernetes.Clientset
+	theme           *theme.Theme
+	jobName         string
+	namespace       string
+	width           int
+	height          int
+}
+
+// NewDescribeJobController creates a new describe job controller
+func NewDescribeJobController(clientset *kubernetes.Clientset, theme *theme.Theme, jobName, namespace string) *DescribeJobController {
+	msg := describeJob(clientset, namespace, jobName)
+	if msg.err != nil {
+		log.Printf("error getting job details: %v", msg.err)
+		// Create a placeholder job for error case
+		msg.job = &models.Job{
+			Name:      jobName,
+			Namespace: namespace,
+			Status:    "Error",
+		}
+	}
+
+	describeJobView := views.NewDescribeJobView(msg.job, msg.failures, theme)
+
+	return &DescribeJobController{
+		describeJobView: describeJobView,
+		clientset:       clientset,
+		theme:           theme,
+		jobName:         jobName,
+		namespace:       namespace,
+	}
+}
+
+// describeJobKeys are the key bindings of the describe job view
+var describeJobKeys = registerKeyMap("describe-job", "Describe job",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionPods, Keys: []string{"p"}, Help: "Show the pods of the job"},
+	Binding{Action: ActionLogs, Keys: []string{"l"}, Help: "Show the logs of the latest pod"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe job view
+func (c *DescribeJobController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeJobKeys.Action(msg) {
+	case ActionUp:
+		c.describeJobView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeJobView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeJobView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeJobView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeJobView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeJobView.ScrollToBottom()
+		return nil
+	case ActionPods:
+		return c.openJobPods()
+	case ActionLogs:
+		return c.openLatestPodLogs()
+	case ActionRefresh:
+		// Refresh job details
+		return c.refreshJob()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe job view, which the help overlay lists
+func (c *DescribeJobController) KeyMap() *KeyMap {
+	return describeJobKeys
+}
+
+// openJobPods pushes a pod list scoped to the pods of the job
+func (c *DescribeJobController) openJobPods() tea.Cmd {
+	job, err := models.GetJob(c.clientset, c.namespace, c.jobName)
+	if err != nil {
+		log.Printf("error getting job details: %v", err)
+		return nil
+	}
+	if job.Selector == "" {
+		return nil
+	}
+	return PushView(newJobPodListController(c.clientset, c.theme, "", job), job.Namespace+"/"+job.Name+" pods")
+}
+
+// openLatestPodLogs pushes the logs of the job's most recent pod
+func (c *DescribeJobController) openLatestPodLogs() tea.Cmd {
+	job, err := models.GetJob(c.clientset, c.namespace, c.jobName)
+	if err != nil {
+		log.Printf("error getting job details: %v", err)
+		return nil
+	}
+	podName, err := models.GetLatestJobPod(c.clientset, job)
+	if err != nil {
+		c.describeJobView.SetStatusMessage(fmt.Sprintf("No logs to show: %v", err))
+		return nil
+	}
+	logCtrl := NewPodLogController(NewKubernetesLogFetcher(c.clientset), c.theme, podName, c.namespace)
+	return PushView(logCtrl, c.namespace+"/"+podName+" logs")
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeJobController) ActionText() string {
+	return fmt.Sprintf("Describing job %s", c.jobName)
+}
+
+// Render returns the rendered describe job view
+func (c *DescribeJobController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeJobView.SetSize(width, height)
+	return c.describeJobView.Render()
+}
+
+// jobDescribedMsg carries refreshed job details and pod failures to the update loop
+type jobDescribedMsg struct {
+	job      *models.Job
+	failures []models.JobPodFailure
+	err      error
+}
+
+// Update applies refreshed job details on the update loop
+func (c *DescribeJobController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case jobDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing job details: %v", msg.err)
+			return nil
+		}
+		if msg.job.Name == c.jobName && msg.job.Namespace == c.namespace {
+			c.describeJobView.UpdateJob(msg.job, msg.failures)
+		}
+	}
+	return nil
+}
+
+// refreshJob fetches the job details off the update loop and delivers them as a jobDescribedMsg
+func (c *DescribeJobController) refreshJob() tea.Cmd {
+	clientset, namespace, jobName := c.clientset, c.namespace, c.jobName
+	return func() tea.Msg {
+		return describeJob(clientset, namespace, jobName)
+	}
+}
+
+// describeJob fetches a job and the failures of its pods
+func describeJob(clientset *kubernetes.Clientset, namespace, jobName string) jobDescribedMsg {
+	job, err := models.GetJob(clientset, namespace, jobName)
+	if err != nil {
+		return jobDescribedMsg{err: err}
+	}
+	failures, err := models.GetJobPodFailures(clientset, job)
+	if err != nil {
+		return jobDescribedMsg{err: err}
+	}
+	return jobDescribedMsg{job: job, failures: failures}
+}
+
+// GetFailures returns the failures of the described job's pods (for testing)
+func (c *DescribeJobController) GetFailures() []models.JobPodFailure {
+	return c.describeJobView.Failures()
+}
+
+// GetStatusMessage returns the message shown in the status bar (for testing)
+func (c *DescribeJobController) GetStatusMessage() string {
+	return c.describeJobView.StatusMessage()
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeJobControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeJobController
+	pushed     PushViewMsg
+}
+
+func NewDescribeJobControllerScenario(t *testing.T) *DescribeJobControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeJobControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeJobControllerScenario) Given() *DescribeJobControllerScenario { return s }
+func (s *DescribeJobControllerScenario) When() *DescribeJobControllerScenario  { return s }
+func (s *DescribeJobControllerScenario) Then() *DescribeJobControllerScenario  { return s }
+func (s *DescribeJobControllerScenario) and() *DescribeJobControllerScenario   { return s }
+
+func (s *DescribeJobControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeJobControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeJobControllerScenario) the_describe_job_controller_is_instantiated(name, namespace string) *DescribeJobControllerScenario {
+	s.controller = NewDescribeJobController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeJobControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeJobControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeJobControllerScenario) the_pod_failures_should_be(assertFn func([]models.JobPodFailure)) *DescribeJobControllerScenario {
+	assertFn(s.controller.GetFailures())
+	return s
+}
+
+func (s *DescribeJobControllerScenario) the_status_message_should_be(assertFn func(string)) *DescribeJobControllerScenario {
+	assertFn(s.controller.GetStatusMessage())
+	return s
+}
+
+func (s *DescribeJobControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeJobControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeJobControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
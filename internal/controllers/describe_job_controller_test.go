@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeJobController(t *testing.T) {
+	t.Run("should_show_why_the_job_pods_failed", func(t *testing.T) {
+		s := NewDescribeJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithJob("migrate", "default", 1, 2).
+					WithFailedJobPod("migrate", "default", "migrate-aaaaa", "Error", 1).
+					WithFailedJobPod("migrate", "default", "migrate-bbbbb", "OOMKilled", 137)
+			}).
+			When().
+			the_describe_job_controller_is_instantiated("migrate", "default").
+			Then().
+			the_pod_failures_should_be(func(failures []models.JobPodFailure) {
+				assert.Equal(t, []models.JobPodFailure{
+					{PodName: "migrate-aaaaa", Container: "task", Reason: "Error", ExitCode: 1},
+					{PodName: "migrate-bbbbb", Container: "task", Reason: "OOMKilled", ExitCode: 137},
+				}, failures)
+			})
+	})
+
+	t.Run("should_open_the_logs_of_the_latest_pod", func(t *testing.T) {
+		s := NewDescribeJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithJob("migrate", "default", 1, 2).
+					WithFailedJobPod("migrate", "default", "migrate-aaaaa", "Error", 1)
+			}).
+			the_describe_job_controller_is_instantiated("migrate", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "default/migrate-aaaaa logs", pushed.Title)
+				_, ok := pushed.Controller.(*PodLogController)
+				assert.True(t, ok)
+			})
+	})
+
+	t.Run("should_explain_when_there_are_no_logs_to_show", func(t *testing.T) {
+		s := NewDescribeJobControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithJob("migrate", "default", 1, 2)
+			}).
+			the_describe_job_controller_is_instantiated("migrate", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")}).
+			Then().
+			the_status_message_should_be(func(message string) {
+				assert.Equal(t, "No logs to show: job migrate in namespace default has no pods", message)
+			})
+	})
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeNamespaceController handles input for the describe namespace view
+type DescribeNamespaceController struct {
+	describeNamespaceView *views.DescribeNamespaceView
+	clientset             *kubernetes.Clientset
+	theme                 *theme.Theme
+	namespaceName         string
+	width                 int
+	height                int
+}
+
+// NewDescribeNamespaceController creates a new describe namespace controller
+func NewDescribeNamespaceController(clientset *kubernetes.Clientset, theme *theme.Theme, namespaceName string) *DescribeNamespaceController {
+	msg := describeNamespace(clientset, namespaceName)
+	if msg.err != nil {
+		log.Printf("error getting namespace details: %v", msg.err)
+		// Create a placeholder namespace for error case
+		msg.namespace = &models.Namespace{
+			Name: namespaceName,
+		}
+	}
+
+	describeView := views.NewDescribeNamespaceView(msg.namespace, msg.blockers, theme)
+
+	return &DescribeNamespaceController{
+		describeNamespaceView: describeView,
+		clientset:             clientset,
+		theme:                 theme,
+		namespaceName:         namespaceName,
+	}
+}
+
+// describeNamespaceKeys are the key bindings of the describe namespace view
+var describeNamespaceKeys = registerKeyMap("describe-namespace", "Describe namespace",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionUseNamespace, Keys: []string{"enter"}, Help: "Scope the views to the namespace"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe namespace view
+func (c *DescribeNamespaceController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeNamespaceKeys.Action(msg) {
+	case ActionUp:
+		c.describeNamespaceView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeNamespaceView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeNamespaceView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeNamespaceView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeNamespaceView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeNamespaceView.ScrollToBottom()
+		return nil
+	case ActionUseNamespace:
+		return SetNamespace(c.namespaceName)
+	case ActionRefresh:
+		// Refresh namespace details
+		return c.refreshNamespace()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe namespace view, which the help overlay lists
+func (c *DescribeNamespaceController) KeyMap() *KeyMap {
+	return describeNamespaceKeys
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeNamespaceController) ActionText() string {
+	return fmt.Sprintf("Describing namespace %s", c.namespaceName)
+}
+
+// Render returns the rendered describe namespace view
+func (c *DescribeNamespaceController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeNamespaceView.SetSize(width, height)
+	return c.describeNamespaceView.Render()
+}
+
+// namespaceDescribedMsg carries refreshed namespace details to the update loop
+type namespaceDescribedMsg struct {
+	namespace *models.Namespace
+	blockers  []models.NamespaceBlocker
+	err       error
+}
+
+// Update applies refreshed namespace details on the update loop
+func (c *DescribeNamespaceController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case namespaceDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing namespace details: %v", msg.err)
+			return nil
+		}
+		if msg.namespace.Name == c.namespaceName {
+			c.describeNamespaceView.UpdateNamespace(msg.namespace, msg.blockers)
+		}
+	}
+	return nil
+}
+
+// refreshNamespace fetches the namespace details off the update loop and delivers them as a namespaceDescribedMsg
+func (c *DescribeNamespaceController) refreshNamespace() tea.Cmd {
+	clientset, namespaceName := c.clientset, c.namespaceName
+	return func() tea.Msg {
+		return describeNamespace(clientset, namespaceName)
+	}
+}
+
+// describeNamespace fetches a namespace with its usage and, while it is terminating, the objects blocking its deletion
+func describeNamespace(clientset *kubernetes.Clientset, namespaceName string) namespaceDescribedMsg {
+	namespace, err := models.GetNamespace(clientset, namespaceName)
+	if err != nil {
+		return namespaceDescribedMsg{err: err}
+	}
+	if !namespace.IsTerminating() {
+		return namespaceDescribedMsg{namespace: namespace}
+	}
+	blockers, err := models.GetNamespaceBlockers(clientset, namespace)
+	if err != nil {
+		// The namespace is still worth showing with the blockers that could be found
+		log.Printf("error getting namespace deletion blockers: %v", err)
+	}
+	return namespaceDescribedMsg{namespace: namespace, blockers: blockers}
+}
+
+// GetNamespace returns the described namespace (for testing)
+func (c *DescribeNamespaceController) GetNamespace() *models.Namespace {
+	return c.describeNamespaceView.Namespace()
+}
+
+// GetBlockers returns the objects blocking the namespace's deletion (for testing)
+func (c *DescribeNamespaceController) GetBlockers() []models.NamespaceBlocker {
+	return c.describeNamespaceView.Blockers()
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeNamespaceControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeNamespaceController
+	msg        tea.Msg
+}
+
+func NewDescribeNamespaceControllerScenario(t *testing.T) *DescribeNamespaceControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeNamespaceControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeNamespaceControllerScenario) Given() *DescribeNamespaceControllerScenario {
+	return s
+}
+func (s *DescribeNamespaceControllerScenario) When() *DescribeNamespaceControllerScenario {
+	return s
+}
+func (s *DescribeNamespaceControllerScenario) Then() *DescribeNamespaceControllerScenario {
+	return s
+}
+func (s *DescribeNamespaceControllerScenario) and() *DescribeNamespaceControllerScenario {
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeNamespaceControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) the_describe_namespace_controller_is_instantiated(name string) *DescribeNamespaceControllerScenario {
+	s.controller = NewDescribeNamespaceController(s.builder.GetClientset(), theme.NewDefaultTheme(), name)
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeNamespaceControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	s.msg = cmd()
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) the_namespace_should_be(assertFn func(*models.Namespace)) *DescribeNamespaceControllerScenario {
+	assertFn(s.controller.GetNamespace())
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) the_blockers_should_be(assertFn func([]models.NamespaceBlocker)) *DescribeNamespaceControllerScenario {
+	assertFn(s.controller.GetBlockers())
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) the_issued_message_should_be(assertFn func(tea.Msg)) *DescribeNamespaceControllerScenario {
+	assertFn(s.msg)
+	return s
+}
+
+func (s *DescribeNamespaceControllerScenario) Cleanup() {
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
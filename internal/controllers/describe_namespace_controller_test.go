@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDescribeNamespaceController(t *testing.T) {
+	t.Run("should_describe_pods_and_resource_quotas", func(t *testing.T) {
+		s := NewDescribeNamespaceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPodInPhase("web", "apps", corev1.PodRunning).
+					WithPodInPhase("report", "apps", corev1.PodSucceeded).
+					WithResourceQuota("compute", "apps", map[string]string{"pods": "10"}, map[string]string{"pods": "2"})
+			}).
+			When().
+			the_describe_namespace_controller_is_instantiated("apps").
+			Then().
+			the_namespace_should_be(func(namespace *models.Namespace) {
+				assert.Equal(t, "apps", namespace.Name)
+				assert.Equal(t, "Active", namespace.Status)
+				assert.False(t, namespace.IsTerminating())
+				assert.Equal(t, 1, namespace.Usage.Pods.Running)
+				assert.Equal(t, 1, namespace.Usage.Pods.Succeeded)
+				if assert.Len(t, namespace.Usage.Quotas, 1) {
+					quota := namespace.Usage.Quotas[0]
+					assert.Equal(t, "compute", quota.Quota)
+					assert.Equal(t, "pods", quota.Resource)
+					assert.Equal(t, "2", quota.Used)
+					assert.Equal(t, "10", quota.Hard)
+					assert.Equal(t, "20%", quota.FormatPercent())
+				}
+			}).
+			and().
+			the_blockers_should_be(func(blockers []models.NamespaceBlocker) {
+				assert.Empty(t, blockers)
+			})
+	})
+
+	t.Run("should_show_what_blocks_a_terminating_namespace", func(t *testing.T) {
+		s := NewDescribeNamespaceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithFinalizedConfigMap("settings", "doomed", "example.com/protect").
+					WithTerminatingNamespace("doomed")
+			}).
+			When().
+			the_describe_namespace_controller_is_instantiated("doomed").
+			Then().
+			the_namespace_should_be(func(namespace *models.Namespace) {
+				assert.Equal(t, "Terminating", namespace.Status)
+				assert.True(t, namespace.IsTerminating())
+				assert.Contains(t, namespace.Finalizers, "kubernetes")
+			}).
+			and().
+			the_blockers_should_be(func(blockers []models.NamespaceBlocker) {
+				assert.Contains(t, blockers, models.NamespaceBlocker{
+					Kind:       "ConfigMap",
+					Name:       "settings",
+					Finalizers: []string{"example.com/protect"},
+				})
+			})
+	})
+
+	t.Run("should_scope_views_to_the_described_namespace", func(t *testing.T) {
+		s := NewDescribeNamespaceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithNamespace("apps")
+			}).
+			the_describe_namespace_controller_is_instantiated("apps").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_issued_message_should_be(func(msg tea.Msg) {
+				assert.Equal(t, SetNamespaceMsg{Namespace: "apps"}, msg)
+			})
+	})
+}
@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeNodeController handles input for the describe node view
+type DescribeNodeController struct {
+	describeNodeView *views.DescribeNodeView
+	clientset        *kubernetes.Clientset
+	theme            *theme.Theme
+	nodeName         string
+	// readOnly disables cordoning, uncordoning and draining the node
+	readOnly bool
+	width    int
+	height   int
+}
+
+// NewDescribeNodeController creates a new describe node controller. In read-only mode the node cannot be cordoned or drained.
+func NewDescribeNodeController(clientset *kubernetes.Clientset, theme *theme.Theme, nodeName string, readOnly bool) *DescribeNodeController {
+	// Fetch node details
+	node, err := models.GetNode(clientset, nodeName)
+	if err != nil {
+		log.Printf("error getting node details: %v", err)
+		// Create a placeholder node for error case
+		node = &models.Node{
+			Name:   nodeName,
+			Status: "Error",
+		}
+	}
+	node.Usage = getNodeUsage(clientset, nodeName)
+
+	describeNodeView := views.NewDescribeNodeView(node, theme)
+
+	return &DescribeNodeController{
+		describeNodeView: describeNodeView,
+		clientset:        clientset,
+		theme:            theme,
+		nodeName:         nodeName,
+		readOnly:         readOnly,
+	}
+}
+
+// describeNodeKeys are the key bindings of the describe node view
+var describeNodeKeys = registerKeyMap("describe-node", "Describe node",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods on the node"},
+	Binding{Action: ActionCordon, Keys: []string{"c"}, Help: "Cordon the node"},
+	Binding{Action: ActionUncordon, Keys: []string{"u"}, Help: "Uncordon the node"},
+	Binding{Action: ActionDrain, Keys: []string{"D"}, Help: "Drain the node"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe node view
+func (c *DescribeNodeController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	action := describeNodeKeys.Action(msg)
+	switch action {
+	case ActionUp:
+		c.describeNodeView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeNodeView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeNodeView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeNodeView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeNodeView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeNodeView.ScrollToBottom()
+		return nil
+	case ActionPods:
+		// Drill down to the pods scheduled on the node
+		return PushView(newNodePodListController(c.clientset, c.theme, "", c.nodeName), "pods")
+	case ActionCordon, ActionUncordon, ActionDrain:
+		return c.nodeAction(action)
+	case ActionRefresh:
+		// Refresh node details
+		return c.refreshNode()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe node view, which the help overlay lists
+func (c *DescribeNodeController) KeyMap() *KeyMap {
+	return describeNodeKeys
+}
+
+// nodeAction performs the cordon, uncordon or drain action, which are ignored in read-only mode
+func (c *DescribeNodeController) nodeAction(action Action) tea.Cmd {
+	if c.readOnly {
+		log.Printf("ignoring %s on node %s: read-only mode", action, c.nodeName)
+		return nil
+	}
+	switch action {
+	case ActionCordon:
+		return setNodeUnschedulable(c.clientset, c.nodeName, true)
+	case ActionUncordon:
+		return setNodeUnschedulable(c.clientset, c.nodeName, false)
+	case ActionDrain:
+		return PushView(NewDrainNodeController(c.clientset, c.theme, c.nodeName), "drain")
+	default:
+		return nil
+	}
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeNodeController) ActionText() string {
+	return fmt.Sprintf("Describing node %s", c.nodeName)
+}
+
+// Render returns the rendered describe node view
+func (c *DescribeNodeController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeNodeView.SetSize(width, height)
+	return c.describeNodeView.Render()
+}
+
+// nodeDescribedMsg carries refreshed node details to the update loop
+type nodeDescribedMsg struct {
+	node *models.Node
+}
+
+// Update applies refreshed node details on the update loop
+func (c *DescribeNodeController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case nodeDescribedMsg:
+		if msg.node.Name == c.nodeName {
+			c.describeNodeView.UpdateNode(msg.node)
+		}
+	case nodeCordonedMsg:
+		if msg.err != nil {
+			log.Printf("error patching node %s: %v", msg.nodeName, msg.err)
+			return nil
+		}
+		// Show the new scheduling status
+		return c.refreshNode()
+	}
+	return nil
+}
+
+// refreshNode fetches the node details off the update loop and delivers them as a nodeDescribedMsg
+func (c *DescribeNodeController) refreshNode() tea.Cmd {
+	clientset, nodeName := c.clientset, c.nodeName
+	return func() tea.Msg {
+		node, err := models.GetNode(clientset, nodeName)
+		if err != nil {
+			log.Printf("error refreshing node details: %v", err)
+			return nil
+		}
+		node.Usage = getNodeUsage(clientset, nodeName)
+		return nodeDescribedMsg{node: node}
+	}
+}
+
+// getNodeUsage returns the node's current usage, or nil when metrics-server is absent
+func getNodeUsage(clientset *kubernetes.Clientset, nodeName string) *models.ResourceUsage {
+	msg := fetchNodeMetrics(context.Background(), models.NewMetricsClient(clientset))
+	if msg.err != nil {
+		if !errors.Is(msg.err, models.ErrMetricsUnavailable) {
+			log.Printf("error getting node usage: %v", msg.err)
+		}
+		return nil
+	}
+	usage, ok := msg.usage[nodeName]
+	if !ok {
+		return nil
+	}
+	return &usage
+}
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribePersistentVolumeController handles input for the describe persistent volume view
+type DescribePersistentVolumeController struct {
+	describePersistentVolumeView *views.DescribePersistentVolumeView
+	clientset                    *kubernetes.Clientset
+	theme                        *theme.Theme
+	volumeName                   string
+	width                        int
+	height                       int
+}
+
+// NewDescribePersistentVolumeController creates a new describe persistent volume controller
+func NewDescribePersistentVolumeController(clientset *kubernetes.Clientset, theme *theme.Theme, volumeName string) *DescribePersistentVolumeController {
+	msg := describePersistentVolume(clientset, volumeName)
+	if msg.err != nil {
+		log.Printf("error getting persistent volume details: %v", msg.err)
+		// Create a placeholder volume for error case
+		msg.volume = &models.PersistentVolume{
+			Name:   volumeName,
+			Status: "Error",
+		}
+	}
+
+	describeView := views.NewDescribePersistentVolumeView(msg.volume, theme)
+
+	return &DescribePersistentVolumeController{
+		describePersistentVolumeView: describeView,
+		clientset:                    clientset,
+		theme:                        theme,
+		volumeName:                   volumeName,
+	}
+}
+
+// describePersistentVolumeKeys are the key bindings of the describe persistent volume view
+var describePersistentVolumeKeys = registerKeyMap("describe-pv", "Describe persistent volume",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionClaim, Keys: []string{"c"}, Help: "Describe the bound claim"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe persistent volume view
+func (c *DescribePersistentVolumeController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describePersistentVolumeKeys.Action(msg) {
+	case ActionUp:
+		c.describePersistentVolumeView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describePersistentVolumeView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describePersistentVolumeView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describePersistentVolumeView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describePersistentVolumeView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describePersistentVolumeView.ScrollToBottom()
+		return nil
+	case ActionClaim:
+		return c.describeBoundClaim()
+	case ActionRefresh:
+		// Refresh volume details
+		return c.refreshPersistentVolume()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe persistent volume view, which the help overlay lists
+func (c *DescribePersistentVolumeController) KeyMap() *KeyMap {
+	return describePersistentVolumeKeys
+}
+
+// describeBoundClaim pushes the describe view for the claim the volume is bound to
+func (c *DescribePersistentVolumeController) describeBoundClaim() tea.Cmd {
+	volume := c.describePersistentVolumeView.PersistentVolume()
+	if volume == nil || volume.ClaimName == "" {
+		return nil
+	}
+	describeCtrl := NewDescribePersistentVolumeClaimController(c.clientset, c.theme, volume.ClaimName, volume.ClaimNamespace)
+	return PushView(describeCtrl, volume.FormatClaim())
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribePersistentVolumeController) ActionText() string {
+	return fmt.Sprintf("Describing persistent volume %s", c.volumeName)
+}
+
+// Render returns the rendered describe persistent volume view
+func (c *DescribePersistentVolumeController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describePersistentVolumeView.SetSize(width, height)
+	return c.describePersistentVolumeView.Render()
+}
+
+// persistentVolumeDescribedMsg carries refreshed volume details to the update loop
+type persistentVolumeDescribedMsg struct {
+	volume *models.PersistentVolume
+	err    error
+}
+
+// Update applies refreshed volume details on the update loop
+func (c *DescribePersistentVolumeController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case persistentVolumeDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing persistent volume details: %v", msg.err)
+			return nil
+		}
+		if msg.volume.Name == c.volumeName {
+			c.describePersistentVolumeView.UpdatePersistentVolume(msg.volume)
+		}
+	}
+	return nil
+}
+
+// refreshPersistentVolume fetches the volume details off the update loop and delivers them as a persistentVolumeDescribedMsg
+func (c *DescribePersistentVolumeController) refreshPersistentVolume() tea.Cmd {
+	clientset, volumeName := c.clientset, c.volumeName
+	return func() tea.Msg {
+		return describePersistentVolume(clientset, volumeName)
+	}
+}
+
+// describePersistentVolume fetches a persistent volume
+func describePersistentVolume(clientset *kubernetes.Clientset, volumeName string) persistentVolumeDescribedMsg {
+	volume, err := models.GetPersistentVolume(clientset, volumeName)
+	if err != nil {
+		return persistentVolumeDescribedMsg{err: err}
+	}
+	return persistentVolumeDescribedMsg{volume: volume}
+}
+
+// GetPersistentVolume returns the described volume (for testing)
+func (c *DescribePersistentVolumeController) GetPersistentVolume() *models.PersistentVolume {
+	return c.describePersistentVolumeView.PersistentVolume()
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribePersistentVolumeControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribePersistentVolumeController
+	pushed     PushViewMsg
+}
+
+func NewDescribePersistentVolumeControllerScenario(t *testing.T) *DescribePersistentVolumeControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribePersistentVolumeControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribePersistentVolumeControllerScenario) Given() *DescribePersistentVolumeControllerScenario {
+	return s
+}
+func (s *DescribePersistentVolumeControllerScenario) When() *DescribePersistentVolumeControllerScenario {
+	return s
+}
+func (s *DescribePersistentVolumeControllerScenario) Then() *DescribePersistentVolumeControllerScenario {
+	return s
+}
+func (s *DescribePersistentVolumeControllerScenario) and() *DescribePersistentVolumeControllerScenario {
+	return s
+}
+
+func (s *DescribePersistentVolumeControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribePersistentVolumeControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribePersistentVolumeControllerScenario) the_describe_persistent_volume_controller_is_instantiated(name string) *DescribePersistentVolumeControllerScenario {
+	s.controller = NewDescribePersistentVolumeController(s.builder.GetClientset(), theme.NewDefaultTheme(), name)
+	return s
+}
+
+func (s *DescribePersistentVolumeControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribePersistentVolumeControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribePersistentVolumeControllerScenario) the_volume_should_be(assertFn func(*models.PersistentVolume)) *DescribePersistentVolumeControllerScenario {
+	assertFn(s.controller.GetPersistentVolume())
+	return s
+}
+
+func (s *DescribePersistentVolumeControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribePersistentVolumeControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribePersistentVolumeControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribePersistentVolumeController(t *testing.T) {
+	t.Run("should_describe_the_volume_and_its_claim", func(t *testing.T) {
+		s := NewDescribePersistentVolumeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data")
+			}).
+			When().
+			the_describe_persistent_volume_controller_is_instantiated("pv-data").
+			Then().
+			the_volume_should_be(func(volume *models.PersistentVolume) {
+				assert.Equal(t, "Bound", volume.Status)
+				assert.Equal(t, "Retain", volume.ReclaimPolicy)
+				assert.Equal(t, "standard", volume.StorageClass)
+				assert.Equal(t, []string{"ReadWriteOnce"}, volume.AccessModes)
+			})
+	})
+
+	t.Run("should_navigate_to_the_bound_claim", func(t *testing.T) {
+		s := NewDescribePersistentVolumeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data").
+					WithPodMountingClaim("db-0", "apps", "data")
+			}).
+			the_describe_persistent_volume_controller_is_instantiated("pv-data").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/data", pushed.Title)
+				describe, ok := pushed.Controller.(*DescribePersistentVolumeClaimController)
+				if assert.True(t, ok) {
+					assert.Len(t, describe.GetPods(), 1)
+				}
+			})
+	})
+}
@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribePersistentVolumeClaimController handles input for the describe persistent volume claim view
+type DescribePersistentVolumeClaimController struct {
+	describePersistentVolumeClaimView *views.DescribePersistentVolumeClaimView
+	clientset                         *kubernetes.Clientset
+	theme                             *theme.Theme
+	claimName                         string
+	namespace                         string
+	width                             int
+	height                            int
+}
+
+// NewDescribePersistentVolumeClaimController creates a new describe persistent volume claim controller
+func NewDescribePersistentVolumeClaimController(clientset *kubernetes.Clientset, theme *theme.Theme, claimName, namespace string) *DescribePersistentVolumeClaimController {
+	msg := describePersistentVolumeClaim(clientset, namespace, claimName)
+	if msg.err != nil {
+		log.Printf("error getting persistent volume claim details: %v", msg.err)
+		// Create a placeholder claim for error case
+		msg.claim = &models.PersistentVolumeClaim{
+			Name:      claimName,
+			Namespace: namespace,
+			Status:    "Error",
+		}
+	}
+
+	describeView := views.NewDescribePersistentVolumeClaimView(msg.claim, msg.pods, msg.events, theme)
+
+	return &DescribePersistentVolumeClaimController{
+		describePersistentVolumeClaimView: describeView,
+		clientset:                         clientset,
+		theme:                             theme,
+		claimName:                         claimName,
+		namespace:                         namespace,
+	}
+}
+
+// describePersistentVolumeClaimKeys are the key bindings of the describe persistent volume claim view
+var describePersistentVolumeClaimKeys = registerKeyMap("describe-pvc", "Describe persistent volume claim",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"enter"}, Help: "Describe the selected pod"},
+	Binding{Action: ActionVolume, Keys: []string{"v"}, Help: "Describe the bound volume"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe persistent volume claim view
+func (c *DescribePersistentVolumeClaimController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describePersistentVolumeClaimKeys.Action(msg) {
+	case ActionUp:
+		c.describePersistentVolumeClaimView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.describePersistentVolumeClaimView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedPod()
+	case ActionVolume:
+		return c.describeBoundVolume()
+	case ActionRefresh:
+		// Refresh claim details, pods and events
+		return c.refreshPersistentVolumeClaim()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe persistent volume claim view, which the help overlay lists
+func (c *DescribePersistentVolumeClaimController) KeyMap() *KeyMap {
+	return describePersistentVolumeClaimKeys
+}
+
+// describeSelectedPod pushes the describe view for the selected pod mounting the claim
+func (c *DescribePersistentVolumeClaimController) describeSelectedPod() tea.Cmd {
+	pod := c.describePersistentVolumeClaimView.GetSelectedPod()
+	if pod == nil {
+		return nil
+	}
+	describeCtrl := NewDescribePodController(c.clientset, c.theme, pod.Name, pod.Namespace)
+	return PushView(describeCtrl, pod.Namespace+"/"+pod.Name)
+}
+
+// describeBoundVolume pushes the describe view for the persistent volume the claim is bound to
+func (c *DescribePersistentVolumeClaimController) describeBoundVolume() tea.Cmd {
+	claim := c.describePersistentVolumeClaimView.PersistentVolumeClaim()
+	if claim == nil || claim.Volume == "" {
+		return nil
+	}
+	describeCtrl := NewDescribePersistentVolumeController(c.clientset, c.theme, claim.Volume)
+	return PushView(describeCtrl, claim.Volume)
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribePersistentVolumeClaimController) ActionText() string {
+	return fmt.Sprintf("Describing persistent volume claim %s", c.claimName)
+}
+
+// Render returns the rendered describe persistent volume claim view
+func (c *DescribePersistentVolumeClaimController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describePersistentVolumeClaimView.SetSize(width, height)
+	return c.describePersistentVolumeClaimView.Render()
+}
+
+// persistentVolumeClaimDescribedMsg carries refreshed claim details, mounting pods and events to the update loop
+type persistentVolumeClaimDescribedMsg struct {
+	claim  *models.PersistentVolumeClaim
+	pods   []models.ClaimPod
+	events []models.Event
+	err    error
+}
+
+// Update applies refreshed claim details on the update loop
+func (c *DescribePersistentVolumeClaimController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case persistentVolumeClaimDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing persistent volume claim details: %v", msg.err)
+			return nil
+		}
+		if msg.claim.Name == c.claimName && msg.claim.Namespace == c.namespace {
+			c.describePersistentVolumeClaimView.UpdatePersistentVolumeClaim(msg.claim, msg.pods, msg.events)
+		}
+	}
+	return nil
+}
+
+// refreshPersistentVolumeClaim fetches the claim details off the update loop and delivers them as a persistentVolumeClaimDescribedMsg
+func (c *DescribePersistentVolumeClaimController) refreshPersistentVolumeClaim() tea.Cmd {
+	clientset, namespace, claimName := c.clientset, c.namespace, c.claimName
+	return func() tea.Msg {
+		return describePersistentVolumeClaim(clientset, namespace, claimName)
+	}
+}
+
+// describePersistentVolumeClaim fetches a claim and the pods mounting it, plus its events while it is pending
+func describePersistentVolumeClaim(clientset *kubernetes.Clientset, namespace, claimName string) persistentVolumeClaimDescribedMsg {
+	claim, err := models.GetPersistentVolumeClaim(clientset, namespace, claimName)
+	if err != nil {
+		return persistentVolumeClaimDescribedMsg{err: err}
+	}
+	pods, err := models.GetClaimPods(clientset, namespace, claimName)
+	if err != nil {
+		return persistentVolumeClaimDescribedMsg{err: err}
+	}
+	var events []models.Event
+	if claim.IsPending() {
+		events, err = models.GetEventsFor(clientset, namespace, "PersistentVolumeClaim", claimName)
+		if err != nil {
+			return persistentVolumeClaimDescribedMsg{err: err}
+		}
+	}
+	return persistentVolumeClaimDescribedMsg{claim: claim, pods: pods, events: events}
+}
+
+// GetPersistentVolumeClaim returns the described claim (for testing)
+func (c *DescribePersistentVolumeClaimController) GetPersistentVolumeClaim() *models.PersistentVolumeClaim {
+	return c.describePersistentVolumeClaimView.PersistentVolumeClaim()
+}
+
+// GetPods returns the pods mounting the described claim (for testing)
+func (c *DescribePersistentVolumeClaimController) GetPods() []models.ClaimPod {
+	return c.describePersistentVolumeClaimView.Pods()
+}
+
+// GetEvents returns the provisioning events of the described claim (for testing)
+func (c *DescribePersistentVolumeClaimController) GetEvents() []models.Event {
+	return c.describePersistentVolumeClaimView.Events()
+}
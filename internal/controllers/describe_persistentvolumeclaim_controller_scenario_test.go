@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribePersistentVolumeClaimControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribePersistentVolumeClaimController
+	pushed     PushViewMsg
+}
+
+func NewDescribePersistentVolumeClaimControllerScenario(t *testing.T) *DescribePersistentVolumeClaimControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribePersistentVolumeClaimControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) Given() *DescribePersistentVolumeClaimControllerScenario {
+	return s
+}
+func (s *DescribePersistentVolumeClaimControllerScenario) When() *DescribePersistentVolumeClaimControllerScenario {
+	return s
+}
+func (s *DescribePersistentVolumeClaimControllerScenario) Then() *DescribePersistentVolumeClaimControllerScenario {
+	return s
+}
+func (s *DescribePersistentVolumeClaimControllerScenario) and() *DescribePersistentVolumeClaimControllerScenario {
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribePersistentVolumeClaimControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) the_describe_persistent_volume_claim_controller_is_instantiated(name, namespace string) *DescribePersistentVolumeClaimControllerScenario {
+	s.controller = NewDescribePersistentVolumeClaimController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribePersistentVolumeClaimControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) the_claim_should_be(assertFn func(*models.PersistentVolumeClaim)) *DescribePersistentVolumeClaimControllerScenario {
+	assertFn(s.controller.GetPersistentVolumeClaim())
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) the_mounting_pods_should_be(assertFn func([]models.ClaimPod)) *DescribePersistentVolumeClaimControllerScenario {
+	assertFn(s.controller.GetPods())
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) the_provisioning_events_should_be(assertFn func([]models.Event)) *DescribePersistentVolumeClaimControllerScenario {
+	assertFn(s.controller.GetEvents())
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribePersistentVolumeClaimControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribePersistentVolumeClaimControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
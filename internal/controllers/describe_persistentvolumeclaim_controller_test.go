@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribePersistentVolumeClaimController(t *testing.T) {
+	t.Run("should_list_the_pods_mounting_the_claim", func(t *testing.T) {
+		s := NewDescribePersistentVolumeClaimControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data").
+					WithPodMountingClaim("db-0", "apps", "data").
+					WithPod("web-1", "apps")
+			}).
+			When().
+			the_describe_persistent_volume_claim_controller_is_instantiated("data", "apps").
+			Then().
+			the_claim_should_be(func(claim *models.PersistentVolumeClaim) {
+				assert.Equal(t, "Bound", claim.Status)
+				assert.Equal(t, "pv-data", claim.Volume)
+			}).
+			and().
+			the_mounting_pods_should_be(func(pods []models.ClaimPod) {
+				if assert.Len(t, pods, 1) {
+					assert.Equal(t, "db-0", pods[0].Name)
+					assert.Equal(t, "data", pods[0].Volume)
+				}
+			}).
+			and().
+			the_provisioning_events_should_be(func(events []models.Event) {
+				assert.Empty(t, events)
+			})
+	})
+
+	t.Run("should_show_provisioning_events_of_a_pending_claim", func(t *testing.T) {
+		s := NewDescribePersistentVolumeClaimControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolumeClaim("scratch", "apps", "").
+					WithClaimEvent("scratch", "apps", "ProvisioningFailed", "storageclass.storage.k8s.io \"fast\" not found")
+			}).
+			When().
+			the_describe_persistent_volume_claim_controller_is_instantiated("scratch", "apps").
+			Then().
+			the_provisioning_events_should_be(func(events []models.Event) {
+				if assert.Len(t, events, 1) {
+					assert.Equal(t, "ProvisioningFailed", events[0].Reason)
+					assert.True(t, events[0].IsWarning())
+					assert.Contains(t, events[0].Message, "not found")
+				}
+			})
+	})
+
+	t.Run("should_drill_down_from_a_mounting_pod_to_its_description", func(t *testing.T) {
+		s := NewDescribePersistentVolumeClaimControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data").
+					WithPodMountingClaim("db-0", "apps", "data")
+			}).
+			the_describe_persistent_volume_claim_controller_is_instantiated("data", "apps").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/db-0", pushed.Title)
+				_, ok := pushed.Controller.(*DescribePodController)
+				assert.True(t, ok)
+			})
+	})
+
+	t.Run("should_navigate_to_the_bound_volume", func(t *testing.T) {
+		s := NewDescribePersistentVolumeClaimControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data")
+			}).
+			the_describe_persistent_volume_claim_controller_is_instantiated("data", "apps").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "pv-data", pushed.Title)
+				_, ok := pushed.Controller.(*DescribePersistentVolumeController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -14,17 +15,32 @@ import (
 // DescribePodController handles input for the describe pod view
 type DescribePodController struct {
 	describePodView *views.DescribePodView
-	onBack          func() tea.Cmd
 	clientset       *kubernetes.Clientset
 	theme           *theme.Theme
 	podName         string
 	namespace       string
 	width           int
 	height          int
+
+	// Metrics-related fields; metrics is nil when usage history is disabled
+	metrics          *models.MetricsClient
+	metricsAvailable bool
+
+	// Message channel carrying metrics polls to the update loop
+	updateChan chan tea.Msg
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // NewDescribePodController creates a new describe pod controller
-func NewDescribePodController(clientset *kubernetes.Clientset, theme *theme.Theme, podName, namespace string, onBack func() tea.Cmd) *DescribePodController {
+func NewDescribePodController(clientset *kubernetes.Clientset, theme *theme.Theme, podName, namespace string) *DescribePodController {
+	return NewDescribePodControllerWithMetrics(clientset, models.NewMetricsClient(clientset), theme, podName, namespace)
+}
+
+// NewDescribePodControllerWithMetrics creates a describe pod controller charting usage polled from metrics.
+// A nil metrics client disables the usage history.
+func NewDescribePodControllerWithMetrics(clientset *kubernetes.Clientset, metrics *models.MetricsClient, theme *theme.Theme, podName, namespace string) *DescribePodController {
 	// Fetch pod details
 	pod, err := models.GetPod(clientset, namespace, podName)
 	if err != nil {
@@ -39,40 +55,103 @@ func NewDescribePodController(clientset *kubernetes.Clientset, theme *theme.Them
 
 	describePodView := views.NewDescribePodView(pod, theme)
 
-	return &DescribePodController{
+	ctx, cancel := context.WithCancel(context.Background())
+	controller := &DescribePodController{
 		describePodView: describePodView,
-		onBack:          onBack,
 		clientset:       clientset,
 		theme:           theme,
 		podName:         podName,
 		namespace:       namespace,
+		metrics:         metrics,
+		ctx:             ctx,
+		cancel:          cancel,
+	}
+
+	if metrics != nil {
+		// Take a sample straight away, then keep polling while the view is open
+		controller.applyPodMetrics(controller.pollMetrics())
+		controller.updateChan = make(chan tea.Msg, updateChannelSize)
+		go controller.pollMetricsLoop()
+	}
+
+	return controller
+}
+
+// pollMetricsLoop polls the pod's usage until the controller is stopped.
+// It closes the update channel when it returns, as the channel's only sender.
+func (c *DescribePodController) pollMetricsLoop() {
+	defer close(c.updateChan)
+
+	metricsTick, stopMetrics := metricsTicker(c.metrics)
+	defer stopMetrics()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-metricsTick:
+			if !sendMsg(c.ctx, c.updateChan, c.pollMetrics()) {
+				return
+			}
+		}
+	}
+}
+
+// pollMetrics polls the usage of the pods in the described pod's namespace
+func (c *DescribePodController) pollMetrics() podMetricsMsg {
+	return fetchPodMetrics(c.ctx, c.metrics, c.namespace, "")
+}
+
+// applyPodMetrics records a metrics poll in the usage history and charts the described pod's history
+func (c *DescribePodController) applyPodMetrics(msg podMetricsMsg) {
+	available, apply := metricsAvailability(msg.err)
+	if !apply {
+		return
 	}
+	c.metricsAvailable = available
+	history := usageHistory(c.metrics)
+	history.RecordAll(msg.usage)
+	c.describePodView.SetUsageHistory(history.PodHistory(c.namespace, c.podName), available)
 }
 
+// describePodKeys are the key bindings of the describe pod view
+var describePodKeys = registerKeyMap("describe-pod", "Describe pod",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionLogs, Keys: []string{"l"}, Help: "Show the logs of the pod"},
+	refreshBinding,
+)
+
 // HandleKey handles key press events for the describe pod view
 func (c *DescribePodController) HandleKey(msg tea.KeyMsg) tea.Cmd {
-	switch msg.String() {
-	case "up", "k":
+	switch describePodKeys.Action(msg) {
+	case ActionUp:
 		c.describePodView.ScrollUp()
 		return nil
-	case "down", "j":
+	case ActionDown:
 		c.describePodView.ScrollDown()
 		return nil
-	case "pgup", "ctrl+u":
+	case ActionPageUp:
 		c.describePodView.ScrollPageUp()
 		return nil
-	case "pgdown", "ctrl+d":
+	case ActionPageDown:
 		c.describePodView.ScrollPageDown()
 		return nil
-	case "g":
+	case ActionTop:
 		c.describePodView.ScrollToTop()
 		return nil
-	case "G":
+	case ActionBottom:
 		c.describePodView.ScrollToBottom()
 		return nil
-	case "esc":
-		return c.onBack()
-	case "r":
+	case ActionLogs:
+		// Open the logs of the described pod
+		logCtrl := NewPodLogController(NewKubernetesLogFetcher(c.clientset), c.theme, c.podName, c.namespace)
+		return PushView(logCtrl, "logs")
+	case ActionRefresh:
 		// Refresh pod details
 		return c.refreshPod()
 	default:
@@ -80,6 +159,11 @@ func (c *DescribePodController) HandleKey(msg tea.KeyMsg) tea.Cmd {
 	}
 }
 
+// KeyMap returns the key bindings of the describe pod view, which the help overlay lists
+func (c *DescribePodController) KeyMap() *KeyMap {
+	return describePodKeys
+}
+
 // ActionText returns the text to describe the action the controller is performing for the header bar
 func (c *DescribePodController) ActionText() string {
 	return fmt.Sprintf("Describing pod %s", c.podName)
@@ -93,17 +177,50 @@ func (c *DescribePodController) Render(width, height int) string {
 	return c.describePodView.Render()
 }
 
-// refreshPod refreshes the pod details
+// podDescribedMsg carries refreshed pod details to the update loop
+type podDescribedMsg struct {
+	pod *models.Pod
+}
+
+// Update applies refreshed pod details on the update loop
+func (c *DescribePodController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case podDescribedMsg:
+		if msg.pod.Name == c.podName && msg.pod.Namespace == c.namespace {
+			c.describePodView.UpdatePod(msg.pod)
+		}
+	case podMetricsMsg:
+		c.applyPodMetrics(msg)
+	}
+	return nil
+}
+
+// UsageHistory returns the usage history charted for each container (for testing)
+func (c *DescribePodController) UsageHistory() map[string][]models.UsageSample {
+	return usageHistory(c.metrics).PodHistory(c.namespace, c.podName)
+}
+
+// GetUpdateChannel returns the channel carrying metrics polls
+func (c *DescribePodController) GetUpdateChannel() <-chan tea.Msg {
+	return c.updateChan
+}
+
+// Stop stops polling metrics
+func (c *DescribePodController) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+}
+
+// refreshPod fetches the pod details off the update loop and delivers them as a podDescribedMsg
 func (c *DescribePodController) refreshPod() tea.Cmd {
+	clientset, namespace, podName := c.clientset, c.namespace, c.podName
 	return func() tea.Msg {
-		pod, err := models.GetPod(c.clientset, c.namespace, c.podName)
+		pod, err := models.GetPod(clientset, namespace, podName)
 		if err != nil {
 			log.Printf("error refreshing pod details: %v", err)
 			return nil
 		}
-
-		// Update the describe pod view with new data
-		c.describePodView.UpdatePod(pod)
-		return nil
+		return podDescribedMsg{pod: pod}
 	}
 }
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribePodControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribePodController
+}
+
+func NewDescribePodControllerScenario(t *testing.T) *DescribePodControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribePodControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribePodControllerScenario) Given() *DescribePodControllerScenario { return s }
+func (s *DescribePodControllerScenario) When() *DescribePodControllerScenario  { return s }
+func (s *DescribePodControllerScenario) Then() *DescribePodControllerScenario  { return s }
+func (s *DescribePodControllerScenario) and() *DescribePodControllerScenario   { return s }
+
+func (s *DescribePodControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribePodControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribePodControllerScenario) the_describe_pod_controller_is_instantiated_with_metrics_from(standIn *MetricsServerStandIn, name, namespace string) *DescribePodControllerScenario {
+	s.controller = NewDescribePodControllerWithMetrics(s.builder.GetClientset(), standIn.Client(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribePodControllerScenario) the_usage_history_should_be(assertFn func(map[string][]models.UsageSample)) *DescribePodControllerScenario {
+	assertFn(s.controller.UsageHistory())
+	return s
+}
+
+func (s *DescribePodControllerScenario) metrics_should_be_available(available bool) *DescribePodControllerScenario {
+	if s.controller.metricsAvailable != available {
+		s.t.Errorf("expected metrics available to be %t", available)
+	}
+	return s
+}
+
+func (s *DescribePodControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
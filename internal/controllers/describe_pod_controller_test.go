@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribePodController(t *testing.T) {
+	t.Run("should_chart_usage_history_per_container", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).
+			WithPodUsage("default", "web", "app", "250m", "64Mi").
+			WithPodUsage("default", "web", "sidecar", "10m", "8Mi")
+		defer standIn.Close()
+
+		s := NewDescribePodControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithResourcedPod("web", "default", "500m", "128Mi", "1", "256Mi")
+			}).
+			When().
+			the_describe_pod_controller_is_instantiated_with_metrics_from(standIn, "web", "default").
+			Then().
+			metrics_should_be_available(true).
+			and().
+			the_usage_history_should_be(func(history map[string][]models.UsageSample) {
+				if assert.Len(t, history["app"], 1) {
+					assert.Equal(t, int64(250), history["app"][0].Usage.CPUMilli)
+				}
+				assert.Len(t, history["sidecar"], 1)
+			})
+	})
+
+	t.Run("should_report_usage_unavailable_without_metrics_server", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).Absent()
+		defer standIn.Close()
+
+		s := NewDescribePodControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPod("web", "default")
+			}).
+			When().
+			the_describe_pod_controller_is_instantiated_with_metrics_from(standIn, "web", "default").
+			Then().
+			metrics_should_be_available(false)
+	})
+}
@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// secretRevealTimeout is how long a revealed secret value stays visible before it is masked again
+const secretRevealTimeout = 15 * time.Second
+
+// DescribeSecretController handles input for the describe secret view
+type DescribeSecretController struct {
+	describeSecretView *views.DescribeSecretView
+	secret             *models.Secret
+	clientset          *kubernetes.Clientset
+	theme              *theme.Theme
+	secretName         string
+	namespace          string
+	width              int
+	height             int
+
+	// readOnly disables revealing secret values entirely
+	readOnly      bool
+	revealTimeout time.Duration
+	// reveals counts the reveals of each key, so that only the timer of the latest reveal hides it
+	reveals map[string]int
+}
+
+// NewDescribeSecretController creates a new describe secret controller. In read-only mode values can never be revealed.
+func NewDescribeSecretController(clientset *kubernetes.Clientset, theme *theme.Theme, secretName, namespace string, readOnly bool) *DescribeSecretController {
+	secret, err := models.GetSecret(clientset, namespace, secretName)
+	if err != nil {
+		log.Printf("error getting secret details: %v", err)
+		// Create a placeholder secret for error case
+		secret = &models.Secret{
+			Name:      secretName,
+			Namespace: namespace,
+		}
+	}
+
+	return &DescribeSecretController{
+		describeSecretView: views.NewDescribeSecretView(secret, theme),
+		secret:             secret,
+		clientset:          clientset,
+		theme:              theme,
+		secretName:         secretName,
+		namespace:          namespace,
+		readOnly:           readOnly,
+		revealTimeout:      secretRevealTimeout,
+		reveals:            make(map[string]int),
+	}
+}
+
+// describeSecretKeys are the key bindings of the describe secret view
+var describeSecretKeys = registerKeyMap("describe-secret", "Describe secret",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionReveal, Keys: []string{"v"}, Help: "Reveal or hide the selected value"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe secret view
+func (c *DescribeSecretController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeSecretKeys.Action(msg) {
+	case ActionUp:
+		c.describeSecretView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.describeSecretView.SelectNext()
+		return nil
+	case ActionReveal:
+		return c.toggleSelectedReveal()
+	case ActionRefresh:
+		// Refresh secret details
+		return c.refreshSecret()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe secret view, which the help overlay lists
+func (c *DescribeSecretController) KeyMap() *KeyMap {
+	return describeSecretKeys
+}
+
+// secretHideMsg masks a revealed key again once its reveal has timed out
+type secretHideMsg struct {
+	key    string
+	reveal int
+}
+
+// toggleSelectedReveal reveals the decoded value of the selected key, or hides it if it is already revealed.
+// A reveal is hidden again after the reveal timeout.
+func (c *DescribeSecretController) toggleSelectedReveal() tea.Cmd {
+	if c.readOnly {
+		c.describeSecretView.SetStatusMessage("Revealing secret values is disabled in read-only mode")
+		return nil
+	}
+	key := c.describeSecretView.GetSelectedKey()
+	if key == nil {
+		return nil
+	}
+	if c.describeSecretView.IsRevealed(key.Name) {
+		c.describeSecretView.Hide(key.Name)
+		c.describeSecretView.SetStatusMessage("")
+		return nil
+	}
+
+	value, err := c.secret.RevealSecretValue(key.Name)
+	if err != nil {
+		c.describeSecretView.SetStatusMessage(err.Error())
+		return nil
+	}
+	c.describeSecretView.Reveal(key.Name, value)
+	c.describeSecretView.SetStatusMessage(fmt.Sprintf("Revealing %s for %s | Press 'v' to hide it", key.Name, c.revealTimeout))
+
+	c.reveals[key.Name]++
+	hide := secretHideMsg{key: key.Name, reveal: c.reveals[key.Name]}
+	return tea.Tick(c.revealTimeout, func(time.Time) tea.Msg {
+		return hide
+	})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeSecretController) ActionText() string {
+	return fmt.Sprintf("Describing secret %s", c.secretName)
+}
+
+// Render returns the rendered describe secret view
+func (c *DescribeSecretController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeSecretView.SetSize(width, height)
+	return c.describeSecretView.Render()
+}
+
+// secretDescribedMsg carries refreshed secret details to the update loop
+type secretDescribedMsg struct {
+	secret *models.Secret
+	err    error
+}
+
+// Update applies refreshed secret details and reveal timeouts on the update loop
+func (c *DescribeSecretController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case secretHideMsg:
+		// A later reveal of the same key restarts its timeout
+		if c.reveals[msg.key] == msg.reveal && c.describeSecretView.IsRevealed(msg.key) {
+			c.describeSecretView.Hide(msg.key)
+			c.describeSecretView.SetStatusMessage("")
+		}
+	case secretDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing secret details: %v", msg.err)
+			return nil
+		}
+		if msg.secret.Name == c.secretName && msg.secret.Namespace == c.namespace {
+			c.secret = msg.secret
+			c.describeSecretView.UpdateSecret(msg.secret)
+			c.describeSecretView.SetStatusMessage("")
+		}
+	}
+	return nil
+}
+
+// refreshSecret fetches the secret details off the update loop and delivers them as a secretDescribedMsg
+func (c *DescribeSecretController) refreshSecret() tea.Cmd {
+	clientset, namespace, secretName := c.clientset, c.namespace, c.secretName
+	return func() tea.Msg {
+		secret, err := models.GetSecret(clientset, namespace, secretName)
+		return secretDescribedMsg{secret: secret, err: err}
+	}
+}
+
+// GetRevealed returns the decoded values currently shown, keyed by key (for testing)
+func (c *DescribeSecretController) GetRevealed() map[string]string {
+	return c.describeSecretView.Revealed()
+}
+
+// GetStatusMessage returns the status bar message (for testing)
+func (c *DescribeSecretController) GetStatusMessage() string {
+	return c.describeSecretView.StatusMessage()
+}
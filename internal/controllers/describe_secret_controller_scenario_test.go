@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeSecretControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeSecretController
+	revealCmd  tea.Cmd
+}
+
+func NewDescribeSecretControllerScenario(t *testing.T) *DescribeSecretControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeSecretControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeSecretControllerScenario) Given() *DescribeSecretControllerScenario { return s }
+func (s *DescribeSecretControllerScenario) When() *DescribeSecretControllerScenario  { return s }
+func (s *DescribeSecretControllerScenario) Then() *DescribeSecretControllerScenario  { return s }
+func (s *DescribeSecretControllerScenario) and() *DescribeSecretControllerScenario   { return s }
+
+func (s *DescribeSecretControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeSecretControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_describe_secret_controller_is_instantiated(name, namespace string) *DescribeSecretControllerScenario {
+	s.controller = NewDescribeSecretController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace, false)
+	// Keep the reveal timeout short so the tests can wait for it
+	s.controller.revealTimeout = 10 * time.Millisecond
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_describe_secret_controller_is_instantiated_in_read_only_mode(name, namespace string) *DescribeSecretControllerScenario {
+	s.controller = NewDescribeSecretController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace, true)
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeSecretControllerScenario {
+	s.controller.HandleKey(msg)
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_user_reveals_the_selected_key() *DescribeSecretControllerScenario {
+	s.revealCmd = s.controller.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_reveal_times_out() *DescribeSecretControllerScenario {
+	if s.revealCmd == nil {
+		s.t.Fatal("expected the reveal to start a timeout")
+	}
+	s.controller.Update(s.revealCmd())
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_revealed_values_should_be(assertFn func(map[string]string)) *DescribeSecretControllerScenario {
+	assertFn(s.controller.GetRevealed())
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) the_status_message_should_be(assertFn func(string)) *DescribeSecretControllerScenario {
+	assertFn(s.controller.GetStatusMessage())
+	return s
+}
+
+func (s *DescribeSecretControllerScenario) Cleanup() {
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeSecretController(t *testing.T) {
+	t.Run("should_mask_every_value_by_default", func(t *testing.T) {
+		s := NewDescribeSecretControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithSecret("credentials", "default", map[string]string{"username": "admin", "password": "hunter2"})
+			}).
+			When().
+			the_describe_secret_controller_is_instantiated("credentials", "default").
+			Then().
+			the_revealed_values_should_be(func(revealed map[string]string) {
+				assert.Empty(t, revealed)
+			})
+	})
+
+	t.Run("should_reveal_the_decoded_value_of_the_selected_key", func(t *testing.T) {
+		s := NewDescribeSecretControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithSecret("credentials", "default", map[string]string{"username": "admin", "password": "hunter2"})
+			}).
+			the_describe_secret_controller_is_instantiated("credentials", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyDown}).
+			and().
+			the_user_reveals_the_selected_key().
+			Then().
+			the_revealed_values_should_be(func(revealed map[string]string) {
+				assert.Equal(t, map[string]string{"username": "admin"}, revealed)
+			})
+	})
+
+	t.Run("should_hide_a_revealed_value_after_the_timeout", func(t *testing.T) {
+		s := NewDescribeSecretControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithSecret("credentials", "default", map[string]string{"password": "hunter2"})
+			}).
+			the_describe_secret_controller_is_instantiated("credentials", "default").
+			When().
+			the_user_reveals_the_selected_key().
+			and().
+			the_reveal_times_out().
+			Then().
+			the_revealed_values_should_be(func(revealed map[string]string) {
+				assert.Empty(t, revealed)
+			})
+	})
+
+	t.Run("should_refuse_to_reveal_in_read_only_mode", func(t *testing.T) {
+		s := NewDescribeSecretControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithSecret("credentials", "default", map[string]string{"password": "hunter2"})
+			}).
+			the_describe_secret_controller_is_instantiated_in_read_only_mode("credentials", "default").
+			When().
+			the_user_reveals_the_selected_key().
+			Then().
+			the_revealed_values_should_be(func(revealed map[string]string) {
+				assert.Empty(t, revealed)
+			}).
+			and().
+			the_status_message_should_be(func(message string) {
+				assert.Equal(t, "Revealing secret values is disabled in read-only mode", message)
+			})
+	})
+}
@@ -0,0 +1,175 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeServiceController handles input for the describe service view
+type DescribeServiceController struct {
+	describeServiceView *views.DescribeServiceView
+	clientset           *kubernetes.Clientset
+	theme               *theme.Theme
+	serviceName         string
+	namespace           string
+	width               int
+	height              int
+}
+
+// NewDescribeServiceController creates a new describe service controller
+func NewDescribeServiceController(clientset *kubernetes.Clientset, theme *theme.Theme, serviceName, namespace string) *DescribeServiceController {
+	msg := describeService(clientset, namespace, serviceName)
+	if msg.err != nil {
+		log.Printf("error getting service details: %v", msg.err)
+		// Create a placeholder service for error case
+		msg.service = &models.Service{
+			Name:      serviceName,
+			Namespace: namespace,
+		}
+	}
+
+	describeServiceView := views.NewDescribeServiceView(msg.service, msg.endpoints, msg.hints, theme)
+
+	return &DescribeServiceController{
+		describeServiceView: describeServiceView,
+		clientset:           clientset,
+		theme:               theme,
+		serviceName:         serviceName,
+		namespace:           namespace,
+	}
+}
+
+// describeServiceKeys are the key bindings of the describe service view
+var describeServiceKeys = registerKeyMap("describe-service", "Describe service",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"enter"}, Help: "Describe the selected endpoint pod"},
+	Binding{Action: ActionPods, Keys: []string{"p"}, Help: "Show the pods of the service"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe service view
+func (c *DescribeServiceController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeServiceKeys.Action(msg) {
+	case ActionUp:
+		c.describeServiceView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.describeServiceView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedEndpointPod()
+	case ActionPods:
+		return c.openServicePods()
+	case ActionRefresh:
+		// Refresh service details and endpoints
+		return c.refreshService()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe service view, which the help overlay lists
+func (c *DescribeServiceController) KeyMap() *KeyMap {
+	return describeServiceKeys
+}
+
+// describeSelectedEndpointPod pushes the describe view for the pod behind the selected endpoint
+func (c *DescribeServiceController) describeSelectedEndpointPod() tea.Cmd {
+	endpoint := c.describeServiceView.GetSelectedEndpoint()
+	if endpoint == nil || endpoint.PodName == "" {
+		return nil
+	}
+	describeCtrl := NewDescribePodController(c.clientset, c.theme, endpoint.PodName, endpoint.PodNamespace)
+	return PushView(describeCtrl, endpoint.PodNamespace+"/"+endpoint.PodName)
+}
+
+// openServicePods pushes a pod list scoped to the pods matching the service's selector
+func (c *DescribeServiceController) openServicePods() tea.Cmd {
+	service, err := models.GetService(c.clientset, c.namespace, c.serviceName)
+	if err != nil {
+		log.Printf("error getting service details: %v", err)
+		return nil
+	}
+	if len(service.Selector) == 0 {
+		return nil
+	}
+	return PushView(newServicePodListController(c.clientset, c.theme, "", service), service.Namespace+"/"+service.Name+" pods")
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeServiceController) ActionText() string {
+	return fmt.Sprintf("Describing service %s", c.serviceName)
+}
+
+// Render returns the rendered describe service view
+func (c *DescribeServiceController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeServiceView.SetSize(width, height)
+	return c.describeServiceView.Render()
+}
+
+// serviceDescribedMsg carries refreshed service details and endpoints to the update loop
+type serviceDescribedMsg struct {
+	service   *models.Service
+	endpoints []models.ServiceEndpoint
+	hints     []string
+	err       error
+}
+
+// Update applies refreshed service details on the update loop
+func (c *DescribeServiceController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case serviceDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing service details: %v", msg.err)
+			return nil
+		}
+		if msg.service.Name == c.serviceName && msg.service.Namespace == c.namespace {
+			c.describeServiceView.UpdateService(msg.service, msg.endpoints, msg.hints)
+		}
+	}
+	return nil
+}
+
+// refreshService fetches the service details off the update loop and delivers them as a serviceDescribedMsg
+func (c *DescribeServiceController) refreshService() tea.Cmd {
+	clientset, namespace, serviceName := c.clientset, c.namespace, c.serviceName
+	return func() tea.Msg {
+		return describeService(clientset, namespace, serviceName)
+	}
+}
+
+// describeService fetches a service, resolves its endpoints and diagnoses a lack of ready endpoints
+func describeService(clientset *kubernetes.Clientset, namespace, serviceName string) serviceDescribedMsg {
+	service, err := models.GetService(clientset, namespace, serviceName)
+	if err != nil {
+		return serviceDescribedMsg{err: err}
+	}
+	endpoints, err := models.GetServiceEndpoints(clientset, namespace, serviceName)
+	if err != nil {
+		return serviceDescribedMsg{err: err}
+	}
+	hints, err := models.DiagnoseServiceEndpoints(clientset, service, endpoints)
+	if err != nil {
+		return serviceDescribedMsg{err: err}
+	}
+	return serviceDescribedMsg{service: service, endpoints: endpoints, hints: hints}
+}
+
+// GetEndpoints returns the endpoints of the described service (for testing)
+func (c *DescribeServiceController) GetEndpoints() []models.ServiceEndpoint {
+	return c.describeServiceView.Endpoints()
+}
+
+// GetHints returns the explanation of why the service has no ready endpoints (for testing)
+func (c *DescribeServiceController) GetHints() []string {
+	return c.describeServiceView.Hints()
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeServiceControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeServiceController
+	pushed     PushViewMsg
+}
+
+func NewDescribeServiceControllerScenario(t *testing.T) *DescribeServiceControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeServiceControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeServiceControllerScenario) Given() *DescribeServiceControllerScenario { return s }
+func (s *DescribeServiceControllerScenario) When() *DescribeServiceControllerScenario  { return s }
+func (s *DescribeServiceControllerScenario) Then() *DescribeServiceControllerScenario  { return s }
+func (s *DescribeServiceControllerScenario) and() *DescribeServiceControllerScenario   { return s }
+
+func (s *DescribeServiceControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeServiceControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeServiceControllerScenario) the_describe_service_controller_is_instantiated(name, namespace string) *DescribeServiceControllerScenario {
+	s.controller = NewDescribeServiceController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeServiceControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeServiceControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeServiceControllerScenario) the_endpoints_should_be(assertFn func([]models.ServiceEndpoint)) *DescribeServiceControllerScenario {
+	assertFn(s.controller.GetEndpoints())
+	return s
+}
+
+func (s *DescribeServiceControllerScenario) the_diagnosis_should_be(assertFn func([]string)) *DescribeServiceControllerScenario {
+	assertFn(s.controller.GetHints())
+	return s
+}
+
+func (s *DescribeServiceControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeServiceControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeServiceControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
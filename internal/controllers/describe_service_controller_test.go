@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeServiceController(t *testing.T) {
+	t.Run("should_resolve_endpoint_slices_into_ready_and_not_ready_addresses", func(t *testing.T) {
+		s := NewDescribeServiceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web"}).
+					WithServiceEndpoint("web", "default", "10.0.0.2", "web-2", false).
+					WithServiceEndpoint("web", "default", "10.0.0.1", "web-1", true)
+			}).
+			When().
+			the_describe_service_controller_is_instantiated("web", "default").
+			Then().
+			the_endpoints_should_be(func(endpoints []models.ServiceEndpoint) {
+				if assert.Len(t, endpoints, 2) {
+					assert.Equal(t, "10.0.0.1", endpoints[0].Address)
+					assert.True(t, endpoints[0].Ready)
+					assert.Equal(t, "web-1", endpoints[0].PodName)
+					assert.Equal(t, "10.0.0.2", endpoints[1].Address)
+					assert.False(t, endpoints[1].Ready)
+				}
+			}).
+			and().
+			the_diagnosis_should_be(func(hints []string) {
+				assert.Empty(t, hints)
+			})
+	})
+
+	t.Run("should_explain_a_selector_matching_no_pods", func(t *testing.T) {
+		s := NewDescribeServiceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web"}).
+					WithLabelledPod("other", "default", map[string]string{"app": "other"})
+			}).
+			When().
+			the_describe_service_controller_is_instantiated("web", "default").
+			Then().
+			the_endpoints_should_be(func(endpoints []models.ServiceEndpoint) {
+				assert.Empty(t, endpoints)
+			}).
+			and().
+			the_diagnosis_should_be(func(hints []string) {
+				assert.Equal(t, []string{"No pods in namespace default match selector app=web"}, hints)
+			})
+	})
+
+	t.Run("should_explain_matching_pods_that_are_not_ready", func(t *testing.T) {
+		s := NewDescribeServiceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web"}).
+					WithLabelledPod("web-1", "default", map[string]string{"app": "web"})
+			}).
+			When().
+			the_describe_service_controller_is_instantiated("web", "default").
+			Then().
+			the_diagnosis_should_be(func(hints []string) {
+				assert.Contains(t, hints, "1 of 1 pods matching the selector are not ready")
+			})
+	})
+
+	t.Run("should_drill_down_from_an_endpoint_to_its_pod", func(t *testing.T) {
+		s := NewDescribeServiceControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web"}).
+					WithLabelledPod("web-1", "default", map[string]string{"app": "web"}).
+					WithServiceEndpoint("web", "default", "10.0.0.1", "web-1", true)
+			}).
+			the_describe_service_controller_is_instantiated("web", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "default/web-1", pushed.Title)
+				_, ok := pushed.Controller.(*DescribePodController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DescribeStatefulSetController handles input for the describe stateful set view
+type DescribeStatefulSetController struct {
+	describeStatefulSetView *views.DescribeStatefulSetView
+	clientset               *kubernetes.Clientset
+	theme                   *theme.Theme
+	statefulSetName         string
+	namespace               string
+	width                   int
+	height                  int
+}
+
+// NewDescribeStatefulSetController creates a new describe stateful set controller
+func NewDescribeStatefulSetController(clientset *kubernetes.Clientset, theme *theme.Theme, statefulSetName, namespace string) *DescribeStatefulSetController {
+	msg := describeStatefulSet(clientset, namespace, statefulSetName)
+	if msg.err != nil {
+		log.Printf("error getting stateful set details: %v", msg.err)
+		// Create a placeholder stateful set for error case
+		msg.statefulSet = &models.StatefulSet{
+			Name:      statefulSetName,
+			Namespace: namespace,
+		}
+	}
+
+	describeStatefulSetView := views.NewDescribeStatefulSetView(msg.statefulSet, msg.pods, theme)
+
+	return &DescribeStatefulSetController{
+		describeStatefulSetView: describeStatefulSetView,
+		clientset:               clientset,
+		theme:                   theme,
+		statefulSetName:         statefulSetName,
+		namespace:               namespace,
+	}
+}
+
+// describeStatefulSetKeys are the key bindings of the describe stateful set view
+var describeStatefulSetKeys = registerKeyMap("describe-statefulset", "Describe stateful set",
+	scrollUpBinding,
+	scrollDownBinding,
+	pageUpBinding,
+	pageDownBinding,
+	scrollTopBinding,
+	scrollBottomBinding,
+	Binding{Action: ActionPods, Keys: []string{"p"}, Help: "Show the pods of the stateful set"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the describe stateful set view
+func (c *DescribeStatefulSetController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch describeStatefulSetKeys.Action(msg) {
+	case ActionUp:
+		c.describeStatefulSetView.ScrollUp()
+		return nil
+	case ActionDown:
+		c.describeStatefulSetView.ScrollDown()
+		return nil
+	case ActionPageUp:
+		c.describeStatefulSetView.ScrollPageUp()
+		return nil
+	case ActionPageDown:
+		c.describeStatefulSetView.ScrollPageDown()
+		return nil
+	case ActionTop:
+		c.describeStatefulSetView.ScrollToTop()
+		return nil
+	case ActionBottom:
+		c.describeStatefulSetView.ScrollToBottom()
+		return nil
+	case ActionPods:
+		return c.openStatefulSetPods()
+	case ActionRefresh:
+		// Refresh stateful set details
+		return c.refreshStatefulSet()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the describe stateful set view, which the help overlay lists
+func (c *DescribeStatefulSetController) KeyMap() *KeyMap {
+	return describeStatefulSetKeys
+}
+
+// openStatefulSetPods pushes a pod list scoped to the pods of the stateful set
+func (c *DescribeStatefulSetController) openStatefulSetPods() tea.Cmd {
+	statefulSet, err := models.GetStatefulSet(c.clientset, c.namespace, c.statefulSetName)
+	if err != nil {
+		log.Printf("error getting stateful set details: %v", err)
+		return nil
+	}
+	if statefulSet.Selector == "" {
+		return nil
+	}
+	return PushView(newStatefulSetPodListController(c.clientset, c.theme, "", statefulSet), statefulSet.Namespace+"/"+statefulSet.Name+" pods")
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DescribeStatefulSetController) ActionText() string {
+	return fmt.Sprintf("Describing stateful set %s", c.statefulSetName)
+}
+
+// Render returns the rendered describe stateful set view
+func (c *DescribeStatefulSetController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.describeStatefulSetView.SetSize(width, height)
+	return c.describeStatefulSetView.Render()
+}
+
+// statefulSetDescribedMsg carries refreshed stateful set details and ordinal pods to the update loop
+type statefulSetDescribedMsg struct {
+	statefulSet *models.StatefulSet
+	pods        []models.StatefulSetPod
+	err         error
+}
+
+// Update applies refreshed stateful set details on the update loop
+func (c *DescribeStatefulSetController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case statefulSetDescribedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing stateful set details: %v", msg.err)
+			return nil
+		}
+		if msg.statefulSet.Name == c.statefulSetName && msg.statefulSet.Namespace == c.namespace {
+			c.describeStatefulSetView.UpdateStatefulSet(msg.statefulSet, msg.pods)
+		}
+	}
+	return nil
+}
+
+// refreshStatefulSet fetches the stateful set details off the update loop and delivers them as a statefulSetDescribedMsg
+func (c *DescribeStatefulSetController) refreshStatefulSet() tea.Cmd {
+	clientset, namespace, statefulSetName := c.clientset, c.namespace, c.statefulSetName
+	return func() tea.Msg {
+		return describeStatefulSet(clientset, namespace, statefulSetName)
+	}
+}
+
+// describeStatefulSet fetches a stateful set and the pod of each of its ordinals
+func describeStatefulSet(clientset *kubernetes.Clientset, namespace, statefulSetName string) statefulSetDescribedMsg {
+	statefulSet, err := models.GetStatefulSet(clientset, namespace, statefulSetName)
+	if err != nil {
+		return statefulSetDescribedMsg{err: err}
+	}
+	pods, err := models.GetStatefulSetPods(clientset, statefulSet)
+	if err != nil {
+		return statefulSetDescribedMsg{err: err}
+	}
+	return statefulSetDescribedMsg{statefulSet: statefulSet, pods: pods}
+}
+
+// GetPods returns the pod of each ordinal of the described stateful set (for testing)
+func (c *DescribeStatefulSetController) GetPods() []models.StatefulSetPod {
+	return c.describeStatefulSetView.Pods()
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type DescribeStatefulSetControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DescribeStatefulSetController
+	pushed     PushViewMsg
+}
+
+func NewDescribeStatefulSetControllerScenario(t *testing.T) *DescribeStatefulSetControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DescribeStatefulSetControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DescribeStatefulSetControllerScenario) Given() *DescribeStatefulSetControllerScenario {
+	return s
+}
+func (s *DescribeStatefulSetControllerScenario) When() *DescribeStatefulSetControllerScenario {
+	return s
+}
+func (s *DescribeStatefulSetControllerScenario) Then() *DescribeStatefulSetControllerScenario {
+	return s
+}
+func (s *DescribeStatefulSetControllerScenario) and() *DescribeStatefulSetControllerScenario {
+	return s
+}
+
+func (s *DescribeStatefulSetControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DescribeStatefulSetControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DescribeStatefulSetControllerScenario) the_describe_stateful_set_controller_is_instantiated(name, namespace string) *DescribeStatefulSetControllerScenario {
+	s.controller = NewDescribeStatefulSetController(s.builder.GetClientset(), theme.NewDefaultTheme(), name, namespace)
+	return s
+}
+
+func (s *DescribeStatefulSetControllerScenario) the_user_presses(msg tea.KeyMsg) *DescribeStatefulSetControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *DescribeStatefulSetControllerScenario) the_ordinal_pods_should_be(assertFn func([]models.StatefulSetPod)) *DescribeStatefulSetControllerScenario {
+	assertFn(s.controller.GetPods())
+	return s
+}
+
+func (s *DescribeStatefulSetControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *DescribeStatefulSetControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *DescribeStatefulSetControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
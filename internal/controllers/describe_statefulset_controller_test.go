@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeStatefulSetController(t *testing.T) {
+	t.Run("should_show_the_pod_of_each_ordinal_with_its_revision", func(t *testing.T) {
+		s := NewDescribeStatefulSetControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithStatefulSet("db", "default", 3, 2).
+					WithStatefulSetPod("db", "default", 0, "db-1").
+					WithStatefulSetPod("db", "default", 2, "db-2")
+			}).
+			When().
+			the_describe_stateful_set_controller_is_instantiated("db", "default").
+			Then().
+			the_ordinal_pods_should_be(func(pods []models.StatefulSetPod) {
+				if assert.Len(t, pods, 3) {
+					assert.Equal(t, models.StatefulSetPod{Ordinal: 0, Name: "db-0", Exists: true, Phase: "Pending", Revision: "db-1"}, pods[0])
+					assert.Equal(t, models.StatefulSetPod{Ordinal: 1, Name: "db-1"}, pods[1], "a missing ordinal should be listed")
+					assert.Equal(t, models.StatefulSetPod{Ordinal: 2, Name: "db-2", Exists: true, Phase: "Pending", Revision: "db-2"}, pods[2])
+				}
+			})
+	})
+
+	t.Run("should_drill_down_to_the_stateful_set_pods", func(t *testing.T) {
+		s := NewDescribeStatefulSetControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithStatefulSet("db", "default", 1, 0).
+					WithStatefulSetPod("db", "default", 0, "db-1")
+			}).
+			the_describe_stateful_set_controller_is_instantiated("db", "default").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "default/db pods", pushed.Title)
+				_, ok := pushed.Controller.(*PodListController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -0,0 +1,333 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/utils"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DrainNodeController confirms and runs a node drain, showing live per-pod progress
+type DrainNodeController struct {
+	drainView *views.DrainNodeView
+	clientset *kubernetes.Clientset
+	theme     *theme.Theme
+	nodeName  string
+	options   models.DrainOptions
+	phase     views.DrainPhase
+	width     int
+	height    int
+
+	// Drain-related fields
+	pods      *utils.OrderedMap[models.PodDrainStatus] // drain progress keyed by namespace/name
+	reviewed  []corev1.Pod                             // the node's pods as last reviewed, re-planned as options change
+	reviewing bool
+	started   bool
+
+	// Message channel carrying drain progress to the update loop
+	updateChan chan tea.Msg
+
+	// ctx is cancelled when the view is popped, which stops progress reaching it but not the drain
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewDrainNodeController creates a new drain node controller. Nothing happens to the node until the
+// user confirms the drain.
+func NewDrainNodeController(clientset *kubernetes.Clientset, theme *theme.Theme, nodeName string) *DrainNodeController {
+	ctx, cancel := context.WithCancel(context.Background())
+	options := models.DefaultDrainOptions()
+	return &DrainNodeController{
+		drainView:  views.NewDrainNodeView(nodeName, options, theme),
+		clientset:  clientset,
+		theme:      theme,
+		nodeName:   nodeName,
+		options:    options,
+		phase:      views.DrainConfirming,
+		pods:       utils.NewOrderedMap[models.PodDrainStatus](),
+		updateChan: make(chan tea.Msg, updateChannelSize),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// drainReviewedMsg carries the node's pods, listed when the user asks to start the drain, to the update loop
+type drainReviewedMsg struct {
+	pods []corev1.Pod
+	err  error
+}
+
+// drainPlannedMsg carries the node's pods and what the drain will do with each to the update loop
+type drainPlannedMsg struct {
+	pods []models.PodDrainStatus
+	err  error
+}
+
+// podDrainProgressMsg carries a change in a single pod's drain state to the update loop
+type podDrainProgressMsg struct {
+	pod models.PodDrainStatus
+}
+
+// drainFinishedMsg signals that no pod evictions remain in progress
+type drainFinishedMsg struct{}
+
+// drainNodeKeys are the key bindings of the drain view
+var drainNodeKeys = registerKeyMap("drain", "Drain node",
+	Binding{Action: ActionToggleEmptyDir, Keys: []string{"e"}, Help: "Toggle deleting emptyDir data"},
+	Binding{Action: ActionToggleForce, Keys: []string{"f"}, Help: "Toggle forcing unmanaged pods"},
+	Binding{Action: ActionConfirm, Keys: []string{"enter"}, Help: "Start the drain"},
+)
+
+// HandleKey handles key press events for the drain view
+func (c *DrainNodeController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.phase != views.DrainConfirming {
+		return nil
+	}
+
+	switch drainNodeKeys.Action(msg) {
+	case ActionToggleEmptyDir:
+		c.options.DeleteEmptyDirData = !c.options.DeleteEmptyDirData
+		c.setOptions()
+	case ActionToggleForce:
+		c.options.Force = !c.options.Force
+		c.setOptions()
+	case ActionConfirm:
+		return c.reviewDrain()
+	}
+	return nil
+}
+
+// setOptions shows the chosen options, re-planning the pods already reviewed so blocked pods clear as they are allowed
+func (c *DrainNodeController) setOptions() {
+	c.drainView.SetOptions(c.options)
+	if c.reviewed != nil {
+		c.reviewPlan(models.PlanDrain(c.reviewed, c.options))
+	}
+}
+
+// reviewPlan shows a reviewed plan, explaining why the drain cannot start while it has blocked pods.
+// It reports whether the drain can start.
+func (c *DrainNodeController) reviewPlan(plan []models.PodDrainStatus) bool {
+	c.setPlan(plan)
+	if blocked := models.BlockedPods(plan); blocked > 0 {
+		c.drainView.SetError(errDrainBlocked(blocked))
+		return false
+	}
+	c.drainView.SetError(nil)
+	return true
+}
+
+// reviewDrain lists the node's pods off the update loop, so the drain only starts if the options allow evicting all of them
+func (c *DrainNodeController) reviewDrain() tea.Cmd {
+	if c.reviewing {
+		return nil
+	}
+	c.reviewing = true
+	clientset, nodeName := c.clientset, c.nodeName
+	return func() tea.Msg {
+		pods, err := models.ListNodePods(clientset, nodeName)
+		return drainReviewedMsg{pods: pods, err: err}
+	}
+}
+
+// KeyMap returns the key bindings of the drain view, which the help overlay lists
+func (c *DrainNodeController) KeyMap() *KeyMap {
+	return drainNodeKeys
+}
+
+// startDrain starts draining the node with the chosen options
+func (c *DrainNodeController) startDrain() {
+	if c.started {
+		return
+	}
+	c.started = true
+	c.setPhase(views.DrainRunning)
+
+	go func() {
+		c.drain(c.options)
+	}()
+}
+
+// drain cordons the node and evicts its pods concurrently, sending progress to the update loop.
+// Like kubectl drain it runs to completion once started, even if the view is popped meanwhile.
+// It closes the update channel when it returns, as the channel's only sender.
+func (c *DrainNodeController) drain(options models.DrainOptions) {
+	defer close(c.updateChan)
+
+	if err := models.SetNodeUnschedulable(c.clientset, c.nodeName, true); err != nil {
+		sendMsg(c.ctx, c.updateChan, drainPlannedMsg{err: err})
+		return
+	}
+
+	k8sPods, err := models.ListNodePods(c.clientset, c.nodeName)
+	if err != nil {
+		sendMsg(c.ctx, c.updateChan, drainPlannedMsg{err: err})
+		return
+	}
+
+	// Pods may have arrived since the review; like kubectl drain, leave the node cordoned but evict nothing
+	plan := models.PlanDrain(k8sPods, options)
+	if blocked := models.BlockedPods(plan); blocked > 0 {
+		err := fmt.Errorf("%d pods that cannot be evicted with these options arrived after the review; nothing was evicted and the node stays cordoned", blocked)
+		sendMsg(c.ctx, c.updateChan, drainPlannedMsg{pods: plan, err: err})
+		return
+	}
+	sendMsg(c.ctx, c.updateChan, drainPlannedMsg{pods: plan})
+
+	var wg sync.WaitGroup
+	for _, pod := range plan {
+		if pod.State != models.PodDrainPending {
+			continue
+		}
+		wg.Add(1)
+		go func(pod models.PodDrainStatus) {
+			defer wg.Done()
+			c.evict(pod, options)
+		}(pod)
+	}
+	wg.Wait()
+
+	debugLogger.Printf("finished draining node %s", c.nodeName)
+	sendMsg(c.ctx, c.updateChan, drainFinishedMsg{})
+}
+
+// evict evicts a single pod and waits for it to be deleted, reporting each state it passes through
+func (c *DrainNodeController) evict(pod models.PodDrainStatus, options models.DrainOptions) {
+	progress := func(state models.PodDrainState, message string) {
+		pod.State = state
+		pod.Message = message
+		sendMsg(c.ctx, c.updateChan, podDrainProgressMsg{pod: pod})
+	}
+
+	progress(models.PodDrainEvicting, "")
+	err := models.EvictPod(context.Background(), c.clientset, pod.Namespace, pod.Name, options, func(reason string) {
+		progress(models.PodDrainWaitingForPDB, reason)
+	})
+	if err != nil {
+		progress(models.PodDrainFailed, err.Error())
+		return
+	}
+
+	progress(models.PodDrainTerminating, "")
+	if err := models.WaitForPodDeletion(context.Background(), c.clientset, pod.Namespace, pod.Name, pod.UID, options); err != nil {
+		progress(models.PodDrainFailed, err.Error())
+		return
+	}
+	progress(models.PodDrainEvicted, "")
+}
+
+// Update applies drain progress on the update loop
+func (c *DrainNodeController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case drainReviewedMsg:
+		c.reviewing = false
+		if c.ctx.Err() != nil {
+			// The view was popped while its pods were listed; a drain started now would have nowhere to report
+			return nil
+		}
+		if msg.err != nil {
+			debugLogger.Printf("error reviewing drain of node %s: %v", c.nodeName, msg.err)
+			c.drainView.SetError(msg.err)
+			return nil
+		}
+		c.reviewed = msg.pods
+		if c.reviewPlan(models.PlanDrain(msg.pods, c.options)) {
+			c.startDrain()
+		}
+	case drainPlannedMsg:
+		if msg.pods != nil {
+			c.setPlan(msg.pods)
+		}
+		if msg.err != nil {
+			debugLogger.Printf("error draining node %s: %v", c.nodeName, msg.err)
+			c.drainView.SetError(msg.err)
+			c.setPhase(views.DrainFinished)
+			return nil
+		}
+	case podDrainProgressMsg:
+		c.pods.Set(msg.pod.Key(), msg.pod)
+		c.updateView()
+	case drainFinishedMsg:
+		c.setPhase(views.DrainFinished)
+	}
+	return nil
+}
+
+// setPlan replaces the pods shown with what the drain does with each
+func (c *DrainNodeController) setPlan(plan []models.PodDrainStatus) {
+	c.pods.Clear()
+	for _, pod := range plan {
+		c.pods.Set(pod.Key(), pod)
+	}
+	c.updateView()
+}
+
+// errDrainBlocked explains why a drain evicts nothing while the options leave pods it cannot evict
+func errDrainBlocked(blocked int) error {
+	return fmt.Errorf("%d pods cannot be evicted with these options, so the drain has not started; allow them with e/f and press enter again", blocked)
+}
+
+// setPhase moves the drain to a new phase
+func (c *DrainNodeController) setPhase(phase views.DrainPhase) {
+	c.phase = phase
+	c.drainView.SetPhase(phase)
+}
+
+// updateView updates the drain view with the current pod progress
+func (c *DrainNodeController) updateView() {
+	c.drainView.UpdatePods(c.pods.Values())
+}
+
+// GetPods returns the current drain progress of each pod
+func (c *DrainNodeController) GetPods() []models.PodDrainStatus {
+	return c.pods.Values()
+}
+
+// Phase returns the phase the drain is in
+func (c *DrainNodeController) Phase() views.DrainPhase {
+	return c.phase
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *DrainNodeController) ActionText() string {
+	switch c.phase {
+	case views.DrainConfirming:
+		return fmt.Sprintf("Confirm drain of node %s", c.nodeName)
+	case views.DrainRunning:
+		return fmt.Sprintf("Draining node %s", c.nodeName)
+	default:
+		return fmt.Sprintf("Drained node %s", c.nodeName)
+	}
+}
+
+// Render returns the rendered drain view
+func (c *DrainNodeController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.drainView.SetSize(width, height)
+	return c.drainView.Render()
+}
+
+// GetUpdateChannel returns the channel carrying drain progress
+func (c *DrainNodeController) GetUpdateChannel() <-chan tea.Msg {
+	return c.updateChan
+}
+
+// Stop stops sending drain progress to the view. A drain already started carries on until every pod is evicted.
+func (c *DrainNodeController) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	// Without a drain goroutine nothing else will close the channel
+	if !c.started {
+		c.started = true
+		close(c.updateChan)
+	}
+}
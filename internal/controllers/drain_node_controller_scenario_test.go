@@ -0,0 +1,213 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type DrainNodeControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *DrainNodeController
+}
+
+func NewDrainNodeControllerScenario(t *testing.T) *DrainNodeControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &DrainNodeControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *DrainNodeControllerScenario) Given() *DrainNodeControllerScenario { return s }
+func (s *DrainNodeControllerScenario) When() *DrainNodeControllerScenario  { return s }
+func (s *DrainNodeControllerScenario) Then() *DrainNodeControllerScenario  { return s }
+func (s *DrainNodeControllerScenario) and() *DrainNodeControllerScenario   { return s }
+
+func (s *DrainNodeControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *DrainNodeControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_drain_controller_is_instantiated_for(nodeName string) *DrainNodeControllerScenario {
+	s.controller = NewDrainNodeController(s.builder.GetClientset(), theme.NewDefaultTheme(), nodeName)
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_user_presses(key string) *DrainNodeControllerScenario {
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	if key == "enter" {
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	}
+	// Run the command a key returns, as the App would, so a confirmed drain is reviewed and started
+	if cmd := s.controller.HandleKey(msg); cmd != nil {
+		s.controller.Update(cmd())
+	}
+	return s
+}
+
+// the_drain_finishes drains the update channel on the test goroutine, as the App update loop would,
+// until the drain reports it has finished. The test cluster has no kubelet, so it stands in for one by
+// removing the pods the drain evicts.
+func (s *DrainNodeControllerScenario) the_drain_finishes() *DrainNodeControllerScenario {
+	return s.drain_until("the drain finished", true, func() bool {
+		return s.controller.Phase() == views.DrainFinished
+	})
+}
+
+// the_pod_drain_state_becomes drains the update channel until the pod reaches the state, without
+// removing evicted pods
+func (s *DrainNodeControllerScenario) the_pod_drain_state_becomes(key string, state models.PodDrainState) *DrainNodeControllerScenario {
+	return s.drain_until("pod "+key+" was "+string(state), false, func() bool {
+		for _, pod := range s.controller.GetPods() {
+			if pod.Key() == key {
+				return pod.State == state
+			}
+		}
+		return false
+	})
+}
+
+// drain_until applies drain progress until done reports true, removing evicted pods as a kubelet would if asked
+func (s *DrainNodeControllerScenario) drain_until(what string, removeEvicted bool, done func() bool) *DrainNodeControllerScenario {
+	timeout := time.After(10 * time.Second)
+	for !done() {
+		if removeEvicted {
+			s.the_kubelet_removes_evicted_pods()
+		}
+		select {
+		case msg, ok := <-s.controller.GetUpdateChannel():
+			if !ok {
+				s.t.Fatalf("update channel closed before %s", what)
+			}
+			s.controller.Update(msg)
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			s.t.Fatalf("timed out before %s", what)
+		}
+	}
+	return s
+}
+
+// the_kubelet_removes_evicted_pods finishes deleting the terminating pods, as the kubelet would once their containers stop
+func (s *DrainNodeControllerScenario) the_kubelet_removes_evicted_pods() *DrainNodeControllerScenario {
+	clientset := s.builder.GetClientset()
+	pods, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		s.t.Fatalf("could not list pods: %v", err)
+	}
+	gracePeriod := int64(0)
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp == nil {
+			continue
+		}
+		err := clientset.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod})
+		if err != nil && !apierrors.IsNotFound(err) {
+			s.t.Fatalf("could not remove pod %s in namespace %s: %v", pod.Name, pod.Namespace, err)
+		}
+	}
+	return s
+}
+
+// the_view_is_popped stops the controller, as the App does when the view leaves the navigation stack
+func (s *DrainNodeControllerScenario) the_view_is_popped() *DrainNodeControllerScenario {
+	s.controller.Stop()
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_drain_should_be_refused_with(message string) *DrainNodeControllerScenario {
+	s.the_phase_should_be(views.DrainConfirming)
+	if s.controller.drainView.Err() == nil || !strings.Contains(s.controller.drainView.Err().Error(), message) {
+		s.t.Errorf("expected the drain to be refused with %q, got %v", message, s.controller.drainView.Err())
+	}
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_phase_should_be(phase views.DrainPhase) *DrainNodeControllerScenario {
+	if s.controller.Phase() != phase {
+		s.t.Errorf("expected drain phase %v, got %v", phase, s.controller.Phase())
+	}
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_pod_drain_states_should_be(expected map[string]models.PodDrainState) *DrainNodeControllerScenario {
+	actual := make(map[string]models.PodDrainState)
+	for _, pod := range s.controller.GetPods() {
+		actual[pod.Key()] = pod.State
+	}
+	if len(actual) != len(expected) {
+		s.t.Errorf("expected drain states %v, got %v", expected, actual)
+	}
+	for key, state := range expected {
+		if actual[key] != state {
+			s.t.Errorf("expected pod %s to be %s, got %s", key, state, actual[key])
+		}
+	}
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_node_should_be_unschedulable(nodeName string, unschedulable bool) *DrainNodeControllerScenario {
+	node, err := s.builder.GetClientset().CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		s.t.Fatalf("could not get node %s: %v", nodeName, err)
+	}
+	if node.Spec.Unschedulable != unschedulable {
+		s.t.Errorf("expected node %s unschedulable to be %t", nodeName, unschedulable)
+	}
+	return s
+}
+
+// the_pod_should_eventually_be_evicted waits for the pod to be terminating or gone
+func (s *DrainNodeControllerScenario) the_pod_should_eventually_be_evicted(name, namespace string) *DrainNodeControllerScenario {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		pod, err := s.builder.GetClientset().CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil || pod.DeletionTimestamp != nil {
+			return s
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	s.t.Errorf("expected pod %s in namespace %s to be evicted", name, namespace)
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_pod_should_be_terminating(name, namespace string) *DrainNodeControllerScenario {
+	pod, err := s.builder.GetClientset().CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		// Evicted and already removed
+		return s
+	}
+	if pod.DeletionTimestamp == nil {
+		s.t.Errorf("expected pod %s in namespace %s to be evicted", name, namespace)
+	}
+	return s
+}
+
+func (s *DrainNodeControllerScenario) the_pod_should_still_be_running(name, namespace string) *DrainNodeControllerScenario {
+	pod, err := s.builder.GetClientset().CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		s.t.Fatalf("expected pod %s in namespace %s to remain: %v", name, namespace, err)
+	}
+	if pod.DeletionTimestamp != nil {
+		s.t.Errorf("expected pod %s in namespace %s not to be evicted", name, namespace)
+	}
+	return s
+}
+
+func (s *DrainNodeControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDrainNodeController(t *testing.T) {
+	t.Run("should_not_touch_the_node_until_confirmed", func(t *testing.T) {
+		s := NewDrainNodeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithOwnedPodOnNode("web-1", "default", "worker-node-1", "ReplicaSet", "web")
+			}).
+			When().
+			the_drain_controller_is_instantiated_for("worker-node-1").
+			Then().
+			the_node_should_be_unschedulable("worker-node-1", false).
+			and().
+			the_pod_should_still_be_running("web-1", "default")
+	})
+
+	t.Run("should_cordon_and_evict_managed_pods_skipping_daemonset_pods", func(t *testing.T) {
+		s := NewDrainNodeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(2).
+					WithOwnedPodOnNode("web-1", "default", "worker-node-1", "ReplicaSet", "web").
+					WithOwnedPodOnNode("agent-1", "kube-system", "worker-node-1", "DaemonSet", "agent").
+					WithOwnedPodOnNode("web-2", "default", "worker-node-2", "ReplicaSet", "web")
+			}).
+			When().
+			the_drain_controller_is_instantiated_for("worker-node-1").
+			the_user_presses("enter").
+			the_drain_finishes().
+			Then().
+			the_node_should_be_unschedulable("worker-node-1", true).
+			and().
+			the_pod_drain_states_should_be(map[string]models.PodDrainState{
+				"default/web-1":       models.PodDrainEvicted,
+				"kube-system/agent-1": models.PodDrainSkipped,
+			}).
+			and().
+			the_pod_should_still_be_running("agent-1", "kube-system").
+			and().
+			the_pod_should_still_be_running("web-2", "default")
+	})
+
+	t.Run("should_refuse_to_drain_while_empty_dir_or_unmanaged_pods_are_not_allowed", func(t *testing.T) {
+		s := NewDrainNodeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithPodOnNode("bare", "default", "worker-node-1").
+					WithOwnedPodOnNode("cache-1", "default", "worker-node-1", "ReplicaSet", "cache", corev1.Volume{
+						Name:         "scratch",
+						VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					}).
+					WithOwnedPodOnNode("web-1", "default", "worker-node-1", "ReplicaSet", "web")
+			}).
+			When().
+			the_drain_controller_is_instantiated_for("worker-node-1").
+			the_user_presses("enter").
+			Then().
+			the_drain_should_be_refused_with("2 pods cannot be evicted").
+			and().
+			the_pod_drain_states_should_be(map[string]models.PodDrainState{
+				"default/bare":    models.PodDrainFailed,
+				"default/cache-1": models.PodDrainFailed,
+				"default/web-1":   models.PodDrainPending,
+			}).
+			and().
+			the_node_should_be_unschedulable("worker-node-1", false).
+			and().
+			the_pod_should_still_be_running("web-1", "default").
+			When().
+			the_user_presses("e").
+			the_user_presses("f").
+			the_user_presses("enter").
+			the_drain_finishes().
+			Then().
+			the_pod_drain_states_should_be(map[string]models.PodDrainState{
+				"default/bare":    models.PodDrainEvicted,
+				"default/cache-1": models.PodDrainEvicted,
+				"default/web-1":   models.PodDrainEvicted,
+			})
+	})
+
+	t.Run("should_evict_empty_dir_and_unmanaged_pods_when_enabled", func(t *testing.T) {
+		s := NewDrainNodeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithPodOnNode("bare", "default", "worker-node-1").
+					WithOwnedPodOnNode("cache-1", "default", "worker-node-1", "ReplicaSet", "cache", corev1.Volume{
+						Name:         "scratch",
+						VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					})
+			}).
+			When().
+			the_drain_controller_is_instantiated_for("worker-node-1").
+			the_user_presses("e").
+			the_user_presses("f").
+			the_user_presses("enter").
+			the_drain_finishes().
+			Then().
+			the_pod_drain_states_should_be(map[string]models.PodDrainState{
+				"default/bare":    models.PodDrainEvicted,
+				"default/cache-1": models.PodDrainEvicted,
+			})
+	})
+	t.Run("should_wait_for_evicted_pods_to_be_deleted", func(t *testing.T) {
+		s := NewDrainNodeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithOwnedPodOnNode("web-1", "default", "worker-node-1", "ReplicaSet", "web")
+			}).
+			When().
+			the_drain_controller_is_instantiated_for("worker-node-1").
+			the_user_presses("enter").
+			the_pod_drain_state_becomes("default/web-1", models.PodDrainTerminating).
+			Then().
+			the_pod_should_be_terminating("web-1", "default").
+			and().
+			the_phase_should_be(views.DrainRunning).
+			When().
+			the_drain_finishes().
+			Then().
+			the_pod_drain_states_should_be(map[string]models.PodDrainState{
+				"default/web-1": models.PodDrainEvicted,
+			})
+	})
+
+	t.Run("should_carry_on_draining_after_the_view_is_popped", func(t *testing.T) {
+		s := NewDrainNodeControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithOwnedPodOnNode("web-1", "default", "worker-node-1", "ReplicaSet", "web")
+			}).
+			the_drain_controller_is_instantiated_for("worker-node-1").
+			When().
+			the_user_presses("enter").
+			the_view_is_popped().
+			Then().
+			the_pod_should_eventually_be_evicted("web-1", "default").
+			and().
+			the_node_should_be_unschedulable("worker-node-1", true)
+	})
+}
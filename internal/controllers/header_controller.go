@@ -53,15 +53,7 @@ func buildHeaderModel(clientset *kubernetes.Clientset) *models.HeaderModel {
 	nodeList, err := clientset.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
 	if err == nil {
 		for _, node := range nodeList.Items {
-			labels := node.GetLabels()
-			isControlPlane := false
-			if _, ok := labels["node-role.kubernetes.io/control-plane"]; ok {
-				isControlPlane = true
-			}
-			if _, ok := labels["node-role.kubernetes.io/master"]; ok {
-				isControlPlane = true
-			}
-			if isControlPlane {
+			if models.IsControlPlaneNode(node.GetLabels()) {
 				controlPlaneNodes++
 			} else {
 				workerNodes++
@@ -77,14 +69,24 @@ func buildHeaderModel(clientset *kubernetes.Clientset) *models.HeaderModel {
 	}
 }
 
-// Render renders the header with the given view text
-func (hc *HeaderController) Render(width int, viewText string) string {
+// SetNamespace records the namespace the resource views are scoped to, empty for all namespaces
+func (hc *HeaderController) SetNamespace(namespace string) {
+	hc.headerModel.Namespace = namespace
+}
+
+// SetClusters records the connection health of the clusters of a multi-cluster view, nil for other views
+func (hc *HeaderController) SetClusters(clusters []models.ClusterHealth) {
+	hc.headerModel.Clusters = clusters
+}
+
+// Render renders the header with the given view text and navigation breadcrumbs
+func (hc *HeaderController) Render(width int, viewText string, breadcrumbs []string) string {
 	hc.headerView.SetSize(width)
-	return hc.headerView.Render(hc.headerModel, viewText)
+	return hc.headerView.Render(hc.headerModel, viewText, breadcrumbs)
 }
 
 // GetHeight returns the height of the header
 func (hc *HeaderController) GetHeight() int {
-	sampleHeader := hc.headerView.Render(hc.headerModel, "Sample")
+	sampleHeader := hc.headerView.Render(hc.headerModel, "Sample", []string{"Sample"})
 	return lipgloss.Height(sampleHeader)
 }
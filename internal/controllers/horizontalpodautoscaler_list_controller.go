@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// horizontalPodAutoscalerResource lists and watches horizontal pod autoscalers for the horizontal pod autoscaler list
+var horizontalPodAutoscalerResource = typedResource("horizontalpodautoscalers", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*autoscalingv2.HorizontalPodAutoscalerList] {
+		return clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace)
+	},
+	func(_ string, horizontalPodAutoscaler *autoscalingv2.HorizontalPodAutoscaler) models.HorizontalPodAutoscaler {
+		return models.ToHorizontalPodAutoscalerModel(*horizontalPodAutoscaler)
+	})
+
+// HorizontalPodAutoscalerListController handles input for the horizontal pod autoscaler list view
+type HorizontalPodAutoscalerListController struct {
+	horizontalPodAutoscalerView *views.HorizontalPodAutoscalerListView
+	clientset                   *kubernetes.Clientset
+	theme                       *theme.Theme
+	clusterName                 string
+	width                       int
+	height                      int
+
+	// watches lists and watches the horizontal pod autoscalers, listing again whenever the watch ends
+	watches *clusterWatches[models.HorizontalPodAutoscaler]
+}
+
+// NewHorizontalPodAutoscalerListController creates a new horizontal pod autoscaler list controller
+func NewHorizontalPodAutoscalerListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *HorizontalPodAutoscalerListController {
+	return NewNamespacedHorizontalPodAutoscalerListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedHorizontalPodAutoscalerListController creates a new horizontal pod autoscaler list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedHorizontalPodAutoscalerListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *HorizontalPodAutoscalerListController {
+	controller := &HorizontalPodAutoscalerListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, horizontalPodAutoscalerResource),
+	}
+
+	// Create the view with initial horizontal pod autoscalers
+	horizontalPodAutoscalerView := views.NewHorizontalPodAutoscalerListView(controller.getHorizontalPodAutoscalersList(), theme, clusterName)
+	controller.horizontalPodAutoscalerView = horizontalPodAutoscalerView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *HorizontalPodAutoscalerListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the horizontal pod autoscaler list view with current horizontal pod autoscalers
+func (c *HorizontalPodAutoscalerListController) updateView() {
+	c.horizontalPodAutoscalerView.UpdateHorizontalPodAutoscalers(c.getHorizontalPodAutoscalersList())
+}
+
+// getHorizontalPodAutoscalersList returns the current horizontal pod autoscalers as a slice in consistent order
+func (c *HorizontalPodAutoscalerListController) getHorizontalPodAutoscalersList() []models.HorizontalPodAutoscaler {
+	return c.watches.list()
+}
+
+// horizontalPodAutoscalerListKeys are the key bindings of the horizontal pod autoscaler list
+var horizontalPodAutoscalerListKeys = registerKeyMap("hpa", "Horizontal pod autoscaler list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected autoscaler"},
+	Binding{Action: ActionTarget, Keys: []string{"t"}, Help: "Describe the scale target of the selected autoscaler"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the horizontal pod autoscaler list view
+func (c *HorizontalPodAutoscalerListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.horizontalPodAutoscalerView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.horizontalPodAutoscalerView, msg)
+	}
+
+	switch horizontalPodAutoscalerListKeys.Action(msg) {
+	case ActionUp:
+		c.horizontalPodAutoscalerView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.horizontalPodAutoscalerView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedHorizontalPodAutoscaler()
+	case ActionTarget:
+		selectedHorizontalPodAutoscaler := c.horizontalPodAutoscalerView.GetSelected()
+		if selectedHorizontalPodAutoscaler == nil {
+			return nil
+		}
+		return describeScaleTarget(c.clientset, c.theme, selectedHorizontalPodAutoscaler)
+	case ActionRefresh:
+		// Refresh horizontal pod autoscalers
+		return c.refreshHorizontalPodAutoscalers()
+	case ActionColumns:
+		return openColumnChooser(c.horizontalPodAutoscalerView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the horizontal pod autoscaler list, which the help overlay lists
+func (c *HorizontalPodAutoscalerListController) KeyMap() *KeyMap {
+	return horizontalPodAutoscalerListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *HorizontalPodAutoscalerListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *HorizontalPodAutoscalerListController) Modal() bool {
+	return c.horizontalPodAutoscalerView.ColumnChooser() != nil
+}
+
+// describeSelectedHorizontalPodAutoscaler pushes the describe view for the selected horizontal pod autoscaler
+func (c *HorizontalPodAutoscalerListController) describeSelectedHorizontalPodAutoscaler() tea.Cmd {
+	selectedHorizontalPodAutoscaler := c.horizontalPodAutoscalerView.GetSelected()
+	if selectedHorizontalPodAutoscaler == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeHorizontalPodAutoscalerController(c.clientset, c.theme, selectedHorizontalPodAutoscaler.Name, selectedHorizontalPodAutoscaler.Namespace)
+	return PushView(describeCtrl, selectedHorizontalPodAutoscaler.Namespace+"/"+selectedHorizontalPodAutoscaler.Name)
+}
+
+// describeScaleTarget pushes the describe view for the workload a horizontal pod autoscaler scales.
+// Deployments and stateful sets are supported; other target kinds are ignored.
+func describeScaleTarget(clientset *kubernetes.Clientset, theme *theme.Theme, horizontalPodAutoscaler *models.HorizontalPodAutoscaler) tea.Cmd {
+	title := horizontalPodAutoscaler.Namespace + "/" + horizontalPodAutoscaler.TargetName
+	switch horizontalPodAutoscaler.TargetKind {
+	case "Deployment":
+		return PushView(NewDescribeDeploymentController(clientset, theme, horizontalPodAutoscaler.TargetName, horizontalPodAutoscaler.Namespace), title)
+	case "StatefulSet":
+		return PushView(NewDescribeStatefulSetController(clientset, theme, horizontalPodAutoscaler.TargetName, horizontalPodAutoscaler.Namespace), title)
+	default:
+		debugLogger.Printf("cannot describe scale target of kind %s", horizontalPodAutoscaler.TargetKind)
+		return nil
+	}
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *HorizontalPodAutoscalerListController) ActionText() string {
+	return "Listing horizontal pod autoscalers"
+}
+
+// Render returns the rendered horizontal pod autoscaler list view
+func (c *HorizontalPodAutoscalerListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.horizontalPodAutoscalerView.SetSize(width, height)
+	return c.horizontalPodAutoscalerView.Render()
+}
+
+// refreshHorizontalPodAutoscalers lists horizontal pod autoscalers again off the update loop, replacing those listed before
+func (c *HorizontalPodAutoscalerListController) refreshHorizontalPodAutoscalers() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetHorizontalPodAutoscalers returns the current list of horizontal pod autoscalers
+func (c *HorizontalPodAutoscalerListController) GetHorizontalPodAutoscalers() []models.HorizontalPodAutoscaler {
+	return c.getHorizontalPodAutoscalersList()
+}
+
+// GetUpdateChannel returns the channel carrying horizontal pod autoscaler watch events
+func (c *HorizontalPodAutoscalerListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *HorizontalPodAutoscalerListController) Stop() {
+	c.watches.stop()
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type HorizontalPodAutoscalerListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *HorizontalPodAutoscalerListController
+	pushed     PushViewMsg
+}
+
+func NewHorizontalPodAutoscalerListControllerScenario(t *testing.T) *HorizontalPodAutoscalerListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &HorizontalPodAutoscalerListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) Given() *HorizontalPodAutoscalerListControllerScenario {
+	return s
+}
+func (s *HorizontalPodAutoscalerListControllerScenario) When() *HorizontalPodAutoscalerListControllerScenario {
+	return s
+}
+func (s *HorizontalPodAutoscalerListControllerScenario) Then() *HorizontalPodAutoscalerListControllerScenario {
+	return s
+}
+func (s *HorizontalPodAutoscalerListControllerScenario) and() *HorizontalPodAutoscalerListControllerScenario {
+	return s
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *HorizontalPodAutoscalerListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) the_horizontal_pod_autoscaler_list_controller_is_instantiated() *HorizontalPodAutoscalerListControllerScenario {
+	s.controller = NewHorizontalPodAutoscalerListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) the_user_presses(msg tea.KeyMsg) *HorizontalPodAutoscalerListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) the_horizontal_pod_autoscaler_list_should_be(assertFn func([]models.HorizontalPodAutoscaler)) *HorizontalPodAutoscalerListControllerScenario {
+	assertFn(s.controller.GetHorizontalPodAutoscalers())
+	return s
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *HorizontalPodAutoscalerListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *HorizontalPodAutoscalerListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHorizontalPodAutoscalerListController(t *testing.T) {
+	t.Run("should_list_autoscalers_with_current_against_target_metrics", func(t *testing.T) {
+		s := NewHorizontalPodAutoscalerListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "apps").
+					WithHorizontalPodAutoscaler("web", "apps", "web", 2, 10, 80).
+					WithHorizontalPodAutoscalerStatus("web", "apps", 3, 4, 95)
+			}).
+			When().
+			the_horizontal_pod_autoscaler_list_controller_is_instantiated().
+			Then().
+			the_horizontal_pod_autoscaler_list_should_be(func(autoscalers []models.HorizontalPodAutoscaler) {
+				if assert.Len(t, autoscalers, 1) {
+					web := autoscalers[0]
+					assert.Equal(t, "Deployment/web", web.FormatTarget())
+					assert.Equal(t, 2, web.MinReplicas)
+					assert.Equal(t, 10, web.MaxReplicas)
+					assert.Equal(t, "3/4", web.FormatReplicas())
+					assert.Equal(t, "cpu: 95%/80%", web.FormatMetrics())
+				}
+			})
+	})
+
+	t.Run("should_show_unknown_for_metrics_not_yet_observed", func(t *testing.T) {
+		s := NewHorizontalPodAutoscalerListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithHorizontalPodAutoscaler("web", "apps", "web", 1, 5, 60)
+			}).
+			When().
+			the_horizontal_pod_autoscaler_list_controller_is_instantiated().
+			Then().
+			the_horizontal_pod_autoscaler_list_should_be(func(autoscalers []models.HorizontalPodAutoscaler) {
+				if assert.Len(t, autoscalers, 1) {
+					assert.Equal(t, "cpu: <unknown>/60%", autoscalers[0].FormatMetrics())
+				}
+			})
+	})
+
+	t.Run("should_jump_to_the_scaled_deployment", func(t *testing.T) {
+		s := NewHorizontalPodAutoscalerListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "apps").
+					WithHorizontalPodAutoscaler("web-hpa", "apps", "web", 2, 10, 80)
+			}).
+			the_horizontal_pod_autoscaler_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/web", pushed.Title)
+				_, ok := pushed.Controller.(*DescribeDeploymentController)
+				assert.True(t, ok)
+			})
+	})
+}
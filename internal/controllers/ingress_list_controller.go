@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ingressResource lists and watches ingresses for the ingress list
+var ingressResource = typedResource("ingresses", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*networkingv1.IngressList] {
+		return clientset.NetworkingV1().Ingresses(namespace)
+	},
+	func(_ string, ingress *networkingv1.Ingress) models.Ingress {
+		return models.ToIngressModel(*ingress)
+	})
+
+// IngressListController handles input for the ingress list view
+type IngressListController struct {
+	ingressView *views.IngressListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	width       int
+	height      int
+
+	// watches lists and watches the ingresses, listing again whenever the watch ends
+	watches *clusterWatches[models.Ingress]
+}
+
+// NewIngressListController creates a new ingress list controller
+func NewIngressListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *IngressListController {
+	return NewNamespacedIngressListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedIngressListController creates a new ingress list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedIngressListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *IngressListController {
+	controller := &IngressListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, ingressResource),
+	}
+
+	// Create the view with initial ingresses
+	ingressView := views.NewIngressListView(controller.getIngressesList(), theme, clusterName)
+	controller.ingressView = ingressView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *IngressListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the ingress list view with current ingresses
+func (c *IngressListController) updateView() {
+	c.ingressView.UpdateIngresses(c.getIngressesList())
+}
+
+// getIngressesList returns the current ingresses as a slice in consistent order
+func (c *IngressListController) getIngressesList() []models.Ingress {
+	return c.watches.list()
+}
+
+// ingressListKeys are the key bindings of the ingress list
+var ingressListKeys = registerKeyMap("ingresses", "Ingress list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected ingress"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the ingress list view
+func (c *IngressListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.ingressView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.ingressView, msg)
+	}
+
+	switch ingressListKeys.Action(msg) {
+	case ActionUp:
+		c.ingressView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.ingressView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedIngress()
+	case ActionRefresh:
+		// Refresh ingresses
+		return c.refreshIngresses()
+	case ActionColumns:
+		return openColumnChooser(c.ingressView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the ingress list, which the help overlay lists
+func (c *IngressListController) KeyMap() *KeyMap {
+	return ingressListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *IngressListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *IngressListController) Modal() bool {
+	return c.ingressView.ColumnChooser() != nil
+}
+
+// describeSelectedIngress pushes the describe view for the selected ingress
+func (c *IngressListController) describeSelectedIngress() tea.Cmd {
+	selectedIngress := c.ingressView.GetSelected()
+	if selectedIngress == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeIngressController(c.clientset, c.theme, selectedIngress.Name, selectedIngress.Namespace)
+	return PushView(describeCtrl, selectedIngress.Namespace+"/"+selectedIngress.Name)
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *IngressListController) ActionText() string {
+	return "Listing ingresses"
+}
+
+// Render returns the rendered ingress list view
+func (c *IngressListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.ingressView.SetSize(width, height)
+	return c.ingressView.Render()
+}
+
+// refreshIngresses lists ingresses again off the update loop, replacing those listed before
+func (c *IngressListController) refreshIngresses() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetIngresses returns the current list of ingresses
+func (c *IngressListController) GetIngresses() []models.Ingress {
+	return c.getIngressesList()
+}
+
+// GetUpdateChannel returns the channel carrying ingress watch events
+func (c *IngressListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *IngressListController) Stop() {
+	c.watches.stop()
+}
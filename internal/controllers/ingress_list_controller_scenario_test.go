@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type IngressListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *IngressListController
+	pushed     PushViewMsg
+}
+
+func NewIngressListControllerScenario(t *testing.T) *IngressListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &IngressListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *IngressListControllerScenario) Given() *IngressListControllerScenario { return s }
+func (s *IngressListControllerScenario) When() *IngressListControllerScenario  { return s }
+func (s *IngressListControllerScenario) Then() *IngressListControllerScenario  { return s }
+func (s *IngressListControllerScenario) and() *IngressListControllerScenario   { return s }
+
+func (s *IngressListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *IngressListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *IngressListControllerScenario) the_ingress_list_controller_is_instantiated() *IngressListControllerScenario {
+	s.controller = NewIngressListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *IngressListControllerScenario) the_user_presses(msg tea.KeyMsg) *IngressListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *IngressListControllerScenario) the_ingress_list_should_be(assertFn func([]models.Ingress)) *IngressListControllerScenario {
+	assertFn(s.controller.GetIngresses())
+	return s
+}
+
+func (s *IngressListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *IngressListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *IngressListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressListController(t *testing.T) {
+	t.Run("should_list_ingresses_with_hosts_and_tls", func(t *testing.T) {
+		s := NewIngressListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithIngressPath("web", "default", "example.com", "/", "web", 80).
+					WithIngressPath("web", "default", "api.example.com", "/v1", "api", 8080)
+			}).
+			When().
+			the_ingress_list_controller_is_instantiated().
+			Then().
+			the_ingress_list_should_be(func(ingresses []models.Ingress) {
+				if assert.Len(t, ingresses, 1) {
+					web := ingresses[0]
+					assert.Equal(t, "web", web.Name)
+					assert.Equal(t, "<none>", web.FormatClass())
+					assert.Equal(t, "example.com,api.example.com", web.FormatHosts())
+					assert.Equal(t, "<pending>", web.FormatAddresses())
+					assert.Equal(t, "<none>", web.FormatTLS())
+				}
+			})
+	})
+
+	t.Run("should_describe_the_selected_ingress", func(t *testing.T) {
+		s := NewIngressListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithIngressPath("web", "apps", "example.com", "/", "web", 80)
+			}).
+			the_ingress_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/web", pushed.Title)
+				_, ok := pushed.Controller.(*DescribeIngressController)
+				assert.True(t, ok)
+			})
+	})
+}
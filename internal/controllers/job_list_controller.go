@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// jobResource lists and watches jobs for the job list
+var jobResource = typedResource("jobs", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*batchv1.JobList] {
+		return clientset.BatchV1().Jobs(namespace)
+	},
+	func(_ string, job *batchv1.Job) models.Job {
+		return models.ToJobModel(*job)
+	})
+
+// JobListController handles input for the job list view
+type JobListController struct {
+	jobView     *views.JobListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	width       int
+	height      int
+
+	// watches lists and watches the jobs, listing again whenever the watch ends
+	watches *clusterWatches[models.Job]
+}
+
+// NewJobListController creates a new job list controller
+func NewJobListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *JobListController {
+	return NewNamespacedJobListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedJobListController creates a new job list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedJobListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *JobListController {
+	controller := &JobListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, jobResource),
+	}
+
+	// Create the view with initial jobs
+	jobView := views.NewJobListView(controller.getJobsList(), theme, clusterName)
+	controller.jobView = jobView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *JobListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the job list view with current jobs
+func (c *JobListController) updateView() {
+	c.jobView.UpdateJobs(c.getJobsList())
+}
+
+// getJobsList returns the current jobs as a slice in consistent order
+func (c *JobListController) getJobsList() []models.Job {
+	return c.watches.list()
+}
+
+// jobListKeys are the key bindings of the job list
+var jobListKeys = registerKeyMap("jobs", "Job list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected job"},
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods of the selected job"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected job"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the job list view
+func (c *JobListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.jobView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.jobView, msg)
+	}
+
+	switch jobListKeys.Action(msg) {
+	case ActionUp:
+		c.jobView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.jobView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedJob()
+	case ActionPods:
+		return c.openSelectedJobPods()
+	case ActionXRay:
+		return c.xraySelectedJob()
+	case ActionRefresh:
+		// Refresh jobs
+		return c.refreshJobs()
+	case ActionColumns:
+		return openColumnChooser(c.jobView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the job list, which the help overlay lists
+func (c *JobListController) KeyMap() *KeyMap {
+	return jobListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *JobListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *JobListController) Modal() bool {
+	return c.jobView.ColumnChooser() != nil
+}
+
+// describeSelectedJob pushes the describe view for the selected job
+func (c *JobListController) describeSelectedJob() tea.Cmd {
+	selectedJob := c.jobView.GetSelected()
+	if selectedJob == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeJobController(c.clientset, c.theme, selectedJob.Name, selectedJob.Namespace)
+	return PushView(describeCtrl, selectedJob.Namespace+"/"+selectedJob.Name)
+}
+
+// openSelectedJobPods pushes a pod list scoped to the pods of the selected job
+func (c *JobListController) openSelectedJobPods() tea.Cmd {
+	selectedJob := c.jobView.GetSelected()
+	if selectedJob == nil || selectedJob.Selector == "" {
+		return nil
+	}
+	return PushView(newJobPodListController(c.clientset, c.theme, c.clusterName, selectedJob), selectedJob.Namespace+"/"+selectedJob.Name+" pods")
+}
+
+// newJobPodListController creates a pod list scoped to the pods of a job
+func newJobPodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, job *models.Job) *PodListController {
+	return NewScopedPodListController(clientset, theme, clusterName, PodListScope{
+		Namespace:     job.Namespace,
+		LabelSelector: job.Selector,
+		Description:   "job " + job.Namespace + "/" + job.Name,
+	})
+}
+
+// xraySelectedJob pushes the x-ray view of the selected job
+func (c *JobListController) xraySelectedJob() tea.Cmd {
+	selectedJob := c.jobView.GetSelected()
+	if selectedJob == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "Job", Namespace: selectedJob.Namespace, Name: selectedJob.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *JobListController) ActionText() string {
+	return "Listing jobs"
+}
+
+// Render returns the rendered job list view
+func (c *JobListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.jobView.SetSize(width, height)
+	return c.jobView.Render()
+}
+
+// refreshJobs lists jobs again off the update loop, replacing those listed before
+func (c *JobListController) refreshJobs() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetJobs returns the current list of jobs
+func (c *JobListController) GetJobs() []models.Job {
+	return c.getJobsList()
+}
+
+// GetUpdateChannel returns the channel carrying job watch events
+func (c *JobListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *JobListController) Stop() {
+	c.watches.stop()
+}
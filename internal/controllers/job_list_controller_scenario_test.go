@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type JobListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *JobListController
+	pushed     PushViewMsg
+}
+
+func NewJobListControllerScenario(t *testing.T) *JobListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &JobListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *JobListControllerScenario) Given() *JobListControllerScenario { return s }
+func (s *JobListControllerScenario) When() *JobListControllerScenario  { return s }
+func (s *JobListControllerScenario) Then() *JobListControllerScenario  { return s }
+func (s *JobListControllerScenario) and() *JobListControllerScenario   { return s }
+
+func (s *JobListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *JobListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *JobListControllerScenario) the_job_list_controller_is_instantiated() *JobListControllerScenario {
+	s.controller = NewJobListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *JobListControllerScenario) the_user_presses(msg tea.KeyMsg) *JobListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *JobListControllerScenario) the_job_list_should_be(assertFn func([]models.Job)) *JobListControllerScenario {
+	assertFn(s.controller.GetJobs())
+	return s
+}
+
+func (s *JobListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *JobListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *JobListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
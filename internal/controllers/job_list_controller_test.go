@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobListController(t *testing.T) {
+	t.Run("should_list_jobs_with_completions_and_backoff", func(t *testing.T) {
+		s := NewJobListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithJob("migrate", "default", 3, 2)
+			}).
+			When().
+			the_job_list_controller_is_instantiated().
+			Then().
+			the_job_list_should_be(func(jobs []models.Job) {
+				if assert.Len(t, jobs, 1) {
+					migrate := jobs[0]
+					assert.Equal(t, "migrate", migrate.Name)
+					assert.Equal(t, "Pending", migrate.Status)
+					assert.Equal(t, "0/3", migrate.FormatCompletions())
+					assert.Equal(t, "0/2", migrate.FormatBackoff())
+					assert.Equal(t, "<none>", migrate.FormatDuration())
+					assert.NotEmpty(t, migrate.Selector, "the API server should generate the job's selector")
+				}
+			})
+	})
+
+	t.Run("should_drill_down_to_the_job_pods", func(t *testing.T) {
+		s := NewJobListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithJob("migrate", "default", 1, 6).
+					WithFailedJobPod("migrate", "default", "migrate-abcde", "Error", 1).
+					WithPod("unrelated", "default")
+			}).
+			the_job_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "default/migrate pods", pushed.Title)
+				podList, ok := pushed.Controller.(*PodListController)
+				if assert.True(t, ok) && assert.Len(t, podList.GetPods(), 1) {
+					assert.Equal(t, "migrate-abcde", podList.GetPods()[0].Name)
+				}
+			})
+	})
+}
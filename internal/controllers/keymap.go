@@ -0,0 +1,335 @@
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/views"
+)
+
+// Action names something the user can do in a view, such as describing the selected resource.
+// Actions are what keys are bound to, and what the keys section of the config file remaps.
+type Action string
+
+const (
+	ActionUp             Action = "up"
+	ActionDown           Action = "down"
+	ActionPageUp         Action = "page-up"
+	ActionPageDown       Action = "page-down"
+	ActionTop            Action = "top"
+	ActionBottom         Action = "bottom"
+	ActionRefresh        Action = "refresh"
+	ActionDescribe       Action = "describe"
+	ActionView           Action = "view"
+	ActionLogs           Action = "logs"
+	ActionXRay           Action = "x-ray"
+	ActionPods           Action = "pods"
+	ActionTrigger        Action = "trigger"
+	ActionSuspend        Action = "suspend"
+	ActionCordon         Action = "cordon"
+	ActionUncordon       Action = "uncordon"
+	ActionDrain          Action = "drain"
+	ActionReveal         Action = "reveal"
+	ActionVolume         Action = "volume"
+	ActionClaim          Action = "claim"
+	ActionTarget         Action = "target"
+	ActionUseNamespace   Action = "use-namespace"
+	ActionAllNamespaces  Action = "all-namespaces"
+	ActionToggle         Action = "toggle"
+	ActionExpand         Action = "expand"
+	ActionCollapse       Action = "collapse"
+	ActionToggleEmptyDir Action = "toggle-empty-dir"
+	ActionToggleForce    Action = "toggle-force"
+	ActionConfirm        Action = "confirm"
+	ActionColumns        Action = "columns"
+	ActionMoveUp         Action = "move-up"
+	ActionMoveDown       Action = "move-down"
+	ActionWiden          Action = "widen"
+	ActionNarrow         Action = "narrow"
+	ActionClose          Action = "close"
+	ActionFocus          Action = "focus"
+	ActionGrow           Action = "grow"
+	ActionShrink         Action = "shrink"
+	ActionRotate         Action = "rotate"
+
+	// Global actions, handled by the app before the current view sees the key
+	ActionQuit    Action = "quit"
+	ActionCommand Action = "command"
+	ActionHelp    Action = "help"
+	ActionBack    Action = "back"
+)
+
+// Binding binds an action to its keys, with the help shown for it in the help overlay.
+// Keys are written as bubbletea names them, such as "d", "G", "enter", "ctrl+u" or " " for space.
+type Binding struct {
+	Action Action
+	Keys   []string
+	Help   string
+}
+
+// Bindings shared by many views
+var (
+	selectUpBinding     = Binding{Action: ActionUp, Keys: []string{"up", "k"}, Help: "Select previous"}
+	selectDownBinding   = Binding{Action: ActionDown, Keys: []string{"down", "j"}, Help: "Select next"}
+	scrollUpBinding     = Binding{Action: ActionUp, Keys: []string{"up", "k"}, Help: "Scroll up"}
+	scrollDownBinding   = Binding{Action: ActionDown, Keys: []string{"down", "j"}, Help: "Scroll down"}
+	pageUpBinding       = Binding{Action: ActionPageUp, Keys: []string{"pgup", "ctrl+u"}, Help: "Page up"}
+	pageDownBinding     = Binding{Action: ActionPageDown, Keys: []string{"pgdown", "ctrl+d"}, Help: "Page down"}
+	scrollTopBinding    = Binding{Action: ActionTop, Keys: []string{"g"}, Help: "Go to the top"}
+	scrollBottomBinding = Binding{Action: ActionBottom, Keys: []string{"G"}, Help: "Go to the bottom"}
+	refreshBinding      = Binding{Action: ActionRefresh, Keys: []string{"r"}, Help: "Refresh"}
+	columnsBinding      = Binding{Action: ActionColumns, Keys: []string{"C"}, Help: "Choose columns"}
+)
+
+// KeyMap is the set of key bindings of one context, such as the pod list
+type KeyMap struct {
+	// Context is the name the key map is configured by, such as "pods" or "describe-pod"
+	Context string
+	// Title describes the context in the help overlay, such as "Pod list"
+	Title string
+
+	// modal key maps are for dialogs that get every key while they are open, so they may reuse global keys
+	modal bool
+
+	defaults []Binding
+	bindings []Binding
+	// actions maps each bound key to its action
+	actions map[string]Action
+}
+
+// keyMaps holds every key map, keyed by context. Key maps are registered as package variables are
+// initialized, then only read and remapped on the update loop.
+var keyMaps = make(map[string]*KeyMap)
+
+// registerKeyMap creates and registers the key map of a context with its default bindings
+func registerKeyMap(context, title string, bindings ...Binding) *KeyMap {
+	if _, exists := keyMaps[context]; exists {
+		panic(fmt.Sprintf("key map %q registered twice", context))
+	}
+	keyMap := &KeyMap{Context: context, Title: title, defaults: bindings}
+	keyMap.setBindings(bindings)
+	keyMaps[context] = keyMap
+	return keyMap
+}
+
+// registerModalKeyMap creates and registers the key map of a dialog that gets every key while it is open
+func registerModalKeyMap(context, title string, bindings ...Binding) *KeyMap {
+	keyMap := registerKeyMap(context, title, bindings...)
+	keyMap.modal = true
+	return keyMap
+}
+
+// GlobalKeys are the keys handled by the app in every view
+var GlobalKeys = registerKeyMap("global", "Global",
+	Binding{Action: ActionQuit, Keys: []string{"q"}, Help: "Quit (ctrl+c always quits)"},
+	Binding{Action: ActionCommand, Keys: []string{":"}, Help: "Open the command bar"},
+	Binding{Action: ActionHelp, Keys: []string{"?"}, Help: "Show this help"},
+	Binding{Action: ActionBack, Keys: []string{"esc"}, Help: "Go back"},
+)
+
+// setBindings replaces the bindings of the key map
+func (k *KeyMap) setBindings(bindings []Binding) {
+	k.bindings = bindings
+	k.actions = make(map[string]Action)
+	for _, binding := range bindings {
+		for _, key := range binding.Keys {
+			k.actions[key] = binding.Action
+		}
+	}
+}
+
+// Action returns the action bound to the key pressed, or "" when the key is not bound
+func (k *KeyMap) Action(msg tea.KeyMsg) Action {
+	return k.actions[msg.String()]
+}
+
+// Bindings returns the bindings of the key map in the order they are listed in the help overlay
+func (k *KeyMap) Bindings() []Binding {
+	return k.bindings
+}
+
+// KeysFor returns the keys bound to the action
+func (k *KeyMap) KeysFor(action Action) []string {
+	for _, binding := range k.bindings {
+		if binding.Action == action {
+			return binding.Keys
+		}
+	}
+	return nil
+}
+
+// HelpSection returns the key map as a section of the help overlay
+func (k *KeyMap) HelpSection() views.HelpSection {
+	section := views.HelpSection{Title: k.Title}
+	for _, binding := range k.bindings {
+		if len(binding.Keys) == 0 {
+			continue
+		}
+		names := make([]string, len(binding.Keys))
+		for i, key := range binding.Keys {
+			names[i] = keyName(key)
+		}
+		section.Entries = append(section.Entries, views.HelpEntry{Keys: strings.Join(names, "/"), Help: binding.Help})
+	}
+	return section
+}
+
+// keyName returns how a key is written in the help overlay
+func keyName(key string) string {
+	switch key {
+	case "up":
+		return "↑"
+	case "down":
+		return "↓"
+	case "left":
+		return "←"
+	case "right":
+		return "→"
+	case " ":
+		return "space"
+	default:
+		return key
+	}
+}
+
+// KeyMapController is implemented by controllers whose keys come from a key map, so that the help overlay can list them
+type KeyMapController interface {
+	Controller
+	KeyMap() *KeyMap
+}
+
+// HelpController is implemented by controllers that add sections to the help overlay after their keys, such as the
+// expression language of custom columns
+type HelpController interface {
+	Controller
+	HelpSections() []views.HelpSection
+}
+
+// ModalController is implemented by controllers that can open a dialog, such as the column chooser. While Modal
+// reports true the controller gets every key, including the global keys.
+type ModalController interface {
+	Controller
+	Modal() bool
+}
+
+// KeyBindingError lists every problem found in the key bindings of the config file
+type KeyBindingError struct {
+	Problems []string
+}
+
+// Error formats the problems one per line
+func (e *KeyBindingError) Error() string {
+	return fmt.Sprintf("invalid key bindings:\n  - %s", strings.Join(e.Problems, "\n  - "))
+}
+
+// KeyMapContexts returns the names of the key map contexts in sorted order
+func KeyMapContexts() []string {
+	contexts := make([]string, 0, len(keyMaps))
+	for context := range keyMaps {
+		contexts = append(contexts, context)
+	}
+	sort.Strings(contexts)
+	return contexts
+}
+
+// ApplyKeyBindings remaps actions, keyed by context and then action, to the keys given, starting from the default
+// bindings so that removing a remapping restores the default. An action remapped to no keys is unbound.
+// A key may only be bound to one action in a context, and not to a global action as the app handles those first, nor
+// to a split action as a split handles those before the views in its panes, except in the key maps of dialogs,
+// which get every key while they are open.
+// If there is any problem nothing is remapped and every problem is returned as a *KeyBindingError.
+func ApplyKeyBindings(remapped map[string]map[string][]string) error {
+	var problems []string
+	resolved := make(map[string][]Binding, len(keyMaps))
+
+	for _, context := range sortedKeys(remapped) {
+		if _, exists := keyMaps[context]; !exists {
+			problems = append(problems, fmt.Sprintf("keys.%s: unknown context (expected one of: %s)", context, strings.Join(KeyMapContexts(), ", ")))
+		}
+	}
+
+	for _, context := range KeyMapContexts() {
+		keyMap := keyMaps[context]
+		actions := remapped[context]
+		bindings := make([]Binding, len(keyMap.defaults))
+		known := make(map[Action]bool)
+		for i, binding := range keyMap.defaults {
+			known[binding.Action] = true
+			if keys, ok := actions[string(binding.Action)]; ok {
+				binding.Keys = keys
+			}
+			bindings[i] = binding
+		}
+		for _, action := range sortedKeys(actions) {
+			if !known[Action(action)] {
+				problems = append(problems, fmt.Sprintf("keys.%s.%s: unknown action (expected one of: %s)", context, action, strings.Join(actionNames(keyMap.defaults), ", ")))
+			}
+		}
+		resolved[context] = bindings
+	}
+
+	// Conflicts are checked against the resolved global bindings, which every other context must avoid, and the
+	// split bindings, which a split handles before the views in its panes
+	globalActions := boundActions(resolved[GlobalKeys.Context])
+	splitActions := boundActions(resolved[splitKeys.Context])
+	for _, context := range KeyMapContexts() {
+		modal := keyMaps[context].modal
+		bound := make(map[string]Action)
+		for _, binding := range resolved[context] {
+			for _, key := range binding.Keys {
+				field := fmt.Sprintf("keys.%s.%s", context, binding.Action)
+				switch {
+				case strings.TrimSpace(key) == "" && key != " ":
+					problems = append(problems, fmt.Sprintf("%s: key must not be empty", field))
+				case bound[key] != "":
+					problems = append(problems, fmt.Sprintf("%s: key %q is already bound to %s", field, key, bound[key]))
+				case context != GlobalKeys.Context && !modal && globalActions[key] != "":
+					problems = append(problems, fmt.Sprintf("%s: key %q is already bound to global %s", field, key, globalActions[key]))
+				case context != GlobalKeys.Context && context != splitKeys.Context && !modal && splitActions[key] != "":
+					problems = append(problems, fmt.Sprintf("%s: key %q is already bound to split %s", field, key, splitActions[key]))
+				}
+				bound[key] = binding.Action
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &KeyBindingError{Problems: problems}
+	}
+	for context, bindings := range resolved {
+		keyMaps[context].setBindings(bindings)
+	}
+	return nil
+}
+
+// boundActions maps each key of the bindings to its action
+func boundActions(bindings []Binding) map[string]Action {
+	actions := make(map[string]Action)
+	for _, binding := range bindings {
+		for _, key := range binding.Keys {
+			actions[key] = binding.Action
+		}
+	}
+	return actions
+}
+
+// actionNames returns the names of the actions of the bindings
+func actionNames(bindings []Binding) []string {
+	names := make([]string, len(bindings))
+	for i, binding := range bindings {
+		names[i] = string(binding.Action)
+	}
+	return names
+}
+
+// sortedKeys returns the keys of a map in sorted order, so problems are reported in a stable order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
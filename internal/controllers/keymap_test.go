@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/views"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyMap(t *testing.T) {
+	// Every test starts from, and restores, the default bindings
+	resetKeyBindings := func(t *testing.T) {
+		t.Helper()
+		require.NoError(t, ApplyKeyBindings(nil))
+		t.Cleanup(func() { require.NoError(t, ApplyKeyBindings(nil)) })
+	}
+
+	t.Run("should_map_keys_to_actions_with_the_default_bindings", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		assert.Equal(t, ActionDescribe, podListKeys.Action(keyMsg("d")))
+		assert.Equal(t, ActionDown, podListKeys.Action(tea.KeyMsg{Type: tea.KeyDown}))
+		assert.Equal(t, ActionToggle, xrayKeys.Action(keyMsg(" ")))
+		assert.Equal(t, ActionQuit, GlobalKeys.Action(keyMsg("q")))
+		assert.Equal(t, Action(""), podListKeys.Action(keyMsg("z")))
+	})
+
+	t.Run("should_remap_actions_and_restore_the_defaults_when_the_remapping_is_removed", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		require.NoError(t, ApplyKeyBindings(map[string]map[string][]string{
+			"pods":   {"logs": {"L", "enter"}, "x-ray": {}},
+			"global": {"quit": {"Q"}},
+		}))
+		assert.Equal(t, ActionLogs, podListKeys.Action(keyMsg("L")))
+		assert.Equal(t, ActionLogs, podListKeys.Action(tea.KeyMsg{Type: tea.KeyEnter}))
+		assert.Equal(t, Action(""), podListKeys.Action(keyMsg("l")))
+		assert.Equal(t, Action(""), podListKeys.Action(keyMsg("x")))
+		assert.Equal(t, ActionQuit, GlobalKeys.Action(keyMsg("Q")))
+		assert.Equal(t, Action(""), GlobalKeys.Action(keyMsg("q")))
+
+		require.NoError(t, ApplyKeyBindings(nil))
+		assert.Equal(t, ActionLogs, podListKeys.Action(keyMsg("l")))
+		assert.Equal(t, ActionXRay, podListKeys.Action(keyMsg("x")))
+		assert.Equal(t, ActionQuit, GlobalKeys.Action(keyMsg("q")))
+	})
+
+	t.Run("should_report_every_problem_and_keep_the_previous_bindings", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		err := ApplyKeyBindings(map[string]map[string][]string{
+			"pods":    {"logs": {"d"}, "shell": {"s"}},
+			"xray":    {"describe": {"?"}},
+			"widgets": {"describe": {"d"}},
+			"nodes":   {"cordon": {""}},
+		})
+
+		var bindingErr *KeyBindingError
+		require.ErrorAs(t, err, &bindingErr)
+		assert.Equal(t, []string{
+			`keys.widgets: unknown context (expected one of: ` + strings.Join(KeyMapContexts(), ", ") + `)`,
+			`keys.pods.shell: unknown action (expected one of: up, down, describe, logs, x-ray, columns, refresh)`,
+			`keys.nodes.cordon: key must not be empty`,
+			`keys.pods.logs: key "d" is already bound to describe`,
+			`keys.xray.describe: key "?" is already bound to global help`,
+		}, bindingErr.Problems)
+		assert.Equal(t, ActionLogs, podListKeys.Action(keyMsg("l")))
+	})
+
+	t.Run("should_report_global_keys_bound_to_view_actions", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		err := ApplyKeyBindings(map[string]map[string][]string{
+			"global": {"back": {"esc", "h"}},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `keys.xray.collapse: key "h" is already bound to global back`)
+	})
+
+	t.Run("should_report_split_keys_bound_to_view_actions", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		err := ApplyKeyBindings(map[string]map[string][]string{
+			"pods":  {"logs": {"o"}},
+			"split": {"focus": {"tab", "L"}},
+			"logs":  {"bottom": {"L"}},
+		})
+		var bindingErr *KeyBindingError
+		require.ErrorAs(t, err, &bindingErr)
+		assert.Equal(t, []string{
+			`keys.logs.bottom: key "L" is already bound to split focus`,
+			`keys.pods.logs: key "o" is already bound to split rotate`,
+		}, bindingErr.Problems)
+	})
+
+	t.Run("should_allow_dialogs_to_bind_global_keys", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		require.NoError(t, ApplyKeyBindings(map[string]map[string][]string{
+			"columns": {"close": {"?", "esc"}},
+		}))
+		assert.Equal(t, ActionClose, columnChooserKeys.Action(keyMsg("?")))
+	})
+
+	t.Run("should_list_the_bindings_in_the_help_section", func(t *testing.T) {
+		resetKeyBindings(t)
+
+		section := xrayKeys.HelpSection()
+		assert.Equal(t, "X-ray", section.Title)
+		assert.Contains(t, section.Entries, views.HelpEntry{Keys: "↑/k", Help: "Select previous"})
+		assert.Contains(t, section.Entries, views.HelpEntry{Keys: "enter/space", Help: "Expand or collapse the selected node"})
+
+		require.NoError(t, ApplyKeyBindings(map[string]map[string][]string{"xray": {"describe": {}}}))
+		for _, entry := range xrayKeys.HelpSection().Entries {
+			assert.NotEqual(t, "Describe the selected resource", entry.Help)
+		}
+	})
+}
+
+// keyMsg returns the key message bubbletea sends for a key typed as text
+func keyMsg(key string) tea.KeyMsg {
+	if key == " " {
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(key)}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/models"
+)
+
+// metricsPollInterval matches metrics-server's default resolution; polling faster only returns the same sample
+const metricsPollInterval = 15 * time.Second
+
+// metricsTimeout bounds a single metrics poll so a slow metrics API cannot stall a watch loop
+const metricsTimeout = 5 * time.Second
+
+// metricsHistorySize keeps 15 minutes of samples at metrics-server's default resolution
+const metricsHistorySize = 60
+
+// usageHistories are the rolling usage histories shared by every controller polling pod metrics, one per cluster
+// keyed by API server, so a describe view opens with the samples the pod list of its cluster has already collected
+var usageHistories = struct {
+	sync.Mutex
+	byServer map[string]*models.MetricsHistory
+}{byServer: make(map[string]*models.MetricsHistory)}
+
+// usageHistory returns the usage history of the cluster metrics reads from. Without a metrics client nothing is
+// polled, so the history of no cluster is returned.
+func usageHistory(metrics *models.MetricsClient) *models.MetricsHistory {
+	server := ""
+	if metrics != nil {
+		server = metrics.Server()
+	}
+
+	usageHistories.Lock()
+	defer usageHistories.Unlock()
+	history, ok := usageHistories.byServer[server]
+	if !ok {
+		history = models.NewMetricsHistory(metricsHistorySize)
+		usageHistories.byServer[server] = history
+	}
+	return history
+}
+
+// podMetricsMsg carries a pod metrics poll to the update loop
+type podMetricsMsg struct {
+	usage map[string]models.PodUsage
+	err   error
+}
+
+// nodeMetricsMsg carries a node metrics poll to the update loop
+type nodeMetricsMsg struct {
+	usage map[string]models.ResourceUsage
+	err   error
+}
+
+// metricsTicker returns a channel ticking at metricsPollInterval and a func to stop it.
+// Without a metrics client the channel is nil, so selecting on it never fires.
+func metricsTicker(metrics *models.MetricsClient) (<-chan time.Time, func()) {
+	if metrics == nil {
+		return nil, func() {}
+	}
+	ticker := time.NewTicker(metricsPollInterval)
+	return ticker.C, ticker.Stop
+}
+
+// fetchPodMetrics polls pod usage for the pods in namespace matching labelSelector
+func fetchPodMetrics(ctx context.Context, metrics *models.MetricsClient, namespace, labelSelector string) podMetricsMsg {
+	ctx, cancel := context.WithTimeout(ctx, metricsTimeout)
+	defer cancel()
+	usage, err := metrics.PodUsage(ctx, namespace, labelSelector)
+	return podMetricsMsg{usage: usage, err: err}
+}
+
+// listedPodUsage keeps the usage of the pods in a list, dropping the rest of a poll
+func listedPodUsage(usage map[string]models.PodUsage, listed func(key string) bool) map[string]models.PodUsage {
+	kept := make(map[string]models.PodUsage, len(usage))
+	for key, podUsage := range usage {
+		if listed(key) {
+			kept[key] = podUsage
+		}
+	}
+	return kept
+}
+
+// fetchNodeMetrics polls the usage of every node
+func fetchNodeMetrics(ctx context.Context, metrics *models.MetricsClient) nodeMetricsMsg {
+	ctx, cancel := context.WithTimeout(ctx, metricsTimeout)
+	defer cancel()
+	usage, err := metrics.NodeUsage(ctx)
+	return nodeMetricsMsg{usage: usage, err: err}
+}
+
+// metricsAvailability interprets a metrics poll error: the metrics API is unavailable when metrics-server is absent,
+// while other errors are transient, so apply is false and the caller keeps its last sample
+func metricsAvailability(err error) (available bool, apply bool) {
+	switch {
+	case err == nil:
+		return true, true
+	case errors.Is(err, models.ErrMetricsUnavailable):
+		return false, true
+	default:
+		debugLogger.Printf("error polling metrics: %v", err)
+		return false, false
+	}
+}
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+// MetricsServerStandIn serves canned samples in the shape of the metrics.k8s.io API, standing in for metrics-server
+type MetricsServerStandIn struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu     sync.Mutex
+	pods   []map[string]interface{}
+	nodes  []map[string]interface{}
+	absent bool
+}
+
+// NewMetricsServerStandIn starts a stand-in metrics API with no samples
+func NewMetricsServerStandIn(t *testing.T) *MetricsServerStandIn {
+	m := &MetricsServerStandIn{t: t}
+	m.server = httptest.NewServer(http.HandlerFunc(m.serve))
+	return m
+}
+
+// WithPodUsage adds a sample for one container of a pod; cpu and memory are quantities such as "250m" and "64Mi"
+func (m *MetricsServerStandIn) WithPodUsage(namespace, name, container, cpu, memory string) *MetricsServerStandIn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	usage := map[string]interface{}{"name": container, "usage": map[string]string{"cpu": cpu, "memory": memory}}
+	for _, pod := range m.pods {
+		metadata := pod["metadata"].(map[string]string)
+		if metadata["namespace"] == namespace && metadata["name"] == name {
+			pod["containers"] = append(pod["containers"].([]interface{}), usage)
+			return m
+		}
+	}
+	m.pods = append(m.pods, map[string]interface{}{
+		"metadata":   map[string]string{"namespace": namespace, "name": name},
+		"timestamp":  "2024-01-01T00:00:00Z",
+		"window":     "15s",
+		"containers": []interface{}{usage},
+	})
+	return m
+}
+
+// WithNodeUsage adds a sample for a node
+func (m *MetricsServerStandIn) WithNodeUsage(name, cpu, memory string) *MetricsServerStandIn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nodes = append(m.nodes, map[string]interface{}{
+		"metadata":  map[string]string{"name": name},
+		"timestamp": "2024-01-01T00:00:00Z",
+		"window":    "15s",
+		"usage":     map[string]string{"cpu": cpu, "memory": memory},
+	})
+	return m
+}
+
+// Absent makes the stand-in answer as a cluster without metrics-server would
+func (m *MetricsServerStandIn) Absent() *MetricsServerStandIn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.absent = true
+	return m
+}
+
+// Client returns a metrics client reading from the stand-in
+func (m *MetricsServerStandIn) Client() *models.MetricsClient {
+	client, err := models.NewMetricsClientForConfig(&rest.Config{Host: m.server.URL})
+	require.NoError(m.t, err)
+	return client
+}
+
+// Close stops the stand-in
+func (m *MetricsServerStandIn) Close() {
+	m.server.Close()
+}
+
+func (m *MetricsServerStandIn) serve(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	const root = "/apis/metrics.k8s.io/v1beta1"
+	path := r.URL.Path
+	if m.absent || !strings.HasPrefix(path, root) {
+		http.NotFound(w, r)
+		return
+	}
+	path = strings.TrimPrefix(path, root)
+
+	var items []map[string]interface{}
+	kind := "PodMetricsList"
+	switch {
+	case path == "/nodes":
+		kind = "NodeMetricsList"
+		items = m.nodes
+	case path == "/pods":
+		items = m.pods
+	case strings.HasPrefix(path, "/namespaces/") && strings.HasSuffix(path, "/pods"):
+		namespace := strings.TrimSuffix(strings.TrimPrefix(path, "/namespaces/"), "/pods")
+		for _, pod := range m.pods {
+			if pod["metadata"].(map[string]string)["namespace"] == namespace {
+				items = append(items, pod)
+			}
+		}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if items == nil {
+		items = []map[string]interface{}{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(m.t, json.NewEncoder(w).Encode(map[string]interface{}{
+		"kind":       kind,
+		"apiVersion": "metrics.k8s.io/v1beta1",
+		"metadata":   map[string]string{},
+		"items":      items,
+	}))
+}
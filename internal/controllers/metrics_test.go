@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsClient(t *testing.T) {
+	t.Run("should_read_pod_usage_summed_over_containers", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).
+			WithPodUsage("default", "web", "app", "250m", "64Mi").
+			WithPodUsage("default", "web", "sidecar", "50m", "16Mi").
+			WithPodUsage("other", "db", "postgres", "1", "1Gi")
+		defer standIn.Close()
+
+		usage, err := standIn.Client().PodUsage(context.Background(), "default", "")
+		require.NoError(t, err)
+		assert.Len(t, usage, 1)
+		assert.Equal(t, models.ResourceUsage{CPUMilli: 300, MemoryBytes: 80 * 1024 * 1024}, usage["default/web"].Total())
+		assert.Equal(t, int64(50), usage["default/web"].Containers["sidecar"].CPUMilli)
+	})
+
+	t.Run("should_keep_only_the_usage_of_listed_pods", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).
+			WithPodUsage("default", "web", "app", "250m", "64Mi").
+			WithPodUsage("other", "db", "postgres", "1", "1Gi")
+		defer standIn.Close()
+
+		msg := fetchPodMetrics(context.Background(), standIn.Client(), "", "")
+		require.NoError(t, msg.err)
+		usage := listedPodUsage(msg.usage, func(key string) bool { return key == "other/db" })
+		assert.Len(t, usage, 1)
+		assert.Contains(t, usage, "other/db")
+	})
+
+	t.Run("should_read_node_usage", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).WithNodeUsage("worker-node-1", "1500m", "2Gi")
+		defer standIn.Close()
+
+		usage, err := standIn.Client().NodeUsage(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, models.ResourceUsage{CPUMilli: 1500, MemoryBytes: 2 * 1024 * 1024 * 1024}, usage["worker-node-1"])
+	})
+
+	t.Run("should_report_metrics_unavailable_without_metrics_server", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).Absent()
+		defer standIn.Close()
+
+		_, err := standIn.Client().PodUsage(context.Background(), "", "")
+		assert.True(t, errors.Is(err, models.ErrMetricsUnavailable))
+		_, err = standIn.Client().NodeUsage(context.Background())
+		assert.True(t, errors.Is(err, models.ErrMetricsUnavailable))
+	})
+
+	t.Run("should_keep_a_rolling_window_of_new_samples_per_container", func(t *testing.T) {
+		history := models.NewMetricsHistory(3)
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 5; i++ {
+			history.Record("default", "web", models.PodUsage{
+				Timestamp:  start.Add(time.Duration(i) * 15 * time.Second),
+				Containers: map[string]models.ResourceUsage{"app": {CPUMilli: int64(100 * (i + 1))}},
+			})
+		}
+		// metrics-server serves the same sample until it next scrapes
+		history.Record("default", "web", models.PodUsage{
+			Timestamp:  start.Add(60 * time.Second),
+			Containers: map[string]models.ResourceUsage{"app": {CPUMilli: 999}},
+		})
+
+		samples := history.PodHistory("default", "web")["app"]
+		require.Len(t, samples, 3)
+		assert.Equal(t, []int64{300, 400, 500}, []int64{samples[0].Usage.CPUMilli, samples[1].Usage.CPUMilli, samples[2].Usage.CPUMilli})
+
+		history.Forget("default", "web")
+		assert.Empty(t, history.PodHistory("default", "web"))
+	})
+
+	t.Run("should_keep_the_usage_history_of_each_cluster_apart", func(t *testing.T) {
+		production := NewMetricsServerStandIn(t).WithPodUsage("default", "web", "app", "250m", "64Mi")
+		defer production.Close()
+		staging := NewMetricsServerStandIn(t).WithPodUsage("default", "web", "app", "10m", "8Mi")
+		defer staging.Close()
+
+		for _, standIn := range []*MetricsServerStandIn{production, staging} {
+			msg := fetchPodMetrics(context.Background(), standIn.Client(), "default", "")
+			require.NoError(t, msg.err)
+			usageHistory(standIn.Client()).RecordAll(msg.usage)
+		}
+
+		productionSamples := usageHistory(production.Client()).PodHistory("default", "web")["app"]
+		require.Len(t, productionSamples, 1)
+		assert.Equal(t, int64(250), productionSamples[0].Usage.CPUMilli)
+		stagingSamples := usageHistory(staging.Client()).PodHistory("default", "web")["app"]
+		require.Len(t, stagingSamples, 1)
+		assert.Equal(t, int64(10), stagingSamples[0].Usage.CPUMilli)
+	})
+}
@@ -0,0 +1,195 @@
+package controllers
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterDeployments lists and watches deployments for the multi-cluster deployment list
+var clusterDeployments = typedResource("deployments", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*appsv1.DeploymentList] {
+		return clientset.AppsV1().Deployments(namespace)
+	},
+	func(cluster string, deployment *appsv1.Deployment) models.Deployment {
+		model := models.ToDeploymentModel(*deployment)
+		model.Cluster = cluster
+		return model
+	})
+
+// MultiClusterDeploymentListController lists the deployments of several clusters in one table, with a column naming
+// the cluster of each deployment, so that the replicas of a service in each cluster are shown side by side
+type MultiClusterDeploymentListController struct {
+	deploymentView *views.DeploymentListView
+	theme          *theme.Theme
+	watches        *clusterWatches[models.Deployment]
+	width          int
+	height         int
+}
+
+// NewMultiClusterDeploymentListController creates a deployment list merging the deployments of clusters, restricted
+// to namespace; empty for all namespaces. Each cluster is listed and watched with its own client.
+func NewMultiClusterDeploymentListController(clusters []models.Cluster, theme *theme.Theme, namespace string) *MultiClusterDeploymentListController {
+	controller := &MultiClusterDeploymentListController{
+		deploymentView: views.NewClusterDeploymentListView(nil, theme),
+		theme:          theme,
+		watches:        newClusterWatches(clusters, namespace, clusterDeployments),
+	}
+	controller.watches.start()
+	return controller
+}
+
+// Update applies the lists, watch events and health of the clusters on the update loop
+func (c *MultiClusterDeploymentListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.deploymentView.UpdateDeployments(c.watches.list())
+	}
+	return nil
+}
+
+// HandleKey handles key press events with the key bindings of the deployment list
+func (c *MultiClusterDeploymentListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.deploymentView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.deploymentView, msg)
+	}
+
+	switch deploymentListKeys.Action(msg) {
+	case ActionUp:
+		c.deploymentView.SelectPrev()
+	case ActionDown:
+		c.deploymentView.SelectNext()
+	case ActionDescribe:
+		return c.describeSelectedDeployment()
+	case ActionPods:
+		return c.openSelectedDeploymentPods()
+	case ActionXRay:
+		return c.xraySelectedDeployment()
+	case ActionRefresh:
+		return c.watches.refresh()
+	case ActionColumns:
+		return openColumnChooser(c.deploymentView)
+	}
+	return nil
+}
+
+// KeyMap returns the key bindings of the deployment list, which the help overlay lists
+func (c *MultiClusterDeploymentListController) KeyMap() *KeyMap {
+	return deploymentListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *MultiClusterDeploymentListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *MultiClusterDeploymentListController) Modal() bool {
+	return c.deploymentView.ColumnChooser() != nil
+}
+
+// ClusterHealth returns the connection health of each cluster, which the header shows
+func (c *MultiClusterDeploymentListController) ClusterHealth() []models.ClusterHealth {
+	return c.watches.clusterHealth()
+}
+
+// selected returns the selected deployment and the client of its cluster, or nil when there is none
+func (c *MultiClusterDeploymentListController) selected() (*models.Deployment, *kubernetes.Clientset) {
+	deployment := c.deploymentView.GetSelected()
+	if deployment == nil {
+		return nil, nil
+	}
+	clientset := c.watches.clientset(deployment.Cluster)
+	if clientset == nil {
+		return nil, nil
+	}
+	return deployment, clientset
+}
+
+// describeSelectedDeployment pushes the describe view for the selected deployment, read from its cluster
+func (c *MultiClusterDeploymentListController) describeSelectedDeployment() tea.Cmd {
+	deployment, clientset := c.selected()
+	if deployment == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeDeploymentController(clientset, c.theme, deployment.Name, deployment.Namespace)
+	return PushView(describeCtrl, deployment.Cluster+": "+deployment.Namespace+"/"+deployment.Name)
+}
+
+// Details returns the views of the selected deployment a split can show beside the list
+func (c *MultiClusterDeploymentListController) Details() []Action {
+	return deploymentDetails
+}
+
+// Selection identifies the selected deployment and its cluster, or returns "" when there is none
+func (c *MultiClusterDeploymentListController) Selection() string {
+	return deploymentSelection(c.deploymentView.GetSelected())
+}
+
+// Detail creates the describe view of the selected deployment, read from its cluster, for a split to show beside
+// the list
+func (c *MultiClusterDeploymentListController) Detail(action Action) Controller {
+	deployment, clientset := c.selected()
+	return deploymentDetail(clientset, c.theme, deployment, action)
+}
+
+// openSelectedDeploymentPods pushes a pod list scoped to the pods of the selected deployment in its cluster
+func (c *MultiClusterDeploymentListController) openSelectedDeploymentPods() tea.Cmd {
+	deployment, clientset := c.selected()
+	if deployment == nil {
+		return nil
+	}
+	selector, err := models.GetDeploymentPodSelector(clientset, deployment.Namespace, deployment.Name)
+	if err != nil {
+		debugLogger.Printf("error resolving pods of deployment: %v", err)
+		return nil
+	}
+	qualifiedName := deployment.Namespace + "/" + deployment.Name
+	podListCtrl := NewScopedPodListController(clientset, c.theme, deployment.Cluster, PodListScope{
+		Namespace:     deployment.Namespace,
+		LabelSelector: selector,
+		Description:   fmt.Sprintf("deployment %s in %s", qualifiedName, deployment.Cluster),
+	})
+	return PushView(podListCtrl, deployment.Cluster+": "+qualifiedName+" pods")
+}
+
+// xraySelectedDeployment pushes the x-ray view of the selected deployment, read from its cluster
+func (c *MultiClusterDeploymentListController) xraySelectedDeployment() tea.Cmd {
+	deployment, clientset := c.selected()
+	if deployment == nil {
+		return nil
+	}
+	return XRay(clientset, c.theme, models.ResourceRef{Kind: "Deployment", Namespace: deployment.Namespace, Name: deployment.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *MultiClusterDeploymentListController) ActionText() string {
+	return fmt.Sprintf("Listing deployments in %d clusters", len(c.watches.clusters))
+}
+
+// Render returns the rendered deployment list, under the reasons any cluster is unreachable
+func (c *MultiClusterDeploymentListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	return renderClusterList(c.watches.unreachable(), width, height, c.theme, c.deploymentView.SetSize, c.deploymentView.Render)
+}
+
+// GetUpdateChannel returns the channel carrying the watch events of every cluster
+func (c *MultiClusterDeploymentListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the watches of every cluster
+func (c *MultiClusterDeploymentListController) Stop() {
+	c.watches.stop()
+}
+
+// GetDeployments returns the current deployments of every cluster (for testing)
+func (c *MultiClusterDeploymentListController) GetDeployments() []models.Deployment {
+	return c.watches.list()
+}
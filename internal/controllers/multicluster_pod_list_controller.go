@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// clusterPods lists and watches pods for the multi-cluster pod list
+var clusterPods = typedResource("pods", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*corev1.PodList] {
+		return clientset.CoreV1().Pods(namespace)
+	},
+	func(cluster string, pod *corev1.Pod) models.Pod {
+		model := models.ToPodModel(*pod)
+		model.Cluster = cluster
+		return model
+	})
+
+// MultiClusterPodListController lists the pods of several clusters in one table, with a column naming the cluster
+// of each pod
+type MultiClusterPodListController struct {
+	podView *views.PodListView
+	theme   *theme.Theme
+	watches *clusterWatches[models.Pod]
+	width   int
+	height  int
+}
+
+// NewMultiClusterPodListController creates a pod list merging the pods of clusters, restricted to namespace; empty
+// for all namespaces. Each cluster is listed and watched with its own client.
+func NewMultiClusterPodListController(clusters []models.Cluster, theme *theme.Theme, namespace string) *MultiClusterPodListController {
+	controller := &MultiClusterPodListController{
+		podView: views.NewClusterPodListView(nil, theme),
+		theme:   theme,
+		watches: newClusterWatches(clusters, namespace, clusterPods),
+	}
+	controller.watches.start()
+	return controller
+}
+
+// Update applies the lists, watch events and health of the clusters on the update loop
+func (c *MultiClusterPodListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.podView.UpdatePods(c.watches.list())
+	}
+	return nil
+}
+
+// HandleKey handles key press events with the key bindings of the pod list
+func (c *MultiClusterPodListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.podView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.podView, msg)
+	}
+
+	switch podListKeys.Action(msg) {
+	case ActionUp:
+		c.podView.SelectPrev()
+	case ActionDown:
+		c.podView.SelectNext()
+	case ActionDescribe:
+		return c.describeSelectedPod()
+	case ActionLogs:
+		return c.openSelectedPodLogs()
+	case ActionXRay:
+		return c.xraySelectedPod()
+	case ActionRefresh:
+		return c.watches.refresh()
+	case ActionColumns:
+		return openColumnChooser(c.podView)
+	}
+	return nil
+}
+
+// KeyMap returns the key bindings of the pod list, which the help overlay lists
+func (c *MultiClusterPodListController) KeyMap() *KeyMap {
+	return podListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *MultiClusterPodListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *MultiClusterPodListController) Modal() bool {
+	return c.podView.ColumnChooser() != nil
+}
+
+// ClusterHealth returns the connection health of each cluster, which the header shows
+func (c *MultiClusterPodListController) ClusterHealth() []models.ClusterHealth {
+	return c.watches.clusterHealth()
+}
+
+// selected returns the selected pod and the client of its cluster, or nil when there is none
+func (c *MultiClusterPodListController) selected() (*models.Pod, *kubernetes.Clientset) {
+	pod := c.podView.GetSelected()
+	if pod == nil {
+		return nil, nil
+	}
+	clientset := c.watches.clientset(pod.Cluster)
+	if clientset == nil {
+		return nil, nil
+	}
+	return pod, clientset
+}
+
+// describeSelectedPod pushes the describe view for the selected pod, read from its cluster
+func (c *MultiClusterPodListController) describeSelectedPod() tea.Cmd {
+	pod, clientset := c.selected()
+	if pod == nil {
+		return nil
+	}
+	describeCtrl := NewDescribePodController(clientset, c.theme, pod.Name, pod.Namespace)
+	return PushView(describeCtrl, pod.Cluster+": "+pod.Namespace+"/"+pod.Name)
+}
+
+// openSelectedPodLogs pushes the logs view for the selected pod, read from its cluster
+func (c *MultiClusterPodListController) openSelectedPodLogs() tea.Cmd {
+	pod, clientset := c.selected()
+	if pod == nil {
+		return nil
+	}
+	logCtrl := NewPodLogController(NewKubernetesLogFetcher(clientset), c.theme, pod.Name, pod.Namespace)
+	return PushView(logCtrl, pod.Cluster+": "+pod.Namespace+"/"+pod.Name+" logs")
+}
+
+// xraySelectedPod pushes the x-ray view of the selected pod, read from its cluster
+func (c *MultiClusterPodListController) xraySelectedPod() tea.Cmd {
+	pod, clientset := c.selected()
+	if pod == nil {
+		return nil
+	}
+	return XRay(clientset, c.theme, models.ResourceRef{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name})
+}
+
+// Details returns the views of the selected pod a split can show beside the list
+func (c *MultiClusterPodListController) Details() []Action {
+	return podDetails
+}
+
+// Selection identifies the selected pod and its cluster, or returns "" when there is none
+func (c *MultiClusterPodListController) Selection() string {
+	return podSelection(c.podView.GetSelected())
+}
+
+// Detail creates the describe or logs view of the selected pod, read from its cluster, for a split to show beside
+// the list
+func (c *MultiClusterPodListController) Detail(action Action) Controller {
+	pod, clientset := c.selected()
+	return podDetail(clientset, c.theme, pod, action)
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *MultiClusterPodListController) ActionText() string {
+	return fmt.Sprintf("Viewing pods in %d clusters", len(c.watches.clusters))
+}
+
+// Render returns the rendered pod list, under the reasons any cluster is unreachable
+func (c *MultiClusterPodListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	return renderClusterList(c.watches.unreachable(), width, height, c.theme, c.podView.SetSize, c.podView.Render)
+}
+
+// GetUpdateChannel returns the channel carrying the watch events of every cluster
+func (c *MultiClusterPodListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the watches of every cluster
+func (c *MultiClusterPodListController) Stop() {
+	c.watches.stop()
+}
+
+// GetPods returns the current pods of every cluster (for testing)
+func (c *MultiClusterPodListController) GetPods() []models.Pod {
+	return c.watches.list()
+}
+
+// renderClusterList renders a multi-cluster list view below a line describing the unreachable clusters, when any are
+func renderClusterList(unreachable string, width, height int, t *theme.Theme, setSize func(width, height int), render func() string) string {
+	if unreachable == "" {
+		setSize(width, height)
+		return render()
+	}
+	line := lipgloss.NewStyle().Foreground(t.Error).MaxWidth(width).Render(unreachable)
+	setSize(width, height-1)
+	return lipgloss.JoinVertical(lipgloss.Left, line, render())
+}
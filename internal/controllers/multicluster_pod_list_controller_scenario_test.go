@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+type MultiClusterPodListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	clusters   []models.Cluster
+	controller *MultiClusterPodListController
+	pushed     PushViewMsg
+}
+
+func NewMultiClusterPodListControllerScenario(t *testing.T) *MultiClusterPodListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &MultiClusterPodListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *MultiClusterPodListControllerScenario) Given() *MultiClusterPodListControllerScenario {
+	return s
+}
+func (s *MultiClusterPodListControllerScenario) When() *MultiClusterPodListControllerScenario {
+	return s
+}
+func (s *MultiClusterPodListControllerScenario) Then() *MultiClusterPodListControllerScenario {
+	return s
+}
+func (s *MultiClusterPodListControllerScenario) and() *MultiClusterPodListControllerScenario {
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *MultiClusterPodListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+// clusters_named registers the test cluster under each name, so that each name is a cluster with its own watch
+func (s *MultiClusterPodListControllerScenario) clusters_named(names ...string) *MultiClusterPodListControllerScenario {
+	for _, name := range names {
+		s.clusters = append(s.clusters, models.Cluster{Name: name, Clientset: s.builder.GetClientset()})
+	}
+	return s
+}
+
+// an_unreachable_cluster_named adds a cluster whose API server refuses connections
+func (s *MultiClusterPodListControllerScenario) an_unreachable_cluster_named(name string) *MultiClusterPodListControllerScenario {
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: "http://127.0.0.1:1", Timeout: time.Second})
+	require.NoError(s.t, err)
+	s.clusters = append(s.clusters, models.Cluster{Name: name, Clientset: clientset})
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) the_multi_cluster_pod_list_controller_is_instantiated() *MultiClusterPodListControllerScenario {
+	s.controller = NewMultiClusterPodListController(s.clusters, theme.NewDefaultTheme(), "")
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) a_new_pod_is_added_to_cluster(name, namespace string) *MultiClusterPodListControllerScenario {
+	s.builder.WithPod(name, namespace)
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) select_next_pod() *MultiClusterPodListControllerScenario {
+	s.controller.podView.SelectNext()
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) the_selected_pod_is_described() *MultiClusterPodListControllerScenario {
+	cmd := s.controller.describeSelectedPod()
+	require.NotNil(s.t, cmd)
+	s.pushed = cmd().(PushViewMsg)
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) the_pushed_view_title_should_be(expected string) *MultiClusterPodListControllerScenario {
+	if s.pushed.Title != expected {
+		s.t.Errorf("expected pushed view %q, got %q", expected, s.pushed.Title)
+	}
+	return s
+}
+
+// watch_events_are_applied_until drains the update channel on the test goroutine, as the App update loop would,
+// until cond holds for the controller's pods and cluster health or a timeout elapses
+func (s *MultiClusterPodListControllerScenario) watch_events_are_applied_until(cond func([]models.Pod, []models.ClusterHealth) bool) *MultiClusterPodListControllerScenario {
+	timeout := time.After(10 * time.Second)
+	for !cond(s.controller.GetPods(), s.controller.ClusterHealth()) {
+		select {
+		case msg := <-s.controller.GetUpdateChannel():
+			s.controller.Update(msg)
+		case <-timeout:
+			s.t.Errorf("condition not met after applying watch events; pods: %d, health: %v", len(s.controller.GetPods()), s.controller.ClusterHealth())
+			return s
+		}
+	}
+	return s
+}
+
+// the_clusters_of_pod_should_be checks the clusters the pods with the name are listed in, in the order they are listed
+func (s *MultiClusterPodListControllerScenario) the_clusters_of_pod_should_be(name string, expected ...string) *MultiClusterPodListControllerScenario {
+	var clusters []string
+	for _, pod := range s.controller.GetPods() {
+		if pod.Name == name {
+			clusters = append(clusters, pod.Cluster)
+		}
+	}
+	require.Equal(s.t, expected, clusters)
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) the_render_should_contain(expected string) *MultiClusterPodListControllerScenario {
+	require.Contains(s.t, s.controller.Render(160, 30), expected)
+	return s
+}
+
+func (s *MultiClusterPodListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/kevholditch/vigilant/internal/models"
+)
+
+func TestMultiClusterPodListController(t *testing.T) {
+	// podsIn returns the clusters of the pods with the name, in the order they are listed
+	podsIn := func(pods []models.Pod, name string) []string {
+		var clusters []string
+		for _, pod := range pods {
+			if pod.Name == name {
+				clusters = append(clusters, pod.Cluster)
+			}
+		}
+		return clusters
+	}
+	connected := func(health []models.ClusterHealth) bool {
+		for _, cluster := range health {
+			if cluster.State != models.ClusterConnected {
+				return false
+			}
+		}
+		return true
+	}
+
+	t.Run("should_list_the_pods_of_every_cluster_side_by_side", func(t *testing.T) {
+		s := NewMultiClusterPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPod("web", "shop")
+			}).
+			clusters_named("us-east", "eu-west").
+			When().
+			the_multi_cluster_pod_list_controller_is_instantiated().
+			watch_events_are_applied_until(func(pods []models.Pod, health []models.ClusterHealth) bool {
+				return connected(health) && len(pods) == 2
+			}).
+			Then().
+			the_clusters_of_pod_should_be("web", "eu-west", "us-east").
+			the_render_should_contain("CLUSTER")
+	})
+
+	t.Run("should_apply_watch_events_from_every_cluster", func(t *testing.T) {
+		s := NewMultiClusterPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			clusters_named("eu-west", "us-east").
+			When().
+			the_multi_cluster_pod_list_controller_is_instantiated().
+			watch_events_are_applied_until(func(pods []models.Pod, health []models.ClusterHealth) bool {
+				return connected(health)
+			}).
+			a_new_pod_is_added_to_cluster("api", "shop").
+			Then().
+			watch_events_are_applied_until(func(pods []models.Pod, health []models.ClusterHealth) bool {
+				return len(podsIn(pods, "api")) == 2
+			})
+	})
+
+	t.Run("should_report_unreachable_clusters_and_keep_showing_the_others", func(t *testing.T) {
+		s := NewMultiClusterPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPod("web", "shop")
+			}).
+			clusters_named("eu-west").
+			an_unreachable_cluster_named("ap-south").
+			When().
+			the_multi_cluster_pod_list_controller_is_instantiated().
+			Then().
+			watch_events_are_applied_until(func(pods []models.Pod, health []models.ClusterHealth) bool {
+				return len(pods) == 1 && health[0].State == models.ClusterConnected && health[1].State == models.ClusterUnreachable
+			}).
+			the_render_should_contain("ap-south unreachable")
+	})
+
+	t.Run("should_describe_the_selected_pod_in_its_cluster", func(t *testing.T) {
+		s := NewMultiClusterPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPod("web", "shop")
+			}).
+			clusters_named("eu-west", "us-east").
+			When().
+			the_multi_cluster_pod_list_controller_is_instantiated().
+			watch_events_are_applied_until(func(pods []models.Pod, health []models.ClusterHealth) bool {
+				return len(pods) == 2
+			}).
+			select_next_pod().
+			the_selected_pod_is_described().
+			Then().
+			the_pushed_view_title_should_be("us-east: shop/web")
+	})
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// namespaceResource lists and watches namespaces for the namespace list. Their usage is filled in by the list.
+var namespaceResource = typedResource("namespaces", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, _ string) listWatcher[*corev1.NamespaceList] {
+		return clientset.CoreV1().Namespaces()
+	},
+	func(_ string, namespace *corev1.Namespace) models.Namespace {
+		return models.ToNamespaceModel(*namespace)
+	})
+
+// NamespaceListController handles input for the namespace list view
+type NamespaceListController struct {
+	namespaceView *views.NamespaceListView
+	clientset     *kubernetes.Clientset
+	theme         *theme.Theme
+	clusterName   string
+	width         int
+	height        int
+
+	// watches lists and watches the namespaces, listing again whenever the watch ends
+	watches *clusterWatches[models.Namespace]
+	usage   map[string]models.NamespaceUsage // pod counts and quota usage by namespace, refreshed with 'r'
+}
+
+// NewNamespaceListController creates a new namespace list controller. The active namespace, the one the
+// resource views are scoped to, is marked in the list; empty means all namespaces.
+func NewNamespaceListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, active string) *NamespaceListController {
+	controller := &NamespaceListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, "", namespaceResource),
+		usage:       make(map[string]models.NamespaceUsage),
+	}
+
+	// Summarise the namespaces for the initial list
+	if msg := summariseNamespaces(clientset); msg.err != nil {
+		// Namespaces are still worth listing without their pod counts and quotas
+		debugLogger.Printf("error getting initial namespace usage: %v", msg.err)
+	} else {
+		controller.usage = msg.usage
+	}
+
+	// Create the view with initial namespaces
+	namespaceView := views.NewNamespaceListView(controller.getNamespacesList(), active, theme, clusterName)
+	controller.namespaceView = namespaceView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// namespacesSummarisedMsg carries the usage of every namespace to the update loop
+type namespacesSummarisedMsg struct {
+	usage map[string]models.NamespaceUsage
+	err   error
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *NamespaceListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+		return nil
+	}
+	switch msg := msg.(type) {
+	case namespacesSummarisedMsg:
+		if msg.err != nil {
+			debugLogger.Printf("error refreshing namespace usage: %v", msg.err)
+			return nil
+		}
+		c.usage = msg.usage
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the namespace list view with current namespaces
+func (c *NamespaceListController) updateView() {
+	c.namespaceView.UpdateNamespaces(c.getNamespacesList())
+}
+
+// getNamespacesList returns the current namespaces as a slice in consistent order, with their last known usage
+func (c *NamespaceListController) getNamespacesList() []models.Namespace {
+	namespaces := c.watches.list()
+	for i := range namespaces {
+		namespaces[i].Usage = c.usage[namespaces[i].Name]
+	}
+	return namespaces
+}
+
+// namespaceListKeys are the key bindings of the namespace list
+var namespaceListKeys = registerKeyMap("namespaces", "Namespace list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionUseNamespace, Keys: []string{"enter"}, Help: "Scope the views to the selected namespace"},
+	Binding{Action: ActionAllNamespaces, Keys: []string{"a"}, Help: "Show all namespaces"},
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected namespace"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the namespace list view
+func (c *NamespaceListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.namespaceView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.namespaceView, msg)
+	}
+
+	switch namespaceListKeys.Action(msg) {
+	case ActionUp:
+		c.namespaceView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.namespaceView.SelectNext()
+		return nil
+	case ActionUseNamespace:
+		selectedNamespace := c.namespaceView.GetSelected()
+		if selectedNamespace == nil {
+			return nil
+		}
+		return SetNamespace(selectedNamespace.Name)
+	case ActionAllNamespaces:
+		return SetNamespace("")
+	case ActionDescribe:
+		return c.describeSelectedNamespace()
+	case ActionRefresh:
+		// Refresh namespaces and their usage
+		return c.refreshNamespaces()
+	case ActionColumns:
+		return openColumnChooser(c.namespaceView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the namespace list, which the help overlay lists
+func (c *NamespaceListController) KeyMap() *KeyMap {
+	return namespaceListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *NamespaceListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *NamespaceListController) Modal() bool {
+	return c.namespaceView.ColumnChooser() != nil
+}
+
+// describeSelectedNamespace pushes the describe view for the selected namespace
+func (c *NamespaceListController) describeSelectedNamespace() tea.Cmd {
+	selectedNamespace := c.namespaceView.GetSelected()
+	if selectedNamespace == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeNamespaceController(c.clientset, c.theme, selectedNamespace.Name)
+	return PushView(describeCtrl, selectedNamespace.Name)
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *NamespaceListController) ActionText() string {
+	return "Listing namespaces"
+}
+
+// Render returns the rendered namespace list view
+func (c *NamespaceListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.namespaceView.SetSize(width, height)
+	return c.namespaceView.Render()
+}
+
+// refreshNamespaces lists namespaces and their usage again off the update loop
+func (c *NamespaceListController) refreshNamespaces() tea.Cmd {
+	clientset := c.clientset
+	return tea.Batch(c.watches.refresh(), func() tea.Msg {
+		return summariseNamespaces(clientset)
+	})
+}
+
+// summariseNamespaces collects the usage of every namespace
+func summariseNamespaces(clientset *kubernetes.Clientset) namespacesSummarisedMsg {
+	usage, err := models.GetNamespaceUsage(clientset, "")
+	return namespacesSummarisedMsg{usage: usage, err: err}
+}
+
+// GetNamespaces returns the current list of namespaces
+func (c *NamespaceListController) GetNamespaces() []models.Namespace {
+	return c.getNamespacesList()
+}
+
+// GetUpdateChannel returns the channel carrying namespace watch events
+func (c *NamespaceListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *NamespaceListController) Stop() {
+	c.watches.stop()
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type NamespaceListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *NamespaceListController
+	msg        tea.Msg
+}
+
+func NewNamespaceListControllerScenario(t *testing.T) *NamespaceListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &NamespaceListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *NamespaceListControllerScenario) Given() *NamespaceListControllerScenario {
+	return s
+}
+func (s *NamespaceListControllerScenario) When() *NamespaceListControllerScenario {
+	return s
+}
+func (s *NamespaceListControllerScenario) Then() *NamespaceListControllerScenario {
+	return s
+}
+func (s *NamespaceListControllerScenario) and() *NamespaceListControllerScenario {
+	return s
+}
+
+func (s *NamespaceListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *NamespaceListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *NamespaceListControllerScenario) the_namespace_list_controller_is_instantiated() *NamespaceListControllerScenario {
+	s.controller = NewNamespaceListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster", "")
+	return s
+}
+
+func (s *NamespaceListControllerScenario) the_user_selects_namespace(name string) *NamespaceListControllerScenario {
+	for _, namespace := range s.controller.GetNamespaces() {
+		if namespace.Name == name {
+			return s
+		}
+		s.controller.HandleKey(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	s.t.Fatalf("namespace %s not listed", name)
+	return s
+}
+
+func (s *NamespaceListControllerScenario) the_user_presses(msg tea.KeyMsg) *NamespaceListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to issue a command", msg.String())
+	}
+	s.msg = cmd()
+	return s
+}
+
+func (s *NamespaceListControllerScenario) the_namespace_list_should_be(assertFn func([]models.Namespace)) *NamespaceListControllerScenario {
+	assertFn(s.controller.GetNamespaces())
+	return s
+}
+
+func (s *NamespaceListControllerScenario) the_issued_message_should_be(assertFn func(tea.Msg)) *NamespaceListControllerScenario {
+	assertFn(s.msg)
+	return s
+}
+
+func (s *NamespaceListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if pushed, ok := s.msg.(PushViewMsg); ok {
+		if stoppable, ok := pushed.Controller.(StoppableController); ok {
+			stoppable.Stop()
+		}
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// findNamespace returns the named namespace from a list, or nil when it is not listed
+func findNamespace(namespaces []models.Namespace, name string) *models.Namespace {
+	for i := range namespaces {
+		if namespaces[i].Name == name {
+			return &namespaces[i]
+		}
+	}
+	return nil
+}
+
+func TestNamespaceListController(t *testing.T) {
+	t.Run("should_list_namespaces_with_pod_counts_by_phase_and_quota_usage", func(t *testing.T) {
+		s := NewNamespaceListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPodInPhase("web-1", "apps", corev1.PodRunning).
+					WithPodInPhase("web-2", "apps", corev1.PodRunning).
+					WithPodInPhase("migrate", "apps", corev1.PodFailed).
+					WithPodInPhase("batch", "apps", corev1.PodPending).
+					WithResourceQuota("compute", "apps", map[string]string{"pods": "10", "requests.cpu": "4"}, map[string]string{"pods": "4", "requests.cpu": "3800m"}).
+					WithNamespace("empty")
+			}).
+			When().
+			the_namespace_list_controller_is_instantiated().
+			Then().
+			the_namespace_list_should_be(func(namespaces []models.Namespace) {
+				apps := findNamespace(namespaces, "apps")
+				if assert.NotNil(t, apps) {
+					assert.Equal(t, "Active", apps.Status)
+					assert.Equal(t, 4, apps.Usage.Pods.Total())
+					assert.Equal(t, 2, apps.Usage.Pods.Running)
+					assert.Equal(t, 1, apps.Usage.Pods.Pending)
+					assert.Equal(t, 1, apps.Usage.Pods.Failed)
+					assert.Len(t, apps.Usage.Quotas, 2)
+					assert.Equal(t, "requests.cpu 3800m/4", apps.FormatQuota())
+					assert.True(t, apps.Usage.TopQuota().IsNearLimit())
+				}
+
+				empty := findNamespace(namespaces, "empty")
+				if assert.NotNil(t, empty) {
+					assert.Equal(t, 0, empty.Usage.Pods.Total())
+					assert.Equal(t, "<none>", empty.FormatQuota())
+				}
+			})
+	})
+
+	t.Run("should_scope_views_to_the_selected_namespace", func(t *testing.T) {
+		s := NewNamespaceListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithNamespace("apps")
+			}).
+			the_namespace_list_controller_is_instantiated().
+			When().
+			the_user_selects_namespace("apps").
+			and().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_issued_message_should_be(func(msg tea.Msg) {
+				assert.Equal(t, SetNamespaceMsg{Namespace: "apps"}, msg)
+			})
+	})
+
+	t.Run("should_scope_views_to_all_namespaces", func(t *testing.T) {
+		s := NewNamespaceListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			the_namespace_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}}).
+			Then().
+			the_issued_message_should_be(func(msg tea.Msg) {
+				assert.Equal(t, SetNamespaceMsg{Namespace: ""}, msg)
+			})
+	})
+
+	t.Run("should_describe_the_selected_namespace", func(t *testing.T) {
+		s := NewNamespaceListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithNamespace("apps")
+			}).
+			the_namespace_list_controller_is_instantiated().
+			When().
+			the_user_selects_namespace("apps").
+			and().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}}).
+			Then().
+			the_issued_message_should_be(func(msg tea.Msg) {
+				pushed, ok := msg.(PushViewMsg)
+				if assert.True(t, ok) {
+					assert.Equal(t, "apps", pushed.Title)
+					_, ok := pushed.Controller.(*DescribeNamespaceController)
+					assert.True(t, ok)
+				}
+			})
+	})
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// PushViewMsg asks the App to push a child controller on top of the navigation stack
+type PushViewMsg struct {
+	Controller Controller
+	// Title is the breadcrumb shown in the header for the pushed controller
+	Title string
+}
+
+// PopViewMsg asks the App to pop the current controller off the navigation stack
+type PopViewMsg struct{}
+
+// PushView returns a command that pushes controller onto the navigation stack under the given breadcrumb title
+func PushView(controller Controller, title string) tea.Cmd {
+	return func() tea.Msg {
+		return PushViewMsg{Controller: controller, Title: title}
+	}
+}
+
+// PopView returns a command that pops the current controller off the navigation stack
+func PopView() tea.Cmd {
+	return func() tea.Msg {
+		return PopViewMsg{}
+	}
+}
+
+// SetNamespaceMsg asks the App to scope the resource views to a namespace, empty for all namespaces
+type SetNamespaceMsg struct {
+	Namespace string
+}
+
+// SetNamespace returns a command that scopes the resource views to namespace, empty for all namespaces
+func SetNamespace(namespace string) tea.Cmd {
+	return func() tea.Msg {
+		return SetNamespaceMsg{Namespace: namespace}
+	}
+}
+
+// navigationEntry is a controller on the navigation stack together with its breadcrumb title
+type navigationEntry struct {
+	controller Controller
+	title      string
+}
+
+// NavigationStack tracks the chain of controllers the user has drilled down through.
+// The bottom entry is the root resource view and is never popped.
+type NavigationStack struct {
+	entries []navigationEntry
+}
+
+// NewNavigationStack creates an empty navigation stack
+func NewNavigationStack() *NavigationStack {
+	return &NavigationStack{}
+}
+
+// Reset replaces the whole stack with a single root controller and returns the controllers that were discarded above the old root
+func (s *NavigationStack) Reset(root Controller, title string) []Controller {
+	var discarded []Controller
+	for i := len(s.entries) - 1; i > 0; i-- {
+		discarded = append(discarded, s.entries[i].controller)
+	}
+	s.entries = []navigationEntry{{controller: root, title: title}}
+	return discarded
+}
+
+// Push adds a child controller on top of the stack
+func (s *NavigationStack) Push(controller Controller, title string) {
+	s.entries = append(s.entries, navigationEntry{controller: controller, title: title})
+}
+
+// Pop removes the top controller and returns it. The root controller is never popped.
+func (s *NavigationStack) Pop() (Controller, bool) {
+	if len(s.entries) <= 1 {
+		return nil, false
+	}
+	top := s.entries[len(s.entries)-1]
+	s.entries = s.entries[:len(s.entries)-1]
+	return top.controller, true
+}
+
+// Current returns the controller on top of the stack, or nil if the stack is empty
+func (s *NavigationStack) Current() Controller {
+	if len(s.entries) == 0 {
+		return nil
+	}
+	return s.entries[len(s.entries)-1].controller
+}
+
+// Controllers returns the controllers on the stack from root to top
+func (s *NavigationStack) Controllers() []Controller {
+	controllers := make([]Controller, 0, len(s.entries))
+	for _, entry := range s.entries {
+		controllers = append(controllers, entry.controller)
+	}
+	return controllers
+}
+
+// Depth returns the number of controllers on the stack
+func (s *NavigationStack) Depth() int {
+	return len(s.entries)
+}
+
+// Breadcrumbs returns the titles of the controllers on the stack from root to top
+func (s *NavigationStack) Breadcrumbs() []string {
+	breadcrumbs := make([]string, 0, len(s.entries))
+	for _, entry := range s.entries {
+		breadcrumbs = append(breadcrumbs, entry.title)
+	}
+	return breadcrumbs
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stubController is a minimal Controller used to exercise the navigation stack
+type stubController struct {
+	name     string
+	selected int
+}
+
+func (c *stubController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if msg.String() == "down" {
+		c.selected++
+	}
+	return nil
+}
+func (c *stubController) Update(msg tea.Msg) tea.Cmd      { return nil }
+func (c *stubController) Render(width, height int) string { return c.name }
+func (c *stubController) ActionText() string              { return c.name }
+
+type NavigationStackScenario struct {
+	t           *testing.T
+	stack       *NavigationStack
+	controllers map[string]*stubController
+	popped      Controller
+	discarded   []Controller
+}
+
+func NewNavigationStackScenario(t *testing.T) *NavigationStackScenario {
+	return &NavigationStackScenario{
+		t:           t,
+		stack:       NewNavigationStack(),
+		controllers: make(map[string]*stubController),
+	}
+}
+
+func (s *NavigationStackScenario) Given() *NavigationStackScenario { return s }
+func (s *NavigationStackScenario) When() *NavigationStackScenario  { return s }
+func (s *NavigationStackScenario) Then() *NavigationStackScenario  { return s }
+func (s *NavigationStackScenario) and() *NavigationStackScenario   { return s }
+
+func (s *NavigationStackScenario) controller(name string) *stubController {
+	if c, ok := s.controllers[name]; ok {
+		return c
+	}
+	c := &stubController{name: name}
+	s.controllers[name] = c
+	return c
+}
+
+func (s *NavigationStackScenario) the_root_is(name string) *NavigationStackScenario {
+	s.discarded = s.stack.Reset(s.controller(name), name)
+	return s
+}
+
+func (s *NavigationStackScenario) a_child_is_pushed(name string) *NavigationStackScenario {
+	s.stack.Push(s.controller(name), name)
+	return s
+}
+
+func (s *NavigationStackScenario) the_user_moves_down_in(name string) *NavigationStackScenario {
+	s.controller(name).HandleKey(tea.KeyMsg{Type: tea.KeyDown})
+	return s
+}
+
+func (s *NavigationStackScenario) the_stack_is_popped() *NavigationStackScenario {
+	s.popped, _ = s.stack.Pop()
+	return s
+}
+
+func (s *NavigationStackScenario) the_breadcrumbs_should_be(assertFn func([]string)) *NavigationStackScenario {
+	assertFn(s.stack.Breadcrumbs())
+	return s
+}
+
+func (s *NavigationStackScenario) the_current_controller_should_be(assertFn func(Controller)) *NavigationStackScenario {
+	assertFn(s.stack.Current())
+	return s
+}
+
+func (s *NavigationStackScenario) the_popped_controller_should_be(assertFn func(Controller)) *NavigationStackScenario {
+	assertFn(s.popped)
+	return s
+}
+
+func (s *NavigationStackScenario) the_discarded_controllers_should_be(assertFn func([]Controller)) *NavigationStackScenario {
+	assertFn(s.discarded)
+	return s
+}
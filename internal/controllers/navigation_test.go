@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavigationStack(t *testing.T) {
+	t.Run("should_build_breadcrumbs_from_root_to_top", func(t *testing.T) {
+		s := NewNavigationStackScenario(t)
+		s.Given().
+			the_root_is("deployments").
+			When().
+			a_child_is_pushed("default/web").
+			a_child_is_pushed("default/web-abc").
+			a_child_is_pushed("logs").
+			Then().
+			the_breadcrumbs_should_be(func(breadcrumbs []string) {
+				assert.Equal(t, []string{"deployments", "default/web", "default/web-abc", "logs"}, breadcrumbs)
+			})
+	})
+
+	t.Run("should_pop_one_level_and_preserve_parent_state", func(t *testing.T) {
+		s := NewNavigationStackScenario(t)
+		s.Given().
+			the_root_is("pods").
+			the_user_moves_down_in("pods").
+			the_user_moves_down_in("pods").
+			a_child_is_pushed("default/web").
+			When().
+			the_stack_is_popped().
+			Then().
+			the_popped_controller_should_be(func(c Controller) {
+				assert.Equal(t, "default/web", c.ActionText())
+			}).
+			the_current_controller_should_be(func(c Controller) {
+				assert.Equal(t, "pods", c.ActionText())
+				assert.Equal(t, 2, c.(*stubController).selected)
+			})
+	})
+
+	t.Run("should_never_pop_the_root", func(t *testing.T) {
+		s := NewNavigationStackScenario(t)
+		s.Given().
+			the_root_is("pods").
+			When().
+			the_stack_is_popped().
+			Then().
+			the_popped_controller_should_be(func(c Controller) {
+				assert.Nil(t, c)
+			}).
+			the_breadcrumbs_should_be(func(breadcrumbs []string) {
+				assert.Equal(t, []string{"pods"}, breadcrumbs)
+			})
+	})
+
+	t.Run("should_discard_children_when_root_is_reset", func(t *testing.T) {
+		s := NewNavigationStackScenario(t)
+		s.Given().
+			the_root_is("pods").
+			a_child_is_pushed("default/web").
+			a_child_is_pushed("logs").
+			When().
+			the_root_is("deployments").
+			Then().
+			the_discarded_controllers_should_be(func(discarded []Controller) {
+				assert.Len(t, discarded, 2)
+				assert.Equal(t, "logs", discarded[0].ActionText())
+				assert.Equal(t, "default/web", discarded[1].ActionText())
+			}).
+			and().
+			the_breadcrumbs_should_be(func(breadcrumbs []string) {
+				assert.Equal(t, []string{"deployments"}, breadcrumbs)
+			})
+	})
+}
@@ -0,0 +1,332 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nodeResource lists and watches nodes for the node list. Their pod counts are filled in by the list.
+var nodeResource = typedResource("nodes", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, _ string) listWatcher[*corev1.NodeList] {
+		return clientset.CoreV1().Nodes()
+	},
+	func(_ string, node *corev1.Node) models.Node {
+		return models.ToNodeModel(*node, 0)
+	})
+
+// NodeListController handles input for the node list view
+type NodeListController struct {
+	nodeView    *views.NodeListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	// readOnly disables cordoning, uncordoning and draining nodes
+	readOnly bool
+	width    int
+	height   int
+
+	// watches lists and watches the nodes, listing again whenever the watch ends, and polls their metrics
+	watches   *clusterWatches[models.Node]
+	podCounts map[string]int // running pods per node as of the last count; node watch events do not recount them, 'r' does
+
+	// Metrics-related fields; metrics is nil when usage columns are disabled
+	metrics          *models.MetricsClient
+	nodeUsage        map[string]models.ResourceUsage // latest sample keyed by node name
+	metricsAvailable bool
+}
+
+// NewNodeListController creates a new node list controller. In read-only mode nodes cannot be cordoned or drained.
+func NewNodeListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, readOnly bool) *NodeListController {
+	return NewNodeListControllerWithMetrics(clientset, models.NewMetricsClient(clientset), theme, clusterName, readOnly)
+}
+
+// NewNodeListControllerWithMetrics creates a node list controller polling usage from metrics.
+// A nil metrics client disables the usage columns.
+func NewNodeListControllerWithMetrics(clientset *kubernetes.Clientset, metrics *models.MetricsClient, theme *theme.Theme, clusterName string, readOnly bool) *NodeListController {
+	controller := &NodeListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		readOnly:    readOnly,
+		watches:     newClusterWatch(clientset, clusterName, "", nodeResource),
+		podCounts:   make(map[string]int),
+		metrics:     metrics,
+	}
+
+	// Count the pods on each node for the initial list
+	if msg := countNodePods(clientset); msg.err != nil {
+		debugLogger.Printf("error counting pods per node: %v", msg.err)
+	} else {
+		controller.podCounts = msg.podCounts
+	}
+
+	// Create the view with initial nodes
+	nodeView := views.NewNodeListView(controller.getNodesList(), theme, clusterName)
+	controller.nodeView = nodeView
+
+	// Take the first metrics sample so usage shows straight away
+	if metrics != nil {
+		controller.applyNodeMetrics(fetchNodeMetrics(context.Background(), metrics))
+	}
+
+	// Start watching for changes, polling metrics alongside
+	if metrics != nil {
+		controller.watches.poll(metricsPollInterval, func(ctx context.Context) tea.Msg {
+			return fetchNodeMetrics(ctx, metrics)
+		})
+	}
+	controller.watches.start()
+
+	return controller
+}
+
+// nodePodsCountedMsg carries the running pods counted on each node to the update loop
+type nodePodsCountedMsg struct {
+	podCounts map[string]int
+	err       error
+}
+
+// Update applies lists, watch events, refresh results and metrics on the update loop
+func (c *NodeListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+		return nil
+	}
+	switch msg := msg.(type) {
+	case nodePodsCountedMsg:
+		if msg.err != nil {
+			debugLogger.Printf("error counting pods per node: %v", msg.err)
+			return nil
+		}
+		c.podCounts = msg.podCounts
+		c.updateView()
+	case nodeCordonedMsg:
+		if msg.err != nil {
+			debugLogger.Printf("error patching node %s: %v", msg.nodeName, msg.err)
+		}
+		c.nodeView.SetStatusMessage(cordonStatusMessage(msg))
+	case nodeMetricsMsg:
+		c.applyNodeMetrics(msg)
+	}
+	return nil
+}
+
+// applyNodeMetrics records a metrics poll, hiding the usage columns when metrics-server is absent
+func (c *NodeListController) applyNodeMetrics(msg nodeMetricsMsg) {
+	available, apply := metricsAvailability(msg.err)
+	if !apply {
+		return
+	}
+	c.metricsAvailable = available
+	c.nodeUsage = msg.usage
+	c.nodeView.SetMetricsAvailable(available)
+	c.updateView()
+}
+
+// updateView updates the node list view with current nodes
+func (c *NodeListController) updateView() {
+	c.nodeView.UpdateNodes(c.getNodesList())
+}
+
+// getNodesList returns the current nodes as a slice in consistent order, with their pod counts and latest usage
+func (c *NodeListController) getNodesList() []models.Node {
+	nodes := c.watches.list()
+	for i := range nodes {
+		nodes[i].Pods = c.podCounts[nodes[i].Name]
+		if usage, ok := c.nodeUsage[nodes[i].Name]; ok {
+			nodes[i].Usage = &usage
+		}
+	}
+	return nodes
+}
+
+// nodeListKeys are the key bindings of the node list
+var nodeListKeys = registerKeyMap("nodes", "Node list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected node"},
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods on the selected node"},
+	Binding{Action: ActionCordon, Keys: []string{"c"}, Help: "Cordon the selected node"},
+	Binding{Action: ActionUncordon, Keys: []string{"u"}, Help: "Uncordon the selected node"},
+	Binding{Action: ActionDrain, Keys: []string{"D"}, Help: "Drain the selected node"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the node list view
+func (c *NodeListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.nodeView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.nodeView, msg)
+	}
+
+	switch nodeListKeys.Action(msg) {
+	case ActionUp:
+		c.nodeView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.nodeView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedNode()
+	case ActionPods:
+		return c.openSelectedNodePods()
+	case ActionCordon:
+		return c.setSelectedNodeUnschedulable(true)
+	case ActionUncordon:
+		return c.setSelectedNodeUnschedulable(false)
+	case ActionDrain:
+		return c.drainSelectedNode()
+	case ActionRefresh:
+		// Refresh nodes and pod counts
+		return c.refreshNodes()
+	case ActionColumns:
+		return openColumnChooser(c.nodeView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the node list, which the help overlay lists
+func (c *NodeListController) KeyMap() *KeyMap {
+	return nodeListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *NodeListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *NodeListController) Modal() bool {
+	return c.nodeView.ColumnChooser() != nil
+}
+
+// nodeCordonedMsg carries the result of cordoning or uncordoning a node to the update loop
+type nodeCordonedMsg struct {
+	nodeName      string
+	unschedulable bool
+	err           error
+}
+
+// setSelectedNodeUnschedulable cordons or uncordons the selected node off the update loop.
+// The node watch picks up the change, so the result message only reports the outcome.
+func (c *NodeListController) setSelectedNodeUnschedulable(unschedulable bool) tea.Cmd {
+	if c.readOnly {
+		c.nodeView.SetStatusMessage("Cordoning nodes is disabled in read-only mode")
+		return nil
+	}
+	selectedNode := c.nodeView.GetSelected()
+	if selectedNode == nil {
+		return nil
+	}
+	return setNodeUnschedulable(c.clientset, selectedNode.Name, unschedulable)
+}
+
+// setNodeUnschedulable returns a command that patches spec.unschedulable and reports a nodeCordonedMsg
+func setNodeUnschedulable(clientset *kubernetes.Clientset, nodeName string, unschedulable bool) tea.Cmd {
+	return func() tea.Msg {
+		err := models.SetNodeUnschedulable(clientset, nodeName, unschedulable)
+		return nodeCordonedMsg{nodeName: nodeName, unschedulable: unschedulable, err: err}
+	}
+}
+
+// cordonStatusMessage describes the outcome of a cordon or uncordon for the status bar
+func cordonStatusMessage(msg nodeCordonedMsg) string {
+	action := "cordoned"
+	if !msg.unschedulable {
+		action = "uncordoned"
+	}
+	if msg.err != nil {
+		return fmt.Sprintf("Node %s could not be %s: %v", msg.nodeName, action, msg.err)
+	}
+	return fmt.Sprintf("Node %s %s", msg.nodeName, action)
+}
+
+// drainSelectedNode pushes the drain view for the selected node
+func (c *NodeListController) drainSelectedNode() tea.Cmd {
+	if c.readOnly {
+		c.nodeView.SetStatusMessage("Draining nodes is disabled in read-only mode")
+		return nil
+	}
+	selectedNode := c.nodeView.GetSelected()
+	if selectedNode == nil {
+		return nil
+	}
+	return PushView(NewDrainNodeController(c.clientset, c.theme, selectedNode.Name), selectedNode.Name+" drain")
+}
+
+// describeSelectedNode pushes the describe view for the selected node
+func (c *NodeListController) describeSelectedNode() tea.Cmd {
+	selectedNode := c.nodeView.GetSelected()
+	if selectedNode == nil {
+		return nil
+	}
+	return PushView(NewDescribeNodeController(c.clientset, c.theme, selectedNode.Name, c.readOnly), selectedNode.Name)
+}
+
+// openSelectedNodePods pushes a pod list scoped to the pods scheduled on the selected node
+func (c *NodeListController) openSelectedNodePods() tea.Cmd {
+	selectedNode := c.nodeView.GetSelected()
+	if selectedNode == nil {
+		return nil
+	}
+	return PushView(newNodePodListController(c.clientset, c.theme, c.clusterName, selectedNode.Name), selectedNode.Name+" pods")
+}
+
+// newNodePodListController creates a pod list scoped to the pods scheduled on a node
+func newNodePodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, nodeName string) *PodListController {
+	return NewScopedPodListController(clientset, theme, clusterName, PodListScope{
+		FieldSelector: models.NodePodsFieldSelector(nodeName),
+		Description:   "node " + nodeName,
+	})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *NodeListController) ActionText() string {
+	return "Listing nodes"
+}
+
+// Render returns the rendered node list view
+func (c *NodeListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.nodeView.SetSize(width, height)
+	return c.nodeView.Render()
+}
+
+// refreshNodes lists nodes and counts their pods again off the update loop
+func (c *NodeListController) refreshNodes() tea.Cmd {
+	clientset := c.clientset
+	return tea.Batch(c.watches.refresh(), func() tea.Msg {
+		return countNodePods(clientset)
+	})
+}
+
+// countNodePods counts the running pods on each node
+func countNodePods(clientset *kubernetes.Clientset) nodePodsCountedMsg {
+	podCounts, err := models.CountPodsByNode(clientset)
+	return nodePodsCountedMsg{podCounts: podCounts, err: err}
+}
+
+// GetNodes returns the current list of nodes
+func (c *NodeListController) GetNodes() []models.Node {
+	return c.getNodesList()
+}
+
+// GetUpdateChannel returns the channel carrying node watch events
+func (c *NodeListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *NodeListController) Stop() {
+	c.watches.stop()
+}
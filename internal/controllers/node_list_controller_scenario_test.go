@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NodeListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *NodeListController
+	pushed     PushViewMsg
+}
+
+func NewNodeListControllerScenario(t *testing.T) *NodeListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &NodeListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *NodeListControllerScenario) Given() *NodeListControllerScenario { return s }
+func (s *NodeListControllerScenario) When() *NodeListControllerScenario  { return s }
+func (s *NodeListControllerScenario) Then() *NodeListControllerScenario  { return s }
+func (s *NodeListControllerScenario) and() *NodeListControllerScenario   { return s }
+
+func (s *NodeListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *NodeListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *NodeListControllerScenario) the_node_list_controller_is_instantiated() *NodeListControllerScenario {
+	theme := theme.NewDefaultTheme()
+	s.controller = NewNodeListController(s.builder.GetClientset(), theme, "test-cluster", false)
+	return s
+}
+
+func (s *NodeListControllerScenario) the_node_list_controller_is_instantiated_with_metrics_from(standIn *MetricsServerStandIn) *NodeListControllerScenario {
+	s.controller = NewNodeListControllerWithMetrics(s.builder.GetClientset(), standIn.Client(), theme.NewDefaultTheme(), "test-cluster", false)
+	return s
+}
+
+func (s *NodeListControllerScenario) the_usage_columns_should_be_shown(shown bool) *NodeListControllerScenario {
+	if s.controller.nodeView.MetricsAvailable() != shown {
+		s.t.Errorf("expected usage columns shown to be %t", shown)
+	}
+	return s
+}
+
+func (s *NodeListControllerScenario) the_user_presses(msg tea.KeyMsg) *NodeListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+// the_user_runs presses a key whose command acts on the cluster and applies its result, as the App update loop would
+func (s *NodeListControllerScenario) the_user_runs(msg tea.KeyMsg) *NodeListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to return a command", msg.String())
+	}
+	s.controller.Update(cmd())
+	return s
+}
+
+func (s *NodeListControllerScenario) the_node_should_be_unschedulable(nodeName string, unschedulable bool) *NodeListControllerScenario {
+	node, err := s.builder.GetClientset().CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		s.t.Fatalf("could not get node %s: %v", nodeName, err)
+	}
+	if node.Spec.Unschedulable != unschedulable {
+		s.t.Errorf("expected node %s unschedulable to be %t", nodeName, unschedulable)
+	}
+	return s
+}
+
+func (s *NodeListControllerScenario) the_status_message_should_be(expected string) *NodeListControllerScenario {
+	if actual := s.controller.nodeView.StatusMessage(); actual != expected {
+		s.t.Errorf("expected status message %q, got %q", expected, actual)
+	}
+	return s
+}
+
+func (s *NodeListControllerScenario) the_node_list_should_be(assertFn func([]models.Node)) *NodeListControllerScenario {
+	assertFn(s.controller.GetNodes())
+	return s
+}
+
+// the_described_node_should_be checks the node as the describe node view fetches it
+func (s *NodeListControllerScenario) the_described_node_should_be(name string, assertFn func(*models.Node)) *NodeListControllerScenario {
+	node, err := models.GetNode(s.builder.GetClientset(), name)
+	if err != nil {
+		s.t.Fatalf("could not get node %s: %v", name, err)
+	}
+	assertFn(node)
+	return s
+}
+
+func (s *NodeListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *NodeListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *NodeListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNodeListController(t *testing.T) {
+	t.Run("should_list_nodes_with_roles", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithControlPlaneNodes(1).WithWorkerNodes(1)
+			}).
+			When().
+			the_node_list_controller_is_instantiated().
+			Then().
+			the_node_list_should_be(func(nodes []models.Node) {
+				assert.Len(t, nodes, 2)
+				assert.Equal(t, "control-plane-node-1", nodes[0].Name)
+				assert.Equal(t, "control-plane", nodes[0].DisplayRoles())
+				assert.Equal(t, "worker-node-1", nodes[1].Name)
+				assert.Equal(t, "<none>", nodes[1].DisplayRoles())
+			})
+	})
+
+	t.Run("should_count_pods_scheduled_on_each_node", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(2).
+					WithPodOnNode("pod-a", "ns1", "worker-node-1").
+					WithPodOnNode("pod-b", "ns2", "worker-node-1").
+					WithPodOnNode("pod-c", "ns1", "worker-node-2")
+			}).
+			When().
+			the_node_list_controller_is_instantiated().
+			Then().
+			the_node_list_should_be(func(nodes []models.Node) {
+				assert.Len(t, nodes, 2)
+				assert.Equal(t, 2, nodes[0].Pods)
+				assert.Equal(t, 1, nodes[1].Pods)
+			})
+	})
+
+	t.Run("should_not_count_finished_pods", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithPodOnNode("web", "ns1", "worker-node-1").
+					WithPodOnNodeInPhase("migrate", "ns1", "worker-node-1", corev1.PodSucceeded).
+					WithPodOnNodeInPhase("crashed", "ns1", "worker-node-1", corev1.PodFailed)
+			}).
+			When().
+			the_node_list_controller_is_instantiated().
+			Then().
+			the_node_list_should_be(func(nodes []models.Node) {
+				assert.Len(t, nodes, 1)
+				assert.Equal(t, 1, nodes[0].Pods)
+			}).
+			and().
+			the_described_node_should_be("worker-node-1", func(node *models.Node) {
+				assert.Equal(t, 1, node.Pods, "the describe view should count the same pods as the list")
+			})
+	})
+
+	t.Run("should_show_not_ready_and_pressure_conditions", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1).
+					WithNodeConditions("worker-node-1",
+						corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Reason: "KubeletNotReady"},
+						corev1.NodeCondition{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue},
+						corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse},
+					)
+			}).
+			When().
+			the_node_list_controller_is_instantiated().
+			Then().
+			the_node_list_should_be(func(nodes []models.Node) {
+				assert.Len(t, nodes, 1)
+				assert.Equal(t, "NotReady", nodes[0].Status)
+				assert.Equal(t, []string{"MemoryPressure"}, nodes[0].Pressure)
+			})
+	})
+
+	t.Run("should_drill_down_to_the_pods_on_the_selected_node", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(2).
+					WithPodOnNode("pod-a", "ns1", "worker-node-1").
+					WithPodOnNode("pod-b", "ns2", "worker-node-1").
+					WithPodOnNode("pod-c", "ns1", "worker-node-2")
+			}).
+			the_node_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "worker-node-1 pods", pushed.Title)
+				podList, ok := pushed.Controller.(*PodListController)
+				if assert.True(t, ok) {
+					var names []string
+					for _, pod := range podList.GetPods() {
+						names = append(names, pod.Name)
+					}
+					assert.ElementsMatch(t, []string{"pod-a", "pod-b"}, names)
+				}
+			})
+	})
+
+	t.Run("should_cordon_and_uncordon_the_selected_node", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1)
+			}).
+			the_node_list_controller_is_instantiated().
+			When().
+			the_user_runs(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")}).
+			Then().
+			the_node_should_be_unschedulable("worker-node-1", true).
+			and().
+			the_status_message_should_be("Node worker-node-1 cordoned").
+			When().
+			the_user_runs(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")}).
+			Then().
+			the_node_should_be_unschedulable("worker-node-1", false).
+			and().
+			the_status_message_should_be("Node worker-node-1 uncordoned")
+	})
+
+	t.Run("should_open_the_drain_view_for_the_selected_node", func(t *testing.T) {
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1)
+			}).
+			the_node_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "worker-node-1 drain", pushed.Title)
+				_, ok := pushed.Controller.(*DrainNodeController)
+				assert.True(t, ok)
+			})
+	})
+
+	t.Run("should_show_node_usage_against_allocatable_from_metrics_server", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).WithNodeUsage("worker-node-1", "500m", "1Gi")
+		defer standIn.Close()
+
+		s := NewNodeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithWorkerNodes(1)
+			}).
+			When().
+			the_node_list_controller_is_instantiated_with_metrics_from(standIn).
+			Then().
+			the_usage_columns_should_be_shown(true).
+			and().
+			the_node_list_should_be(func(nodes []models.Node) {
+				assert.Len(t, nodes, 1)
+				if assert.NotNil(t, nodes[0].Usage) {
+					assert.Equal(t, "500m", nodes[0].Usage.FormatCPU())
+					assert.Equal(t, "1.0Gi", nodes[0].Usage.FormatMemory())
+				}
+			})
+	})
+}
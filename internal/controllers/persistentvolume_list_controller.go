@@ -0,0 +1,175 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// persistentVolumeResource lists and watches persistent volumes for the persistent volume list
+var persistentVolumeResource = typedResource("persistentvolumes", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, _ string) listWatcher[*corev1.PersistentVolumeList] {
+		return clientset.CoreV1().PersistentVolumes()
+	},
+	func(_ string, persistentVolume *corev1.PersistentVolume) models.PersistentVolume {
+		return models.ToPersistentVolumeModel(*persistentVolume)
+	})
+
+// PersistentVolumeListController handles input for the persistent volume list view
+type PersistentVolumeListController struct {
+	persistentVolumeView *views.PersistentVolumeListView
+	clientset            *kubernetes.Clientset
+	theme                *theme.Theme
+	clusterName          string
+	width                int
+	height               int
+
+	// watches lists and watches the persistent volumes, listing again whenever the watch ends
+	watches *clusterWatches[models.PersistentVolume]
+}
+
+// NewPersistentVolumeListController creates a new persistent volume list controller
+func NewPersistentVolumeListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *PersistentVolumeListController {
+	controller := &PersistentVolumeListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, "", persistentVolumeResource),
+	}
+
+	// Create the view with initial persistent volumes
+	persistentVolumeView := views.NewPersistentVolumeListView(controller.getPersistentVolumesList(), theme, clusterName)
+	controller.persistentVolumeView = persistentVolumeView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *PersistentVolumeListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the persistent volume list view with current persistent volumes
+func (c *PersistentVolumeListController) updateView() {
+	c.persistentVolumeView.UpdatePersistentVolumes(c.getPersistentVolumesList())
+}
+
+// getPersistentVolumesList returns the current persistent volumes as a slice in consistent order
+func (c *PersistentVolumeListController) getPersistentVolumesList() []models.PersistentVolume {
+	return c.watches.list()
+}
+
+// persistentVolumeListKeys are the key bindings of the persistent volume list
+var persistentVolumeListKeys = registerKeyMap("pv", "Persistent volume list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected volume"},
+	Binding{Action: ActionClaim, Keys: []string{"c"}, Help: "Describe the claim bound to the selected volume"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the persistent volume list view
+func (c *PersistentVolumeListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.persistentVolumeView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.persistentVolumeView, msg)
+	}
+
+	switch persistentVolumeListKeys.Action(msg) {
+	case ActionUp:
+		c.persistentVolumeView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.persistentVolumeView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedPersistentVolume()
+	case ActionClaim:
+		return c.describeSelectedPersistentVolumeClaim()
+	case ActionRefresh:
+		// Refresh persistent volumes
+		return c.refreshPersistentVolumes()
+	case ActionColumns:
+		return openColumnChooser(c.persistentVolumeView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the persistent volume list, which the help overlay lists
+func (c *PersistentVolumeListController) KeyMap() *KeyMap {
+	return persistentVolumeListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *PersistentVolumeListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *PersistentVolumeListController) Modal() bool {
+	return c.persistentVolumeView.ColumnChooser() != nil
+}
+
+// describeSelectedPersistentVolume pushes the describe view for the selected persistent volume
+func (c *PersistentVolumeListController) describeSelectedPersistentVolume() tea.Cmd {
+	selectedPersistentVolume := c.persistentVolumeView.GetSelected()
+	if selectedPersistentVolume == nil {
+		return nil
+	}
+	describeCtrl := NewDescribePersistentVolumeController(c.clientset, c.theme, selectedPersistentVolume.Name)
+	return PushView(describeCtrl, selectedPersistentVolume.Name)
+}
+
+// describeSelectedPersistentVolumeClaim pushes the describe view for the claim the selected persistent volume is bound to
+func (c *PersistentVolumeListController) describeSelectedPersistentVolumeClaim() tea.Cmd {
+	selectedPersistentVolume := c.persistentVolumeView.GetSelected()
+	if selectedPersistentVolume == nil || selectedPersistentVolume.ClaimName == "" {
+		return nil
+	}
+	describeCtrl := NewDescribePersistentVolumeClaimController(c.clientset, c.theme, selectedPersistentVolume.ClaimName, selectedPersistentVolume.ClaimNamespace)
+	return PushView(describeCtrl, selectedPersistentVolume.FormatClaim())
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *PersistentVolumeListController) ActionText() string {
+	return "Listing persistent volumes"
+}
+
+// Render returns the rendered persistent volume list view
+func (c *PersistentVolumeListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.persistentVolumeView.SetSize(width, height)
+	return c.persistentVolumeView.Render()
+}
+
+// refreshPersistentVolumes lists persistent volumes again off the update loop, replacing those listed before
+func (c *PersistentVolumeListController) refreshPersistentVolumes() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetPersistentVolumes returns the current list of persistent volumes
+func (c *PersistentVolumeListController) GetPersistentVolumes() []models.PersistentVolume {
+	return c.getPersistentVolumesList()
+}
+
+// GetUpdateChannel returns the channel carrying persistent volume watch events
+func (c *PersistentVolumeListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *PersistentVolumeListController) Stop() {
+	c.watches.stop()
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type PersistentVolumeListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *PersistentVolumeListController
+	pushed     PushViewMsg
+}
+
+func NewPersistentVolumeListControllerScenario(t *testing.T) *PersistentVolumeListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &PersistentVolumeListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *PersistentVolumeListControllerScenario) Given() *PersistentVolumeListControllerScenario {
+	return s
+}
+func (s *PersistentVolumeListControllerScenario) When() *PersistentVolumeListControllerScenario {
+	return s
+}
+func (s *PersistentVolumeListControllerScenario) Then() *PersistentVolumeListControllerScenario {
+	return s
+}
+func (s *PersistentVolumeListControllerScenario) and() *PersistentVolumeListControllerScenario {
+	return s
+}
+
+func (s *PersistentVolumeListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *PersistentVolumeListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *PersistentVolumeListControllerScenario) the_persistent_volume_list_controller_is_instantiated() *PersistentVolumeListControllerScenario {
+	s.controller = NewPersistentVolumeListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *PersistentVolumeListControllerScenario) the_user_presses(msg tea.KeyMsg) *PersistentVolumeListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *PersistentVolumeListControllerScenario) the_persistent_volume_list_should_be(assertFn func([]models.PersistentVolume)) *PersistentVolumeListControllerScenario {
+	assertFn(s.controller.GetPersistentVolumes())
+	return s
+}
+
+func (s *PersistentVolumeListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *PersistentVolumeListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *PersistentVolumeListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
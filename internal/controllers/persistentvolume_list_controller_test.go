@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentVolumeListController(t *testing.T) {
+	t.Run("should_list_volumes_with_reclaim_policy_and_claim", func(t *testing.T) {
+		s := NewPersistentVolumeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolume("pv-spare", "10Gi", "").
+					WithPersistentVolumeClaim("data", "apps", "pv-data")
+			}).
+			When().
+			the_persistent_volume_list_controller_is_instantiated().
+			Then().
+			the_persistent_volume_list_should_be(func(volumes []models.PersistentVolume) {
+				if assert.Len(t, volumes, 2) {
+					data, spare := volumes[0], volumes[1]
+					assert.Equal(t, "pv-data", data.Name)
+					assert.Equal(t, "Bound", data.Status)
+					assert.Equal(t, "5Gi", data.Capacity)
+					assert.Equal(t, "Retain", data.ReclaimPolicy)
+					assert.Equal(t, "apps/data", data.FormatClaim())
+					assert.Equal(t, "HostPath /data/pv-data", data.Source)
+
+					assert.Equal(t, "pv-spare", spare.Name)
+					assert.Equal(t, "Available", spare.Status)
+					assert.Equal(t, "<none>", spare.FormatClaim())
+					assert.Equal(t, "<none>", spare.FormatStorageClass())
+				}
+			})
+	})
+
+	t.Run("should_navigate_from_a_volume_to_its_bound_claim", func(t *testing.T) {
+		s := NewPersistentVolumeListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data")
+			}).
+			the_persistent_volume_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/data", pushed.Title)
+				_, ok := pushed.Controller.(*DescribePersistentVolumeClaimController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// persistentVolumeClaimResource lists and watches persistent volume claims for the persistent volume claim list
+var persistentVolumeClaimResource = typedResource("persistentvolumeclaims", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*corev1.PersistentVolumeClaimList] {
+		return clientset.CoreV1().PersistentVolumeClaims(namespace)
+	},
+	func(_ string, persistentVolumeClaim *corev1.PersistentVolumeClaim) models.PersistentVolumeClaim {
+		return models.ToPersistentVolumeClaimModel(*persistentVolumeClaim)
+	})
+
+// PersistentVolumeClaimListController handles input for the persistent volume claim list view
+type PersistentVolumeClaimListController struct {
+	persistentVolumeClaimView *views.PersistentVolumeClaimListView
+	clientset                 *kubernetes.Clientset
+	theme                     *theme.Theme
+	clusterName               string
+	width                     int
+	height                    int
+
+	// watches lists and watches the persistent volume claims, listing again whenever the watch ends
+	watches *clusterWatches[models.PersistentVolumeClaim]
+}
+
+// NewPersistentVolumeClaimListController creates a new persistent volume claim list controller
+func NewPersistentVolumeClaimListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *PersistentVolumeClaimListController {
+	return NewNamespacedPersistentVolumeClaimListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedPersistentVolumeClaimListController creates a new persistent volume claim list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedPersistentVolumeClaimListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *PersistentVolumeClaimListController {
+	controller := &PersistentVolumeClaimListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, persistentVolumeClaimResource),
+	}
+
+	// Create the view with initial persistent volume claims
+	persistentVolumeClaimView := views.NewPersistentVolumeClaimListView(controller.getPersistentVolumeClaimsList(), theme, clusterName)
+	controller.persistentVolumeClaimView = persistentVolumeClaimView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *PersistentVolumeClaimListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the persistent volume claim list view with current persistent volume claims
+func (c *PersistentVolumeClaimListController) updateView() {
+	c.persistentVolumeClaimView.UpdatePersistentVolumeClaims(c.getPersistentVolumeClaimsList())
+}
+
+// getPersistentVolumeClaimsList returns the current persistent volume claims as a slice in consistent order
+func (c *PersistentVolumeClaimListController) getPersistentVolumeClaimsList() []models.PersistentVolumeClaim {
+	return c.watches.list()
+}
+
+// persistentVolumeClaimListKeys are the key bindings of the persistent volume claim list
+var persistentVolumeClaimListKeys = registerKeyMap("pvc", "Persistent volume claim list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected claim"},
+	Binding{Action: ActionVolume, Keys: []string{"v"}, Help: "Describe the volume bound to the selected claim"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected claim"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the persistent volume claim list view
+func (c *PersistentVolumeClaimListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.persistentVolumeClaimView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.persistentVolumeClaimView, msg)
+	}
+
+	switch persistentVolumeClaimListKeys.Action(msg) {
+	case ActionUp:
+		c.persistentVolumeClaimView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.persistentVolumeClaimView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedPersistentVolumeClaim()
+	case ActionVolume:
+		return c.describeSelectedPersistentVolume()
+	case ActionXRay:
+		return c.xraySelectedPersistentVolumeClaim()
+	case ActionRefresh:
+		// Refresh persistent volume claims
+		return c.refreshPersistentVolumeClaims()
+	case ActionColumns:
+		return openColumnChooser(c.persistentVolumeClaimView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the persistent volume claim list, which the help overlay lists
+func (c *PersistentVolumeClaimListController) KeyMap() *KeyMap {
+	return persistentVolumeClaimListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *PersistentVolumeClaimListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *PersistentVolumeClaimListController) Modal() bool {
+	return c.persistentVolumeClaimView.ColumnChooser() != nil
+}
+
+// describeSelectedPersistentVolumeClaim pushes the describe view for the selected persistent volume claim
+func (c *PersistentVolumeClaimListController) describeSelectedPersistentVolumeClaim() tea.Cmd {
+	selectedPersistentVolumeClaim := c.persistentVolumeClaimView.GetSelected()
+	if selectedPersistentVolumeClaim == nil {
+		return nil
+	}
+	describeCtrl := NewDescribePersistentVolumeClaimController(c.clientset, c.theme, selectedPersistentVolumeClaim.Name, selectedPersistentVolumeClaim.Namespace)
+	return PushView(describeCtrl, selectedPersistentVolumeClaim.Namespace+"/"+selectedPersistentVolumeClaim.Name)
+}
+
+// describeSelectedPersistentVolume pushes the describe view for the volume the selected claim is bound to
+func (c *PersistentVolumeClaimListController) describeSelectedPersistentVolume() tea.Cmd {
+	selectedPersistentVolumeClaim := c.persistentVolumeClaimView.GetSelected()
+	if selectedPersistentVolumeClaim == nil || selectedPersistentVolumeClaim.Volume == "" {
+		return nil
+	}
+	describeCtrl := NewDescribePersistentVolumeController(c.clientset, c.theme, selectedPersistentVolumeClaim.Volume)
+	return PushView(describeCtrl, selectedPersistentVolumeClaim.Volume)
+}
+
+// xraySelectedPersistentVolumeClaim pushes the x-ray view of the selected claim
+func (c *PersistentVolumeClaimListController) xraySelectedPersistentVolumeClaim() tea.Cmd {
+	selectedPersistentVolumeClaim := c.persistentVolumeClaimView.GetSelected()
+	if selectedPersistentVolumeClaim == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "PersistentVolumeClaim", Namespace: selectedPersistentVolumeClaim.Namespace, Name: selectedPersistentVolumeClaim.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *PersistentVolumeClaimListController) ActionText() string {
+	return "Listing persistent volume claims"
+}
+
+// Render returns the rendered persistent volume claim list view
+func (c *PersistentVolumeClaimListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.persistentVolumeClaimView.SetSize(width, height)
+	return c.persistentVolumeClaimView.Render()
+}
+
+// refreshPersistentVolumeClaims lists persistent volume claims again off the update loop, replacing those listed before
+func (c *PersistentVolumeClaimListController) refreshPersistentVolumeClaims() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetPersistentVolumeClaims returns the current list of persistent volume claims
+func (c *PersistentVolumeClaimListController) GetPersistentVolumeClaims() []models.PersistentVolumeClaim {
+	return c.getPersistentVolumeClaimsList()
+}
+
+// GetUpdateChannel returns the channel carrying persistent volume claim watch events
+func (c *PersistentVolumeClaimListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *PersistentVolumeClaimListController) Stop() {
+	c.watches.stop()
+}
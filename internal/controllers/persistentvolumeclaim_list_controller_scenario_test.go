@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type PersistentVolumeClaimListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *PersistentVolumeClaimListController
+	pushed     PushViewMsg
+}
+
+func NewPersistentVolumeClaimListControllerScenario(t *testing.T) *PersistentVolumeClaimListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &PersistentVolumeClaimListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) Given() *PersistentVolumeClaimListControllerScenario {
+	return s
+}
+func (s *PersistentVolumeClaimListControllerScenario) When() *PersistentVolumeClaimListControllerScenario {
+	return s
+}
+func (s *PersistentVolumeClaimListControllerScenario) Then() *PersistentVolumeClaimListControllerScenario {
+	return s
+}
+func (s *PersistentVolumeClaimListControllerScenario) and() *PersistentVolumeClaimListControllerScenario {
+	return s
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *PersistentVolumeClaimListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) the_persistent_volume_claim_list_controller_is_instantiated() *PersistentVolumeClaimListControllerScenario {
+	s.controller = NewPersistentVolumeClaimListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) the_user_presses(msg tea.KeyMsg) *PersistentVolumeClaimListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) the_persistent_volume_claim_list_should_be(assertFn func([]models.PersistentVolumeClaim)) *PersistentVolumeClaimListControllerScenario {
+	assertFn(s.controller.GetPersistentVolumeClaims())
+	return s
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *PersistentVolumeClaimListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *PersistentVolumeClaimListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
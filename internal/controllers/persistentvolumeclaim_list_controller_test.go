@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistentVolumeClaimListController(t *testing.T) {
+	t.Run("should_list_bound_and_pending_claims", func(t *testing.T) {
+		s := NewPersistentVolumeClaimListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data").
+					WithPersistentVolumeClaim("scratch", "apps", "")
+			}).
+			When().
+			the_persistent_volume_claim_list_controller_is_instantiated().
+			Then().
+			the_persistent_volume_claim_list_should_be(func(claims []models.PersistentVolumeClaim) {
+				if assert.Len(t, claims, 2) {
+					data, scratch := claims[0], claims[1]
+					assert.Equal(t, "data", data.Name)
+					assert.Equal(t, "Bound", data.Status)
+					assert.Equal(t, "pv-data", data.Volume)
+					assert.Equal(t, "5Gi", data.Capacity)
+					assert.Equal(t, "RWO", data.FormatAccessModes())
+
+					assert.Equal(t, "scratch", scratch.Name)
+					assert.Equal(t, "Pending", scratch.Status)
+					assert.True(t, scratch.IsPending())
+					assert.Equal(t, "1Gi (requested)", scratch.FormatCapacity())
+				}
+			})
+	})
+
+	t.Run("should_navigate_from_a_claim_to_its_bound_volume", func(t *testing.T) {
+		s := NewPersistentVolumeClaimListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPersistentVolume("pv-data", "5Gi", "standard").
+					WithPersistentVolumeClaim("data", "apps", "pv-data")
+			}).
+			the_persistent_volume_claim_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "pv-data", pushed.Title)
+				describe, ok := pushed.Controller.(*DescribePersistentVolumeController)
+				if assert.True(t, ok) {
+					assert.Equal(t, "apps/data", describe.GetPersistentVolume().FormatClaim())
+				}
+			})
+	})
+}
@@ -2,13 +2,14 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
+	"sync/atomic"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/kevholditch/vigilant/internal/theme"
-	"github.com/kevholditch/vigilant/internal/utils"
 	"github.com/kevholditch/vigilant/internal/views"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,9 +17,6 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-// UpdateMsg is sent when a controller needs to trigger a re-render
-type UpdateMsg struct{}
-
 var debugLogger *log.Logger
 
 func init() {
@@ -32,146 +30,145 @@ func init() {
 	}
 }
 
+// PodListScope restricts a pod list to a namespace and label selector.
+// The zero value lists pods in all namespaces.
+type PodListScope struct {
+	Namespace     string
+	LabelSelector string
+	FieldSelector string
+	// Description names what the scoped pods belong to, e.g. "deployment default/web"
+	Description string
+}
+
+// listOptions returns the list options selecting the pods in scope
+func (s PodListScope) listOptions() metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: s.LabelSelector, FieldSelector: s.FieldSelector}
+}
+
+// podResource lists and watches the pods in scope for the pod list
+func podResource(scope PodListScope) clusterResource[models.Pod] {
+	return typedResource("pods", scope.listOptions(),
+		func(clientset *kubernetes.Clientset, namespace string) listWatcher[*corev1.PodList] {
+			return clientset.CoreV1().Pods(namespace)
+		},
+		func(_ string, pod *corev1.Pod) models.Pod {
+			return models.ToPodModel(*pod)
+		})
+}
+
 // PodListController handles input for the pod list view
 type PodListController struct {
-	podView       *views.PodListView
-	onDescribePod func(*views.PodListView) tea.Cmd
-	onOpenLogs    func(*views.PodListView) tea.Cmd
-	clientset     *kubernetes.Clientset
-	theme         *theme.Theme
-	clusterName   string
-	width         int
-	height        int
-
-	// Watch-related fields
-	pods            *utils.OrderedMap[models.Pod] // ordered collection of pods
-	watchStarted    bool
-	resourceVersion string // <--- store resource version here
-	needsUpdate     bool   // Flag to indicate if view needs updating
-
-	// Message channel for updates
-	updateChan chan tea.Msg
-
-	ctx    context.Context
-	cancel context.CancelFunc
-}
-
-// NewPodListController creates a new pod list controller
-func NewPodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, onDescribePod func(*views.PodListView) tea.Cmd, onOpenLogs func(*views.PodListView) tea.Cmd) *PodListController {
-	ctx, cancel := context.WithCancel(context.Background())
+	podView     *views.PodListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	scope       PodListScope
+	width       int
+	height      int
+
+	// watches lists and watches the pods in scope, listing again whenever the watch ends, and polls their metrics
+	watches *clusterWatches[models.Pod]
+
+	// Metrics-related fields; metrics is nil when usage columns are disabled
+	metrics          *models.MetricsClient
+	podUsage         map[string]models.PodUsage // latest sample keyed by namespace/name
+	metricsAvailable bool
+	listed           atomic.Int64 // pods in the list, read by the polling goroutine to skip polling an empty list
+}
+
+// NewPodListController creates a new pod list controller listing pods in all namespaces
+func NewPodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *PodListController {
+	return NewScopedPodListController(clientset, theme, clusterName, PodListScope{})
+}
+
+// NewScopedPodListController creates a new pod list controller whose list and watch are restricted to scope
+func NewScopedPodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, scope PodListScope) *PodListController {
+	return NewScopedPodListControllerWithMetrics(clientset, models.NewMetricsClient(clientset), theme, clusterName, scope)
+}
+
+// NewScopedPodListControllerWithMetrics creates a scoped pod list controller polling usage from metrics.
+// A nil metrics client disables the usage columns.
+func NewScopedPodListControllerWithMetrics(clientset *kubernetes.Clientset, metrics *models.MetricsClient, theme *theme.Theme, clusterName string, scope PodListScope) *PodListController {
 	controller := &PodListController{
-		onDescribePod: onDescribePod,
-		onOpenLogs:    onOpenLogs,
-		clientset:     clientset,
-		theme:         theme,
-		clusterName:   clusterName,
-		pods:          utils.NewOrderedMap[models.Pod](),
-		updateChan:    make(chan tea.Msg),
-		ctx:           ctx,
-		cancel:        cancel,
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		scope:       scope,
+		metrics:     metrics,
+		watches:     newClusterWatch(clientset, clusterName, scope.Namespace, podResource(scope)),
 	}
 
-	// Initialize with initial pod list
-	controller.initializePods()
-
 	// Create the view with initial pods
 	podView := views.NewPodListView(controller.getPodsList(), theme, clusterName)
 	controller.podView = podView
 
-	// Start watching for changes
-	controller.startWatch()
+	// Take the first metrics sample so usage shows straight away
+	controller.listed.Store(int64(len(podView.Pods())))
+	if msg, ok := controller.pollMetrics(context.Background()); ok {
+		controller.applyPodMetrics(msg)
+	}
+
+	// Start watching for changes, polling metrics alongside
+	if metrics != nil {
+		controller.watches.poll(metricsPollInterval, func(ctx context.Context) tea.Msg {
+			if msg, ok := controller.pollMetrics(ctx); ok {
+				return msg
+			}
+			return nil
+		})
+	}
+	controller.watches.start()
 
 	return controller
 }
 
-// initializePods fetches initial pods and populates the map
-func (c *PodListController) initializePods() {
-	podList, err := c.clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		debugLogger.Printf("error getting initial pods: %v", err)
-		return
+// Update applies lists, watch events, refresh results and metrics on the update loop
+func (c *PodListController) Update(msg tea.Msg) tea.Cmd {
+	if event, ok := msg.(clusterEventMsg[models.Pod]); ok && event.eventType == watch.Deleted {
+		usageHistory(c.metrics).Forget(event.row.Namespace, event.row.Name)
 	}
-
-	// Clear existing data
-	c.pods.Clear()
-
-	// Add pods in a consistent order (sorted by namespace, then name)
-	for _, k8sPod := range podList.Items {
-		key := k8sPod.Namespace + "/" + k8sPod.Name
-		c.pods.Set(key, models.ToPodModel(k8sPod))
+	if c.watches.update(msg) {
+		c.updateView()
+		return nil
 	}
-
-	c.resourceVersion = podList.ResourceVersion // <--- store resource version
+	switch msg := msg.(type) {
+	case podMetricsMsg:
+		c.applyPodMetrics(msg)
+	}
+	return nil
 }
 
-// startWatch starts watching for pod changes
-func (c *PodListController) startWatch() {
-	if c.watchStarted {
-		return
+// pollMetrics polls the usage of the pods in scope. Nothing is polled, and ok is false, without a metrics client
+// or while the list is empty.
+func (c *PodListController) pollMetrics(ctx context.Context) (msg podMetricsMsg, ok bool) {
+	if c.metrics == nil || c.listed.Load() == 0 {
+		return podMetricsMsg{}, false
 	}
-
-	go func() {
-		c.watchPods()
-	}()
-
-	c.watchStarted = true
+	return fetchPodMetrics(ctx, c.metrics, c.scope.Namespace, c.scope.LabelSelector), true
 }
 
-// watchPods watches for pod changes and updates the local state
-func (c *PodListController) watchPods() {
-	watcher, err := c.clientset.CoreV1().Pods("").Watch(c.ctx, metav1.ListOptions{
-		ResourceVersion: c.resourceVersion,
-	})
-	if err != nil {
-		debugLogger.Printf("error starting pod watch: %v", err)
+// applyPodMetrics records a metrics poll, hiding the usage columns when metrics-server is absent
+func (c *PodListController) applyPodMetrics(msg podMetricsMsg) {
+	available, apply := metricsAvailability(msg.err)
+	if !apply {
 		return
 	}
-	defer watcher.Stop()
-
-	debugLogger.Printf("Started watching pods from resource version: %s", c.resourceVersion)
-
-	for {
-		select {
-		case <-c.ctx.Done():
-			debugLogger.Printf("Pod watch stopped by context cancellation")
-			return
-		case event, ok := <-watcher.ResultChan():
-			if !ok {
-				debugLogger.Printf("Pod watch channel closed")
-				return
-			}
-			pod, ok := event.Object.(*corev1.Pod)
-			if !ok {
-				debugLogger.Printf("unexpected object type in watch event: %T", event.Object)
-				continue
-			}
-
-			key := pod.Namespace + "/" + pod.Name
-
-			switch event.Type {
-			case watch.Added:
-				c.pods.Set(key, models.ToPodModel(*pod))
-				debugLogger.Printf("Pod added: %s", key)
-			case watch.Modified:
-				c.pods.Set(key, models.ToPodModel(*pod))
-				debugLogger.Printf("Pod modified: %s", key)
-			case watch.Deleted:
-				c.pods.Delete(key)
-				debugLogger.Printf("Pod deleted: %s", key)
-			}
-			c.needsUpdate = true
-
-			// Send update message to trigger re-render
-			SendUpdate(c.updateChan)
-			debugLogger.Printf("Sent UpdateMsg for %s event", event.Type)
-		}
-	}
+	c.metricsAvailable = available
+	// The metrics API cannot select pods by field, so a node's pod list is sent the usage of every pod in scope
+	c.podUsage = listedPodUsage(msg.usage, func(key string) bool {
+		_, ok := c.watches.row(c.clusterName, key)
+		return ok
+	})
+	usageHistory(c.metrics).RecordAll(c.podUsage)
+	c.podView.SetMetricsAvailable(available)
+	c.updateView()
 }
 
 // updateView updates the pod list view with current pods
 func (c *PodListController) updateView() {
 	pods := c.getPodsList()
-	debugLogger.Printf("[updateView] Controller pod map count: %d", c.pods.Len())
+	c.listed.Store(int64(len(pods)))
+	debugLogger.Printf("[updateView] Controller pod count: %d", len(pods))
 	if len(pods) > 0 {
 		debugLogger.Printf("[updateView] First 3 pods in controller: %v", podNamesPreview(pods, 3))
 	}
@@ -182,71 +179,161 @@ func (c *PodListController) updateView() {
 	}
 }
 
-// getPodsList returns the current pods as a slice in consistent order
+// getPodsList returns the current pods as a slice in consistent order, with their latest usage
 func (c *PodListController) getPodsList() []models.Pod {
-	return c.pods.Values()
+	pods := c.watches.list()
+	for i := range pods {
+		if usage, ok := c.podUsage[pods[i].Namespace+"/"+pods[i].Name]; ok {
+			total := usage.Total()
+			pods[i].Usage = &total
+		}
+	}
+	return pods
 }
 
+// podListKeys are the key bindings of the pod list
+var podListKeys = registerKeyMap("pods", "Pod list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected pod"},
+	Binding{Action: ActionLogs, Keys: []string{"l"}, Help: "Show the logs of the selected pod"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected pod"},
+	columnsBinding,
+	refreshBinding,
+)
+
 // HandleKey handles key press events for the pod list view
 func (c *PodListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
-	switch msg.String() {
-	case "up", "k":
+	if c.podView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.podView, msg)
+	}
+
+	switch podListKeys.Action(msg) {
+	case ActionUp:
 		c.podView.SelectPrev()
 		return nil
-	case "down", "j":
+	case ActionDown:
 		c.podView.SelectNext()
 		return nil
-	case "d":
-		return c.onDescribePod(c.podView)
-	case "l":
-		return c.onOpenLogs(c.podView)
-	case "r":
+	case ActionDescribe:
+		return c.describeSelectedPod()
+	case ActionLogs:
+		return c.openSelectedPodLogs()
+	case ActionXRay:
+		return c.xraySelectedPod()
+	case ActionRefresh:
 		// Refresh pods data
 		return c.refreshPods()
+	case ActionColumns:
+		return openColumnChooser(c.podView)
 	default:
 		return nil
 	}
 }
 
-// ActionText returns the text to describe the action the controller is performing for the header bar
-func (c *PodListController) ActionText() string {
-	return "Viewing pods"
+// KeyMap returns the key bindings of the pod list, which the help overlay lists
+func (c *PodListController) KeyMap() *KeyMap {
+	return podListKeys
 }
 
-// Render returns the rendered pod list view
-func (c *PodListController) Render(width, height int) string {
-	c.width = width
-	c.height = height
-	c.podView.SetSize(width, height)
+// HelpSections documents the expressions custom columns can be filled from
+func (c *PodListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
 
-	debugLogger.Printf("[Render] Called. needsUpdate: %v", c.needsUpdate)
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *PodListController) Modal() bool {
+	return c.podView.ColumnChooser() != nil
+}
 
-	// Always update the view if needed, regardless of needsUpdate flag
-	if c.needsUpdate {
-		debugLogger.Printf("[Render] needsUpdate is true, calling updateView")
-		c.updateView()
-		c.needsUpdate = false
+// describeSelectedPod pushes the describe view for the selected pod
+func (c *PodListController) describeSelectedPod() tea.Cmd {
+	selectedPod := c.podView.GetSelected()
+	if selectedPod == nil {
+		return nil
 	}
+	describeCtrl := NewDescribePodController(c.clientset, c.theme, selectedPod.Name, selectedPod.Namespace)
+	return PushView(describeCtrl, selectedPod.Namespace+"/"+selectedPod.Name)
+}
 
-	return c.podView.Render()
+// openSelectedPodLogs pushes the logs view for the selected pod
+func (c *PodListController) openSelectedPodLogs() tea.Cmd {
+	selectedPod := c.podView.GetSelected()
+	if selectedPod == nil {
+		return nil
+	}
+	logCtrl := NewPodLogController(NewKubernetesLogFetcher(c.clientset), c.theme, selectedPod.Name, selectedPod.Namespace)
+	return PushView(logCtrl, selectedPod.Namespace+"/"+selectedPod.Name+" logs")
 }
 
-// refreshPods refreshes the pods data by reinitializing and restarting watch
-func (c *PodListController) refreshPods() tea.Cmd {
-	return func() tea.Msg {
-		debugLogger.Printf("Refreshing pods")
+// xraySelectedPod pushes the x-ray view of the selected pod
+func (c *PodListController) xraySelectedPod() tea.Cmd {
+	selectedPod := c.podView.GetSelected()
+	if selectedPod == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "Pod", Namespace: selectedPod.Namespace, Name: selectedPod.Name})
+}
 
-		// Clear current pods
-		c.pods.Clear()
+// Details returns the views of the selected pod a split can show beside the list
+func (c *PodListController) Details() []Action {
+	return podDetails
+}
+
+// Selection identifies the selected pod, or returns "" when there is none
+func (c *PodListController) Selection() string {
+	return podSelection(c.podView.GetSelected())
+}
+
+// Detail creates the describe or logs view of the selected pod, for a split to show beside the list
+func (c *PodListController) Detail(action Action) Controller {
+	return podDetail(c.clientset, c.theme, c.podView.GetSelected(), action)
+}
 
-		// Reinitialize pods
-		c.initializePods()
+// podDetails are the views of a pod a split can show beside a pod list
+var podDetails = []Action{ActionDescribe, ActionLogs}
 
-		// Mark that we need to update the view
-		c.needsUpdate = true
+// podSelection identifies a selected pod by its cluster, namespace and name, or returns "" for no pod
+func podSelection(pod *models.Pod) string {
+	if pod == nil {
+		return ""
+	}
+	return pod.Cluster + "/" + pod.Namespace + "/" + pod.Name
+}
 
+// podDetail creates the describe or logs view of a pod read with clientset, or returns nil for no pod
+func podDetail(clientset *kubernetes.Clientset, theme *theme.Theme, pod *models.Pod, action Action) Controller {
+	if pod == nil {
 		return nil
 	}
+	switch action {
+	case ActionDescribe:
+		return NewDescribePodController(clientset, theme, pod.Name, pod.Namespace)
+	case ActionLogs:
+		return NewPodLogController(NewKubernetesLogFetcher(clientset), theme, pod.Name, pod.Namespace)
+	}
+	return nil
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *PodListController) ActionText() string {
+	if c.scope.Description != "" {
+		return fmt.Sprintf("Viewing pods of %s", c.scope.Description)
+	}
+	return "Viewing pods"
+}
+
+// Render returns the rendered pod list view
+func (c *PodListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.podView.SetSize(width, height)
+	return c.podView.Render()
+}
+
+// refreshPods lists pods again off the update loop, replacing those listed before
+func (c *PodListController) refreshPods() tea.Cmd {
+	return c.watches.refresh()
 }
 
 // GetPods returns the current pods (for testing)
@@ -254,26 +341,28 @@ func (c *PodListController) GetPods() []models.Pod {
 	return c.getPodsList()
 }
 
-// SendUpdate sends an UpdateMsg through the given channel
-func SendUpdate(updateChan chan<- tea.Msg) {
+// updateChannelSize is the buffer size of controller update channels, absorbing bursts of watch events
+const updateChannelSize = 100
+
+// sendMsg delivers msg on ch, blocking until it is received or ctx is cancelled.
+// It returns false if ctx was cancelled before the message could be sent.
+func sendMsg(ctx context.Context, ch chan<- tea.Msg, msg tea.Msg) bool {
 	select {
-	case updateChan <- UpdateMsg{}:
-		// Message sent successfully
-	default:
-		// Channel is full, skip sending message
+	case ch <- msg:
+		return true
+	case <-ctx.Done():
+		return false
 	}
 }
 
-// GetUpdateChannel returns the channel for pod update messages
+// GetUpdateChannel returns the channel carrying pod watch events
 func (c *PodListController) GetUpdateChannel() <-chan tea.Msg {
-	return c.updateChan
+	return c.watches.updateChan
 }
 
-// Stop stops the watch goroutine
+// Stop stops the watch and metrics goroutines
 func (c *PodListController) Stop() {
-	if c.cancel != nil {
-		c.cancel()
-	}
+	c.watches.stop()
 }
 
 // podNamesPreview returns a preview of pod names for logging
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -35,7 +36,19 @@ func (s *PodListControllerScenario) ConfigureCluster(configFn func(*ClusterBuild
 
 func (s *PodListControllerScenario) the_pod_list_controller_is_instantiated() *PodListControllerScenario {
 	theme := theme.NewDefaultTheme()
-	s.controller = NewPodListController(s.builder.GetClientset(), theme, "test-cluster", nil, nil)
+	s.controller = NewPodListController(s.builder.GetClientset(), theme, "test-cluster")
+	return s
+}
+
+func (s *PodListControllerScenario) the_pod_list_controller_is_instantiated_with_metrics_from(standIn *MetricsServerStandIn) *PodListControllerScenario {
+	s.controller = NewScopedPodListControllerWithMetrics(s.builder.GetClientset(), standIn.Client(), theme.NewDefaultTheme(), "test-cluster", PodListScope{})
+	return s
+}
+
+func (s *PodListControllerScenario) the_usage_columns_should_be_shown(shown bool) *PodListControllerScenario {
+	if s.controller.podView.MetricsAvailable() != shown {
+		s.t.Errorf("expected usage columns shown to be %t", shown)
+	}
 	return s
 }
 
@@ -68,7 +81,7 @@ func (s *PodListControllerScenario) the_selected_pod_should_be(assertFn func(*mo
 func (s *PodListControllerScenario) refresh_pods() *PodListControllerScenario {
 	cmd := s.controller.refreshPods()
 	if cmd != nil {
-		cmd() // simulate running the command
+		s.controller.Update(cmd()) // simulate the update loop running the command and applying its result
 	}
 	return s
 }
@@ -77,25 +90,73 @@ func (s *PodListControllerScenario) a_new_pod_is_added_to_cluster(name, namespac
 	// Add the pod to the cluster
 	s.builder.WithPod(name, namespace)
 
-	// Wait for the watch to detect the change by polling until the pod appears
-	// This is deterministic because we're waiting for a specific condition
-	maxAttempts := 10
-	for i := 0; i < maxAttempts; i++ {
-		pods := s.controller.GetPods()
+	// Apply watch events until the pod appears
+	found := s.watch_events_are_applied_until(func(pods []models.Pod) bool {
 		for _, pod := range pods {
 			if pod.Name == name && pod.Namespace == namespace {
-				return s // Pod found, test can continue
+				return true
+			}
+		}
+		return false
+	})
+	if !found {
+		s.t.Errorf("Pod %s in namespace %s was not detected by watch", name, namespace)
+	}
+	return s
+}
+
+func (s *PodListControllerScenario) pods_are_churned_while_rendering(namespace string, count int) *PodListControllerScenario {
+	s.builder.WithNamespace(namespace)
+
+	// Create and delete pods from another goroutine while this one plays the update loop
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < count; i++ {
+			name := fmt.Sprintf("churn-%d", i)
+			s.builder.WithPod(name, namespace)
+			if i%2 == 0 {
+				s.builder.WithoutPod(name, namespace)
 			}
 		}
-		// Small delay between attempts
-		time.Sleep(50 * time.Millisecond)
+	}()
+
+	for {
+		select {
+		case msg := <-s.controller.GetUpdateChannel():
+			s.controller.Update(msg)
+			s.controller.Render(120, 40)
+		case <-done:
+			return s
+		}
 	}
+}
 
-	// If we get here, the pod wasn't detected - this will cause the test to fail
-	s.t.Errorf("Pod %s in namespace %s was not detected by watch after %d attempts", name, namespace, maxAttempts)
+func (s *PodListControllerScenario) the_pod_count_should_settle_at(expected int) *PodListControllerScenario {
+	settled := s.watch_events_are_applied_until(func(pods []models.Pod) bool {
+		return len(pods) == expected
+	})
+	if !settled {
+		s.t.Errorf("expected %d pods after applying watch events, got %d", expected, len(s.controller.GetPods()))
+	}
 	return s
 }
 
+// watch_events_are_applied_until drains the update channel on the test goroutine, as the App update loop would,
+// until cond holds for the controller's pods or a timeout elapses
+func (s *PodListControllerScenario) watch_events_are_applied_until(cond func([]models.Pod) bool) bool {
+	timeout := time.After(5 * time.Second)
+	for !cond(s.controller.GetPods()) {
+		select {
+		case msg := <-s.controller.GetUpdateChannel():
+			s.controller.Update(msg)
+		case <-timeout:
+			return false
+		}
+	}
+	return true
+}
+
 func (s *PodListControllerScenario) Cleanup() {
 	if s.controller != nil {
 		s.controller.Stop()
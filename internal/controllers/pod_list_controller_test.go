@@ -5,6 +5,7 @@ import (
 
 	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPodListController(t *testing.T) {
@@ -107,4 +108,64 @@ func TestPodListController(t *testing.T) {
 				assert.ElementsMatch(t, []string{"pod-a", "pod-b"}, []string{pods[0].Name, pods[1].Name})
 			})
 	})
+
+	t.Run("should_apply_watch_events_on_the_update_loop_under_churn", func(t *testing.T) {
+		s := NewPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPod("pod-a", "ns1")
+			}).
+			the_pod_list_controller_is_instantiated().
+			When().
+			pods_are_churned_while_rendering("churn", 20).
+			Then().
+			// pod-a plus the 10 odd-numbered churn pods survive
+			the_pod_count_should_settle_at(11)
+	})
+
+	t.Run("should_show_usage_against_requests_and_limits_from_metrics_server", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).WithPodUsage("default", "web", "app", "250m", "64Mi")
+		defer standIn.Close()
+
+		s := NewPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithResourcedPod("web", "default", "500m", "128Mi", "1", "256Mi").
+					WithPod("idle", "default")
+			}).
+			When().
+			the_pod_list_controller_is_instantiated_with_metrics_from(standIn).
+			Then().
+			the_usage_columns_should_be_shown(true).
+			and().
+			the_pod_list_should_be(func(pods []models.Pod) {
+				require.Len(t, pods, 2)
+				idle, web := pods[0], pods[1]
+				assert.Nil(t, idle.Usage)
+				require.NotNil(t, web.Usage)
+				assert.Equal(t, "250m", web.Usage.FormatCPU())
+				assert.Equal(t, "50%", models.FormatUsagePercent(web.Usage.CPUMilli, web.CPURequestMilli))
+				assert.Equal(t, "25%", models.FormatUsagePercent(web.Usage.CPUMilli, web.CPULimitMilli))
+				assert.Equal(t, "50%", models.FormatUsagePercent(web.Usage.MemoryBytes, web.MemoryRequestBytes))
+				assert.Equal(t, "25%", models.FormatUsagePercent(web.Usage.MemoryBytes, web.MemoryLimitBytes))
+			})
+	})
+
+	t.Run("should_hide_usage_columns_without_metrics_server", func(t *testing.T) {
+		standIn := NewMetricsServerStandIn(t).Absent()
+		defer standIn.Close()
+
+		s := NewPodListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithPod("web", "default")
+			}).
+			When().
+			the_pod_list_controller_is_instantiated_with_metrics_from(standIn).
+			Then().
+			the_usage_columns_should_be_shown(false)
+	})
 }
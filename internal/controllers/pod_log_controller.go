@@ -19,7 +19,6 @@ type LogFetcher func(podName, namespace string) (string, error)
 // PodLogController handles input for the pod log view
 type PodLogController struct {
 	podLogView *views.PodLogView
-	onBack     func() tea.Cmd
 	logFetcher LogFetcher
 	theme      *theme.Theme
 	podName    string
@@ -28,10 +27,32 @@ type PodLogController struct {
 	height     int
 }
 
+// LogOptions controls how much of a pod's log is fetched and how it is shown
+type LogOptions struct {
+	// TailLines is the number of most recent lines fetched; 0 fetches the whole log
+	TailLines int64
+	// Timestamps prefixes each line with the time it was written
+	Timestamps bool
+}
+
+// logOptions are the options used by log fetchers created from now on. It is only read and written on the update loop.
+var logOptions LogOptions
+
+// SetLogOptions sets the options used by log fetchers created from now on
+func SetLogOptions(opts LogOptions) {
+	logOptions = opts
+}
+
 // NewKubernetesLogFetcher creates a LogFetcher that uses the Kubernetes API
 func NewKubernetesLogFetcher(clientset *kubernetes.Clientset) LogFetcher {
+	// Capture the options now, as the fetcher runs off the update loop
+	podLogOptions := &corev1.PodLogOptions{Timestamps: logOptions.Timestamps}
+	if logOptions.TailLines > 0 {
+		tailLines := logOptions.TailLines
+		podLogOptions.TailLines = &tailLines
+	}
 	return func(podName, namespace string) (string, error) {
-		req := clientset.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{})
+		req := clientset.CoreV1().Pods(namespace).GetLogs(podName, podLogOptions)
 		readCloser, err := req.Stream(context.TODO())
 		if err != nil {
 			return "", fmt.Errorf("error getting pod logs: %v", err)
@@ -49,12 +70,11 @@ func NewKubernetesLogFetcher(clientset *kubernetes.Clientset) LogFetcher {
 }
 
 // NewPodLogController creates a new pod log controller
-func NewPodLogController(logFetcher LogFetcher, theme *theme.Theme, podName, namespace string, onBack func() tea.Cmd) *PodLogController {
+func NewPodLogController(logFetcher LogFetcher, theme *theme.Theme, podName, namespace string) *PodLogController {
 	podLogView := views.NewPodLogView(podName, namespace, theme)
 
 	controller := &PodLogController{
 		podLogView: podLogView,
-		onBack:     onBack,
 		logFetcher: logFetcher,
 		theme:      theme,
 		podName:    podName,
@@ -70,6 +90,11 @@ func NewPodLogController(logFetcher LogFetcher, theme *theme.Theme, podName, nam
 // loadLogs fetches pod logs and updates the view
 func (c *PodLogController) loadLogs() {
 	content, err := c.logFetcher(c.podName, c.namespace)
+	c.applyLogs(content, err)
+}
+
+// applyLogs updates the view with fetched log content or the fetch error
+func (c *PodLogController) applyLogs(content string, err error) {
 	if err != nil {
 		c.podLogView.UpdateContent(fmt.Sprintf("Error getting pod logs: %v", err))
 		return
@@ -78,30 +103,58 @@ func (c *PodLogController) loadLogs() {
 	c.podLogView.UpdateContent(content)
 }
 
+// podLogsLoadedMsg carries freshly fetched pod logs to the update loop
+type podLogsLoadedMsg struct {
+	podName   string
+	namespace string
+	content   string
+	err       error
+}
+
+// Update applies freshly fetched logs on the update loop
+func (c *PodLogController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case podLogsLoadedMsg:
+		if msg.podName == c.podName && msg.namespace == c.namespace {
+			c.applyLogs(msg.content, msg.err)
+		}
+	}
+	return nil
+}
+
+// podLogKeys are the key bindings of the pod log view
+var podLogKeys = registerKeyMap("logs", "Pod logs",
+	scrollUpBinding,
+	scrollDownBinding,
+	Binding{Action: ActionPageUp, Keys: []string{"pgup", "b", "ctrl+u"}, Help: "Page up"},
+	Binding{Action: ActionPageDown, Keys: []string{"pgdown", "f", "ctrl+d"}, Help: "Page down"},
+	Binding{Action: ActionTop, Keys: []string{"g", "home"}, Help: "Go to the start"},
+	Binding{Action: ActionBottom, Keys: []string{"G", "end"}, Help: "Go to the end and follow new lines"},
+	refreshBinding,
+)
+
 // HandleKey handles key press events for the pod log view
 func (c *PodLogController) HandleKey(msg tea.KeyMsg) tea.Cmd {
-	switch msg.String() {
-	case "up", "k":
+	switch podLogKeys.Action(msg) {
+	case ActionUp:
 		c.podLogView.ScrollUp()
 		return nil
-	case "down", "j":
+	case ActionDown:
 		c.podLogView.ScrollDown()
 		return nil
-	case "pgup", "b", "ctrl+u":
+	case ActionPageUp:
 		c.podLogView.PageUp()
 		return nil
-	case "pgdown", "f", "ctrl+d":
+	case ActionPageDown:
 		c.podLogView.PageDown()
 		return nil
-	case "g", "home":
+	case ActionTop:
 		c.podLogView.GoToStart()
 		return nil
-	case "G", "end":
+	case ActionBottom:
 		c.podLogView.GoToEnd()
 		return nil
-	case "esc":
-		return c.onBack()
-	case "r":
+	case ActionRefresh:
 		// Refresh pod logs
 		return c.refreshLogs()
 	default:
@@ -109,6 +162,11 @@ func (c *PodLogController) HandleKey(msg tea.KeyMsg) tea.Cmd {
 	}
 }
 
+// KeyMap returns the key bindings of the pod log view, which the help overlay lists
+func (c *PodLogController) KeyMap() *KeyMap {
+	return podLogKeys
+}
+
 // ActionText returns the text to describe the action the controller is performing for the header bar
 func (c *PodLogController) ActionText() string {
 	return fmt.Sprintf("Viewing logs for pod %s", c.podName)
@@ -122,11 +180,12 @@ func (c *PodLogController) Render(width, height int) string {
 	return c.podLogView.Render()
 }
 
-// refreshLogs refreshes the pod logs
+// refreshLogs fetches the pod logs off the update loop and delivers them as a podLogsLoadedMsg
 func (c *PodLogController) refreshLogs() tea.Cmd {
+	logFetcher, podName, namespace := c.logFetcher, c.podName, c.namespace
 	return func() tea.Msg {
-		log.Printf("Refreshing logs for pod %s in namespace %s", c.podName, c.namespace)
-		c.loadLogs()
-		return nil
+		log.Printf("Refreshing logs for pod %s in namespace %s", podName, namespace)
+		content, err := logFetcher(podName, namespace)
+		return podLogsLoadedMsg{podName: podName, namespace: namespace, content: content, err: err}
 	}
 }
@@ -3,7 +3,6 @@ package controllers
 import (
 	"testing"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/kevholditch/vigilant/internal/theme"
 )
 
@@ -75,7 +74,6 @@ func (s *PodLogControllerScenario) the_pod_log_controller_is_instantiated() *Pod
 		theme,
 		s.podName,
 		s.namespace,
-		func() tea.Cmd { return nil },
 	)
 
 	// Set the view size after controller creation
@@ -117,7 +115,7 @@ func (s *PodLogControllerScenario) go_to_end() *PodLogControllerScenario {
 func (s *PodLogControllerScenario) refresh_logs() *PodLogControllerScenario {
 	cmd := s.controller.refreshLogs()
 	if cmd != nil {
-		cmd() // simulate running the command
+		s.controller.Update(cmd()) // simulate the update loop running the command and applying its result
 	}
 	return s
 }
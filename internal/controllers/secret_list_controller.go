@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// secretResource lists and watches secrets for the secret list
+var secretResource = typedResource("secrets", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*corev1.SecretList] {
+		return clientset.CoreV1().Secrets(namespace)
+	},
+	func(_ string, secret *corev1.Secret) models.Secret {
+		return models.ToSecretModel(*secret)
+	})
+
+// SecretListController handles input for the secret list view
+type SecretListController struct {
+	secretView  *views.SecretListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	width       int
+	height      int
+
+	// readOnly disables revealing secret values in the describe view
+	readOnly bool
+
+	// watches lists and watches the secrets, listing again whenever the watch ends
+	watches *clusterWatches[models.Secret]
+}
+
+// NewSecretListController creates a new secret list controller. In read-only mode secret values can never be revealed.
+func NewSecretListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, readOnly bool) *SecretListController {
+	return NewNamespacedSecretListController(clientset, theme, clusterName, "", readOnly)
+}
+
+// NewNamespacedSecretListController creates a new secret list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedSecretListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string, readOnly bool) *SecretListController {
+	controller := &SecretListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		readOnly:    readOnly,
+		watches:     newClusterWatch(clientset, clusterName, namespace, secretResource),
+	}
+
+	// Create the view with initial secrets
+	secretView := views.NewSecretListView(controller.getSecretsList(), theme, clusterName)
+	controller.secretView = secretView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *SecretListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the secret list view with current secrets
+func (c *SecretListController) updateView() {
+	c.secretView.UpdateSecrets(c.getSecretsList())
+}
+
+// getSecretsList returns the current secrets as a slice in consistent order
+func (c *SecretListController) getSecretsList() []models.Secret {
+	return c.watches.list()
+}
+
+// secretListKeys are the key bindings of the secret list
+var secretListKeys = registerKeyMap("secrets", "Secret list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d", "enter"}, Help: "Describe the selected secret"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected secret"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the secret list view
+func (c *SecretListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.secretView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.secretView, msg)
+	}
+
+	switch secretListKeys.Action(msg) {
+	case ActionUp:
+		c.secretView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.secretView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedSecret()
+	case ActionXRay:
+		return c.xraySelectedSecret()
+	case ActionRefresh:
+		// Refresh secrets
+		return c.refreshSecrets()
+	case ActionColumns:
+		return openColumnChooser(c.secretView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the secret list, which the help overlay lists
+func (c *SecretListController) KeyMap() *KeyMap {
+	return secretListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *SecretListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *SecretListController) Modal() bool {
+	return c.secretView.ColumnChooser() != nil
+}
+
+// describeSelectedSecret pushes the describe view for the selected secret
+func (c *SecretListController) describeSelectedSecret() tea.Cmd {
+	selectedSecret := c.secretView.GetSelected()
+	if selectedSecret == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeSecretController(c.clientset, c.theme, selectedSecret.Name, selectedSecret.Namespace, c.readOnly)
+	return PushView(describeCtrl, selectedSecret.Namespace+"/"+selectedSecret.Name)
+}
+
+// xraySelectedSecret pushes the x-ray view of the selected secret
+func (c *SecretListController) xraySelectedSecret() tea.Cmd {
+	selectedSecret := c.secretView.GetSelected()
+	if selectedSecret == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "Secret", Namespace: selectedSecret.Namespace, Name: selectedSecret.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *SecretListController) ActionText() string {
+	return "Listing secrets"
+}
+
+// Render returns the rendered secret list view
+func (c *SecretListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.secretView.SetSize(width, height)
+	return c.secretView.Render()
+}
+
+// refreshSecrets lists secrets again off the update loop, replacing those listed before
+func (c *SecretListController) refreshSecrets() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetSecrets returns the current list of secrets
+func (c *SecretListController) GetSecrets() []models.Secret {
+	return c.getSecretsList()
+}
+
+// GetUpdateChannel returns the channel carrying secret watch events
+func (c *SecretListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *SecretListController) Stop() {
+	c.watches.stop()
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type SecretListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *SecretListController
+	pushed     PushViewMsg
+}
+
+func NewSecretListControllerScenario(t *testing.T) *SecretListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &SecretListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *SecretListControllerScenario) Given() *SecretListControllerScenario { return s }
+func (s *SecretListControllerScenario) When() *SecretListControllerScenario  { return s }
+func (s *SecretListControllerScenario) Then() *SecretListControllerScenario  { return s }
+func (s *SecretListControllerScenario) and() *SecretListControllerScenario   { return s }
+
+func (s *SecretListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *SecretListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *SecretListControllerScenario) the_secret_list_controller_is_instantiated() *SecretListControllerScenario {
+	s.controller = NewSecretListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster", false)
+	return s
+}
+
+func (s *SecretListControllerScenario) the_user_presses(msg tea.KeyMsg) *SecretListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *SecretListControllerScenario) the_secret_list_should_be(assertFn func([]models.Secret)) *SecretListControllerScenario {
+	assertFn(s.controller.GetSecrets())
+	return s
+}
+
+func (s *SecretListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *SecretListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *SecretListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretListController(t *testing.T) {
+	t.Run("should_list_secrets_with_their_type_and_key_count", func(t *testing.T) {
+		s := NewSecretListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithSecret("credentials", "apps", map[string]string{"username": "admin", "password": "hunter2"})
+			}).
+			When().
+			the_secret_list_controller_is_instantiated().
+			Then().
+			the_secret_list_should_be(func(secrets []models.Secret) {
+				var credentials *models.Secret
+				for i := range secrets {
+					if secrets[i].Namespace == "apps" && secrets[i].Name == "credentials" {
+						credentials = &secrets[i]
+					}
+				}
+				if assert.NotNil(t, credentials) {
+					assert.Equal(t, "Opaque", credentials.Type)
+					assert.Equal(t, 2, credentials.KeyCount())
+					assert.NotContains(t, credentials.Data["password"], "hunter2", "values should stay encoded until revealed")
+				}
+			})
+	})
+
+	t.Run("should_describe_the_selected_secret", func(t *testing.T) {
+		s := NewSecretListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithSecret("credentials", "apps", map[string]string{"password": "hunter2"})
+			}).
+			the_secret_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				_, ok := pushed.Controller.(*DescribeSecretController)
+				assert.True(t, ok)
+			})
+	})
+}
@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// serviceResource lists and watches services for the service list
+var serviceResource = typedResource("services", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*corev1.ServiceList] {
+		return clientset.CoreV1().Services(namespace)
+	},
+	func(_ string, service *corev1.Service) models.Service {
+		return models.ToServiceModel(*service)
+	})
+
+// ServiceListController handles input for the service list view
+type ServiceListController struct {
+	serviceView *views.ServiceListView
+	clientset   *kubernetes.Clientset
+	theme       *theme.Theme
+	clusterName string
+	width       int
+	height      int
+
+	// watches lists and watches the services, listing again whenever the watch ends
+	watches *clusterWatches[models.Service]
+}
+
+// NewServiceListController creates a new service list controller
+func NewServiceListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *ServiceListController {
+	return NewNamespacedServiceListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedServiceListController creates a new service list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedServiceListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *ServiceListController {
+	controller := &ServiceListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, serviceResource),
+	}
+
+	// Create the view with initial services
+	serviceView := views.NewServiceListView(controller.getServicesList(), theme, clusterName)
+	controller.serviceView = serviceView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *ServiceListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the service list view with current services
+func (c *ServiceListController) updateView() {
+	c.serviceView.UpdateServices(c.getServicesList())
+}
+
+// getServicesList returns the current services as a slice in consistent order
+func (c *ServiceListController) getServicesList() []models.Service {
+	return c.watches.list()
+}
+
+// serviceListKeys are the key bindings of the service list
+var serviceListKeys = registerKeyMap("services", "Service list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected service"},
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods of the selected service"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected service"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the service list view
+func (c *ServiceListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.serviceView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.serviceView, msg)
+	}
+
+	switch serviceListKeys.Action(msg) {
+	case ActionUp:
+		c.serviceView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.serviceView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedService()
+	case ActionPods:
+		return c.openSelectedServicePods()
+	case ActionXRay:
+		return c.xraySelectedService()
+	case ActionRefresh:
+		// Refresh services
+		return c.refreshServices()
+	case ActionColumns:
+		return openColumnChooser(c.serviceView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the service list, which the help overlay lists
+func (c *ServiceListController) KeyMap() *KeyMap {
+	return serviceListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *ServiceListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *ServiceListController) Modal() bool {
+	return c.serviceView.ColumnChooser() != nil
+}
+
+// describeSelectedService pushes the describe view for the selected service
+func (c *ServiceListController) describeSelectedService() tea.Cmd {
+	selectedService := c.serviceView.GetSelected()
+	if selectedService == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeServiceController(c.clientset, c.theme, selectedService.Name, selectedService.Namespace)
+	return PushView(describeCtrl, selectedService.Namespace+"/"+selectedService.Name)
+}
+
+// openSelectedServicePods pushes a pod list scoped to the pods matching the selected service's selector
+func (c *ServiceListController) openSelectedServicePods() tea.Cmd {
+	selectedService := c.serviceView.GetSelected()
+	if selectedService == nil || len(selectedService.Selector) == 0 {
+		return nil
+	}
+	return PushView(newServicePodListController(c.clientset, c.theme, c.clusterName, selectedService), selectedService.Namespace+"/"+selectedService.Name+" pods")
+}
+
+// newServicePodListController creates a pod list scoped to the pods matching a service's selector
+func newServicePodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, service *models.Service) *PodListController {
+	return NewScopedPodListController(clientset, theme, clusterName, PodListScope{
+		Namespace:     service.Namespace,
+		LabelSelector: service.FormatSelector(),
+		Description:   "service " + service.Namespace + "/" + service.Name,
+	})
+}
+
+// xraySelectedService pushes the x-ray view of the selected service
+func (c *ServiceListController) xraySelectedService() tea.Cmd {
+	selectedService := c.serviceView.GetSelected()
+	if selectedService == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "Service", Namespace: selectedService.Namespace, Name: selectedService.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *ServiceListController) ActionText() string {
+	return "Listing services"
+}
+
+// Render returns the rendered service list view
+func (c *ServiceListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.serviceView.SetSize(width, height)
+	return c.serviceView.Render()
+}
+
+// refreshServices lists services again off the update loop, replacing those listed before
+func (c *ServiceListController) refreshServices() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetServices returns the current list of services
+func (c *ServiceListController) GetServices() []models.Service {
+	return c.getServicesList()
+}
+
+// GetUpdateChannel returns the channel carrying service watch events
+func (c *ServiceListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *ServiceListController) Stop() {
+	c.watches.stop()
+}
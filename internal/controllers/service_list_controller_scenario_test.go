@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type ServiceListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *ServiceListController
+	pushed     PushViewMsg
+}
+
+func NewServiceListControllerScenario(t *testing.T) *ServiceListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &ServiceListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *ServiceListControllerScenario) Given() *ServiceListControllerScenario { return s }
+func (s *ServiceListControllerScenario) When() *ServiceListControllerScenario  { return s }
+func (s *ServiceListControllerScenario) Then() *ServiceListControllerScenario  { return s }
+func (s *ServiceListControllerScenario) and() *ServiceListControllerScenario   { return s }
+
+func (s *ServiceListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *ServiceListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *ServiceListControllerScenario) the_service_list_controller_is_instantiated() *ServiceListControllerScenario {
+	s.controller = NewServiceListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *ServiceListControllerScenario) the_user_presses(msg tea.KeyMsg) *ServiceListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *ServiceListControllerScenario) the_service_list_should_be(assertFn func([]models.Service)) *ServiceListControllerScenario {
+	assertFn(s.controller.GetServices())
+	return s
+}
+
+func (s *ServiceListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *ServiceListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *ServiceListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
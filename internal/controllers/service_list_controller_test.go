@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceListController(t *testing.T) {
+	t.Run("should_list_services_with_ports_and_selector", func(t *testing.T) {
+		s := NewServiceListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "default", map[string]string{"app": "web", "tier": "frontend"})
+			}).
+			When().
+			the_service_list_controller_is_instantiated().
+			Then().
+			the_service_list_should_be(func(services []models.Service) {
+				var web *models.Service
+				for i := range services {
+					if services[i].Name == "web" {
+						web = &services[i]
+					}
+				}
+				if assert.NotNil(t, web) {
+					assert.Equal(t, "ClusterIP", web.Type)
+					assert.NotEmpty(t, web.ClusterIP)
+					assert.Equal(t, "<none>", web.FormatExternalIPs())
+					assert.Equal(t, "80/TCP", web.FormatPorts())
+					assert.Equal(t, "app=web,tier=frontend", web.FormatSelector())
+				}
+			})
+	})
+
+	t.Run("should_drill_down_to_the_pods_matching_the_selector", func(t *testing.T) {
+		s := NewServiceListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithService("web", "apps", map[string]string{"app": "web"}).
+					WithLabelledPod("web-1", "apps", map[string]string{"app": "web"}).
+					WithLabelledPod("db-1", "apps", map[string]string{"app": "db"})
+			}).
+			the_service_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/web pods", pushed.Title)
+				podList, ok := pushed.Controller.(*PodListController)
+				if assert.True(t, ok) {
+					pods := podList.GetPods()
+					if assert.Len(t, pods, 1) {
+						assert.Equal(t, "web-1", pods[0].Name)
+					}
+				}
+			})
+	})
+}
@@ -0,0 +1,323 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+)
+
+// splitFollowDelay is how long the selection of a split's list must settle before the detail pane follows it, so
+// that moving through the list does not fetch the detail of every row passed over
+const splitFollowDelay = 150 * time.Millisecond
+
+// splitKeys are the key bindings of a split, handled before the keys of the focused pane
+var splitKeys = registerKeyMap("split", "Split panes",
+	Binding{Action: ActionFocus, Keys: []string{"tab"}, Help: "Move focus to the other pane"},
+	Binding{Action: ActionGrow, Keys: []string{"+", "="}, Help: "Grow the focused pane"},
+	Binding{Action: ActionShrink, Keys: []string{"-"}, Help: "Shrink the focused pane"},
+	Binding{Action: ActionRotate, Keys: []string{"o"}, Help: "Stack the panes or place them side by side"},
+)
+
+// splitPaneMsg wraps a message from the update channel of the detail pane, so that it is routed back to that pane
+type splitPaneMsg struct {
+	pane Controller
+	msg  tea.Msg
+}
+
+// splitFollowMsg asks a split to show the detail of the row selected when the message was scheduled, if the
+// selection has settled on it
+type splitFollowMsg struct {
+	split *SplitController
+	key   string
+}
+
+// SplitController shows a list in one pane and a view of its selected row, such as its logs or description, in the
+// other, replacing that view whenever the selection changes. Keys go to the focused pane.
+// The split does not own the list, which stays on the navigation stack below it; Stop only stops the detail pane.
+type SplitController struct {
+	list      DetailController
+	action    Action
+	splitView *views.SplitView
+	theme     *theme.Theme
+
+	// detail is the view of the row identified by detailKey; nil when nothing is selected
+	detail    Controller
+	detailKey string
+	// pendingKey is the selection the detail will follow once it settles
+	pendingKey string
+
+	// Message channel carrying the messages of the detail pane to the update loop
+	updateChan chan tea.Msg
+	wg         sync.WaitGroup
+	// stopForwarding stops forwarding the messages of the current detail pane
+	stopForwarding context.CancelFunc
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewSplitController creates a split showing list and, laid out as orientation, the view of action for its selected
+// row. action must be one of the list's Details.
+func NewSplitController(list DetailController, action Action, theme *theme.Theme, orientation views.SplitOrientation) *SplitController {
+	ctx, cancel := context.WithCancel(context.Background())
+	controller := &SplitController{
+		list:           list,
+		action:         action,
+		splitView:      views.NewSplitView(orientation, theme),
+		theme:          theme,
+		updateChan:     make(chan tea.Msg, updateChannelSize),
+		stopForwarding: func() {},
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+	controller.showDetail()
+	return controller
+}
+
+// List returns the list shown in the first pane
+func (c *SplitController) List() DetailController {
+	return c.list
+}
+
+// Action returns the action whose view of the selected row the detail pane shows
+func (c *SplitController) Action() Action {
+	return c.action
+}
+
+// SetAction switches the detail pane to the view of action for the selected row. action must be one of the list's
+// Details.
+func (c *SplitController) SetAction(action Action) {
+	if action == c.action {
+		return
+	}
+	c.action = action
+	c.showDetail()
+}
+
+// showDetail replaces the detail pane with the view of the selected row
+func (c *SplitController) showDetail() {
+	c.stopDetail()
+	c.detailKey = c.list.Selection()
+	if c.detailKey == "" {
+		return
+	}
+	c.detail = c.list.Detail(c.action)
+	c.forward(c.detail)
+}
+
+// stopDetail stops the detail pane and the forwarding of its messages
+func (c *SplitController) stopDetail() {
+	c.stopForwarding()
+	if stoppable, ok := c.detail.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	c.detail = nil
+	c.detailKey = ""
+}
+
+// forward sends the messages of the pane's update channel, if it has one, to the update loop on the split's channel
+func (c *SplitController) forward(pane Controller) {
+	updateable, ok := pane.(UpdateableController)
+	if !ok || updateable.GetUpdateChannel() == nil {
+		return
+	}
+	updates := updateable.GetUpdateChannel()
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.stopForwarding = cancel
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-updates:
+				if !ok {
+					return
+				}
+				if !sendMsg(ctx, c.updateChan, splitPaneMsg{pane: pane, msg: msg}) {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// ListUpdated follows the selection after a background update applied to the split's list, such as a watch event
+// deleting the selected row
+func (c *SplitController) ListUpdated(list Controller) tea.Cmd {
+	if list != Controller(c.list) {
+		return nil
+	}
+	return c.follow()
+}
+
+// follow schedules the detail pane to follow the selection of the list, if it has changed
+func (c *SplitController) follow() tea.Cmd {
+	key := c.list.Selection()
+	if key == c.detailKey || key == c.pendingKey {
+		return nil
+	}
+	c.pendingKey = key
+	return tea.Tick(splitFollowDelay, func(time.Time) tea.Msg {
+		return splitFollowMsg{split: c, key: key}
+	})
+}
+
+// focused returns the focused pane, or nil when the detail pane is focused and nothing is selected
+func (c *SplitController) focused() Controller {
+	if c.splitView.Focus() == 0 {
+		return c.list
+	}
+	return c.detail
+}
+
+// HandleKey handles the keys of the split and passes any other key to the focused pane
+func (c *SplitController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	// A dialog open in the focused pane gets every key
+	if c.Modal() {
+		return c.focused().HandleKey(msg)
+	}
+
+	switch splitKeys.Action(msg) {
+	case ActionFocus:
+		c.splitView.FocusNext()
+		return nil
+	case ActionGrow:
+		c.splitView.Grow()
+		return nil
+	case ActionShrink:
+		c.splitView.Shrink()
+		return nil
+	case ActionRotate:
+		c.splitView.Rotate()
+		return nil
+	}
+
+	focused := c.focused()
+	if focused == nil {
+		return nil
+	}
+	return tea.Batch(focused.HandleKey(msg), c.follow())
+}
+
+// Update routes the messages of the detail pane back to it. The results of commands issued by either pane are
+// passed to both, as each ignores those of the other.
+func (c *SplitController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case splitPaneMsg:
+		// Messages still queued from a replaced detail pane are dropped
+		if c.detail == nil || msg.pane != c.detail {
+			return nil
+		}
+		return c.detail.Update(msg.msg)
+	case splitFollowMsg:
+		if msg.split != c || msg.key != c.pendingKey {
+			return nil
+		}
+		c.pendingKey = ""
+		if msg.key == c.list.Selection() && msg.key != c.detailKey {
+			c.showDetail()
+		}
+		return nil
+	}
+
+	cmds := []tea.Cmd{c.list.Update(msg)}
+	if c.detail != nil {
+		cmds = append(cmds, c.detail.Update(msg))
+	}
+	// The list may have moved its selection, such as when the selected row was deleted
+	return tea.Batch(append(cmds, c.follow())...)
+}
+
+// KeyMap returns the key bindings of the split, which the help overlay lists before those of the focused pane
+func (c *SplitController) KeyMap() *KeyMap {
+	return splitKeys
+}
+
+// HelpSections returns the keys of the focused pane and the sections it adds to the help overlay
+func (c *SplitController) HelpSections() []views.HelpSection {
+	var sections []views.HelpSection
+	focused := c.focused()
+	if keyMapped, ok := focused.(KeyMapController); ok {
+		sections = append(sections, keyMapped.KeyMap().HelpSection())
+	}
+	if helped, ok := focused.(HelpController); ok {
+		sections = append(sections, helped.HelpSections()...)
+	}
+	return sections
+}
+
+// Modal reports whether a dialog is open in the focused pane, so that it gets every key
+func (c *SplitController) Modal() bool {
+	modal, ok := c.focused().(ModalController)
+	return ok && modal.Modal()
+}
+
+// ClusterHealth returns the connection health of the clusters of a multi-cluster list, which the header shows
+func (c *SplitController) ClusterHealth() []models.ClusterHealth {
+	if multiCluster, ok := c.list.(ClusterHealthController); ok {
+		return multiCluster.ClusterHealth()
+	}
+	return nil
+}
+
+// ActionText returns the text to describe the action the focused pane is performing for the header bar
+func (c *SplitController) ActionText() string {
+	if focused := c.focused(); focused != nil {
+		return focused.ActionText()
+	}
+	return c.list.ActionText()
+}
+
+// Render returns the list and the detail of its selected row, laid out side by side or stacked
+func (c *SplitController) Render(width, height int) string {
+	c.splitView.SetSize(width, height)
+
+	listWidth, listHeight := c.splitView.PaneSize(0)
+	list := views.SplitPane{Title: c.list.ActionText(), Content: c.list.Render(listWidth, listHeight)}
+
+	detail := views.SplitPane{Title: string(c.action), Content: "Nothing selected"}
+	if c.detail != nil {
+		detailWidth, detailHeight := c.splitView.PaneSize(1)
+		detail = views.SplitPane{Title: c.detail.ActionText(), Content: c.detail.Render(detailWidth, detailHeight)}
+	}
+	return c.splitView.Render(list, detail)
+}
+
+// GetUpdateChannel returns the channel carrying the messages of the detail pane
+func (c *SplitController) GetUpdateChannel() <-chan tea.Msg {
+	return c.updateChan
+}
+
+// Stop stops the detail pane. The update channel is closed once its messages are no longer forwarded.
+func (c *SplitController) Stop() {
+	if c.ctx.Err() != nil {
+		return
+	}
+	c.stopDetail()
+	c.cancel()
+	go func() {
+		c.wg.Wait()
+		close(c.updateChan)
+	}()
+}
+
+// Orientation returns how the panes are laid out (for testing)
+func (c *SplitController) Orientation() views.SplitOrientation {
+	return c.splitView.Orientation()
+}
+
+// DetailText describes the detail pane (for testing)
+func (c *SplitController) DetailText() string {
+	if c.detail == nil {
+		return ""
+	}
+	return c.detail.ActionText()
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDetailList is a minimal DetailController listing rows, whose details are stubDetails
+type stubDetailList struct {
+	rows     []string
+	selected int
+	details  []*stubDetail
+}
+
+func (c *stubDetailList) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "j":
+		c.selected = min(c.selected+1, len(c.rows)-1)
+	case "k":
+		c.selected = max(c.selected-1, 0)
+	}
+	return nil
+}
+func (c *stubDetailList) Update(msg tea.Msg) tea.Cmd {
+	if deleted, ok := msg.(rowDeletedMsg); ok {
+		for i, row := range c.rows {
+			if row == string(deleted) {
+				c.rows = append(c.rows[:i], c.rows[i+1:]...)
+				c.selected = min(c.selected, max(len(c.rows)-1, 0))
+			}
+		}
+	}
+	return nil
+}
+func (c *stubDetailList) Render(width, height int) string { return "list" }
+func (c *stubDetailList) ActionText() string              { return "Listing rows" }
+func (c *stubDetailList) Details() []Action               { return []Action{ActionDescribe, ActionLogs} }
+
+func (c *stubDetailList) Selection() string {
+	if len(c.rows) == 0 {
+		return ""
+	}
+	return c.rows[c.selected]
+}
+
+func (c *stubDetailList) Detail(action Action) Controller {
+	detail := &stubDetail{text: string(action) + " " + c.Selection(), updateChan: make(chan tea.Msg, 1)}
+	c.details = append(c.details, detail)
+	return detail
+}
+
+// rowDeletedMsg is a watch event removing a row from a stubDetailList
+type rowDeletedMsg string
+
+// stubDetail is a minimal detail pane, recording the keys and updates it gets and whether it was stopped
+type stubDetail struct {
+	text       string
+	keys       []string
+	updates    []tea.Msg
+	stopped    bool
+	updateChan chan tea.Msg
+}
+
+func (c *stubDetail) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	c.keys = append(c.keys, msg.String())
+	return nil
+}
+func (c *stubDetail) Update(msg tea.Msg) tea.Cmd {
+	c.updates = append(c.updates, msg)
+	return nil
+}
+func (c *stubDetail) Render(width, height int) string  { return c.text }
+func (c *stubDetail) ActionText() string               { return c.text }
+func (c *stubDetail) GetUpdateChannel() <-chan tea.Msg { return c.updateChan }
+func (c *stubDetail) Stop()                            { c.stopped = true }
+
+type SplitControllerScenario struct {
+	t          *testing.T
+	list       *stubDetailList
+	controller *SplitController
+	cmds       []tea.Cmd
+}
+
+func NewSplitControllerScenario(t *testing.T) *SplitControllerScenario {
+	return &SplitControllerScenario{t: t}
+}
+
+func (s *SplitControllerScenario) Given() *SplitControllerScenario { return s }
+func (s *SplitControllerScenario) When() *SplitControllerScenario  { return s }
+func (s *SplitControllerScenario) Then() *SplitControllerScenario  { return s }
+func (s *SplitControllerScenario) and() *SplitControllerScenario   { return s }
+
+func (s *SplitControllerScenario) a_list_of(rows ...string) *SplitControllerScenario {
+	s.list = &stubDetailList{rows: rows}
+	return s
+}
+
+func (s *SplitControllerScenario) the_list_is_split_with(action Action) *SplitControllerScenario {
+	s.controller = NewSplitController(s.list, action, theme.NewDefaultTheme(), views.SplitHorizontal)
+	return s
+}
+
+func (s *SplitControllerScenario) the_keys_are_pressed(keys ...string) *SplitControllerScenario {
+	for _, key := range keys {
+		msg := keyMsg(key)
+		if key == "tab" {
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		}
+		s.cmds = append(s.cmds, s.controller.HandleKey(msg))
+	}
+	return s
+}
+
+// the_selection_settles runs the commands returned so far, as the update loop would, passing their messages back
+// to the split
+func (s *SplitControllerScenario) the_selection_settles() *SplitControllerScenario {
+	cmds := s.cmds
+	s.cmds = nil
+	for len(cmds) > 0 {
+		cmd := cmds[0]
+		cmds = cmds[1:]
+		if cmd == nil {
+			continue
+		}
+		switch msg := cmd().(type) {
+		case tea.BatchMsg:
+			cmds = append(cmds, msg...)
+		default:
+			cmds = append(cmds, s.controller.Update(msg))
+		}
+	}
+	return s
+}
+
+// the_row_is_deleted_by_a_watch_event applies the event to the list, as the update loop does with the list's own
+// update channel, and tells the split
+func (s *SplitControllerScenario) the_row_is_deleted_by_a_watch_event(row string) *SplitControllerScenario {
+	s.list.Update(rowDeletedMsg(row))
+	s.cmds = append(s.cmds, s.controller.ListUpdated(s.list))
+	return s
+}
+
+func (s *SplitControllerScenario) the_detail_pane_sends(msg tea.Msg) *SplitControllerScenario {
+	s.list.details[len(s.list.details)-1].updateChan <- msg
+	s.controller.Update(<-s.controller.GetUpdateChannel())
+	return s
+}
+
+func (s *SplitControllerScenario) the_split_is_stopped() *SplitControllerScenario {
+	s.controller.Stop()
+	return s
+}
+
+func (s *SplitControllerScenario) the_detail_should_show(expected string) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.controller.DetailText())
+	return s
+}
+
+func (s *SplitControllerScenario) details_created_should_be(expected int) *SplitControllerScenario {
+	require.Len(s.t, s.list.details, expected)
+	return s
+}
+
+func (s *SplitControllerScenario) the_detail_should_be_stopped(index int, stopped bool) *SplitControllerScenario {
+	require.Equal(s.t, stopped, s.list.details[index].stopped)
+	return s
+}
+
+func (s *SplitControllerScenario) the_list_selection_should_be(expected string) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.list.Selection())
+	return s
+}
+
+func (s *SplitControllerScenario) the_detail_should_have_received_keys(expected ...string) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.list.details[len(s.list.details)-1].keys)
+	return s
+}
+
+func (s *SplitControllerScenario) the_detail_should_have_received(expected ...tea.Msg) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.list.details[len(s.list.details)-1].updates)
+	return s
+}
+
+func (s *SplitControllerScenario) the_action_text_should_be(expected string) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.controller.ActionText())
+	return s
+}
+
+func (s *SplitControllerScenario) the_ratio_should_be(expected int) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.controller.splitView.Ratio())
+	return s
+}
+
+func (s *SplitControllerScenario) the_orientation_should_be(expected views.SplitOrientation) *SplitControllerScenario {
+	require.Equal(s.t, expected, s.controller.Orientation())
+	return s
+}
+
+func (s *SplitControllerScenario) the_render_should_contain(expected ...string) *SplitControllerScenario {
+	rendered := s.controller.Render(80, 10)
+	for _, text := range expected {
+		require.Contains(s.t, rendered, text)
+	}
+	return s
+}
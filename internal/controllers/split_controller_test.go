@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/kevholditch/vigilant/internal/views"
+)
+
+func TestSplitController(t *testing.T) {
+	t.Run("should_show_the_detail_of_the_selected_row_beside_the_list", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web", "api").
+			When().
+			the_list_is_split_with(ActionLogs).
+			Then().
+			the_detail_should_show("logs web").
+			the_render_should_contain("Listing rows", "list", "logs web")
+	})
+
+	t.Run("should_follow_the_selection_once_it_settles", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web", "api", "worker").
+			the_list_is_split_with(ActionDescribe).
+			When().
+			the_keys_are_pressed("j", "j").
+			Then().
+			the_detail_should_show("describe web").
+			and().
+			the_selection_settles().
+			the_detail_should_show("describe worker").
+			details_created_should_be(2).
+			the_detail_should_be_stopped(0, true).
+			the_detail_should_be_stopped(1, false)
+	})
+
+	t.Run("should_follow_the_selection_when_the_selected_row_is_deleted", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web", "api").
+			the_list_is_split_with(ActionDescribe).
+			When().
+			the_row_is_deleted_by_a_watch_event("web").
+			the_selection_settles().
+			Then().
+			the_detail_should_show("describe api").
+			details_created_should_be(2).
+			the_detail_should_be_stopped(0, true)
+	})
+
+	t.Run("should_keep_the_detail_when_the_selection_returns_before_it_settles", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web", "api").
+			the_list_is_split_with(ActionDescribe).
+			When().
+			the_keys_are_pressed("j", "k").
+			the_selection_settles().
+			Then().
+			the_detail_should_show("describe web").
+			details_created_should_be(1)
+	})
+
+	t.Run("should_send_keys_to_the_focused_pane", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web", "api").
+			the_list_is_split_with(ActionLogs).
+			When().
+			the_keys_are_pressed("tab", "j", "G").
+			Then().
+			the_list_selection_should_be("web").
+			the_detail_should_have_received_keys("j", "G").
+			the_action_text_should_be("logs web").
+			and().
+			the_keys_are_pressed("tab", "j").
+			the_list_selection_should_be("api").
+			the_action_text_should_be("Listing rows")
+	})
+
+	t.Run("should_resize_the_focused_pane_within_limits", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web").
+			the_list_is_split_with(ActionLogs).
+			When().
+			the_keys_are_pressed("+", "+").
+			Then().
+			the_ratio_should_be(60).
+			and().
+			the_keys_are_pressed("tab", "+").
+			the_ratio_should_be(55).
+			and().
+			the_keys_are_pressed("+", "+", "+", "+", "+", "+", "+", "+").
+			the_ratio_should_be(20).
+			and().
+			the_keys_are_pressed("-").
+			the_ratio_should_be(25)
+	})
+
+	t.Run("should_stack_the_panes_or_place_them_side_by_side", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web").
+			the_list_is_split_with(ActionLogs).
+			When().
+			the_keys_are_pressed("o").
+			Then().
+			the_orientation_should_be(views.SplitVertical).
+			the_render_should_contain("list", "logs web").
+			and().
+			the_keys_are_pressed("o").
+			the_orientation_should_be(views.SplitHorizontal)
+	})
+
+	t.Run("should_route_the_updates_of_the_detail_pane_back_to_it", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web").
+			the_list_is_split_with(ActionLogs).
+			When().
+			the_detail_pane_sends("new lines").
+			Then().
+			the_detail_should_have_received("new lines")
+	})
+
+	t.Run("should_show_nothing_selected_for_an_empty_list", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of().
+			When().
+			the_list_is_split_with(ActionLogs).
+			the_keys_are_pressed("tab", "j").
+			Then().
+			the_detail_should_show("").
+			details_created_should_be(0).
+			the_render_should_contain("Nothing selected")
+	})
+
+	t.Run("should_stop_the_detail_pane_when_stopped", func(t *testing.T) {
+		s := NewSplitControllerScenario(t)
+		s.Given().
+			a_list_of("web").
+			the_list_is_split_with(ActionLogs).
+			When().
+			the_split_is_stopped().
+			Then().
+			the_detail_should_be_stopped(0, true)
+	})
+}
@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// statefulSetResource lists and watches stateful sets for the stateful set list
+var statefulSetResource = typedResource("statefulsets", metav1.ListOptions{},
+	func(clientset *kubernetes.Clientset, namespace string) listWatcher[*appsv1.StatefulSetList] {
+		return clientset.AppsV1().StatefulSets(namespace)
+	},
+	func(_ string, statefulSet *appsv1.StatefulSet) models.StatefulSet {
+		return models.ToStatefulSetModel(*statefulSet)
+	})
+
+// StatefulSetListController handles input for the stateful set list view
+type StatefulSetListController struct {
+	statefulSetView *views.StatefulSetListView
+	clientset       *kubernetes.Clientset
+	theme           *theme.Theme
+	clusterName     string
+	width           int
+	height          int
+
+	// watches lists and watches the stateful sets, listing again whenever the watch ends
+	watches *clusterWatches[models.StatefulSet]
+}
+
+// NewStatefulSetListController creates a new stateful set list controller
+func NewStatefulSetListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string) *StatefulSetListController {
+	return NewNamespacedStatefulSetListController(clientset, theme, clusterName, "")
+}
+
+// NewNamespacedStatefulSetListController creates a new stateful set list controller whose list and watch are restricted to namespace.
+// An empty namespace lists all namespaces.
+func NewNamespacedStatefulSetListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName, namespace string) *StatefulSetListController {
+	controller := &StatefulSetListController{
+		clientset:   clientset,
+		theme:       theme,
+		clusterName: clusterName,
+		watches:     newClusterWatch(clientset, clusterName, namespace, statefulSetResource),
+	}
+
+	// Create the view with initial stateful sets
+	statefulSetView := views.NewStatefulSetListView(controller.getStatefulSetsList(), theme, clusterName)
+	controller.statefulSetView = statefulSetView
+
+	// Start watching for changes
+	controller.watches.start()
+
+	return controller
+}
+
+// Update applies lists, watch events and refresh results on the update loop
+func (c *StatefulSetListController) Update(msg tea.Msg) tea.Cmd {
+	if c.watches.update(msg) {
+		c.updateView()
+	}
+	return nil
+}
+
+// updateView updates the stateful set list view with current stateful sets
+func (c *StatefulSetListController) updateView() {
+	c.statefulSetView.UpdateStatefulSets(c.getStatefulSetsList())
+}
+
+// getStatefulSetsList returns the current stateful sets as a slice in consistent order
+func (c *StatefulSetListController) getStatefulSetsList() []models.StatefulSet {
+	return c.watches.list()
+}
+
+// statefulSetListKeys are the key bindings of the stateful set list
+var statefulSetListKeys = registerKeyMap("statefulsets", "Stateful set list",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected stateful set"},
+	Binding{Action: ActionPods, Keys: []string{"enter"}, Help: "Show the pods of the selected stateful set"},
+	Binding{Action: ActionXRay, Keys: []string{"x"}, Help: "X-ray the selected stateful set"},
+	columnsBinding,
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the stateful set list view
+func (c *StatefulSetListController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	if c.statefulSetView.ColumnChooser() != nil {
+		return handleColumnChooserKey(c.statefulSetView, msg)
+	}
+
+	switch statefulSetListKeys.Action(msg) {
+	case ActionUp:
+		c.statefulSetView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.statefulSetView.SelectNext()
+		return nil
+	case ActionDescribe:
+		return c.describeSelectedStatefulSet()
+	case ActionPods:
+		return c.openSelectedStatefulSetPods()
+	case ActionXRay:
+		return c.xraySelectedStatefulSet()
+	case ActionRefresh:
+		// Refresh stateful sets
+		return c.refreshStatefulSets()
+	case ActionColumns:
+		return openColumnChooser(c.statefulSetView)
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the stateful set list, which the help overlay lists
+func (c *StatefulSetListController) KeyMap() *KeyMap {
+	return statefulSetListKeys
+}
+
+// HelpSections documents the expressions custom columns can be filled from
+func (c *StatefulSetListController) HelpSections() []views.HelpSection {
+	return columnHelpSections()
+}
+
+// Modal reports whether the column chooser is open, so that it gets every key
+func (c *StatefulSetListController) Modal() bool {
+	return c.statefulSetView.ColumnChooser() != nil
+}
+
+// describeSelectedStatefulSet pushes the describe view for the selected stateful set
+func (c *StatefulSetListController) describeSelectedStatefulSet() tea.Cmd {
+	selectedStatefulSet := c.statefulSetView.GetSelected()
+	if selectedStatefulSet == nil {
+		return nil
+	}
+	describeCtrl := NewDescribeStatefulSetController(c.clientset, c.theme, selectedStatefulSet.Name, selectedStatefulSet.Namespace)
+	return PushView(describeCtrl, selectedStatefulSet.Namespace+"/"+selectedStatefulSet.Name)
+}
+
+// openSelectedStatefulSetPods pushes a pod list scoped to the pods of the selected stateful set
+func (c *StatefulSetListController) openSelectedStatefulSetPods() tea.Cmd {
+	selectedStatefulSet := c.statefulSetView.GetSelected()
+	if selectedStatefulSet == nil || selectedStatefulSet.Selector == "" {
+		return nil
+	}
+	return PushView(newStatefulSetPodListController(c.clientset, c.theme, c.clusterName, selectedStatefulSet), selectedStatefulSet.Namespace+"/"+selectedStatefulSet.Name+" pods")
+}
+
+// newStatefulSetPodListController creates a pod list scoped to the pods of a stateful set
+func newStatefulSetPodListController(clientset *kubernetes.Clientset, theme *theme.Theme, clusterName string, statefulSet *models.StatefulSet) *PodListController {
+	return NewScopedPodListController(clientset, theme, clusterName, PodListScope{
+		Namespace:     statefulSet.Namespace,
+		LabelSelector: statefulSet.Selector,
+		Description:   "stateful set " + statefulSet.Namespace + "/" + statefulSet.Name,
+	})
+}
+
+// xraySelectedStatefulSet pushes the x-ray view of the selected stateful set
+func (c *StatefulSetListController) xraySelectedStatefulSet() tea.Cmd {
+	selectedStatefulSet := c.statefulSetView.GetSelected()
+	if selectedStatefulSet == nil {
+		return nil
+	}
+	return XRay(c.clientset, c.theme, models.ResourceRef{Kind: "StatefulSet", Namespace: selectedStatefulSet.Namespace, Name: selectedStatefulSet.Name})
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *StatefulSetListController) ActionText() string {
+	return "Listing stateful sets"
+}
+
+// Render returns the rendered stateful set list view
+func (c *StatefulSetListController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.statefulSetView.SetSize(width, height)
+	return c.statefulSetView.Render()
+}
+
+// refreshStatefulSets lists stateful sets again off the update loop, replacing those listed before
+func (c *StatefulSetListController) refreshStatefulSets() tea.Cmd {
+	return c.watches.refresh()
+}
+
+// GetStatefulSets returns the current list of stateful sets
+func (c *StatefulSetListController) GetStatefulSets() []models.StatefulSet {
+	return c.getStatefulSetsList()
+}
+
+// GetUpdateChannel returns the channel carrying stateful set watch events
+func (c *StatefulSetListController) GetUpdateChannel() <-chan tea.Msg {
+	return c.watches.updateChan
+}
+
+// Stop stops the controller and cleans up resources
+func (c *StatefulSetListController) Stop() {
+	c.watches.stop()
+}
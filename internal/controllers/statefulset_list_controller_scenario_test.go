@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type StatefulSetListControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *StatefulSetListController
+	pushed     PushViewMsg
+}
+
+func NewStatefulSetListControllerScenario(t *testing.T) *StatefulSetListControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &StatefulSetListControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *StatefulSetListControllerScenario) Given() *StatefulSetListControllerScenario { return s }
+func (s *StatefulSetListControllerScenario) When() *StatefulSetListControllerScenario  { return s }
+func (s *StatefulSetListControllerScenario) Then() *StatefulSetListControllerScenario  { return s }
+func (s *StatefulSetListControllerScenario) and() *StatefulSetListControllerScenario   { return s }
+
+func (s *StatefulSetListControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *StatefulSetListControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *StatefulSetListControllerScenario) the_stateful_set_list_controller_is_instantiated() *StatefulSetListControllerScenario {
+	s.controller = NewStatefulSetListController(s.builder.GetClientset(), theme.NewDefaultTheme(), "test-cluster")
+	return s
+}
+
+func (s *StatefulSetListControllerScenario) the_user_presses(msg tea.KeyMsg) *StatefulSetListControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	pushed, ok := cmd().(PushViewMsg)
+	if !ok {
+		s.t.Fatalf("expected %s to push a view", msg.String())
+	}
+	s.pushed = pushed
+	return s
+}
+
+func (s *StatefulSetListControllerScenario) the_stateful_set_list_should_be(assertFn func([]models.StatefulSet)) *StatefulSetListControllerScenario {
+	assertFn(s.controller.GetStatefulSets())
+	return s
+}
+
+func (s *StatefulSetListControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *StatefulSetListControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *StatefulSetListControllerScenario) Cleanup() {
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatefulSetListController(t *testing.T) {
+	t.Run("should_list_stateful_sets_with_their_rollout_settings", func(t *testing.T) {
+		s := NewStatefulSetListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithStatefulSet("db", "data", 3, 1)
+			}).
+			When().
+			the_stateful_set_list_controller_is_instantiated().
+			Then().
+			the_stateful_set_list_should_be(func(statefulSets []models.StatefulSet) {
+				if assert.Len(t, statefulSets, 1) {
+					db := statefulSets[0]
+					assert.Equal(t, "db", db.Name)
+					assert.Equal(t, 3, db.Replicas)
+					assert.Equal(t, "RollingUpdate", db.UpdateStrategy)
+					assert.Equal(t, 1, db.Partition)
+					assert.Equal(t, "app=db", db.Selector)
+					if assert.Len(t, db.VolumeClaimTemplates, 1) {
+						assert.Equal(t, models.VolumeClaimTemplate{Name: "data", AccessModes: []string{"ReadWriteOnce"}, Storage: "1Gi"}, db.VolumeClaimTemplates[0])
+					}
+				}
+			})
+	})
+
+	t.Run("should_drill_down_to_the_stateful_set_pods", func(t *testing.T) {
+		s := NewStatefulSetListControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithStatefulSet("db", "data", 2, 0).
+					WithStatefulSetPod("db", "data", 0, "db-1").
+					WithStatefulSetPod("db", "data", 1, "db-1").
+					WithLabelledPod("other", "data", map[string]string{"app": "other"})
+			}).
+			the_stateful_set_list_controller_is_instantiated().
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyEnter}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "data/db pods", pushed.Title)
+				podList, ok := pushed.Controller.(*PodListController)
+				if assert.True(t, ok) {
+					var names []string
+					for _, pod := range podList.GetPods() {
+						names = append(names, pod.Name)
+					}
+					assert.ElementsMatch(t, []string{"db-0", "db-1"}, names)
+				}
+			})
+	})
+}
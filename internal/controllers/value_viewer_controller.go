@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+)
+
+// ValueViewerController handles input for the scrollable value viewer
+type ValueViewerController struct {
+	valueView *views.ValueView
+	title     string
+	width     int
+	height    int
+}
+
+// NewValueViewerController creates a viewer for a single value, titled e.g. by its key
+func NewValueViewerController(theme *theme.Theme, title, value string) *ValueViewerController {
+	return &ValueViewerController{
+		valueView: views.NewValueView(title, value, theme),
+		title:     title,
+	}
+}
+
+// valueViewerKeys are the key bindings of the value viewer
+var valueViewerKeys = registerKeyMap("value", "Value viewer",
+	scrollUpBinding,
+	scrollDownBinding,
+	Binding{Action: ActionPageUp, Keys: []string{"pgup", "b", "ctrl+u"}, Help: "Page up"},
+	Binding{Action: ActionPageDown, Keys: []string{"pgdown", "f", "ctrl+d"}, Help: "Page down"},
+	Binding{Action: ActionTop, Keys: []string{"g", "home"}, Help: "Go to the start"},
+	Binding{Action: ActionBottom, Keys: []string{"G", "end"}, Help: "Go to the end"},
+)
+
+// HandleKey handles key press events for the value viewer
+func (c *ValueViewerController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch valueViewerKeys.Action(msg) {
+	case ActionUp:
+		c.valueView.ScrollUp()
+	case ActionDown:
+		c.valueView.ScrollDown()
+	case ActionPageUp:
+		c.valueView.PageUp()
+	case ActionPageDown:
+		c.valueView.PageDown()
+	case ActionTop:
+		c.valueView.GoToStart()
+	case ActionBottom:
+		c.valueView.GoToEnd()
+	}
+	return nil
+}
+
+// KeyMap returns the key bindings of the value viewer, which the help overlay lists
+func (c *ValueViewerController) KeyMap() *KeyMap {
+	return valueViewerKeys
+}
+
+// Update has nothing to apply, as the value does not change while it is viewed
+func (c *ValueViewerController) Update(msg tea.Msg) tea.Cmd {
+	return nil
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *ValueViewerController) ActionText() string {
+	return fmt.Sprintf("Viewing %s", c.title)
+}
+
+// Render returns the rendered value viewer
+func (c *ValueViewerController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.valueView.SetSize(width, height)
+	return c.valueView.Render()
+}
+
+// GetValue returns the value being viewed (for testing)
+func (c *ValueViewerController) GetValue() string {
+	return c.valueView.Content()
+}
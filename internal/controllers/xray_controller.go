@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	"github.com/kevholditch/vigilant/internal/views"
+	"k8s.io/client-go/kubernetes"
+)
+
+// XRayController handles input for the x-ray view, the owner and relationship tree of a resource
+type XRayController struct {
+	xrayView  *views.XRayView
+	clientset *kubernetes.Clientset
+	theme     *theme.Theme
+	ref       models.ResourceRef
+	width     int
+	height    int
+}
+
+// NewXRayController creates a new x-ray controller for the resource ref, with that resource selected in the tree
+func NewXRayController(clientset *kubernetes.Clientset, theme *theme.Theme, ref models.ResourceRef) *XRayController {
+	msg := resolveRelationships(clientset, ref)
+	if msg.err != nil {
+		log.Printf("error resolving relationships: %v", msg.err)
+		// Show the resource on its own for error case
+		msg.root = &models.RelationshipNode{Ref: ref, Status: "Unknown"}
+	}
+
+	return &XRayController{
+		xrayView:  views.NewXRayView(msg.root, ref, theme),
+		clientset: clientset,
+		theme:     theme,
+		ref:       ref,
+	}
+}
+
+// XRay returns a command that pushes the x-ray view of the resource ref
+func XRay(clientset *kubernetes.Clientset, theme *theme.Theme, ref models.ResourceRef) tea.Cmd {
+	return PushView(NewXRayController(clientset, theme, ref), "x-ray "+ref.String())
+}
+
+// xrayKeys are the key bindings of the x-ray view
+var xrayKeys = registerKeyMap("xray", "X-ray",
+	selectUpBinding,
+	selectDownBinding,
+	Binding{Action: ActionToggle, Keys: []string{"enter", " "}, Help: "Expand or collapse the selected node"},
+	Binding{Action: ActionExpand, Keys: []string{"right", "l"}, Help: "Expand the selected node"},
+	Binding{Action: ActionCollapse, Keys: []string{"left", "h"}, Help: "Collapse the selected node"},
+	Binding{Action: ActionDescribe, Keys: []string{"d"}, Help: "Describe the selected resource"},
+	refreshBinding,
+)
+
+// HandleKey handles key press events for the x-ray view
+func (c *XRayController) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch xrayKeys.Action(msg) {
+	case ActionUp:
+		c.xrayView.SelectPrev()
+		return nil
+	case ActionDown:
+		c.xrayView.SelectNext()
+		return nil
+	case ActionToggle:
+		c.xrayView.Toggle()
+		return nil
+	case ActionExpand:
+		c.xrayView.Expand()
+		return nil
+	case ActionCollapse:
+		c.xrayView.Collapse()
+		return nil
+	case ActionDescribe:
+		selected := c.xrayView.GetSelected()
+		if selected == nil {
+			return nil
+		}
+		return describeResource(c.clientset, c.theme, selected.Ref)
+	case ActionRefresh:
+		// Refresh the relationship tree
+		return c.refreshRelationships()
+	default:
+		return nil
+	}
+}
+
+// KeyMap returns the key bindings of the x-ray view, which the help overlay lists
+func (c *XRayController) KeyMap() *KeyMap {
+	return xrayKeys
+}
+
+// describeResource pushes the describe view for ref. Kinds without a describe view, such as endpoint slices and
+// replica sets, return nil. Secrets and cron jobs are described read-only; their actions are available from their own views.
+func describeResource(clientset *kubernetes.Clientset, theme *theme.Theme, ref models.ResourceRef) tea.Cmd {
+	var controller Controller
+	switch ref.Kind {
+	case "Deployment":
+		controller = NewDescribeDeploymentController(clientset, theme, ref.Name, ref.Namespace)
+	case "StatefulSet":
+		controller = NewDescribeStatefulSetController(clientset, theme, ref.Name, ref.Namespace)
+	case "DaemonSet":
+		controller = NewDescribeDaemonSetController(clientset, theme, ref.Name, ref.Namespace)
+	case "CronJob":
+		controller = NewDescribeCronJobController(clientset, theme, ref.Name, ref.Namespace, true)
+	case "Job":
+		controller = NewDescribeJobController(clientset, theme, ref.Name, ref.Namespace)
+	case "Pod":
+		controller = NewDescribePodController(clientset, theme, ref.Name, ref.Namespace)
+	case "Service":
+		controller = NewDescribeServiceController(clientset, theme, ref.Name, ref.Namespace)
+	case "PersistentVolumeClaim":
+		controller = NewDescribePersistentVolumeClaimController(clientset, theme, ref.Name, ref.Namespace)
+	case "ConfigMap":
+		controller = NewDescribeConfigMapController(clientset, theme, ref.Name, ref.Namespace)
+	case "Secret":
+		controller = NewDescribeSecretController(clientset, theme, ref.Name, ref.Namespace, true)
+	default:
+		return nil
+	}
+	return PushView(controller, fmt.Sprintf("%s/%s", ref.Namespace, ref.Name))
+}
+
+// ActionText returns the text to describe the action the controller is performing for the header bar
+func (c *XRayController) ActionText() string {
+	return fmt.Sprintf("X-ray of %s", c.ref)
+}
+
+// Render returns the rendered x-ray view
+func (c *XRayController) Render(width, height int) string {
+	c.width = width
+	c.height = height
+	c.xrayView.SetSize(width, height)
+	return c.xrayView.Render()
+}
+
+// relationshipsResolvedMsg carries a refreshed relationship tree to the update loop
+type relationshipsResolvedMsg struct {
+	ref  models.ResourceRef
+	root *models.RelationshipNode
+	err  error
+}
+
+// Update applies a refreshed relationship tree on the update loop
+func (c *XRayController) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case relationshipsResolvedMsg:
+		if msg.err != nil {
+			log.Printf("error refreshing relationships: %v", msg.err)
+			return nil
+		}
+		if msg.ref == c.ref {
+			c.xrayView.UpdateRelationships(msg.root)
+		}
+	}
+	return nil
+}
+
+// refreshRelationships resolves the relationship tree off the update loop and delivers it as a relationshipsResolvedMsg
+func (c *XRayController) refreshRelationships() tea.Cmd {
+	clientset, ref := c.clientset, c.ref
+	return func() tea.Msg {
+		return resolveRelationships(clientset, ref)
+	}
+}
+
+// resolveRelationships resolves the relationship tree around ref
+func resolveRelationships(clientset *kubernetes.Clientset, ref models.ResourceRef) relationshipsResolvedMsg {
+	root, err := models.ResolveRelationships(clientset, ref)
+	if err != nil {
+		return relationshipsResolvedMsg{ref: ref, err: err}
+	}
+	return relationshipsResolvedMsg{ref: ref, root: root}
+}
+
+// GetRelationships returns the relationship tree shown (for testing)
+func (c *XRayController) GetRelationships() *models.RelationshipNode {
+	return c.xrayView.Relationships()
+}
+
+// GetSelected returns the selected resource in the tree (for testing)
+func (c *XRayController) GetSelected() *models.RelationshipNode {
+	return c.xrayView.GetSelected()
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+type XRayControllerScenario struct {
+	t          *testing.T
+	builder    *ClusterBuilder
+	controller *XRayController
+	pushed     PushViewMsg
+}
+
+func NewXRayControllerScenario(t *testing.T) *XRayControllerScenario {
+	builder := NewClusterBuilder(t)
+	return &XRayControllerScenario{
+		t:       t,
+		builder: builder,
+	}
+}
+
+func (s *XRayControllerScenario) Given() *XRayControllerScenario {
+	return s
+}
+func (s *XRayControllerScenario) When() *XRayControllerScenario {
+	return s
+}
+func (s *XRayControllerScenario) Then() *XRayControllerScenario {
+	return s
+}
+func (s *XRayControllerScenario) and() *XRayControllerScenario {
+	return s
+}
+
+func (s *XRayControllerScenario) ConfigureCluster(configFn func(*ClusterBuilder)) *XRayControllerScenario {
+	configFn(s.builder)
+	return s
+}
+
+func (s *XRayControllerScenario) the_xray_controller_is_instantiated_for(kind, namespace, name string) *XRayControllerScenario {
+	s.controller = NewXRayController(s.builder.GetClientset(), theme.NewDefaultTheme(), models.ResourceRef{Kind: kind, Namespace: namespace, Name: name})
+	return s
+}
+
+func (s *XRayControllerScenario) the_user_presses(msg tea.KeyMsg) *XRayControllerScenario {
+	cmd := s.controller.HandleKey(msg)
+	if cmd == nil {
+		return s
+	}
+	if pushed, ok := cmd().(PushViewMsg); ok {
+		s.pushed = pushed
+	}
+	return s
+}
+
+func (s *XRayControllerScenario) the_tree_should_be(assertFn func(*models.RelationshipNode)) *XRayControllerScenario {
+	assertFn(s.controller.GetRelationships())
+	return s
+}
+
+func (s *XRayControllerScenario) the_selected_resource_should_be(assertFn func(*models.RelationshipNode)) *XRayControllerScenario {
+	assertFn(s.controller.GetSelected())
+	return s
+}
+
+func (s *XRayControllerScenario) the_pushed_view_should_be(assertFn func(PushViewMsg)) *XRayControllerScenario {
+	assertFn(s.pushed)
+	return s
+}
+
+func (s *XRayControllerScenario) Cleanup() {
+	if stoppable, ok := s.pushed.Controller.(StoppableController); ok {
+		stoppable.Stop()
+	}
+	if s.builder != nil {
+		s.builder.Cleanup()
+	}
+}
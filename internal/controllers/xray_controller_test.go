@@ -0,0 +1,147 @@
+package controllers
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXRayController(t *testing.T) {
+	t.Run("should_walk_up_from_a_pod_to_its_deployment", func(t *testing.T) {
+		s := NewXRayControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "apps").
+					WithDeploymentPods("web", "apps", "abc123", 2)
+			}).
+			When().
+			the_xray_controller_is_instantiated_for("Pod", "apps", "web-abc123-2").
+			Then().
+			the_tree_should_be(func(root *models.RelationshipNode) {
+				assert.Equal(t, models.ResourceRef{Kind: "Deployment", Namespace: "apps", Name: "web"}, root.Ref)
+				assert.Equal(t, "0/3 ready", root.Status)
+				assert.Equal(t, models.HealthFailed, root.Health)
+				if assert.Len(t, root.Children, 1) {
+					replicaSet := root.Children[0]
+					assert.Equal(t, "ReplicaSet/web-abc123", replicaSet.Ref.String())
+					assert.Equal(t, "owns", replicaSet.Relation)
+					if assert.Len(t, replicaSet.Children, 2) {
+						assert.Equal(t, "Pod/web-abc123-1", replicaSet.Children[0].Ref.String())
+						assert.Equal(t, "Pod/web-abc123-2", replicaSet.Children[1].Ref.String())
+						assert.Equal(t, models.HealthDegraded, replicaSet.Children[0].Health)
+					}
+				}
+			}).
+			and().
+			the_selected_resource_should_be(func(selected *models.RelationshipNode) {
+				if assert.NotNil(t, selected) {
+					assert.Equal(t, "Pod/web-abc123-2", selected.Ref.String())
+				}
+			})
+	})
+
+	t.Run("should_walk_down_from_a_cron_job_through_its_jobs_to_their_pods", func(t *testing.T) {
+		s := NewXRayControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithCronJob("nightly", "batch", "0 2 * * *").
+					WithCronJobJob("nightly", "batch", "nightly-1").
+					WithFailedJobPod("nightly-1", "batch", "nightly-1-xyz", "Error", 1)
+			}).
+			When().
+			the_xray_controller_is_instantiated_for("CronJob", "batch", "nightly").
+			Then().
+			the_tree_should_be(func(root *models.RelationshipNode) {
+				assert.Equal(t, "CronJob/nightly", root.Ref.String())
+				if assert.Len(t, root.Children, 1) {
+					job := root.Children[0]
+					assert.Equal(t, "Job/nightly-1", job.Ref.String())
+					if assert.Len(t, job.Children, 1) {
+						pod := job.Children[0]
+						assert.Equal(t, "Pod/nightly-1-xyz", pod.Ref.String())
+						assert.Equal(t, "Failed", pod.Status)
+						assert.Equal(t, models.HealthFailed, pod.Health)
+					}
+				}
+			})
+	})
+
+	t.Run("should_follow_a_service_through_endpoint_slices_to_pods", func(t *testing.T) {
+		s := NewXRayControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithLabelledPod("web-1", "apps", map[string]string{"app": "web"}).
+					WithService("web", "apps", map[string]string{"app": "web"}).
+					WithServiceEndpoint("web", "apps", "10.0.0.1", "web-1", true)
+			}).
+			When().
+			the_xray_controller_is_instantiated_for("Service", "apps", "web").
+			Then().
+			the_tree_should_be(func(root *models.RelationshipNode) {
+				assert.Equal(t, "Service/web", root.Ref.String())
+				assert.Equal(t, models.HealthHealthy, root.Health)
+				if assert.Len(t, root.Children, 1) {
+					slice := root.Children[0]
+					assert.Equal(t, "EndpointSlice/web-web-1", slice.Ref.String())
+					assert.Equal(t, "1/1 ready", slice.Status)
+					if assert.Len(t, slice.Children, 1) {
+						assert.Equal(t, "Pod/web-1", slice.Children[0].Ref.String())
+						assert.Equal(t, "selects", slice.Children[0].Relation)
+					}
+				}
+			})
+	})
+
+	t.Run("should_show_the_claims_config_maps_and_secrets_a_pod_uses", func(t *testing.T) {
+		s := NewXRayControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithConfigMap("settings", "apps", map[string]string{"mode": "fast"}).
+					WithPodUsingConfig("worker", "apps", "settings", "credentials")
+			}).
+			When().
+			the_xray_controller_is_instantiated_for("Pod", "apps", "worker").
+			Then().
+			the_tree_should_be(func(root *models.RelationshipNode) {
+				assert.Equal(t, "Pod/worker", root.Ref.String())
+				if assert.Len(t, root.Children, 2) {
+					secret, configMap := root.Children[0], root.Children[1]
+					assert.Equal(t, "Secret/credentials", secret.Ref.String())
+					assert.Equal(t, "mounts", secret.Relation)
+					assert.Equal(t, "Missing", secret.Status)
+					assert.Equal(t, models.HealthFailed, secret.Health)
+
+					assert.Equal(t, "ConfigMap/settings", configMap.Ref.String())
+					assert.Equal(t, "references", configMap.Relation)
+					assert.Equal(t, models.HealthHealthy, configMap.Health)
+				}
+			})
+	})
+
+	t.Run("should_describe_the_selected_resource", func(t *testing.T) {
+		s := NewXRayControllerScenario(t)
+		defer s.Cleanup()
+		s.Given().
+			ConfigureCluster(func(builder *ClusterBuilder) {
+				builder.WithDeployment("web", "apps").
+					WithDeploymentPods("web", "apps", "abc123", 1)
+			}).
+			the_xray_controller_is_instantiated_for("Deployment", "apps", "web").
+			When().
+			the_user_presses(tea.KeyMsg{Type: tea.KeyDown}).
+			the_user_presses(tea.KeyMsg{Type: tea.KeyDown}).
+			the_user_presses(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}}).
+			Then().
+			the_pushed_view_should_be(func(pushed PushViewMsg) {
+				assert.Equal(t, "apps/web-abc123-1", pushed.Title)
+				_, ok := pushed.Controller.(*DescribePodController)
+				assert.True(t, ok)
+			})
+	})
+}
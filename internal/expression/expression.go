@@ -0,0 +1,184 @@
+// Package expression compiles the JSONPath and CEL expressions that fill custom columns, and evaluates them against
+// Kubernetes objects in their unstructured form, as they are served by the API.
+package expression
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Language is the language an expression is written in
+type Language string
+
+const (
+	// JSONPath is the kubectl JSONPath template language, such as .spec.containers[*].image
+	JSONPath Language = "jsonPath"
+	// CEL is the Common Expression Language used by Kubernetes validation rules, such as metadata.labels['team']
+	CEL Language = "cel"
+)
+
+// costLimit bounds the work a CEL expression may do for one object, so a runaway expression cannot stall rendering
+const costLimit = 1000000
+
+// celVariables are the variables a CEL expression can use: the whole object, and its top-level fields
+var celVariables = []string{"object", "metadata", "spec", "status", "data"}
+
+// celEnv is the environment every CEL expression is compiled in
+var celEnv = newCELEnv()
+
+// newCELEnv creates the CEL environment, declaring the variables as dynamic since objects are unstructured
+func newCELEnv() *cel.Env {
+	options := []cel.EnvOption{ext.Strings(), ext.Lists(), ext.Encoders()}
+	for _, name := range celVariables {
+		options = append(options, cel.Variable(name, cel.DynType))
+	}
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		panic(fmt.Sprintf("could not create CEL environment: %v", err))
+	}
+	return env
+}
+
+// Expression is a compiled expression, ready to be evaluated against any number of objects
+type Expression struct {
+	language Language
+	source   string
+	jsonPath *jsonpath.JSONPath
+	program  cel.Program
+}
+
+// Compile parses and checks an expression once, so that evaluating it for each row only runs it
+func Compile(language Language, source string) (*Expression, error) {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return nil, fmt.Errorf("expression must not be empty")
+	}
+
+	e := &Expression{language: language, source: source}
+	switch language {
+	case JSONPath:
+		e.jsonPath = jsonpath.New(source).AllowMissingKeys(true)
+		if err := e.jsonPath.Parse(jsonPathTemplate(source)); err != nil {
+			return nil, err
+		}
+	case CEL:
+		ast, issues := celEnv.Compile(source)
+		if issues != nil && issues.Err() != nil {
+			return nil, issues.Err()
+		}
+		program, err := celEnv.Program(ast, cel.CostLimit(costLimit))
+		if err != nil {
+			return nil, err
+		}
+		e.program = program
+	default:
+		return nil, fmt.Errorf("unknown expression language %q (expected %s or %s)", language, JSONPath, CEL)
+	}
+	return e, nil
+}
+
+// jsonPathTemplate returns a JSONPath expression as a template, wrapping a bare expression in braces as kubectl does
+func jsonPathTemplate(source string) string {
+	if strings.HasPrefix(source, "{") {
+		return source
+	}
+	return "{" + source + "}"
+}
+
+// Language returns the language the expression is written in
+func (e *Expression) Language() Language {
+	return e.language
+}
+
+// String returns the expression as it was written
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Evaluate runs the expression against an unstructured object and formats the result as a cell: strings as they are,
+// lists of values joined with commas and anything else as JSON. An empty string is returned when there is no result.
+func (e *Expression) Evaluate(object map[string]interface{}) (string, error) {
+	if e.jsonPath != nil {
+		var out bytes.Buffer
+		if err := e.jsonPath.Execute(&out, object); err != nil {
+			return "", err
+		}
+		return out.String(), nil
+	}
+
+	activation := make(map[string]interface{}, len(celVariables))
+	activation["object"] = object
+	for _, name := range celVariables[1:] {
+		// Objects without a field still declare its variable, so that has() can test for it
+		if value, ok := object[name]; ok {
+			activation[name] = value
+		} else {
+			activation[name] = map[string]interface{}{}
+		}
+	}
+	result, _, err := e.program.Eval(activation)
+	if err != nil {
+		return "", err
+	}
+	if types.IsError(result) {
+		return "", fmt.Errorf("%v", result)
+	}
+	native, err := result.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return "", err
+	}
+	return format(native.(*structpb.Value).AsInterface())
+}
+
+// format formats an evaluated value as a cell
+func format(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case bool, float64:
+		return fmt.Sprint(value), nil
+	case []interface{}:
+		items := make([]string, len(value))
+		for i, item := range value {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				return marshal(value)
+			}
+			formatted, err := format(item)
+			if err != nil {
+				return "", err
+			}
+			items[i] = formatted
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return marshal(value)
+	}
+}
+
+// marshal formats a structured value as compact JSON
+func marshal(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ToUnstructured converts a typed Kubernetes object, such as a *v1.Pod, to the unstructured form expressions are
+// evaluated against
+func ToUnstructured(object interface{}) (map[string]interface{}, error) {
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+}
@@ -0,0 +1,85 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpression(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "web-1",
+			Labels: map[string]string{"team": "payments"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "web", Image: "nginx:1.27"},
+				{Name: "proxy", Image: "envoy:1.30"},
+			},
+			NodeSelector: map[string]string{"disk": "ssd"},
+		},
+	}
+	object, err := ToUnstructured(pod)
+	require.NoError(t, err)
+
+	t.Run("should_evaluate_expressions_against_the_object", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			language Language
+			source   string
+			expected string
+		}{
+			{name: "jsonpath_field", language: JSONPath, source: ".metadata.name", expected: "web-1"},
+			{name: "jsonpath_template", language: JSONPath, source: "{.spec.nodeSelector.disk}", expected: "ssd"},
+			{name: "jsonpath_every_match", language: JSONPath, source: ".spec.containers[*].image", expected: "nginx:1.27 envoy:1.30"},
+			{name: "jsonpath_missing_field", language: JSONPath, source: ".spec.priorityClassName", expected: ""},
+			{name: "cel_map_entry", language: CEL, source: "metadata.labels['team']", expected: "payments"},
+			{name: "cel_has", language: CEL, source: "has(metadata.labels.owner) ? metadata.labels.owner : '-'", expected: "-"},
+			{name: "cel_list", language: CEL, source: "spec.containers.map(c, c.name)", expected: "web,proxy"},
+			{name: "cel_join", language: CEL, source: "spec.containers.map(c, c.image).join(' ')", expected: "nginx:1.27 envoy:1.30"},
+			{name: "cel_number", language: CEL, source: "size(spec.containers)", expected: "2"},
+			{name: "cel_bool", language: CEL, source: "spec.containers.exists(c, c.name == 'proxy')", expected: "true"},
+			{name: "cel_object", language: CEL, source: "spec.nodeSelector", expected: `{"disk":"ssd"}`},
+			{name: "cel_whole_object", language: CEL, source: "object.metadata.name", expected: "web-1"},
+			{name: "cel_absent_top_level_field", language: CEL, source: "has(data.key) ? 'yes' : 'no'", expected: "no"},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				compiled, err := Compile(test.language, test.source)
+				require.NoError(t, err)
+
+				value, err := compiled.Evaluate(object)
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, value)
+			})
+		}
+	})
+
+	t.Run("should_report_expressions_that_do_not_compile", func(t *testing.T) {
+		for language, source := range map[Language]string{
+			JSONPath: "{.spec.containers[0",
+			CEL:      "size(spec.containers) >",
+		} {
+			_, err := Compile(language, source)
+			assert.Error(t, err, "%s %s", language, source)
+		}
+
+		_, err := Compile(CEL, "  ")
+		assert.EqualError(t, err, "expression must not be empty")
+
+		_, err = Compile("jq", ".name")
+		assert.EqualError(t, err, `unknown expression language "jq" (expected jsonPath or cel)`)
+	})
+
+	t.Run("should_report_expressions_that_fail_for_an_object", func(t *testing.T) {
+		compiled, err := Compile(CEL, "metadata.labels['owner']")
+		require.NoError(t, err)
+
+		_, err = compiled.Evaluate(object)
+		assert.ErrorContains(t, err, "no such key: owner")
+	})
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// formatAge formats an age duration to a short human-readable string
+func formatAge(age time.Duration) string {
+	if age < time.Minute {
+		return "<1m"
+	}
+	if age < time.Hour {
+		return age.Round(time.Minute).String()
+	}
+	if age < 24*time.Hour {
+		return age.Round(time.Hour).String()
+	}
+	return age.Round(24 * time.Hour).String()
+}
+
+// FormatDuration formats a duration, such as the time until a scheduled run, in the same short form as ages
+func FormatDuration(d time.Duration) string {
+	return formatAge(d)
+}
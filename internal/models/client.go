@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// NewClientSet creates a client for the current context of the kubeconfig and returns the context's name
+func NewClientSet() (*kubernetes.Clientset, string, error) {
+	clientConfig, err := kubeConfig("")
+	if err != nil {
+		return nil, "", err
+	}
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting Kubernetes config: %v", err)
+	}
+
+	clientset, err := newClientSetFor(clientConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	return clientset, rawConfig.CurrentContext, nil
+}
+
+// NewClientSetForContext creates a client for the named context of the kubeconfig
+func NewClientSetForContext(context string) (*kubernetes.Clientset, error) {
+	clientConfig, err := kubeConfig(context)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := newClientSetFor(clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("context %s: %w", context, err)
+	}
+	return clientset, nil
+}
+
+// KubeContexts returns the names of the contexts in the kubeconfig, in sorted order
+func KubeContexts() ([]string, error) {
+	clientConfig, err := kubeConfig("")
+	if err != nil {
+		return nil, err
+	}
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Kubernetes config: %v", err)
+	}
+	contexts := make([]string, 0, len(rawConfig.Contexts))
+	for name := range rawConfig.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+	return contexts, nil
+}
+
+// kubeConfig loads the kubeconfig, using the named context or the current one when context is empty
+func kubeConfig(context string) (clientcmd.ClientConfig, error) {
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("error getting user home dir: %v", err)
+	}
+	kubeConfigPath := filepath.Join(userHomeDir, ".kube", "config")
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeConfigPath}, &clientcmd.ConfigOverrides{CurrentContext: context}), nil
+}
+
+// newClientSetFor creates a client from a loaded kubeconfig
+func newClientSetFor(clientConfig clientcmd.ClientConfig) (*kubernetes.Clientset, error) {
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Kubernetes config: %v", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %v", err)
+	}
+	return clientset, nil
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// Cluster is a kubeconfig context shown alongside others in the multi-cluster views
+type Cluster struct {
+	// Name is the name of the kubeconfig context, shown in the CLUSTER column
+	Name      string
+	Clientset *kubernetes.Clientset
+}
+
+// ClusterState is the state of the connection to a cluster in the multi-cluster views
+type ClusterState string
+
+const (
+	// ClusterConnecting is the state of a cluster until its first list succeeds or fails
+	ClusterConnecting ClusterState = "Connecting"
+	// ClusterConnected is the state of a cluster whose resources are listed and watched
+	ClusterConnected ClusterState = "Connected"
+	// ClusterUnreachable is the state of a cluster whose list or watch failed; it is retried until it succeeds
+	ClusterUnreachable ClusterState = "Unreachable"
+)
+
+// ClusterHealth is the connection health of a cluster, which the header shows for each cluster in the
+// multi-cluster views
+type ClusterHealth struct {
+	Name  string
+	State ClusterState
+	// Err is why the cluster is unreachable; empty otherwise
+	Err string
+	// Since is when the cluster entered its state
+	Since time.Time
+}
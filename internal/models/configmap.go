@@ -0,0 +1,91 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DataKey describes a key of a ConfigMap or Secret without its value
+type DataKey struct {
+	Name string
+	Size int
+	// Binary is true for ConfigMap binaryData keys, whose values are not shown as text
+	Binary bool
+}
+
+// ConfigMap represents a Kubernetes config map
+type ConfigMap struct {
+	Name       string
+	Namespace  string
+	Data       map[string]string
+	BinaryData map[string][]byte
+	Immutable  bool
+	Age        time.Duration
+
+	// Object is the config map the model was converted from, which custom columns are filled from
+	Object *v1.ConfigMap
+}
+
+// GetConfigMap fetches a single config map by name and namespace
+func GetConfigMap(clientset *kubernetes.Clientset, namespace, name string) (*ConfigMap, error) {
+	k8sConfigMap, err := clientset.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get config map %s in namespace %s: %w", name, namespace, err)
+	}
+
+	configMap := ToConfigMapModel(*k8sConfigMap)
+	return &configMap, nil
+}
+
+// ToConfigMapModel converts a Kubernetes API config map object to our internal ConfigMap model
+func ToConfigMapModel(c v1.ConfigMap) ConfigMap {
+	return ConfigMap{
+		Name:       c.Name,
+		Namespace:  c.Namespace,
+		Data:       c.Data,
+		BinaryData: c.BinaryData,
+		Immutable:  c.Immutable != nil && *c.Immutable,
+		Age:        time.Since(c.CreationTimestamp.Time),
+		Object:     &c,
+	}
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (c ConfigMap) FormatAge() string {
+	return formatAge(c.Age)
+}
+
+// KeyCount returns the number of data and binaryData keys
+func (c ConfigMap) KeyCount() int {
+	return len(c.Data) + len(c.BinaryData)
+}
+
+// Keys returns the data and binaryData keys sorted by name
+func (c ConfigMap) Keys() []DataKey {
+	keys := make([]DataKey, 0, c.KeyCount())
+	for name, value := range c.Data {
+		keys = append(keys, DataKey{Name: name, Size: len(value)})
+	}
+	for name, value := range c.BinaryData {
+		keys = append(keys, DataKey{Name: name, Size: len(value), Binary: true})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys
+}
+
+// FormatSize formats a value size in bytes using binary units
+func FormatSize(size int) string {
+	if size < 1024 {
+		return fmt.Sprintf("%dB", size)
+	}
+	if size < 1024*1024 {
+		return fmt.Sprintf("%.1fKiB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%.1fMiB", float64(size)/(1024*1024))
+}
@@ -0,0 +1,318 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// CronSchedule is a parsed standard five field cron schedule, as used by CronJobs
+type CronSchedule struct {
+	Expression string
+	// schedule computes the times the schedule fires
+	schedule cron.Schedule
+	// descriptor is the @ shorthand the schedule was written as, if any
+	descriptor string
+	// fields holds the raw minute, hour, day of month, month and day of week fields
+	fields [5]string
+	// location is the time zone the schedule is evaluated in
+	location *time.Location
+}
+
+const (
+	cronMinute = iota
+	cronHour
+	cronDayOfMonth
+	cronMonth
+	cronDayOfWeek
+)
+
+// cronField describes how the values of a cron field are rendered
+type cronField struct {
+	unit  string
+	names []string // lower-case short names of the values from min, if the field has names
+	full  []string // display names of the values from min
+	min   int
+}
+
+var cronFields = [5]cronField{
+	{unit: "minute"},
+	{unit: "hour"},
+	{unit: "day", min: 1},
+	{
+		unit: "month", min: 1,
+		names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"},
+		full:  []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	},
+	{
+		unit:  "day",
+		names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"},
+		full:  []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	},
+}
+
+// cronParser parses schedules the way the CronJob controller does, including the @ descriptors
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// cronDescriptorDescriptions renders the @ shorthands in words
+var cronDescriptorDescriptions = map[string]string{
+	"@yearly":   "At 00:00 on January 1st",
+	"@annually": "At 00:00 on January 1st",
+	"@monthly":  "At 00:00 on day 1 of the month",
+	"@weekly":   "At 00:00 on Sunday",
+	"@daily":    "At 00:00 every day",
+	"@midnight": "At 00:00 every day",
+	"@hourly":   "Every hour",
+}
+
+// ParseCronSchedule parses a cron schedule evaluated in the named IANA time zone.
+// An empty time zone evaluates the schedule in UTC, the usual time zone of the kube-controller-manager.
+func ParseCronSchedule(expression, timeZone string) (*CronSchedule, error) {
+	schedule := &CronSchedule{Expression: expression, location: time.UTC}
+
+	spec := strings.TrimSpace(expression)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		prefix, rest, _ := strings.Cut(spec, " ")
+		_, timeZone, _ = strings.Cut(prefix, "=")
+		spec = strings.TrimSpace(rest)
+	}
+	if timeZone != "" {
+		location, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %w", timeZone, err)
+		}
+		schedule.location = location
+	}
+
+	parsed, err := cronParser.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	if specSchedule, ok := parsed.(*cron.SpecSchedule); ok {
+		// The parser evaluates schedules in the local time zone unless told otherwise
+		specSchedule.Location = schedule.location
+	}
+	schedule.schedule = parsed
+
+	if strings.HasPrefix(spec, "@") {
+		schedule.descriptor = spec
+	} else {
+		copy(schedule.fields[:], strings.Fields(spec))
+	}
+	return schedule, nil
+}
+
+// Location returns the time zone the schedule is evaluated in
+func (s *CronSchedule) Location() *time.Location {
+	return s.location
+}
+
+// Next returns the first time strictly after the given time that the schedule fires,
+// or the zero time if it never fires in the next five years (e.g. 30 February)
+func (s *CronSchedule) Next(after time.Time) time.Time {
+	return s.schedule.Next(after)
+}
+
+// NextRuns returns the next count times the schedule fires after the given time
+func (s *CronSchedule) NextRuns(after time.Time, count int) []time.Time {
+	var runs []time.Time
+	for len(runs) < count {
+		next := s.Next(after)
+		if next.IsZero() {
+			break
+		}
+		runs = append(runs, next)
+		after = next
+	}
+	return runs
+}
+
+// restricted reports whether a field was written as anything other than a wildcard
+func (s *CronSchedule) restricted(field int) bool {
+	raw := s.fields[field]
+	return raw != "*" && raw != "?"
+}
+
+// Describe renders the schedule in words, such as "At 02:30 on Monday through Friday"
+func (s *CronSchedule) Describe() string {
+	if description, ok := cronDescriptorDescriptions[s.descriptor]; ok {
+		return description
+	}
+	if every, ok := s.schedule.(cron.ConstantDelaySchedule); ok {
+		return "Every " + every.Delay.String()
+	}
+
+	description, atTime := s.describeTime()
+	var days []string
+	if s.restricted(cronDayOfMonth) {
+		label := "day"
+		if !isCronSingleValue(s.fields[cronDayOfMonth]) {
+			label = "days"
+		}
+		days = append(days, fmt.Sprintf("on %s %s of the month", label, describeCronField(s.fields[cronDayOfMonth], cronFields[cronDayOfMonth])))
+	}
+	if s.restricted(cronDayOfWeek) {
+		days = append(days, "on "+describeCronField(s.fields[cronDayOfWeek], cronFields[cronDayOfWeek]))
+	}
+
+	parts := []string{description}
+	switch {
+	case len(days) > 0:
+		// Both day fields restricted fire on a day matching either
+		parts = append(parts, strings.Join(days, " or "))
+	case atTime && !s.restricted(cronMonth):
+		parts = append(parts, "every day")
+	}
+	if s.restricted(cronMonth) {
+		parts = append(parts, "in "+describeCronField(s.fields[cronMonth], cronFields[cronMonth]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// describeTime renders the minute and hour fields, reporting whether they describe specific times of day
+func (s *CronSchedule) describeTime() (string, bool) {
+	minute, hour := s.fields[cronMinute], s.fields[cronHour]
+	minuteValue, minuteSingle := cronSingleValue(minute)
+
+	switch {
+	case minute == "*" && hour == "*":
+		return "Every minute", false
+	case strings.HasPrefix(minute, "*/") && hour == "*":
+		return fmt.Sprintf("Every %s minutes", strings.TrimPrefix(minute, "*/")), false
+	case minuteSingle && hour == "*":
+		if minuteValue == 0 {
+			return "Every hour", false
+		}
+		return fmt.Sprintf("At minute %d past every hour", minuteValue), false
+	case minuteSingle && strings.HasPrefix(hour, "*/"):
+		if minuteValue == 0 {
+			return fmt.Sprintf("Every %s hours", strings.TrimPrefix(hour, "*/")), false
+		}
+		return fmt.Sprintf("At minute %d past every %s hours", minuteValue, strings.TrimPrefix(hour, "*/")), false
+	}
+
+	if minuteSingle {
+		if hours, ok := cronValueList(hour); ok {
+			times := make([]string, len(hours))
+			for i, h := range hours {
+				times[i] = fmt.Sprintf("%02d:%02d", h, minuteValue)
+			}
+			return "At " + joinWords(times), true
+		}
+	}
+
+	hourText := "every hour"
+	if hour != "*" {
+		hourText = "hour " + describeCronField(hour, cronFields[cronHour])
+	}
+	return fmt.Sprintf("At %s past %s", describeCronMinutes(minute), hourText), false
+}
+
+// describeCronMinutes renders a minute field as the object of "At ... past"
+func describeCronMinutes(raw string) string {
+	text := describeCronField(raw, cronFields[cronMinute])
+	if strings.HasPrefix(text, "every ") {
+		return text
+	}
+	return "minute " + text
+}
+
+// describeCronField renders a field's values, ranges and steps in words
+func describeCronField(raw string, field cronField) string {
+	var parts []string
+	for _, part := range strings.Split(raw, ",") {
+		rangePart, step, hasStep := strings.Cut(part, "/")
+		var text string
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			text = ""
+		case strings.Contains(rangePart, "-"):
+			low, high, _ := strings.Cut(rangePart, "-")
+			text = cronValueName(low, field) + " through " + cronValueName(high, field)
+		default:
+			text = cronValueName(rangePart, field)
+		}
+		switch {
+		case hasStep && text == "":
+			text = fmt.Sprintf("every %s %s", ordinal(step), field.unit)
+		case hasStep:
+			text = fmt.Sprintf("every %s %s from %s", ordinal(step), field.unit, text)
+		case text == "":
+			text = "every " + field.unit
+		}
+		parts = append(parts, text)
+	}
+	return joinWords(parts)
+}
+
+// cronValueName renders a single value of a field, by name when the field has names
+func cronValueName(raw string, field cronField) string {
+	if field.full == nil {
+		return raw
+	}
+	for i, name := range field.names {
+		if strings.EqualFold(raw, name) {
+			return field.full[i]
+		}
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < field.min || value-field.min >= len(field.full) {
+		return raw
+	}
+	return field.full[value-field.min]
+}
+
+// cronSingleValue parses a field written as a single number
+func cronSingleValue(raw string) (int, bool) {
+	value, err := strconv.Atoi(raw)
+	return value, err == nil
+}
+
+// isCronSingleValue reports whether a field is a single value rather than a list, range or step
+func isCronSingleValue(raw string) bool {
+	return !strings.ContainsAny(raw, ",-/*")
+}
+
+// cronValueList parses a field written as a comma separated list of numbers
+func cronValueList(raw string) ([]int, bool) {
+	var values []int
+	for _, part := range strings.Split(raw, ",") {
+		value, ok := cronSingleValue(part)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, true
+}
+
+// ordinal renders a step as an English ordinal, such as "2nd"
+func ordinal(raw string) string {
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return raw
+	}
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// joinWords joins items as an English list: "a", "a and b", "a, b and c"
+func joinWords(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronSchedule(t *testing.T) {
+	from := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC) // a Friday
+
+	t.Run("should_describe_and_run_a_five_field_schedule", func(t *testing.T) {
+		schedule, err := ParseCronSchedule("30 2 * * 1-5", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "At 02:30 on Monday through Friday", schedule.Describe())
+		assert.Equal(t, []time.Time{
+			time.Date(2026, time.October, 19, 2, 30, 0, 0, time.UTC),
+			time.Date(2026, time.October, 20, 2, 30, 0, 0, time.UTC),
+		}, schedule.NextRuns(from, 2))
+	})
+
+	t.Run("should_run_the_descriptors", func(t *testing.T) {
+		schedule, err := ParseCronSchedule("@hourly", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Every hour", schedule.Describe())
+		assert.Equal(t, time.Date(2026, time.October, 16, 13, 0, 0, 0, time.UTC), schedule.Next(from))
+
+		schedule, err = ParseCronSchedule("@weekly", "")
+		require.NoError(t, err)
+		assert.Equal(t, "At 00:00 on Sunday", schedule.Describe())
+		assert.Equal(t, time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC), schedule.Next(from))
+	})
+
+	t.Run("should_run_every_interval", func(t *testing.T) {
+		schedule, err := ParseCronSchedule("@every 90m", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Every 1h30m0s", schedule.Describe())
+		assert.Equal(t, from.Add(90*time.Minute), schedule.Next(from))
+	})
+
+	t.Run("should_evaluate_the_schedule_in_its_time_zone", func(t *testing.T) {
+		schedule, err := ParseCronSchedule("0 9 * * *", "Europe/London")
+		require.NoError(t, err)
+
+		// 09:00 BST is 08:00 UTC
+		assert.Equal(t, time.Date(2026, time.October, 17, 8, 0, 0, 0, time.UTC), schedule.Next(from).UTC())
+
+		schedule, err = ParseCronSchedule("CRON_TZ=America/New_York 0 9 * * *", "")
+		require.NoError(t, err)
+		assert.Equal(t, "America/New_York", schedule.Location().String())
+	})
+
+	t.Run("should_reject_invalid_schedules", func(t *testing.T) {
+		for _, expression := range []string{"61 * * * *", "* * *", "@fortnightly", "0 0 * * mon-sun-tue"} {
+			_, err := ParseCronSchedule(expression, "")
+			assert.Error(t, err, expression)
+		}
+		_, err := ParseCronSchedule("0 0 * * *", "Mars/Olympus")
+		assert.Error(t, err)
+	})
+
+	t.Run("should_never_run_a_schedule_that_cannot_fire", func(t *testing.T) {
+		schedule, err := ParseCronSchedule("0 0 30 2 *", "")
+		require.NoError(t, err)
+
+		assert.Empty(t, schedule.NextRuns(from, 3))
+	})
+}
@@ -0,0 +1,218 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+// manualJobAnnotation marks a job created from a cron job's template by hand rather than on schedule,
+// matching kubectl create job --from
+const manualJobAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// CronJob represents a Kubernetes cron job
+type CronJob struct {
+	Name               string
+	Namespace          string
+	UID                types.UID
+	Schedule           string
+	TimeZone           string
+	Suspend            bool
+	ConcurrencyPolicy  string
+	Active             []string
+	LastScheduleTime   *time.Time
+	LastSuccessfulTime *time.Time
+	Age                time.Duration
+
+	// Object is the cron job the model was converted from, which custom columns are filled from
+	Object *batchv1.CronJob
+}
+
+// GetCronJob fetches a single cron job by name and namespace
+func GetCronJob(clientset *kubernetes.Clientset, namespace, name string) (*CronJob, error) {
+	k8sCronJob, err := clientset.BatchV1().CronJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get cron job %s in namespace %s: %w", name, namespace, err)
+	}
+
+	cronJob := ToCronJobModel(*k8sCronJob)
+	return &cronJob, nil
+}
+
+// ToCronJobModel converts a Kubernetes API cron job object to our internal CronJob model
+func ToCronJobModel(c batchv1.CronJob) CronJob {
+	cronJob := CronJob{
+		Name:              c.Name,
+		Namespace:         c.Namespace,
+		UID:               c.UID,
+		Schedule:          c.Spec.Schedule,
+		Suspend:           c.Spec.Suspend != nil && *c.Spec.Suspend,
+		ConcurrencyPolicy: string(c.Spec.ConcurrencyPolicy),
+		Age:               time.Since(c.CreationTimestamp.Time),
+		Object:            &c,
+	}
+	if c.Spec.TimeZone != nil {
+		cronJob.TimeZone = *c.Spec.TimeZone
+	}
+	if cronJob.ConcurrencyPolicy == "" {
+		cronJob.ConcurrencyPolicy = string(batchv1.AllowConcurrent)
+	}
+	for _, active := range c.Status.Active {
+		cronJob.Active = append(cronJob.Active, active.Name)
+	}
+	if c.Status.LastScheduleTime != nil {
+		cronJob.LastScheduleTime = &c.Status.LastScheduleTime.Time
+	}
+	if c.Status.LastSuccessfulTime != nil {
+		cronJob.LastSuccessfulTime = &c.Status.LastSuccessfulTime.Time
+	}
+	return cronJob
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (c CronJob) FormatAge() string {
+	return formatAge(c.Age)
+}
+
+// ParseSchedule parses the cron job's schedule in its time zone
+func (c CronJob) ParseSchedule() (*CronSchedule, error) {
+	return ParseCronSchedule(c.Schedule, c.TimeZone)
+}
+
+// FormatSchedule renders the schedule in words, or why it is invalid
+func (c CronJob) FormatSchedule() string {
+	schedule, err := c.ParseSchedule()
+	if err != nil {
+		return fmt.Sprintf("<invalid: %v>", err)
+	}
+	return schedule.Describe()
+}
+
+// NextRuns returns the next count times the schedule fires after the given time, none while suspended
+func (c CronJob) NextRuns(after time.Time, count int) []time.Time {
+	if c.Suspend {
+		return nil
+	}
+	schedule, err := c.ParseSchedule()
+	if err != nil {
+		return nil
+	}
+	return schedule.NextRuns(after, count)
+}
+
+// FormatNextRun formats how long until the schedule next fires
+func (c CronJob) FormatNextRun(now time.Time) string {
+	if c.Suspend {
+		return "<suspended>"
+	}
+	runs := c.NextRuns(now, 1)
+	if len(runs) == 0 {
+		return "<none>"
+	}
+	return "in " + formatAge(runs[0].Sub(now))
+}
+
+// FormatLastSchedule formats how long ago a job was last scheduled
+func (c CronJob) FormatLastSchedule() string {
+	if c.LastScheduleTime == nil {
+		return "<none>"
+	}
+	return formatAge(time.Since(*c.LastScheduleTime)) + " ago"
+}
+
+// FormatSuspend formats whether the cron job is suspended
+func (c CronJob) FormatSuspend() string {
+	if c.Suspend {
+		return "True"
+	}
+	return "False"
+}
+
+// GetCronJobJobs returns the jobs a cron job created, newest first. Jobs are matched on the cron job's UID, so
+// those left by an earlier cron job of the same name are not included.
+func GetCronJobJobs(clientset *kubernetes.Clientset, cronJob *CronJob) ([]Job, error) {
+	jobList, err := clientset.BatchV1().Jobs(cronJob.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list jobs in namespace %s: %w", cronJob.Namespace, err)
+	}
+
+	var jobs []Job
+	for _, k8sJob := range jobList.Items {
+		if controllerRef := metav1.GetControllerOf(&k8sJob); controllerRef != nil && controllerRef.UID == cronJob.UID {
+			jobs = append(jobs, ToJobModel(k8sJob))
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].Created.After(jobs[j].Created) })
+	return jobs, nil
+}
+
+// TriggerCronJob creates a job from a cron job's job template now, returning the job's name
+func TriggerCronJob(clientset *kubernetes.Clientset, namespace, name string) (string, error) {
+	k8sCronJob, err := clientset.BatchV1().CronJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not get cron job %s in namespace %s: %w", name, namespace, err)
+	}
+
+	// Job names are limited to 63 characters, leaving room for the suffix
+	prefix := name
+	if len(prefix) > 52 {
+		prefix = prefix[:52]
+	}
+	annotations := map[string]string{manualJobAnnotation: "manual"}
+	for key, value := range k8sCronJob.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-manual-%s", prefix, utilrand.String(5)),
+			Namespace:       namespace,
+			Labels:          k8sCronJob.Spec.JobTemplate.Labels,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(k8sCronJob, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		},
+		Spec: k8sCronJob.Spec.JobTemplate.Spec,
+	}
+	created, err := clientset.BatchV1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not create job from cron job %s in namespace %s: %w", name, namespace, err)
+	}
+	return created.Name, nil
+}
+
+// SetCronJobSuspend suspends (true) or resumes (false) a cron job by patching spec.suspend
+func SetCronJobSuspend(clientset *kubernetes.Clientset, namespace, name string, suspend bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend))
+	_, err := clientset.BatchV1().CronJobs(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("could not patch cron job %s in namespace %s: %w", name, namespace, err)
+	}
+	return nil
+}
+
+// GetCronJobLatestPod returns the latest job of a cron job and the name of that job's most recent pod
+func GetCronJobLatestPod(clientset *kubernetes.Clientset, namespace, name string) (*Job, string, error) {
+	cronJob, err := GetCronJob(clientset, namespace, name)
+	if err != nil {
+		return nil, "", err
+	}
+	jobs, err := GetCronJobJobs(clientset, cronJob)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(jobs) == 0 {
+		return nil, "", fmt.Errorf("cron job %s in namespace %s has not created any jobs", name, namespace)
+	}
+	podName, err := GetLatestJobPod(clientset, &jobs[0])
+	if err != nil {
+		return &jobs[0], "", err
+	}
+	return &jobs[0], podName, nil
+}
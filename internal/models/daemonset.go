@@ -0,0 +1,236 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DaemonSet represents a Kubernetes daemon set
+type DaemonSet struct {
+	Name         string
+	Namespace    string
+	Desired      int
+	Current      int
+	Ready        int
+	UpToDate     int
+	Available    int
+	Misscheduled int
+	NodeSelector map[string]string
+	// Selector is the label selector matching the daemon set's pods
+	Selector       string
+	UpdateStrategy string
+	Image          string
+	Age            time.Duration
+
+	// Object is the daemon set the model was converted from, which custom columns are filled from
+	Object *appsv1.DaemonSet
+}
+
+// daemonSetTolerations are the tolerations the daemon set controller adds to every daemon pod,
+// so nodes with these taints still run daemon pods
+var daemonSetTolerations = []v1.Toleration{
+	{Key: v1.TaintNodeNotReady, Operator: v1.TolerationOpExists},
+	{Key: v1.TaintNodeUnreachable, Operator: v1.TolerationOpExists},
+	{Key: v1.TaintNodeDiskPressure, Operator: v1.TolerationOpExists},
+	{Key: v1.TaintNodeMemoryPressure, Operator: v1.TolerationOpExists},
+	{Key: v1.TaintNodePIDPressure, Operator: v1.TolerationOpExists},
+	{Key: v1.TaintNodeUnschedulable, Operator: v1.TolerationOpExists},
+}
+
+// GetDaemonSet fetches a single daemon set by name and namespace
+func GetDaemonSet(clientset *kubernetes.Clientset, namespace, name string) (*DaemonSet, error) {
+	k8sDaemonSet, err := clientset.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get daemon set %s in namespace %s: %w", name, namespace, err)
+	}
+
+	daemonSet := ToDaemonSetModel(*k8sDaemonSet)
+	return &daemonSet, nil
+}
+
+// ToDaemonSetModel converts a Kubernetes API daemon set object to our internal DaemonSet model
+func ToDaemonSetModel(d appsv1.DaemonSet) DaemonSet {
+	selector := ""
+	if d.Spec.Selector != nil {
+		if labelSelector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector); err == nil {
+			selector = labelSelector.String()
+		}
+	}
+
+	strategy := string(d.Spec.UpdateStrategy.Type)
+	if strategy == "" {
+		strategy = string(appsv1.RollingUpdateDaemonSetStrategyType)
+	}
+
+	image := "N/A"
+	if len(d.Spec.Template.Spec.Containers) > 0 {
+		image = d.Spec.Template.Spec.Containers[0].Image
+	}
+
+	return DaemonSet{
+		Name:           d.Name,
+		Namespace:      d.Namespace,
+		Desired:        int(d.Status.DesiredNumberScheduled),
+		Current:        int(d.Status.CurrentNumberScheduled),
+		Ready:          int(d.Status.NumberReady),
+		UpToDate:       int(d.Status.UpdatedNumberScheduled),
+		Available:      int(d.Status.NumberAvailable),
+		Misscheduled:   int(d.Status.NumberMisscheduled),
+		NodeSelector:   d.Spec.Template.Spec.NodeSelector,
+		Selector:       selector,
+		UpdateStrategy: strategy,
+		Image:          image,
+		Age:            time.Since(d.CreationTimestamp.Time),
+		Object:         &d,
+	}
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (d DaemonSet) FormatAge() string {
+	return formatAge(d.Age)
+}
+
+// FormatNodeSelector formats the node selector of the daemon pods
+func (d DaemonSet) FormatNodeSelector() string {
+	if len(d.NodeSelector) == 0 {
+		return "<none>"
+	}
+	return labels.SelectorFromSet(d.NodeSelector).String()
+}
+
+// GetDaemonSetNodesWithoutPod returns the names of the nodes a daemon set should run on that have no daemon pod.
+// Eligibility follows the pod template's node selector, required node affinity and tolerations.
+func GetDaemonSetNodesWithoutPod(clientset *kubernetes.Clientset, namespace, name string) ([]string, error) {
+	k8sDaemonSet, err := clientset.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get daemon set %s in namespace %s: %w", name, namespace, err)
+	}
+
+	nodes, err := clientset.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list nodes: %w", err)
+	}
+
+	daemonSet := ToDaemonSetModel(*k8sDaemonSet)
+	pods, err := clientset.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: daemonSet.Selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods of daemon set %s in namespace %s: %w", name, namespace, err)
+	}
+
+	nodesWithPod := make(map[string]bool)
+	for _, pod := range pods.Items {
+		if isControlledBy(&pod, "DaemonSet", name) && pod.Spec.NodeName != "" {
+			nodesWithPod[pod.Spec.NodeName] = true
+		}
+	}
+
+	podSpec := k8sDaemonSet.Spec.Template.Spec
+	var missing []string
+	for _, node := range nodes.Items {
+		if nodesWithPod[node.Name] || !daemonPodFitsNode(podSpec, node) {
+			continue
+		}
+		missing = append(missing, node.Name)
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// daemonPodFitsNode reports whether a daemon pod with the given spec should run on the node
+func daemonPodFitsNode(podSpec v1.PodSpec, node v1.Node) bool {
+	if !labels.SelectorFromSet(podSpec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	if !matchesRequiredNodeAffinity(podSpec.Affinity, node) {
+		return false
+	}
+
+	tolerations := append(append([]v1.Toleration(nil), podSpec.Tolerations...), daemonSetTolerations...)
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for _, toleration := range tolerations {
+			if toleration.ToleratesTaint(&taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesRequiredNodeAffinity reports whether the node matches any of the required node selector terms, matching
+// label expressions only; field expressions on metadata.name are checked against the node name
+func matchesRequiredNodeAffinity(affinity *v1.Affinity, node v1.Node) bool {
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		return true
+	}
+	for _, term := range terms {
+		if nodeMatchesTerm(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeMatchesTerm reports whether the node matches every expression of a node selector term
+func nodeMatchesTerm(term v1.NodeSelectorTerm, node v1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		// An empty term matches no objects
+		return false
+	}
+	for _, expression := range term.MatchExpressions {
+		if !nodeSelectorRequirementMatches(expression, labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	for _, field := range term.MatchFields {
+		if field.Key == "metadata.name" && !nodeSelectorRequirementMatches(v1.NodeSelectorRequirement{Key: field.Key, Operator: field.Operator, Values: field.Values}, labels.Set{"metadata.name": node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeSelectorOperators maps node selector operators to label selector operators
+var nodeSelectorOperators = map[v1.NodeSelectorOperator]selection.Operator{
+	v1.NodeSelectorOpIn:           selection.In,
+	v1.NodeSelectorOpNotIn:        selection.NotIn,
+	v1.NodeSelectorOpExists:       selection.Exists,
+	v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	v1.NodeSelectorOpGt:           selection.GreaterThan,
+	v1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+// nodeSelectorRequirementMatches evaluates a node selector requirement against a label set
+func nodeSelectorRequirementMatches(requirement v1.NodeSelectorRequirement, set labels.Set) bool {
+	operator, ok := nodeSelectorOperators[requirement.Operator]
+	if !ok {
+		return false
+	}
+	labelRequirement, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+	if err != nil {
+		return false
+	}
+	return labelRequirement.Matches(set)
+}
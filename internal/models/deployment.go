@@ -7,6 +7,8 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -14,6 +16,8 @@ import (
 type Deployment struct {
 	Name      string
 	Namespace string
+	// Cluster is the kubeconfig context the deployment was listed from in the multi-cluster views; empty otherwise
+	Cluster   string
 	Status    string
 	Ready     string
 	UpToDate  int
@@ -21,6 +25,14 @@ type Deployment struct {
 	Age       time.Duration
 	Strategy  string
 	Image     string
+	// Containers are the names of the pod template's containers, in spec order
+	Containers []string
+	// Selector is the deployment's label selector, as kubectl shows it
+	Selector string
+
+	// Object is the deployment the model was converted from, which custom columns read labels, annotations and
+	// JSONPath expressions from
+	Object *appsv1.Deployment
 }
 
 // GetDeployment fetches a single deployment by name and namespace
@@ -34,6 +46,51 @@ func GetDeployment(clientset *kubernetes.Clientset, namespace, name string) (*De
 	return &deployment, nil
 }
 
+// GetDeploymentPodSelector resolves the label selector matching the pods of a deployment.
+// The deployment's selector is narrowed to the pod-template-hash of each ReplicaSet the
+// deployment controls, so pods of other workloads with overlapping labels are excluded.
+// ReplicaSets created after the selector is resolved (e.g. by a later rollout) are not covered.
+func GetDeploymentPodSelector(clientset *kubernetes.Clientset, namespace, name string) (string, error) {
+	k8sDeployment, err := clientset.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not get deployment %s in namespace %s: %w", name, namespace, err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(k8sDeployment.Spec.Selector)
+	if err != nil {
+		return "", fmt.Errorf("invalid selector on deployment %s in namespace %s: %w", name, namespace, err)
+	}
+
+	replicaSets, err := clientset.AppsV1().ReplicaSets(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not list replicasets of deployment %s in namespace %s: %w", name, namespace, err)
+	}
+
+	var hashes []string
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		if !metav1.IsControlledBy(rs, k8sDeployment) {
+			continue
+		}
+		if hash := rs.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; hash != "" {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	// Without any owned ReplicaSets fall back to the deployment's own selector
+	if len(hashes) == 0 {
+		return selector.String(), nil
+	}
+
+	requirement, err := labels.NewRequirement(appsv1.DefaultDeploymentUniqueLabelKey, selection.In, hashes)
+	if err != nil {
+		return "", fmt.Errorf("could not build pod selector for deployment %s in namespace %s: %w", name, namespace, err)
+	}
+	return selector.Add(*requirement).String(), nil
+}
+
 // ToDeploymentModel converts a Kubernetes API deployment object to our internal Deployment model
 func ToDeploymentModel(d appsv1.Deployment) Deployment {
 	ready := d.Status.ReadyReplicas
@@ -64,6 +121,15 @@ func ToDeploymentModel(d appsv1.Deployment) Deployment {
 		image = d.Spec.Template.Spec.Containers[0].Image
 	}
 
+	var containers []string
+	for _, container := range d.Spec.Template.Spec.Containers {
+		containers = append(containers, container.Name)
+	}
+	selector := ""
+	if d.Spec.Selector != nil {
+		selector = metav1.FormatLabelSelector(d.Spec.Selector)
+	}
+
 	return Deployment{
 		Name:      d.Name,
 		Namespace: d.Namespace,
@@ -74,19 +140,14 @@ func ToDeploymentModel(d appsv1.Deployment) Deployment {
 		Age:       time.Since(d.CreationTimestamp.Time),
 		Strategy:  strategy,
 		Image:     image,
+
+		Containers: containers,
+		Selector:   selector,
+		Object:     &d,
 	}
 }
 
 // FormatAge formats the age duration to a human-readable string
 func (d Deployment) FormatAge() string {
-	if d.Age < time.Minute {
-		return "<1m"
-	}
-	if d.Age < time.Hour {
-		return d.Age.Round(time.Minute).String()
-	}
-	if d.Age < 24*time.Hour {
-		return d.Age.Round(time.Hour).String()
-	}
-	return d.Age.Round(24 * time.Hour).String()
+	return formatAge(d.Age)
 }
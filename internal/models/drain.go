@@ -0,0 +1,190 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// mirrorPodAnnotation marks static pods mirrored into the API server by the kubelet; they cannot be evicted
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// DrainOptions controls which pods a drain is allowed to evict
+type DrainOptions struct {
+	// DeleteEmptyDirData allows evicting pods with emptyDir volumes, whose data is lost
+	DeleteEmptyDirData bool
+	// Force allows evicting pods that are not managed by a controller and will not be recreated
+	Force bool
+	// Timeout bounds how long a pod eviction is retried while a PodDisruptionBudget blocks it, and then
+	// how long the evicted pod may take to be deleted
+	Timeout time.Duration
+	// RetryInterval is the wait between eviction attempts blocked by a PodDisruptionBudget
+	RetryInterval time.Duration
+	// PollInterval is the wait between checks that an evicted pod has been deleted
+	PollInterval time.Duration
+}
+
+// DefaultDrainOptions returns the options used when draining from the UI
+func DefaultDrainOptions() DrainOptions {
+	return DrainOptions{
+		Timeout:       5 * time.Minute,
+		RetryInterval: 5 * time.Second,
+		PollInterval:  time.Second,
+	}
+}
+
+// PodDrainState is the progress of a single pod through a drain
+type PodDrainState string
+
+const (
+	PodDrainPending       PodDrainState = "Pending"
+	PodDrainEvicting      PodDrainState = "Evicting"
+	PodDrainWaitingForPDB PodDrainState = "WaitingForPDB"
+	PodDrainTerminating   PodDrainState = "Terminating"
+	PodDrainEvicted       PodDrainState = "Evicted"
+	PodDrainSkipped       PodDrainState = "Skipped"
+	PodDrainFailed        PodDrainState = "Failed"
+)
+
+// Done reports whether the state is final
+func (s PodDrainState) Done() bool {
+	return s == PodDrainEvicted || s == PodDrainSkipped || s == PodDrainFailed
+}
+
+// PodDrainStatus is the drain progress of a single pod
+type PodDrainStatus struct {
+	Namespace string
+	Name      string
+	// UID tells the evicted pod apart from a replacement with the same name, such as a StatefulSet pod
+	UID     types.UID
+	State   PodDrainState
+	Message string
+}
+
+// Key returns the namespace/name key of the pod
+func (s PodDrainStatus) Key() string {
+	return s.Namespace + "/" + s.Name
+}
+
+// PlanDrain decides what a drain does with each pod on the node: DaemonSet and mirror pods are skipped,
+// pods the options do not allow evicting fail up front, and the rest are pending eviction
+func PlanDrain(pods []v1.Pod, opts DrainOptions) []PodDrainStatus {
+	plan := make([]PodDrainStatus, 0, len(pods))
+	for _, pod := range pods {
+		status := PodDrainStatus{Namespace: pod.Namespace, Name: pod.Name, UID: pod.UID, State: PodDrainPending}
+		controllerRef := metav1.GetControllerOf(&pod)
+
+		switch {
+		case pod.Annotations[mirrorPodAnnotation] != "":
+			status.State = PodDrainSkipped
+			status.Message = "static mirror pod"
+		case controllerRef != nil && controllerRef.Kind == "DaemonSet":
+			status.State = PodDrainSkipped
+			status.Message = "managed by DaemonSet " + controllerRef.Name
+		case pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed:
+			// Finished pods hold no workload and are always safe to remove
+		case hasEmptyDir(pod) && !opts.DeleteEmptyDirData:
+			status.State = PodDrainFailed
+			status.Message = "uses emptyDir data; enable deleting emptyDir data to evict"
+		case controllerRef == nil && !opts.Force:
+			status.State = PodDrainFailed
+			status.Message = "not managed by a controller; enable force to evict"
+		}
+		plan = append(plan, status)
+	}
+	return plan
+}
+
+// BlockedPods counts the pods of a plan that the options do not allow evicting. Like kubectl drain, a drain does
+// not evict anything while any remain.
+func BlockedPods(plan []PodDrainStatus) int {
+	blocked := 0
+	for _, pod := range plan {
+		if pod.State == PodDrainFailed {
+			blocked++
+		}
+	}
+	return blocked
+}
+
+// hasEmptyDir reports whether the pod mounts an emptyDir volume
+func hasEmptyDir(pod v1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ListNodePods lists the pods scheduled on a node
+func ListNodePods(clientset *kubernetes.Clientset, nodeName string) ([]v1.Pod, error) {
+	podList, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: NodePodsFieldSelector(nodeName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods on node %s: %w", nodeName, err)
+	}
+	return podList.Items, nil
+}
+
+// EvictPod evicts a pod through the Eviction API, retrying while a PodDisruptionBudget disallows the
+// disruption. onBlocked is called each time an attempt is refused by a budget. A pod that is already
+// gone counts as evicted.
+func EvictPod(ctx context.Context, clientset *kubernetes.Clientset, namespace, name string, opts DrainOptions, onBlocked func(reason string)) error {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	for {
+		err := clientset.PolicyV1().Evictions(namespace).Evict(ctx, eviction)
+		switch {
+		case err == nil, apierrors.IsNotFound(err):
+			return nil
+		case apierrors.IsTooManyRequests(err):
+			onBlocked(err.Error())
+		default:
+			return fmt.Errorf("could not evict pod %s in namespace %s: %w", name, namespace, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up evicting pod %s in namespace %s: disruption budget did not allow it within %s", name, namespace, opts.Timeout)
+		case <-time.After(opts.RetryInterval):
+		}
+	}
+}
+
+// WaitForPodDeletion waits until an evicted pod is gone, as kubectl drain does: its eviction only starts
+// the pod's graceful termination. A pod of the same name with another UID is a replacement, so counts as gone.
+func WaitForPodDeletion(ctx context.Context, clientset *kubernetes.Clientset, namespace, name string, uid types.UID, opts DrainOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	for {
+		pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil
+		case err == nil && pod.UID != uid:
+			return nil
+		case err != nil && ctx.Err() == nil:
+			return fmt.Errorf("could not check pod %s in namespace %s was deleted: %w", name, namespace, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up waiting for pod %s in namespace %s to be deleted within %s", name, namespace, opts.Timeout)
+		case <-time.After(opts.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Event is a Kubernetes event recorded against an object
+type Event struct {
+	Type     string
+	Reason   string
+	Message  string
+	Source   string
+	Count    int
+	LastSeen time.Time
+}
+
+// GetEventsFor returns the events recorded against an object, oldest first
+func GetEventsFor(clientset *kubernetes.Clientset, namespace, kind, name string) ([]Event, error) {
+	selector := fields.Set{
+		"involvedObject.kind": kind,
+		"involvedObject.name": name,
+	}.AsSelector().String()
+	eventList, err := clientset.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("could not list events of %s %s in namespace %s: %w", kind, name, namespace, err)
+	}
+
+	events := make([]Event, 0, len(eventList.Items))
+	for _, e := range eventList.Items {
+		events = append(events, ToEventModel(e))
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].LastSeen.Before(events[j].LastSeen) })
+	return events, nil
+}
+
+// ToEventModel converts a Kubernetes API event object to our internal Event model
+func ToEventModel(e v1.Event) Event {
+	lastSeen := e.LastTimestamp.Time
+	if lastSeen.IsZero() {
+		lastSeen = e.EventTime.Time
+	}
+	if lastSeen.IsZero() {
+		lastSeen = e.CreationTimestamp.Time
+	}
+
+	source := e.Source.Component
+	if source == "" {
+		source = e.ReportingController
+	}
+
+	count := int(e.Count)
+	if count == 0 {
+		count = 1
+	}
+
+	return Event{
+		Type:     e.Type,
+		Reason:   e.Reason,
+		Message:  e.Message,
+		Source:   source,
+		Count:    count,
+		LastSeen: lastSeen,
+	}
+}
+
+// FormatLastSeen formats how long ago the event was last seen
+func (e Event) FormatLastSeen() string {
+	return formatAge(time.Since(e.LastSeen)) + " ago"
+}
+
+// IsWarning reports whether the event is a warning
+func (e Event) IsWarning() bool {
+	return e.Type == v1.EventTypeWarning
+}
@@ -5,4 +5,9 @@ type HeaderModel struct {
 	KubernetesVersion string
 	ControlPlaneNodes int
 	WorkerNodes       int
+	// Namespace is the namespace the resource views are scoped to, empty for all namespaces
+	Namespace string
+	// Clusters is the connection health of each cluster shown by a multi-cluster view, in place of the current
+	// cluster's details; empty otherwise
+	Clusters []ClusterHealth
 }
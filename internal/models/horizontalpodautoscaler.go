@@ -0,0 +1,297 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// unknownMetricValue is shown for a metric whose current value the autoscaler has not yet observed
+const unknownMetricValue = "<unknown>"
+
+// HorizontalPodAutoscaler represents a Kubernetes horizontal pod autoscaler (autoscaling/v2)
+type HorizontalPodAutoscaler struct {
+	Name      string
+	Namespace string
+	// TargetKind and TargetName identify the workload being scaled, such as Deployment/web
+	TargetKind      string
+	TargetName      string
+	MinReplicas     int
+	MaxReplicas     int
+	CurrentReplicas int
+	DesiredReplicas int
+	Metrics         []AutoscalerMetric
+	Conditions      []AutoscalerCondition
+	LastScaleTime   *time.Time
+	Age             time.Duration
+
+	// Object is the autoscaler the model was converted from, which custom columns are filled from
+	Object *autoscalingv2.HorizontalPodAutoscaler
+}
+
+// AutoscalerMetric is one metric a horizontal pod autoscaler scales on, with its current and target values
+type AutoscalerMetric struct {
+	// Type is the metric source type: Resource, ContainerResource, Pods, Object or External
+	Type string
+	// Name describes the metric, such as "cpu" or "requests_per_second on Ingress/web"
+	Name    string
+	Current string
+	Target  string
+}
+
+// AutoscalerCondition is a status condition of a horizontal pod autoscaler, such as AbleToScale
+type AutoscalerCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// GetHorizontalPodAutoscaler fetches a single horizontal pod autoscaler by name and namespace
+func GetHorizontalPodAutoscaler(clientset *kubernetes.Clientset, namespace, name string) (*HorizontalPodAutoscaler, error) {
+	k8sAutoscaler, err := clientset.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get horizontal pod autoscaler %s in namespace %s: %w", name, namespace, err)
+	}
+
+	autoscaler := ToHorizontalPodAutoscalerModel(*k8sAutoscaler)
+	return &autoscaler, nil
+}
+
+// ToHorizontalPodAutoscalerModel converts a Kubernetes API horizontal pod autoscaler object to our internal model
+func ToHorizontalPodAutoscalerModel(h autoscalingv2.HorizontalPodAutoscaler) HorizontalPodAutoscaler {
+	minReplicas := 1
+	if h.Spec.MinReplicas != nil {
+		minReplicas = int(*h.Spec.MinReplicas)
+	}
+
+	autoscaler := HorizontalPodAutoscaler{
+		Name:            h.Name,
+		Namespace:       h.Namespace,
+		TargetKind:      h.Spec.ScaleTargetRef.Kind,
+		TargetName:      h.Spec.ScaleTargetRef.Name,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     int(h.Spec.MaxReplicas),
+		CurrentReplicas: int(h.Status.CurrentReplicas),
+		DesiredReplicas: int(h.Status.DesiredReplicas),
+		Age:             time.Since(h.CreationTimestamp.Time),
+		Object:          &h,
+	}
+	if h.Status.LastScaleTime != nil {
+		t := h.Status.LastScaleTime.Time
+		autoscaler.LastScaleTime = &t
+	}
+
+	for _, spec := range h.Spec.Metrics {
+		metric := AutoscalerMetric{
+			Type:    string(spec.Type),
+			Name:    metricName(spec),
+			Target:  metricTarget(spec),
+			Current: unknownMetricValue,
+		}
+		for _, status := range h.Status.CurrentMetrics {
+			if value, ok := metricCurrent(spec, status); ok {
+				metric.Current = value
+				break
+			}
+		}
+		autoscaler.Metrics = append(autoscaler.Metrics, metric)
+	}
+
+	for _, c := range h.Status.Conditions {
+		autoscaler.Conditions = append(autoscaler.Conditions, AutoscalerCondition{
+			Type:    string(c.Type),
+			Status:  string(c.Status),
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	return autoscaler
+}
+
+// metricName describes the metric a spec refers to
+func metricName(spec autoscalingv2.MetricSpec) string {
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			return string(spec.Resource.Name)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			return fmt.Sprintf("%s (container %s)", spec.ContainerResource.Name, spec.ContainerResource.Container)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			return spec.Pods.Metric.Name
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			return fmt.Sprintf("%s on %s/%s", spec.Object.Metric.Name, spec.Object.DescribedObject.Kind, spec.Object.DescribedObject.Name)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			return spec.External.Metric.Name
+		}
+	}
+	return string(spec.Type)
+}
+
+// metricTarget formats the target value of a metric spec
+func metricTarget(spec autoscalingv2.MetricSpec) string {
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			return formatMetricTarget(spec.Resource.Target)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			return formatMetricTarget(spec.ContainerResource.Target)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			return formatMetricTarget(spec.Pods.Target)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			return formatMetricTarget(spec.Object.Target)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			return formatMetricTarget(spec.External.Target)
+		}
+	}
+	return unknownMetricValue
+}
+
+// formatMetricTarget formats a target as a utilization percentage, an average value or a value,
+// averages marked with "(avg)" as kubectl does
+func formatMetricTarget(target autoscalingv2.MetricTarget) string {
+	switch {
+	case target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *target.AverageUtilization)
+	case target.AverageValue != nil:
+		return target.AverageValue.String() + " (avg)"
+	case target.Value != nil:
+		return target.Value.String()
+	default:
+		return unknownMetricValue
+	}
+}
+
+// metricCurrent returns the current value of a metric spec when the status reports on the same metric
+func metricCurrent(spec autoscalingv2.MetricSpec, status autoscalingv2.MetricStatus) (string, bool) {
+	if spec.Type != status.Type {
+		return "", false
+	}
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource == nil || status.Resource == nil || spec.Resource.Name != status.Resource.Name {
+			return "", false
+		}
+		return formatMetricCurrent(spec.Resource.Target, status.Resource.Current), true
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource == nil || status.ContainerResource == nil ||
+			spec.ContainerResource.Name != status.ContainerResource.Name || spec.ContainerResource.Container != status.ContainerResource.Container {
+			return "", false
+		}
+		return formatMetricCurrent(spec.ContainerResource.Target, status.ContainerResource.Current), true
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods == nil || status.Pods == nil || spec.Pods.Metric.Name != status.Pods.Metric.Name {
+			return "", false
+		}
+		return formatMetricCurrent(spec.Pods.Target, status.Pods.Current), true
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object == nil || status.Object == nil || spec.Object.Metric.Name != status.Object.Metric.Name ||
+			spec.Object.DescribedObject.Kind != status.Object.DescribedObject.Kind || spec.Object.DescribedObject.Name != status.Object.DescribedObject.Name {
+			return "", false
+		}
+		return formatMetricCurrent(spec.Object.Target, status.Object.Current), true
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External == nil || status.External == nil || spec.External.Metric.Name != status.External.Metric.Name {
+			return "", false
+		}
+		return formatMetricCurrent(spec.External.Target, status.External.Current), true
+	}
+	return "", false
+}
+
+// formatMetricCurrent formats a current value in the same form as its target so the two compare directly
+func formatMetricCurrent(target autoscalingv2.MetricTarget, current autoscalingv2.MetricValueStatus) string {
+	switch {
+	case target.AverageUtilization != nil && current.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *current.AverageUtilization)
+	case target.AverageValue != nil && current.AverageValue != nil:
+		return current.AverageValue.String() + " (avg)"
+	case target.Value != nil && current.Value != nil:
+		return current.Value.String()
+	default:
+		return unknownMetricValue
+	}
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (h HorizontalPodAutoscaler) FormatAge() string {
+	return formatAge(h.Age)
+}
+
+// FormatTarget formats the scale target as Kind/name
+func (h HorizontalPodAutoscaler) FormatTarget() string {
+	return h.TargetKind + "/" + h.TargetName
+}
+
+// FormatMetrics formats each metric's current against its target value, as kubectl's TARGETS column does
+func (h HorizontalPodAutoscaler) FormatMetrics() string {
+	if len(h.Metrics) == 0 {
+		return "<none>"
+	}
+	var parts []string
+	for _, m := range h.Metrics {
+		parts = append(parts, fmt.Sprintf("%s: %s/%s", m.Name, strings.TrimSuffix(m.Current, " (avg)"), strings.TrimSuffix(m.Target, " (avg)")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatReplicas formats the current and desired replica counts as current/desired
+func (h HorizontalPodAutoscaler) FormatReplicas() string {
+	return fmt.Sprintf("%d/%d", h.CurrentReplicas, h.DesiredReplicas)
+}
+
+// FormatLastScaleTime formats how long ago the autoscaler last changed the replica count
+func (h HorizontalPodAutoscaler) FormatLastScaleTime() string {
+	if h.LastScaleTime == nil {
+		return "<never>"
+	}
+	return formatAge(time.Since(*h.LastScaleTime)) + " ago"
+}
+
+// Condition returns the condition of the given type, or nil when the autoscaler does not report it
+func (h HorizontalPodAutoscaler) Condition(conditionType string) *AutoscalerCondition {
+	for i := range h.Conditions {
+		if h.Conditions[i].Type == conditionType {
+			return &h.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsScalingLimited reports whether the desired replica count was clamped by the min or max replicas
+func (h HorizontalPodAutoscaler) IsScalingLimited() bool {
+	c := h.Condition(string(autoscalingv2.ScalingLimited))
+	return c != nil && c.Status == string(v1.ConditionTrue)
+}
+
+// IsUnhealthy reports whether the autoscaler is unable to scale or unable to compute a replica count from its metrics
+func (h HorizontalPodAutoscaler) IsUnhealthy() bool {
+	for _, conditionType := range []autoscalingv2.HorizontalPodAutoscalerConditionType{autoscalingv2.AbleToScale, autoscalingv2.ScalingActive} {
+		if c := h.Condition(string(conditionType)); c != nil && c.Status == string(v1.ConditionFalse) {
+			return true
+		}
+	}
+	return false
+}
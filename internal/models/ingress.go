@@ -0,0 +1,269 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ingressClassAnnotation is the deprecated annotation selecting an ingress class, still set by many charts
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+// IngressBackend is where an ingress routes traffic: a service port or, rarely, another resource
+type IngressBackend struct {
+	ServiceName string
+	// ServicePort is the port number or name
+	ServicePort string
+	// Resource is the Kind/name of a resource backend; empty for service backends
+	Resource string
+}
+
+// IngressPath routes a path of a host to a backend
+type IngressPath struct {
+	Path     string
+	PathType string
+	Backend  IngressBackend
+}
+
+// IngressRule holds the paths routed for a host; an empty host matches all hosts
+type IngressRule struct {
+	Host  string
+	Paths []IngressPath
+}
+
+// IngressTLS is a TLS certificate served for a set of hosts
+type IngressTLS struct {
+	Hosts      []string
+	SecretName string
+}
+
+// Ingress represents a Kubernetes ingress
+type Ingress struct {
+	Name           string
+	Namespace      string
+	Class          string
+	Addresses      []string
+	TLS            []IngressTLS
+	Rules          []IngressRule
+	DefaultBackend *IngressBackend
+	Age            time.Duration
+
+	// Object is the ingress the model was converted from, which custom columns are filled from
+	Object *networkingv1.Ingress
+}
+
+// BackendHealth is the state of the service behind an ingress backend
+type BackendHealth struct {
+	ServiceExists  bool
+	ReadyEndpoints int
+}
+
+// Healthy reports whether the backend's service exists and has a ready endpoint
+func (h BackendHealth) Healthy() bool {
+	return h.ServiceExists && h.ReadyEndpoints > 0
+}
+
+// GetIngress fetches a single ingress by name and namespace
+func GetIngress(clientset *kubernetes.Clientset, namespace, name string) (*Ingress, error) {
+	k8sIngress, err := clientset.NetworkingV1().Ingresses(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get ingress %s in namespace %s: %w", name, namespace, err)
+	}
+
+	ingress := ToIngressModel(*k8sIngress)
+	return &ingress, nil
+}
+
+// ToIngressModel converts a Kubernetes API ingress object to our internal Ingress model
+func ToIngressModel(i networkingv1.Ingress) Ingress {
+	class := i.Annotations[ingressClassAnnotation]
+	if i.Spec.IngressClassName != nil {
+		class = *i.Spec.IngressClassName
+	}
+
+	var addresses []string
+	for _, lb := range i.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			addresses = append(addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			addresses = append(addresses, lb.Hostname)
+		}
+	}
+
+	var tls []IngressTLS
+	for _, t := range i.Spec.TLS {
+		tls = append(tls, IngressTLS{Hosts: t.Hosts, SecretName: t.SecretName})
+	}
+
+	var rules []IngressRule
+	for _, r := range i.Spec.Rules {
+		rule := IngressRule{Host: r.Host}
+		if r.HTTP != nil {
+			for _, p := range r.HTTP.Paths {
+				pathType := ""
+				if p.PathType != nil {
+					pathType = string(*p.PathType)
+				}
+				rule.Paths = append(rule.Paths, IngressPath{
+					Path:     p.Path,
+					PathType: pathType,
+					Backend:  toIngressBackend(p.Backend),
+				})
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	var defaultBackend *IngressBackend
+	if i.Spec.DefaultBackend != nil {
+		backend := toIngressBackend(*i.Spec.DefaultBackend)
+		defaultBackend = &backend
+	}
+
+	return Ingress{
+		Name:           i.Name,
+		Namespace:      i.Namespace,
+		Class:          class,
+		Addresses:      addresses,
+		TLS:            tls,
+		Rules:          rules,
+		DefaultBackend: defaultBackend,
+		Age:            time.Since(i.CreationTimestamp.Time),
+		Object:         &i,
+	}
+}
+
+// toIngressBackend converts an API backend, preferring the port name when the port is referenced by name
+func toIngressBackend(b networkingv1.IngressBackend) IngressBackend {
+	if b.Resource != nil {
+		return IngressBackend{Resource: b.Resource.Kind + "/" + b.Resource.Name}
+	}
+	if b.Service == nil {
+		return IngressBackend{}
+	}
+	port := b.Service.Port.Name
+	if port == "" {
+		port = fmt.Sprintf("%d", b.Service.Port.Number)
+	}
+	return IngressBackend{ServiceName: b.Service.Name, ServicePort: port}
+}
+
+// String formats the backend as service:port or Kind/name
+func (b IngressBackend) String() string {
+	if b.Resource != "" {
+		return b.Resource
+	}
+	return b.ServiceName + ":" + b.ServicePort
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (i Ingress) FormatAge() string {
+	return formatAge(i.Age)
+}
+
+// FormatClass formats the ingress class, which is optional
+func (i Ingress) FormatClass() string {
+	if i.Class == "" {
+		return "<none>"
+	}
+	return i.Class
+}
+
+// Hosts returns the hosts of the ingress rules in order, with "*" for a rule matching all hosts
+func (i Ingress) Hosts() []string {
+	var hosts []string
+	seen := make(map[string]bool)
+	for _, r := range i.Rules {
+		host := r.Host
+		if host == "" {
+			host = "*"
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// FormatHosts formats the hosts the way kubectl does
+func (i Ingress) FormatHosts() string {
+	hosts := i.Hosts()
+	if len(hosts) == 0 {
+		return "*"
+	}
+	return strings.Join(hosts, ",")
+}
+
+// FormatAddresses formats the load balancer addresses
+func (i Ingress) FormatAddresses() string {
+	if len(i.Addresses) == 0 {
+		return "<pending>"
+	}
+	return strings.Join(i.Addresses, ",")
+}
+
+// FormatTLS formats the TLS secrets, or <none> when the ingress does not terminate TLS
+func (i Ingress) FormatTLS() string {
+	if len(i.TLS) == 0 {
+		return "<none>"
+	}
+	var secrets []string
+	for _, t := range i.TLS {
+		secret := t.SecretName
+		if secret == "" {
+			secret = "<default>"
+		}
+		secrets = append(secrets, secret)
+	}
+	return strings.Join(secrets, ",")
+}
+
+// Backends returns every service backend of the ingress, including the default backend
+func (i Ingress) Backends() []IngressBackend {
+	var backends []IngressBackend
+	if i.DefaultBackend != nil {
+		backends = append(backends, *i.DefaultBackend)
+	}
+	for _, r := range i.Rules {
+		for _, p := range r.Paths {
+			backends = append(backends, p.Backend)
+		}
+	}
+	return backends
+}
+
+// CheckIngressBackends resolves the health of the service behind each backend of an ingress, keyed by service name
+func CheckIngressBackends(clientset *kubernetes.Clientset, ingress *Ingress) (map[string]BackendHealth, error) {
+	health := make(map[string]BackendHealth)
+	for _, backend := range ingress.Backends() {
+		if backend.ServiceName == "" {
+			continue
+		}
+		if _, checked := health[backend.ServiceName]; checked {
+			continue
+		}
+
+		_, err := clientset.CoreV1().Services(ingress.Namespace).Get(context.TODO(), backend.ServiceName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			health[backend.ServiceName] = BackendHealth{}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get service %s in namespace %s: %w", backend.ServiceName, ingress.Namespace, err)
+		}
+
+		endpoints, err := GetServiceEndpoints(clientset, ingress.Namespace, backend.ServiceName)
+		if err != nil {
+			return nil, err
+		}
+		health[backend.ServiceName] = BackendHealth{ServiceExists: true, ReadyEndpoints: CountReadyEndpoints(endpoints)}
+	}
+	return health, nil
+}
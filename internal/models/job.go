@@ -0,0 +1,264 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// defaultBackoffLimit is the number of retries a job gets when spec.backoffLimit is unset
+const defaultBackoffLimit = 6
+
+// Job represents a Kubernetes job
+type Job struct {
+	Name      string
+	Namespace string
+	// Completions is the number of successful pods wanted, or -1 for a work queue job that has none
+	Completions  int
+	Parallelism  int
+	Succeeded    int
+	Failed       int
+	Active       int
+	BackoffLimit int
+	Suspended    bool
+	Status       string
+	// FailureReason and FailureMessage come from the job's Failed condition
+	FailureReason  string
+	FailureMessage string
+	StartTime      *time.Time
+	CompletionTime *time.Time
+	// FinishTime is when the job completed or failed, nil while it runs
+	FinishTime *time.Time
+	// CronJob is the name of the cron job that created the job, if any
+	CronJob string
+	// Selector is the label selector matching the job's pods
+	Selector string
+	Image    string
+	Created  time.Time
+	Age      time.Duration
+
+	// Object is the job the model was converted from, which custom columns are filled from
+	Object *batchv1.Job
+}
+
+// JobPodFailure is a reason one of a job's pods failed or cannot run
+type JobPodFailure struct {
+	PodName   string
+	Container string
+	Reason    string
+	ExitCode  int
+	Message   string
+}
+
+// GetJob fetches a single job by name and namespace
+func GetJob(clientset *kubernetes.Clientset, namespace, name string) (*Job, error) {
+	k8sJob, err := clientset.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get job %s in namespace %s: %w", name, namespace, err)
+	}
+
+	job := ToJobModel(*k8sJob)
+	return &job, nil
+}
+
+// ToJobModel converts a Kubernetes API job object to our internal Job model
+func ToJobModel(j batchv1.Job) Job {
+	completions := -1
+	if j.Spec.Completions != nil {
+		completions = int(*j.Spec.Completions)
+	}
+	parallelism := 1
+	if j.Spec.Parallelism != nil {
+		parallelism = int(*j.Spec.Parallelism)
+	}
+	backoffLimit := defaultBackoffLimit
+	if j.Spec.BackoffLimit != nil {
+		backoffLimit = int(*j.Spec.BackoffLimit)
+	}
+
+	job := Job{
+		Name:         j.Name,
+		Namespace:    j.Namespace,
+		Completions:  completions,
+		Parallelism:  parallelism,
+		Succeeded:    int(j.Status.Succeeded),
+		Failed:       int(j.Status.Failed),
+		Active:       int(j.Status.Active),
+		BackoffLimit: backoffLimit,
+		Suspended:    j.Spec.Suspend != nil && *j.Spec.Suspend,
+		Image:        "N/A",
+		Created:      j.CreationTimestamp.Time,
+		Age:          time.Since(j.CreationTimestamp.Time),
+		Object:       &j,
+	}
+
+	if j.Status.StartTime != nil {
+		job.StartTime = &j.Status.StartTime.Time
+	}
+	if j.Status.CompletionTime != nil {
+		job.CompletionTime = &j.Status.CompletionTime.Time
+		job.FinishTime = job.CompletionTime
+	}
+
+	job.Status = "Pending"
+	switch {
+	case hasJobCondition(j, batchv1.JobComplete):
+		job.Status = "Complete"
+	case hasJobCondition(j, batchv1.JobFailed):
+		job.Status = "Failed"
+	case job.Suspended:
+		job.Status = "Suspended"
+	case job.Active > 0:
+		job.Status = "Running"
+	}
+	for _, condition := range j.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			job.FailureReason = condition.Reason
+			job.FailureMessage = condition.Message
+			finished := condition.LastTransitionTime.Time
+			job.FinishTime = &finished
+		}
+	}
+
+	if owner := metav1.GetControllerOf(&j); owner != nil && owner.Kind == "CronJob" {
+		job.CronJob = owner.Name
+	}
+	if j.Spec.Selector != nil {
+		if labelSelector, err := metav1.LabelSelectorAsSelector(j.Spec.Selector); err == nil {
+			job.Selector = labelSelector.String()
+		}
+	}
+	if len(j.Spec.Template.Spec.Containers) > 0 {
+		job.Image = j.Spec.Template.Spec.Containers[0].Image
+	}
+	return job
+}
+
+// hasJobCondition reports whether a job has a condition of the given type set to true
+func hasJobCondition(j batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range j.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (j Job) FormatAge() string {
+	return formatAge(j.Age)
+}
+
+// FormatCompletions formats the successful pods against the completions wanted, like kubectl
+func (j Job) FormatCompletions() string {
+	if j.Completions < 0 {
+		return fmt.Sprintf("%d/1 of %d", j.Succeeded, j.Parallelism)
+	}
+	return fmt.Sprintf("%d/%d", j.Succeeded, j.Completions)
+}
+
+// Duration returns how long the job ran, or has been running, and false if it has not started
+func (j Job) Duration() (time.Duration, bool) {
+	if j.StartTime == nil {
+		return 0, false
+	}
+	end := time.Now()
+	if j.FinishTime != nil {
+		end = *j.FinishTime
+	}
+	return end.Sub(*j.StartTime), true
+}
+
+// FormatDuration formats how long the job ran, or has been running
+func (j Job) FormatDuration() string {
+	duration, started := j.Duration()
+	if !started {
+		return "<none>"
+	}
+	return formatAge(duration)
+}
+
+// FormatBackoff formats the failed pods against the backoff limit
+func (j Job) FormatBackoff() string {
+	return fmt.Sprintf("%d/%d", j.Failed, j.BackoffLimit)
+}
+
+// Finished reports whether the job has completed or failed
+func (j Job) Finished() bool {
+	return j.Status == "Complete" || j.Status == "Failed"
+}
+
+// GetJobPodFailures returns why the pods of a job failed: failed pods' reasons, containers that exited
+// with an error and containers stuck waiting on an error such as ImagePullBackOff
+func GetJobPodFailures(clientset *kubernetes.Clientset, job *Job) ([]JobPodFailure, error) {
+	pods, err := clientset.CoreV1().Pods(job.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: job.Selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods of job %s in namespace %s: %w", job.Name, job.Namespace, err)
+	}
+
+	sort.Slice(pods.Items, func(a, b int) bool {
+		return pods.Items[a].CreationTimestamp.Before(&pods.Items[b].CreationTimestamp)
+	})
+
+	var failures []JobPodFailure
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == v1.PodFailed && pod.Status.Reason != "" {
+			failures = append(failures, JobPodFailure{PodName: pod.Name, Reason: pod.Status.Reason, Message: pod.Status.Message})
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			switch {
+			case status.State.Terminated != nil && status.State.Terminated.ExitCode != 0:
+				terminated := status.State.Terminated
+				failures = append(failures, JobPodFailure{
+					PodName:   pod.Name,
+					Container: status.Name,
+					Reason:    terminated.Reason,
+					ExitCode:  int(terminated.ExitCode),
+					Message:   terminated.Message,
+				})
+			case status.State.Waiting != nil && isErrorWaitingReason(status.State.Waiting.Reason):
+				failures = append(failures, JobPodFailure{
+					PodName:   pod.Name,
+					Container: status.Name,
+					Reason:    status.State.Waiting.Reason,
+					Message:   status.State.Waiting.Message,
+				})
+			}
+		}
+	}
+	return failures, nil
+}
+
+// isErrorWaitingReason reports whether a container waiting reason means it cannot start, rather than that it is starting
+func isErrorWaitingReason(reason string) bool {
+	return reason != "" && reason != "ContainerCreating" && reason != "PodInitializing"
+}
+
+// GetLatestJobPod returns the name of the most recently created pod of a job
+func GetLatestJobPod(clientset *kubernetes.Clientset, job *Job) (string, error) {
+	pods, err := clientset.CoreV1().Pods(job.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: job.Selector,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not list pods of job %s in namespace %s: %w", job.Name, job.Namespace, err)
+	}
+	if len(pods.Items) == 0 {
+		return "", fmt.Errorf("job %s in namespace %s has no pods", job.Name, job.Namespace)
+	}
+
+	latest := pods.Items[0]
+	for _, pod := range pods.Items[1:] {
+		if latest.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			latest = pod
+		}
+	}
+	return latest.Name, nil
+}
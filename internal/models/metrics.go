@@ -0,0 +1,180 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// metricsAPIPath is the root of the metrics.k8s.io API served by metrics-server
+const metricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+
+// ErrMetricsUnavailable is returned when the cluster does not serve the metrics API, e.g. metrics-server is not installed
+var ErrMetricsUnavailable = errors.New("metrics API is not available")
+
+// ResourceUsage is the CPU and memory in use at the time of a metrics sample
+type ResourceUsage struct {
+	CPUMilli    int64
+	MemoryBytes int64
+}
+
+// FormatCPU formats the CPU usage in cores, using millicores below one core
+func (u ResourceUsage) FormatCPU() string {
+	return FormatCPU(*resource.NewMilliQuantity(u.CPUMilli, resource.DecimalSI))
+}
+
+// FormatMemory formats the memory usage using binary units
+func (u ResourceUsage) FormatMemory() string {
+	return FormatMemory(*resource.NewQuantity(u.MemoryBytes, resource.BinarySI))
+}
+
+// PodUsage is a metrics sample of a pod's containers
+type PodUsage struct {
+	Timestamp  time.Time
+	Containers map[string]ResourceUsage
+}
+
+// Total returns the usage summed over the pod's containers
+func (p PodUsage) Total() ResourceUsage {
+	var total ResourceUsage
+	for _, usage := range p.Containers {
+		total.CPUMilli += usage.CPUMilli
+		total.MemoryBytes += usage.MemoryBytes
+	}
+	return total
+}
+
+// UsagePercent returns used as a percentage of total; ok is false when there is no total to compare against
+func UsagePercent(used, total int64) (percent int, ok bool) {
+	if total <= 0 {
+		return 0, false
+	}
+	return int(used * 100 / total), true
+}
+
+// FormatUsagePercent formats a usage percentage, or "n/a" when there is no total to compare against
+func FormatUsagePercent(used, total int64) string {
+	percent, ok := UsagePercent(used, total)
+	if !ok {
+		return "n/a"
+	}
+	return fmt.Sprintf("%d%%", percent)
+}
+
+// podMetricsList is the subset of the metrics.k8s.io PodMetricsList shape that vigilant reads
+type podMetricsList struct {
+	Items []struct {
+		Metadata   metav1.ObjectMeta `json:"metadata"`
+		Timestamp  metav1.Time       `json:"timestamp"`
+		Containers []struct {
+			Name  string          `json:"name"`
+			Usage v1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// nodeMetricsList is the subset of the metrics.k8s.io NodeMetricsList shape that vigilant reads
+type nodeMetricsList struct {
+	Items []struct {
+		Metadata  metav1.ObjectMeta `json:"metadata"`
+		Timestamp metav1.Time       `json:"timestamp"`
+		Usage     v1.ResourceList   `json:"usage"`
+	} `json:"items"`
+}
+
+// MetricsClient reads resource usage from the metrics.k8s.io API
+type MetricsClient struct {
+	client rest.Interface
+}
+
+// NewMetricsClient creates a metrics client using the cluster connection of clientset
+func NewMetricsClient(clientset *kubernetes.Clientset) *MetricsClient {
+	return &MetricsClient{client: clientset.Discovery().RESTClient()}
+}
+
+// NewMetricsClientForConfig creates a metrics client for the API server described by config
+func NewMetricsClientForConfig(config *rest.Config) (*MetricsClient, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create metrics client: %w", err)
+	}
+	return &MetricsClient{client: discoveryClient.RESTClient()}, nil
+}
+
+// Server returns the host of the API server the client reads from, which identifies its cluster
+func (m *MetricsClient) Server() string {
+	return m.client.Get().URL().Host
+}
+
+// PodUsage fetches the latest usage of pods in namespace (all namespaces when empty) matching labelSelector,
+// keyed by namespace/name
+func (m *MetricsClient) PodUsage(ctx context.Context, namespace, labelSelector string) (map[string]PodUsage, error) {
+	path := "/pods"
+	if namespace != "" {
+		path = "/namespaces/" + namespace + "/pods"
+	}
+
+	var list podMetricsList
+	if err := m.get(ctx, path, labelSelector, &list); err != nil {
+		return nil, fmt.Errorf("could not get pod metrics: %w", err)
+	}
+
+	usage := make(map[string]PodUsage, len(list.Items))
+	for _, item := range list.Items {
+		podUsage := PodUsage{Timestamp: item.Timestamp.Time, Containers: make(map[string]ResourceUsage, len(item.Containers))}
+		for _, container := range item.Containers {
+			podUsage.Containers[container.Name] = toResourceUsage(container.Usage)
+		}
+		usage[item.Metadata.Namespace+"/"+item.Metadata.Name] = podUsage
+	}
+	return usage, nil
+}
+
+// NodeUsage fetches the latest usage of every node, keyed by node name
+func (m *MetricsClient) NodeUsage(ctx context.Context) (map[string]ResourceUsage, error) {
+	var list nodeMetricsList
+	if err := m.get(ctx, "/nodes", "", &list); err != nil {
+		return nil, fmt.Errorf("could not get node metrics: %w", err)
+	}
+
+	usage := make(map[string]ResourceUsage, len(list.Items))
+	for _, item := range list.Items {
+		usage[item.Metadata.Name] = toResourceUsage(item.Usage)
+	}
+	return usage, nil
+}
+
+// get reads a metrics API list into into, reporting ErrMetricsUnavailable when the API is not served
+func (m *MetricsClient) get(ctx context.Context, path, labelSelector string, into interface{}) error {
+	request := m.client.Get().AbsPath(metricsAPIPath + path)
+	if labelSelector != "" {
+		request = request.Param("labelSelector", labelSelector)
+	}
+
+	body, err := request.DoRaw(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+			return ErrMetricsUnavailable
+		}
+		return err
+	}
+	return json.Unmarshal(body, into)
+}
+
+// toResourceUsage converts a metrics API usage list
+func toResourceUsage(usage v1.ResourceList) ResourceUsage {
+	return ResourceUsage{
+		CPUMilli:    usage.Cpu().MilliValue(),
+		MemoryBytes: usage.Memory().Value(),
+	}
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// UsageSample is a single metrics sample of a container
+type UsageSample struct {
+	Timestamp time.Time
+	Usage     ResourceUsage
+}
+
+// MetricsHistory keeps a rolling window of usage samples per pod container. It is safe for concurrent use.
+type MetricsHistory struct {
+	mu      sync.Mutex
+	size    int
+	samples map[string]map[string][]UsageSample // namespace/name -> container -> oldest-first samples
+}
+
+// NewMetricsHistory creates a history keeping the latest size samples of each container
+func NewMetricsHistory(size int) *MetricsHistory {
+	return &MetricsHistory{
+		size:    size,
+		samples: make(map[string]map[string][]UsageSample),
+	}
+}
+
+// Record appends a pod's sample to the history of each of its containers. A sample no newer than the last one
+// recorded is ignored, since metrics-server serves the same sample until its next scrape.
+func (h *MetricsHistory) Record(namespace, name string, usage PodUsage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := namespace + "/" + name
+	containers, ok := h.samples[key]
+	if !ok {
+		containers = make(map[string][]UsageSample)
+		h.samples[key] = containers
+	}
+
+	for container, containerUsage := range usage.Containers {
+		samples := containers[container]
+		if n := len(samples); n > 0 && !usage.Timestamp.After(samples[n-1].Timestamp) {
+			continue
+		}
+		samples = append(samples, UsageSample{Timestamp: usage.Timestamp, Usage: containerUsage})
+		if len(samples) > h.size {
+			samples = samples[len(samples)-h.size:]
+		}
+		containers[container] = samples
+	}
+}
+
+// RecordAll records every pod sample of a metrics poll keyed by namespace/name
+func (h *MetricsHistory) RecordAll(usage map[string]PodUsage) {
+	for key, podUsage := range usage {
+		namespace, name := splitKey(key)
+		h.Record(namespace, name, podUsage)
+	}
+}
+
+// PodHistory returns a copy of the samples of each container of a pod, oldest first
+func (h *MetricsHistory) PodHistory(namespace, name string) map[string][]UsageSample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	history := make(map[string][]UsageSample)
+	for container, samples := range h.samples[namespace+"/"+name] {
+		history[container] = append([]UsageSample(nil), samples...)
+	}
+	return history
+}
+
+// Forget drops the history of a pod, e.g. once it has been deleted
+func (h *MetricsHistory) Forget(namespace, name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.samples, namespace+"/"+name)
+}
+
+// splitKey splits a namespace/name key
+func splitKey(key string) (namespace, name string) {
+	namespace, name, found := strings.Cut(key, "/")
+	if !found {
+		return "", key
+	}
+	return namespace, name
+}
@@ -0,0 +1,301 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Namespace represents a Kubernetes namespace together with a summary of what runs in it
+type Namespace struct {
+	Name   string
+	Status string // Active or Terminating
+	// Finalizers are the namespace's own finalizers, from both its spec and its metadata
+	Finalizers []string
+	// Conditions are the namespace conditions currently True, which explain why a deletion is stuck
+	Conditions []NamespaceCondition
+	Usage      NamespaceUsage
+	Age        time.Duration
+
+	// Object is the namespace the model was converted from, which custom columns are filled from
+	Object *v1.Namespace
+}
+
+// NamespaceCondition is a status condition of a namespace, such as NamespaceContentRemaining
+type NamespaceCondition struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+// NamespaceUsage summarises the pods in a namespace and its resource quota consumption
+type NamespaceUsage struct {
+	Pods   PodPhaseCounts
+	Quotas []QuotaUsage
+}
+
+// PodPhaseCounts counts pods by phase
+type PodPhaseCounts struct {
+	Running   int
+	Pending   int
+	Succeeded int
+	Failed    int
+	Unknown   int
+}
+
+// QuotaUsage is the consumption of one resource limited by a ResourceQuota
+type QuotaUsage struct {
+	Quota    string
+	Resource string
+	Used     string
+	Hard     string
+	// Fraction is used divided by hard, 0 when the hard limit is zero
+	Fraction float64
+}
+
+// NamespaceBlocker is an object left in a terminating namespace whose finalizers hold up the namespace's deletion
+type NamespaceBlocker struct {
+	Kind       string
+	Name       string
+	Finalizers []string
+	// Message is set instead of Name and Finalizers for a blocker taken from a namespace condition, whose Kind is
+	// the condition type
+	Message string
+}
+
+// quotaWarningFraction is the share of a quota at which its usage is flagged
+const quotaWarningFraction = 0.9
+
+// GetNamespace fetches a single namespace by name along with its usage
+func GetNamespace(clientset *kubernetes.Clientset, name string) (*Namespace, error) {
+	k8sNamespace, err := clientset.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get namespace %s: %w", name, err)
+	}
+
+	namespace := ToNamespaceModel(*k8sNamespace)
+	usage, err := GetNamespaceUsage(clientset, name)
+	if err != nil {
+		return nil, err
+	}
+	namespace.Usage = usage[name]
+	return &namespace, nil
+}
+
+// ToNamespaceModel converts a Kubernetes API namespace object to our internal model, without usage
+func ToNamespaceModel(n v1.Namespace) Namespace {
+	namespace := Namespace{
+		Name:   n.Name,
+		Status: string(n.Status.Phase),
+		Age:    time.Since(n.CreationTimestamp.Time),
+		Object: &n,
+	}
+	if n.DeletionTimestamp != nil {
+		namespace.Status = string(v1.NamespaceTerminating)
+	}
+	if namespace.Status == "" {
+		namespace.Status = string(v1.NamespaceActive)
+	}
+	for _, f := range n.Spec.Finalizers {
+		namespace.Finalizers = append(namespace.Finalizers, string(f))
+	}
+	namespace.Finalizers = append(namespace.Finalizers, n.Finalizers...)
+	for _, c := range n.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		namespace.Conditions = append(namespace.Conditions, NamespaceCondition{
+			Type:    string(c.Type),
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+	}
+	return namespace
+}
+
+// GetNamespaceUsage counts pods by phase and collects resource quota usage, keyed by namespace.
+// An empty namespace summarises every namespace.
+func GetNamespaceUsage(clientset *kubernetes.Clientset, namespace string) (map[string]NamespaceUsage, error) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods: %w", err)
+	}
+	quotas, err := clientset.CoreV1().ResourceQuotas(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list resource quotas: %w", err)
+	}
+
+	usage := make(map[string]NamespaceUsage)
+	for _, pod := range pods.Items {
+		u := usage[pod.Namespace]
+		u.Pods.add(pod.Status.Phase)
+		usage[pod.Namespace] = u
+	}
+	for _, quota := range quotas.Items {
+		u := usage[quota.Namespace]
+		u.Quotas = append(u.Quotas, toQuotaUsages(quota)...)
+		usage[quota.Namespace] = u
+	}
+	return usage, nil
+}
+
+// toQuotaUsages converts the hard limits of a resource quota into usages, sorted by resource name
+func toQuotaUsages(quota v1.ResourceQuota) []QuotaUsage {
+	var usages []QuotaUsage
+	for resourceName, hard := range quota.Status.Hard {
+		used := quota.Status.Used[resourceName]
+		usage := QuotaUsage{
+			Quota:    quota.Name,
+			Resource: string(resourceName),
+			Used:     used.String(),
+			Hard:     hard.String(),
+		}
+		if !hard.IsZero() {
+			usage.Fraction = used.AsApproximateFloat64() / hard.AsApproximateFloat64()
+		}
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool { return usages[i].Resource < usages[j].Resource })
+	return usages
+}
+
+// add counts a pod in the given phase
+func (c *PodPhaseCounts) add(phase v1.PodPhase) {
+	switch phase {
+	case v1.PodRunning:
+		c.Running++
+	case v1.PodPending:
+		c.Pending++
+	case v1.PodSucceeded:
+		c.Succeeded++
+	case v1.PodFailed:
+		c.Failed++
+	default:
+		c.Unknown++
+	}
+}
+
+// Total returns the number of pods counted
+func (c PodPhaseCounts) Total() int {
+	return c.Running + c.Pending + c.Succeeded + c.Failed + c.Unknown
+}
+
+// GetNamespaceBlockers lists the objects left in a namespace that carry finalizers, across every namespaced kind the
+// server can list. These are what keep a terminating namespace from being removed. Kinds that cannot be discovered
+// or listed, for example because listing them is forbidden, are skipped and the namespace's own account of what
+// remains is added from its conditions. The error reports what was skipped; the blockers found are still returned.
+func GetNamespaceBlockers(clientset *kubernetes.Clientset, namespace *Namespace) ([]NamespaceBlocker, error) {
+	resourceLists, err := clientset.Discovery().ServerPreferredNamespacedResources()
+	if err != nil && len(resourceLists) == 0 {
+		return conditionBlockers(namespace), fmt.Errorf("could not discover the resources in namespace %s: %w", namespace.Name, err)
+	}
+	var skipped []string
+	if err != nil {
+		skipped = append(skipped, err.Error())
+	}
+
+	dynamicClient := dynamic.New(clientset.Discovery().RESTClient())
+	var blockers []NamespaceBlocker
+	for _, resourceList := range discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list"}}, resourceLists) {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			list, err := dynamicClient.Resource(groupVersion.WithResource(resource.Name)).Namespace(namespace.Name).
+				List(context.TODO(), metav1.ListOptions{})
+			if err != nil {
+				skipped = append(skipped, fmt.Sprintf("%s: %v", resource.Kind, err))
+				continue
+			}
+			for _, item := range list.Items {
+				if len(item.GetFinalizers()) == 0 {
+					continue
+				}
+				blockers = append(blockers, NamespaceBlocker{
+					Kind:       resource.Kind,
+					Name:       item.GetName(),
+					Finalizers: item.GetFinalizers(),
+				})
+			}
+		}
+	}
+	if len(skipped) == 0 {
+		return blockers, nil
+	}
+	return append(blockers, conditionBlockers(namespace)...), fmt.Errorf("could not list every kind in namespace %s: %s",
+		namespace.Name, strings.Join(skipped, "; "))
+}
+
+// conditionBlockers returns the namespace controller's account of the content left in a namespace, from its
+// conditions, for when the objects themselves cannot all be listed
+func conditionBlockers(namespace *Namespace) []NamespaceBlocker {
+	var blockers []NamespaceBlocker
+	for _, c := range namespace.Conditions {
+		switch v1.NamespaceConditionType(c.Type) {
+		case v1.NamespaceContentRemaining, v1.NamespaceFinalizersRemaining:
+			blockers = append(blockers, NamespaceBlocker{Kind: c.Type, Message: c.Message})
+		}
+	}
+	return blockers
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (n Namespace) FormatAge() string {
+	return formatAge(n.Age)
+}
+
+// IsTerminating reports whether the namespace is being deleted
+func (n Namespace) IsTerminating() bool {
+	return n.Status == string(v1.NamespaceTerminating)
+}
+
+// FormatQuota formats the most consumed quota resource as "resource used/hard", or <none> without quotas
+func (n Namespace) FormatQuota() string {
+	top := n.Usage.TopQuota()
+	if top == nil {
+		return "<none>"
+	}
+	return fmt.Sprintf("%s %s/%s", top.Resource, top.Used, top.Hard)
+}
+
+// FormatFinalizers formats the namespace's own finalizers as a comma separated list
+func (n Namespace) FormatFinalizers() string {
+	if len(n.Finalizers) == 0 {
+		return "<none>"
+	}
+	return strings.Join(n.Finalizers, ", ")
+}
+
+// TopQuota returns the quota resource closest to its hard limit, or nil without quotas
+func (u NamespaceUsage) TopQuota() *QuotaUsage {
+	var top *QuotaUsage
+	for i := range u.Quotas {
+		if top == nil || u.Quotas[i].Fraction > top.Fraction {
+			top = &u.Quotas[i]
+		}
+	}
+	return top
+}
+
+// IsNearLimit reports whether the usage has reached the share of its hard limit at which it is flagged
+func (q QuotaUsage) IsNearLimit() bool {
+	return q.Fraction >= quotaWarningFraction
+}
+
+// FormatPercent formats the fraction of the hard limit used as a percentage
+func (q QuotaUsage) FormatPercent() string {
+	return fmt.Sprintf("%.0f%%", q.Fraction*100)
+}
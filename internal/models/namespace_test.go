@@ -0,0 +1,117 @@
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// blockerAPI serves discovery for core configmaps and secrets and a custom widgets kind, answering each list with
+// the response registered for its path
+func blockerAPI(t *testing.T, lists map[string]interface{}) *kubernetes.Clientset {
+	resource := func(name, kind string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "kind": kind, "namespaced": true, "verbs": []string{"list", "get"}}
+	}
+	responses := map[string]interface{}{
+		"/api": map[string]interface{}{"kind": "APIVersions", "versions": []string{"v1"}},
+		"/apis": map[string]interface{}{"kind": "APIGroupList", "groups": []interface{}{map[string]interface{}{
+			"name":             "example.com",
+			"versions":         []interface{}{map[string]string{"groupVersion": "example.com/v1", "version": "v1"}},
+			"preferredVersion": map[string]string{"groupVersion": "example.com/v1", "version": "v1"},
+		}}},
+		"/api/v1": map[string]interface{}{"kind": "APIResourceList", "groupVersion": "v1", "resources": []interface{}{
+			resource("configmaps", "ConfigMap"), resource("secrets", "Secret"),
+		}},
+		"/apis/example.com/v1": map[string]interface{}{"kind": "APIResourceList", "groupVersion": "example.com/v1",
+			"resources": []interface{}{resource("widgets", "Widget")}},
+	}
+	for path, list := range lists {
+		responses[path] = list
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if status, ok := response.(int); ok {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(server.Close)
+
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+	return clientset
+}
+
+// finalizedList is a list response holding one object with the given finalizers
+func finalizedList(apiVersion, kind, name string, finalizers ...string) map[string]interface{} {
+	return map[string]interface{}{"apiVersion": apiVersion, "kind": kind + "List", "metadata": map[string]string{},
+		"items": []interface{}{map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name, "namespace": "doomed", "finalizers": finalizers},
+		}}}
+}
+
+func TestGetNamespaceBlockers(t *testing.T) {
+	doomed := &Namespace{Name: "doomed", Conditions: []NamespaceCondition{
+		{Type: string(v1.NamespaceDeletionDiscoveryFailure), Message: "discovery failed"},
+		{Type: string(v1.NamespaceFinalizersRemaining), Message: "Some content in the namespace has finalizers remaining: example.com/protect in 1 resource instances"},
+	}}
+
+	t.Run("should_find_finalized_objects_of_every_listable_kind", func(t *testing.T) {
+		clientset := blockerAPI(t, map[string]interface{}{
+			"/api/v1/namespaces/doomed/configmaps":           finalizedList("v1", "ConfigMap", "settings", "example.com/protect"),
+			"/api/v1/namespaces/doomed/secrets":              finalizedList("v1", "Secret", "token"),
+			"/apis/example.com/v1/namespaces/doomed/widgets": finalizedList("example.com/v1", "Widget", "gear", "example.com/cleanup"),
+		})
+
+		blockers, err := GetNamespaceBlockers(clientset, doomed)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []NamespaceBlocker{
+			{Kind: "ConfigMap", Name: "settings", Finalizers: []string{"example.com/protect"}},
+			{Kind: "Widget", Name: "gear", Finalizers: []string{"example.com/cleanup"}},
+		}, blockers)
+	})
+
+	t.Run("should_skip_kinds_that_cannot_be_listed_and_add_the_conditions", func(t *testing.T) {
+		clientset := blockerAPI(t, map[string]interface{}{
+			"/api/v1/namespaces/doomed/configmaps":           finalizedList("v1", "ConfigMap", "settings", "example.com/protect"),
+			"/api/v1/namespaces/doomed/secrets":              http.StatusForbidden,
+			"/apis/example.com/v1/namespaces/doomed/widgets": finalizedList("example.com/v1", "Widget", "gear"),
+		})
+
+		blockers, err := GetNamespaceBlockers(clientset, doomed)
+
+		require.ErrorContains(t, err, "Secret")
+		assert.Equal(t, []NamespaceBlocker{
+			{Kind: "ConfigMap", Name: "settings", Finalizers: []string{"example.com/protect"}},
+			{Kind: "NamespaceFinalizersRemaining", Message: doomed.Conditions[1].Message},
+		}, blockers)
+	})
+
+	t.Run("should_fall_back_to_the_conditions_without_discovery", func(t *testing.T) {
+		clientset := blockerAPI(t, map[string]interface{}{"/api": http.StatusForbidden, "/apis": http.StatusForbidden})
+
+		blockers, err := GetNamespaceBlockers(clientset, doomed)
+
+		require.Error(t, err)
+		assert.Equal(t, []NamespaceBlocker{
+			{Kind: "NamespaceFinalizersRemaining", Message: doomed.Conditions[1].Message},
+		}, blockers)
+	})
+}
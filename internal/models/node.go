@@ -0,0 +1,251 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// nodeRoleLabelPrefix is the prefix of the labels naming a node's roles, e.g. node-role.kubernetes.io/control-plane
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	// legacyNodeRoleLabel is the older single-valued role label, e.g. kubernetes.io/role=master
+	legacyNodeRoleLabel = "kubernetes.io/role"
+)
+
+// NodeCondition is a single condition reported by a node
+type NodeCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+	// Healthy is true when the condition is in its good state (Ready=True, pressure conditions=False)
+	Healthy bool
+}
+
+// NodeAddress is an address reported by a node
+type NodeAddress struct {
+	Type    string
+	Address string
+}
+
+// Node represents a Kubernetes node
+type Node struct {
+	Name              string
+	Status            string
+	Unschedulable     bool
+	Roles             []string
+	Version           string
+	Age               time.Duration
+	CPUAllocatable    string
+	MemoryAllocatable string
+	CPUCapacity       string
+	MemoryCapacity    string
+	PodCapacity       string
+	Pods              int
+	InternalIP        string
+	// Pressure lists the pressure conditions that are currently true, e.g. MemoryPressure
+	Pressure   []string
+	Conditions []NodeCondition
+	Taints     []string
+	Addresses  []NodeAddress
+	OSImage    string
+	Runtime    string
+
+	// Allocatable CPU and memory as numbers, for usage percentages
+	CPUAllocatableMilli    int64
+	MemoryAllocatableBytes int64
+
+	// Usage is the latest metrics-server sample, nil when metrics are unavailable
+	Usage *ResourceUsage
+
+	// Object is the node the model was converted from, which custom columns read labels, annotations and JSONPath
+	// expressions from
+	Object *v1.Node
+}
+
+// GetNode fetches a single node by name together with the number of pods running on it
+func GetNode(clientset *kubernetes.Clientset, name string) (*Node, error) {
+	k8sNode, err := clientset.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get node %s: %w", name, err)
+	}
+
+	podList, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{
+		FieldSelector: NodePodsFieldSelector(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods on node %s: %w", name, err)
+	}
+
+	count := 0
+	for _, pod := range podList.Items {
+		if occupiesNode(pod) {
+			count++
+		}
+	}
+	node := ToNodeModel(*k8sNode, count)
+	return &node, nil
+}
+
+// SetNodeUnschedulable cordons (true) or uncordons (false) a node by patching spec.unschedulable
+func SetNodeUnschedulable(clientset *kubernetes.Clientset, name string, unschedulable bool) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+	_, err := clientset.CoreV1().Nodes().Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("could not patch node %s: %w", name, err)
+	}
+	return nil
+}
+
+// CountPodsByNode returns the number of pods running on each node
+func CountPodsByNode(clientset *kubernetes.Clientset) (map[string]int, error) {
+	podList, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods: %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName != "" && occupiesNode(pod) {
+			counts[pod.Spec.NodeName]++
+		}
+	}
+	return counts, nil
+}
+
+// occupiesNode reports whether a pod counts against its node: finished pods are left out, as kubectl describe node does
+func occupiesNode(pod v1.Pod) bool {
+	return pod.Status.Phase != v1.PodSucceeded && pod.Status.Phase != v1.PodFailed
+}
+
+// NodePodsFieldSelector returns the field selector matching the pods scheduled on a node
+func NodePodsFieldSelector(nodeName string) string {
+	return "spec.nodeName=" + nodeName
+}
+
+// NodeRoles returns the roles of a node from its role labels, sorted by name
+func NodeRoles(labels map[string]string) []string {
+	var roles []string
+	for label, value := range labels {
+		if role, ok := strings.CutPrefix(label, nodeRoleLabelPrefix); ok && role != "" {
+			roles = append(roles, role)
+		} else if label == legacyNodeRoleLabel && value != "" {
+			roles = append(roles, value)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// IsControlPlaneNode reports whether a node's labels mark it as a control plane node,
+// including the legacy master role
+func IsControlPlaneNode(labels map[string]string) bool {
+	for _, role := range NodeRoles(labels) {
+		if role == "control-plane" || role == "master" {
+			return true
+		}
+	}
+	return false
+}
+
+// ToNodeModel converts a Kubernetes API node object to our internal Node model
+func ToNodeModel(n v1.Node, pods int) Node {
+	status := "Unknown"
+	var pressure []string
+	var conditions []NodeCondition
+	for _, c := range n.Status.Conditions {
+		healthy := c.Status == v1.ConditionFalse
+		if c.Type == v1.NodeReady {
+			healthy = c.Status == v1.ConditionTrue
+			switch c.Status {
+			case v1.ConditionTrue:
+				status = "Ready"
+			case v1.ConditionFalse:
+				status = "NotReady"
+			}
+		} else if c.Status == v1.ConditionTrue {
+			pressure = append(pressure, string(c.Type))
+		}
+		conditions = append(conditions, NodeCondition{
+			Type:    string(c.Type),
+			Status:  string(c.Status),
+			Reason:  c.Reason,
+			Message: c.Message,
+			Healthy: healthy,
+		})
+	}
+
+	var taints []string
+	for _, t := range n.Spec.Taints {
+		taint := t.Key
+		if t.Value != "" {
+			taint += "=" + t.Value
+		}
+		taints = append(taints, taint+":"+string(t.Effect))
+	}
+
+	internalIP := ""
+	var addresses []NodeAddress
+	for _, a := range n.Status.Addresses {
+		addresses = append(addresses, NodeAddress{Type: string(a.Type), Address: a.Address})
+		if a.Type == v1.NodeInternalIP && internalIP == "" {
+			internalIP = a.Address
+		}
+	}
+
+	return Node{
+		Name:              n.Name,
+		Status:            status,
+		Unschedulable:     n.Spec.Unschedulable,
+		Roles:             NodeRoles(n.Labels),
+		Version:           n.Status.NodeInfo.KubeletVersion,
+		Age:               time.Since(n.CreationTimestamp.Time),
+		CPUAllocatable:    FormatCPU(n.Status.Allocatable[v1.ResourceCPU]),
+		MemoryAllocatable: FormatMemory(n.Status.Allocatable[v1.ResourceMemory]),
+		CPUCapacity:       FormatCPU(n.Status.Capacity[v1.ResourceCPU]),
+		MemoryCapacity:    FormatMemory(n.Status.Capacity[v1.ResourceMemory]),
+		PodCapacity:       n.Status.Capacity.Pods().String(),
+		Pods:              pods,
+		InternalIP:        internalIP,
+		Pressure:          pressure,
+		Conditions:        conditions,
+		Taints:            taints,
+		Addresses:         addresses,
+		OSImage:           n.Status.NodeInfo.OSImage,
+		Runtime:           n.Status.NodeInfo.ContainerRuntimeVersion,
+
+		CPUAllocatableMilli:    n.Status.Allocatable.Cpu().MilliValue(),
+		MemoryAllocatableBytes: n.Status.Allocatable.Memory().Value(),
+
+		Object: &n,
+	}
+}
+
+// DisplayStatus returns the status as kubectl shows it, flagging cordoned nodes
+func (n Node) DisplayStatus() string {
+	if n.Unschedulable {
+		return n.Status + ",SchedulingDisabled"
+	}
+	return n.Status
+}
+
+// DisplayRoles returns the roles joined for display, or <none>
+func (n Node) DisplayRoles() string {
+	if len(n.Roles) == 0 {
+		return "<none>"
+	}
+	return strings.Join(n.Roles, ",")
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (n Node) FormatAge() string {
+	return formatAge(n.Age)
+}
@@ -0,0 +1,126 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PersistentVolume represents a Kubernetes persistent volume
+type PersistentVolume struct {
+	Name          string
+	Status        string
+	Capacity      string
+	AccessModes   []string
+	ReclaimPolicy string
+	StorageClass  string
+	VolumeMode    string
+	// ClaimNamespace and ClaimName identify the claim the volume is bound or reserved for
+	ClaimNamespace string
+	ClaimName      string
+	// Reason and Message explain a Failed volume
+	Reason  string
+	Message string
+	// Source describes the storage backing the volume, such as "CSI ebs.csi.aws.com vol-0abc"
+	Source string
+	Age    time.Duration
+
+	// Object is the volume the model was converted from, which custom columns are filled from
+	Object *v1.PersistentVolume
+}
+
+// GetPersistentVolume fetches a single persistent volume by name
+func GetPersistentVolume(clientset *kubernetes.Clientset, name string) (*PersistentVolume, error) {
+	k8sVolume, err := clientset.CoreV1().PersistentVolumes().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get persistent volume %s: %w", name, err)
+	}
+
+	volume := ToPersistentVolumeModel(*k8sVolume)
+	return &volume, nil
+}
+
+// ToPersistentVolumeModel converts a Kubernetes API persistent volume object to our internal model
+func ToPersistentVolumeModel(p v1.PersistentVolume) PersistentVolume {
+	volume := PersistentVolume{
+		Name:          p.Name,
+		Status:        string(p.Status.Phase),
+		ReclaimPolicy: string(p.Spec.PersistentVolumeReclaimPolicy),
+		StorageClass:  p.Spec.StorageClassName,
+		Reason:        p.Status.Reason,
+		Message:       p.Status.Message,
+		Source:        persistentVolumeSource(p.Spec.PersistentVolumeSource),
+		Age:           time.Since(p.CreationTimestamp.Time),
+		Object:        &p,
+	}
+	if volume.Status == "" {
+		volume.Status = string(v1.VolumePending)
+	}
+	if p.DeletionTimestamp != nil {
+		volume.Status = "Terminating"
+	}
+	if storage, ok := p.Spec.Capacity[v1.ResourceStorage]; ok {
+		volume.Capacity = storage.String()
+	}
+	for _, mode := range p.Spec.AccessModes {
+		volume.AccessModes = append(volume.AccessModes, string(mode))
+	}
+	if p.Spec.VolumeMode != nil {
+		volume.VolumeMode = string(*p.Spec.VolumeMode)
+	}
+	if p.Spec.ClaimRef != nil {
+		volume.ClaimNamespace = p.Spec.ClaimRef.Namespace
+		volume.ClaimName = p.Spec.ClaimRef.Name
+	}
+	return volume
+}
+
+// persistentVolumeSource describes the storage backing a volume for the common volume types
+func persistentVolumeSource(source v1.PersistentVolumeSource) string {
+	switch {
+	case source.CSI != nil:
+		return fmt.Sprintf("CSI %s %s", source.CSI.Driver, source.CSI.VolumeHandle)
+	case source.HostPath != nil:
+		return "HostPath " + source.HostPath.Path
+	case source.Local != nil:
+		return "Local " + source.Local.Path
+	case source.NFS != nil:
+		return fmt.Sprintf("NFS %s:%s", source.NFS.Server, source.NFS.Path)
+	case source.ISCSI != nil:
+		return fmt.Sprintf("iSCSI %s %s", source.ISCSI.TargetPortal, source.ISCSI.IQN)
+	case source.FC != nil:
+		return "Fibre Channel"
+	default:
+		return "<other>"
+	}
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (p PersistentVolume) FormatAge() string {
+	return formatAge(p.Age)
+}
+
+// FormatAccessModes formats the access modes in kubectl's short form, such as RWO,ROX
+func (p PersistentVolume) FormatAccessModes() string {
+	return formatAccessModes(p.AccessModes)
+}
+
+// FormatClaim formats the claim the volume is bound to as namespace/name
+func (p PersistentVolume) FormatClaim() string {
+	if p.ClaimName == "" {
+		return "<none>"
+	}
+	return p.ClaimNamespace + "/" + p.ClaimName
+}
+
+// FormatStorageClass formats the storage class, which is empty for volumes without one
+func (p PersistentVolume) FormatStorageClass() string {
+	if p.StorageClass == "" {
+		return "<none>"
+	}
+	return p.StorageClass
+}
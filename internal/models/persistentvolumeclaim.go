@@ -0,0 +1,178 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// accessModeAbbreviations are the short forms kubectl shows access modes in
+var accessModeAbbreviations = map[v1.PersistentVolumeAccessMode]string{
+	v1.ReadWriteOnce:    "RWO",
+	v1.ReadOnlyMany:     "ROX",
+	v1.ReadWriteMany:    "RWX",
+	v1.ReadWriteOncePod: "RWOP",
+}
+
+// PersistentVolumeClaim represents a Kubernetes persistent volume claim
+type PersistentVolumeClaim struct {
+	Name      string
+	Namespace string
+	Status    string
+	// Volume is the name of the bound persistent volume, empty until bound
+	Volume string
+	// Capacity is the size of the bound volume, Requested the size asked for
+	Capacity     string
+	Requested    string
+	AccessModes  []string
+	StorageClass string
+	VolumeMode   string
+	Age          time.Duration
+
+	// Object is the claim the model was converted from, which custom columns are filled from
+	Object *v1.PersistentVolumeClaim
+}
+
+// ClaimPod is a pod mounting a persistent volume claim
+type ClaimPod struct {
+	Name      string
+	Namespace string
+	Node      string
+	Phase     string
+	// Volume is the name the pod mounts the claim as
+	Volume string
+}
+
+// GetPersistentVolumeClaim fetches a single persistent volume claim by name and namespace
+func GetPersistentVolumeClaim(clientset *kubernetes.Clientset, namespace, name string) (*PersistentVolumeClaim, error) {
+	k8sClaim, err := clientset.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get persistent volume claim %s in namespace %s: %w", name, namespace, err)
+	}
+
+	claim := ToPersistentVolumeClaimModel(*k8sClaim)
+	return &claim, nil
+}
+
+// ToPersistentVolumeClaimModel converts a Kubernetes API persistent volume claim object to our internal model
+func ToPersistentVolumeClaimModel(c v1.PersistentVolumeClaim) PersistentVolumeClaim {
+	claim := PersistentVolumeClaim{
+		Name:      c.Name,
+		Namespace: c.Namespace,
+		Status:    string(c.Status.Phase),
+		Volume:    c.Spec.VolumeName,
+		Age:       time.Since(c.CreationTimestamp.Time),
+		Object:    &c,
+	}
+	if claim.Status == "" {
+		claim.Status = string(v1.ClaimPending)
+	}
+	if c.DeletionTimestamp != nil {
+		claim.Status = "Terminating"
+	}
+	if storage, ok := c.Status.Capacity[v1.ResourceStorage]; ok {
+		claim.Capacity = storage.String()
+	}
+	if storage, ok := c.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+		claim.Requested = storage.String()
+	}
+	// A bound claim reports the access modes of its volume, a pending one those it asks for
+	modes := c.Status.AccessModes
+	if len(modes) == 0 {
+		modes = c.Spec.AccessModes
+	}
+	for _, mode := range modes {
+		claim.AccessModes = append(claim.AccessModes, string(mode))
+	}
+	if c.Spec.StorageClassName != nil {
+		claim.StorageClass = *c.Spec.StorageClassName
+	}
+	if c.Spec.VolumeMode != nil {
+		claim.VolumeMode = string(*c.Spec.VolumeMode)
+	}
+	return claim
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (c PersistentVolumeClaim) FormatAge() string {
+	return formatAge(c.Age)
+}
+
+// FormatAccessModes formats the access modes in kubectl's short form, such as RWO,ROX
+func (c PersistentVolumeClaim) FormatAccessModes() string {
+	return formatAccessModes(c.AccessModes)
+}
+
+// FormatStorageClass formats the storage class, which is empty when the cluster default applies
+func (c PersistentVolumeClaim) FormatStorageClass() string {
+	if c.StorageClass == "" {
+		return "<default>"
+	}
+	return c.StorageClass
+}
+
+// FormatCapacity formats the bound capacity, or the requested size while pending
+func (c PersistentVolumeClaim) FormatCapacity() string {
+	if c.Capacity != "" {
+		return c.Capacity
+	}
+	if c.Requested != "" {
+		return c.Requested + " (requested)"
+	}
+	return "<none>"
+}
+
+// IsPending reports whether the claim is waiting for a volume
+func (c PersistentVolumeClaim) IsPending() bool {
+	return c.Status == string(v1.ClaimPending)
+}
+
+// GetClaimPods returns the pods in the claim's namespace that mount it, directly or as a generic ephemeral volume
+func GetClaimPods(clientset *kubernetes.Clientset, namespace, claimName string) ([]ClaimPod, error) {
+	pods, err := clientset.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods in namespace %s: %w", namespace, err)
+	}
+
+	var result []ClaimPod
+	for _, pod := range pods.Items {
+		for _, volume := range pod.Spec.Volumes {
+			mounted := volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName
+			// Ephemeral volume claims are named <pod>-<volume>
+			mounted = mounted || (volume.Ephemeral != nil && pod.Name+"-"+volume.Name == claimName)
+			if mounted {
+				result = append(result, ClaimPod{
+					Name:      pod.Name,
+					Namespace: pod.Namespace,
+					Node:      pod.Spec.NodeName,
+					Phase:     string(pod.Status.Phase),
+					Volume:    volume.Name,
+				})
+				break
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// formatAccessModes formats access modes in kubectl's short form
+func formatAccessModes(modes []string) string {
+	if len(modes) == 0 {
+		return "<none>"
+	}
+	short := make([]string, len(modes))
+	for i, mode := range modes {
+		short[i] = mode
+		if abbreviation, ok := accessModeAbbreviations[v1.PersistentVolumeAccessMode(mode)]; ok {
+			short[i] = abbreviation
+		}
+	}
+	return strings.Join(short, ",")
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -14,12 +15,51 @@ import (
 type Pod struct {
 	Name      string
 	Namespace string
-	Status    string
-	Ready     string
-	Restarts  int
-	Age       time.Duration
-	IP        string
-	Node      string
+	// Cluster is the kubeconfig context the pod was listed from in the multi-cluster views; empty otherwise
+	Cluster string
+	// Status is the status kubectl get pods shows, such as Running, CrashLoopBackOff, Init:0/1 or Terminating
+	Status string
+	// Phase is the lifecycle phase the pod reports, such as Running or Pending
+	Phase    string
+	Ready    string
+	Restarts int
+	Age      time.Duration
+	IP       string
+	Node     string
+
+	// Images are the images of the containers, in spec order
+	Images []string
+	// QOSClass is the quality of service class Kubernetes assigned the pod
+	QOSClass string
+	// NominatedNode is the node the scheduler nominated while preempting pods to make room, if any
+	NominatedNode string
+	// ReadinessGates counts the readiness gates that are satisfied as "ready/total", empty without readiness gates
+	ReadinessGates string
+
+	// Object is the pod the model was converted from, which custom columns read labels, annotations and JSONPath
+	// expressions from
+	Object *v1.Pod
+
+	// Requests and limits summed over containers. A zero limit means at least one container is unbounded.
+	CPURequestMilli    int64
+	CPULimitMilli      int64
+	MemoryRequestBytes int64
+	MemoryLimitBytes   int64
+
+	// Containers holds the requests and limits of each container, in spec order
+	Containers []ContainerResources
+
+	// Usage is the latest metrics-server sample, nil when metrics are unavailable
+	Usage *ResourceUsage
+}
+
+// ContainerResources is the CPU and memory a container requests and is limited to. A zero limit means unbounded.
+type ContainerResources struct {
+	Name               string
+	CPURequestMilli    int64
+	CPULimitMilli      int64
+	MemoryRequestBytes int64
+	MemoryLimitBytes   int64
 }
 
 // GetPods fetches a list of pods from the Kubernetes cluster
@@ -39,36 +79,102 @@ func GetPod(clientset *kubernetes.Clientset, namespace, name string) (*Pod, erro
 // ToPodModel converts a Kubernetes API pod object to our internal Pod model
 func ToPodModel(p v1.Pod) Pod {
 	restarts := 0
-	readyContainers := 0
 	for _, cs := range p.Status.ContainerStatuses {
 		restarts += int(cs.RestartCount)
-		if cs.Ready {
-			readyContainers++
-		}
 	}
+	status, readyContainers, totalContainers := podStatus(p)
 
 	return Pod{
 		Name:      p.Name,
 		Namespace: p.Namespace,
-		Status:    string(p.Status.Phase),
-		Ready:     fmt.Sprintf("%d/%d", readyContainers, len(p.Spec.Containers)),
+		Status:    status,
+		Phase:     string(p.Status.Phase),
+		Ready:     fmt.Sprintf("%d/%d", readyContainers, totalContainers),
 		Restarts:  restarts,
 		Age:       time.Since(p.CreationTimestamp.Time),
 		IP:        p.Status.PodIP,
 		Node:      p.Spec.NodeName,
+
+		Images:         containerImages(p.Spec.Containers),
+		QOSClass:       string(p.Status.QOSClass),
+		NominatedNode:  p.Status.NominatedNodeName,
+		ReadinessGates: readinessGates(p),
+		Object:         &p,
+
+		CPURequestMilli:    sumRequests(p.Spec.Containers, v1.ResourceCPU).MilliValue(),
+		CPULimitMilli:      sumLimits(p.Spec.Containers, v1.ResourceCPU).MilliValue(),
+		MemoryRequestBytes: sumRequests(p.Spec.Containers, v1.ResourceMemory).Value(),
+		MemoryLimitBytes:   sumLimits(p.Spec.Containers, v1.ResourceMemory).Value(),
+		Containers:         toContainerResources(p.Spec.Containers),
 	}
 }
 
-// FormatAge formats the age duration to a human-readable string
-func (p Pod) FormatAge() string {
-	if p.Age < time.Minute {
-		return "<1m"
+// containerImages returns the image of each container
+func containerImages(containers []v1.Container) []string {
+	images := make([]string, 0, len(containers))
+	for _, c := range containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+// readinessGates counts the pod's readiness gates whose condition is true, as kubectl get pods -o wide shows them
+func readinessGates(p v1.Pod) string {
+	if len(p.Spec.ReadinessGates) == 0 {
+		return ""
 	}
-	if p.Age < time.Hour {
-		return p.Age.Round(time.Minute).String()
+	ready := 0
+	for _, gate := range p.Spec.ReadinessGates {
+		for _, condition := range p.Status.Conditions {
+			if condition.Type == gate.ConditionType && condition.Status == v1.ConditionTrue {
+				ready++
+				break
+			}
+		}
+	}
+	return fmt.Sprintf("%d/%d", ready, len(p.Spec.ReadinessGates))
+}
+
+// toContainerResources extracts the requests and limits of each container
+func toContainerResources(containers []v1.Container) []ContainerResources {
+	resources := make([]ContainerResources, 0, len(containers))
+	for _, c := range containers {
+		resources = append(resources, ContainerResources{
+			Name:               c.Name,
+			CPURequestMilli:    c.Resources.Requests.Cpu().MilliValue(),
+			CPULimitMilli:      c.Resources.Limits.Cpu().MilliValue(),
+			MemoryRequestBytes: c.Resources.Requests.Memory().Value(),
+			MemoryLimitBytes:   c.Resources.Limits.Memory().Value(),
+		})
 	}
-	if p.Age < 24*time.Hour {
-		return p.Age.Round(time.Hour).String()
+	return resources
+}
+
+// sumRequests sums the containers' requests of a resource
+func sumRequests(containers []v1.Container, name v1.ResourceName) *resource.Quantity {
+	total := &resource.Quantity{}
+	for _, c := range containers {
+		if request, ok := c.Resources.Requests[name]; ok {
+			total.Add(request)
+		}
 	}
-	return p.Age.Round(24 * time.Hour).String()
+	return total
+}
+
+// sumLimits sums the containers' limits of a resource, returning zero if any container has no limit
+func sumLimits(containers []v1.Container, name v1.ResourceName) *resource.Quantity {
+	total := &resource.Quantity{}
+	for _, c := range containers {
+		limit, ok := c.Resources.Limits[name]
+		if !ok {
+			return &resource.Quantity{}
+		}
+		total.Add(limit)
+	}
+	return total
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (p Pod) FormatAge() string {
+	return formatAge(p.Age)
 }
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// nodeLostReason is the reason the node lifecycle controller gives pods on a node that stopped responding
+const nodeLostReason = "NodeLost"
+
+// podErrorReasons are the statuses of pods that cannot run until something is fixed, without the "Init:" prefix
+// init containers add
+var podErrorReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"ErrImageNeverPull":          true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"RunContainerError":          true,
+	"ContainerCannotRun":         true,
+	"PreStartHookError":          true,
+	"PostStartHookError":         true,
+	"Error":                      true,
+	"OOMKilled":                  true,
+	"DeadlineExceeded":           true,
+	"Evicted":                    true,
+	"Failed":                     true,
+	nodeLostReason:               true,
+}
+
+// IsPodErrorStatus reports whether a pod status, as kubectl shows it, is an error such as CrashLoopBackOff,
+// Init:ErrImagePull or ExitCode:1
+func IsPodErrorStatus(status string) bool {
+	status = strings.TrimPrefix(status, "Init:")
+	return podErrorReasons[status] || strings.HasPrefix(status, "ExitCode:") || strings.HasPrefix(status, "Signal:")
+}
+
+// podStatus derives the status of a pod and counts its ready containers as kubectl get pods does. The status is the
+// reason the pod is not running when there is one, such as CrashLoopBackOff, Init:1/2 or Terminating, and its phase
+// otherwise. Sidecars, which are init containers that keep running, count as containers.
+func podStatus(p v1.Pod) (status string, ready, total int) {
+	status = string(p.Status.Phase)
+	if p.Status.Reason != "" {
+		status = p.Status.Reason
+	}
+	for _, condition := range p.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Reason == v1.PodReasonSchedulingGated {
+			status = v1.PodReasonSchedulingGated
+		}
+	}
+
+	total = len(p.Spec.Containers)
+	initContainers := make(map[string]v1.Container, len(p.Spec.InitContainers))
+	for _, container := range p.Spec.InitContainers {
+		initContainers[container.Name] = container
+		if isSidecar(container) {
+			total++
+		}
+	}
+
+	initializing := false
+	for i, container := range p.Status.InitContainerStatuses {
+		switch {
+		case container.State.Terminated != nil && container.State.Terminated.ExitCode == 0:
+			continue
+		case isSidecar(initContainers[container.Name]) && container.Started != nil && *container.Started:
+			if container.Ready {
+				ready++
+			}
+			continue
+		case container.State.Terminated != nil:
+			status = "Init:" + terminatedReason(container.State.Terminated)
+		case container.State.Waiting != nil && container.State.Waiting.Reason != "" && container.State.Waiting.Reason != "PodInitializing":
+			status = "Init:" + container.State.Waiting.Reason
+		default:
+			status = fmt.Sprintf("Init:%d/%d", i, len(p.Spec.InitContainers))
+		}
+		initializing = true
+		break
+	}
+
+	if !initializing || hasCondition(p, v1.PodInitialized) {
+		running := false
+		// The first container with a reason sets the status, as kubectl lists containers in spec order
+		for i := len(p.Status.ContainerStatuses) - 1; i >= 0; i-- {
+			container := p.Status.ContainerStatuses[i]
+			switch {
+			case container.State.Waiting != nil && container.State.Waiting.Reason != "":
+				status = container.State.Waiting.Reason
+			case container.State.Terminated != nil:
+				status = terminatedReason(container.State.Terminated)
+			case container.Ready && container.State.Running != nil:
+				running = true
+				ready++
+			}
+		}
+
+		// A pod whose containers have partly completed is still running while any container is
+		if status == "Completed" && running {
+			if hasCondition(p, v1.PodReady) {
+				status = string(v1.PodRunning)
+			} else {
+				status = "NotReady"
+			}
+		}
+	}
+
+	if p.DeletionTimestamp != nil {
+		switch {
+		case p.Status.Reason == nodeLostReason:
+			status = string(v1.PodUnknown)
+		case p.Status.Phase != v1.PodSucceeded && p.Status.Phase != v1.PodFailed:
+			status = "Terminating"
+		}
+	}
+	return status, ready, total
+}
+
+// terminatedReason describes why a container terminated: its reason, or the signal or exit code that ended it
+func terminatedReason(terminated *v1.ContainerStateTerminated) string {
+	switch {
+	case terminated.Reason != "":
+		return terminated.Reason
+	case terminated.Signal != 0:
+		return fmt.Sprintf("Signal:%d", terminated.Signal)
+	default:
+		return fmt.Sprintf("ExitCode:%d", terminated.ExitCode)
+	}
+}
+
+// isSidecar reports whether an init container keeps running alongside the containers
+func isSidecar(container v1.Container) bool {
+	return container.RestartPolicy != nil && *container.RestartPolicy == v1.ContainerRestartPolicyAlways
+}
+
+// hasCondition reports whether the pod has a condition of the type that is true. A pod's Ready condition includes
+// its readiness gates.
+func hasCondition(p v1.Pod, conditionType v1.PodConditionType) bool {
+	for _, condition := range p.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
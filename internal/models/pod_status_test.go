@@ -0,0 +1,282 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodStatus(t *testing.T) {
+	now := metav1.Now()
+	always := v1.ContainerRestartPolicyAlways
+	started := true
+
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	waiting := func(reason string) v1.ContainerState {
+		return v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: reason}}
+	}
+	terminated := func(reason string, exitCode, signal int32) v1.ContainerState {
+		return v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: reason, ExitCode: exitCode, Signal: signal}}
+	}
+	condition := func(conditionType v1.PodConditionType, status v1.ConditionStatus) v1.PodCondition {
+		return v1.PodCondition{Type: conditionType, Status: status}
+	}
+
+	// pod builds a fixture pod with a container for each status, and init containers for each init status
+	pod := func(phase v1.PodPhase, statuses []v1.ContainerStatus, initStatuses []v1.ContainerStatus, conditions ...v1.PodCondition) v1.Pod {
+		p := v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "shop"},
+			Status: v1.PodStatus{
+				Phase:                 phase,
+				ContainerStatuses:     statuses,
+				InitContainerStatuses: initStatuses,
+				Conditions:            conditions,
+			},
+		}
+		for _, status := range statuses {
+			p.Spec.Containers = append(p.Spec.Containers, v1.Container{Name: status.Name})
+		}
+		if len(statuses) == 0 {
+			p.Spec.Containers = []v1.Container{{Name: "app"}}
+		}
+		for _, status := range initStatuses {
+			p.Spec.InitContainers = append(p.Spec.InitContainers, v1.Container{Name: status.Name})
+		}
+		return p
+	}
+	container := func(name string, state v1.ContainerState, ready bool) v1.ContainerStatus {
+		return v1.ContainerStatus{Name: name, State: state, Ready: ready}
+	}
+
+	tests := []struct {
+		name           string
+		pod            func() v1.Pod
+		expectedStatus string
+		expectedReady  string
+	}{
+		{
+			name:           "running",
+			pod:            func() v1.Pod { return pod(v1.PodRunning, []v1.ContainerStatus{container("app", running, true)}, nil) },
+			expectedStatus: "Running",
+			expectedReady:  "1/1",
+		},
+		{
+			name: "pending_without_container_statuses",
+			pod: func() v1.Pod {
+				return pod(v1.PodPending, nil, nil)
+			},
+			expectedStatus: "Pending",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "crash_loop_back_off_while_running",
+			pod: func() v1.Pod {
+				return pod(v1.PodRunning, []v1.ContainerStatus{container("app", waiting("CrashLoopBackOff"), false)}, nil)
+			},
+			expectedStatus: "CrashLoopBackOff",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "image_pull_back_off_while_pending",
+			pod: func() v1.Pod {
+				return pod(v1.PodPending, []v1.ContainerStatus{container("app", waiting("ImagePullBackOff"), false)}, nil)
+			},
+			expectedStatus: "ImagePullBackOff",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "first_container_with_a_reason_wins",
+			pod: func() v1.Pod {
+				return pod(v1.PodPending, []v1.ContainerStatus{
+					container("app", waiting("ErrImagePull"), false),
+					container("proxy", waiting("ContainerCreating"), false),
+				}, nil)
+			},
+			expectedStatus: "ErrImagePull",
+			expectedReady:  "0/2",
+		},
+		{
+			name: "oom_killed",
+			pod: func() v1.Pod {
+				return pod(v1.PodRunning, []v1.ContainerStatus{container("app", terminated("OOMKilled", 137, 0), false)}, nil)
+			},
+			expectedStatus: "OOMKilled",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "exit_code_without_reason",
+			pod: func() v1.Pod {
+				return pod(v1.PodFailed, []v1.ContainerStatus{container("app", terminated("", 2, 0), false)}, nil)
+			},
+			expectedStatus: "ExitCode:2",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "signal_without_reason",
+			pod: func() v1.Pod {
+				return pod(v1.PodFailed, []v1.ContainerStatus{container("app", terminated("", 0, 9), false)}, nil)
+			},
+			expectedStatus: "Signal:9",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "completed",
+			pod: func() v1.Pod {
+				return pod(v1.PodSucceeded, []v1.ContainerStatus{container("app", terminated("Completed", 0, 0), false)}, nil)
+			},
+			expectedStatus: "Completed",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "partly_completed_and_ready",
+			pod: func() v1.Pod {
+				return pod(v1.PodRunning, []v1.ContainerStatus{
+					container("app", running, true),
+					container("migrate", terminated("Completed", 0, 0), false),
+				}, nil, condition(v1.PodReady, v1.ConditionTrue))
+			},
+			expectedStatus: "Running",
+			expectedReady:  "1/2",
+		},
+		{
+			name: "partly_completed_with_readiness_gates_unsatisfied",
+			pod: func() v1.Pod {
+				p := pod(v1.PodRunning, []v1.ContainerStatus{
+					container("app", running, true),
+					container("migrate", terminated("Completed", 0, 0), false),
+				}, nil, condition(v1.PodReady, v1.ConditionFalse), condition("example.com/load-balancer", v1.ConditionFalse))
+				p.Spec.ReadinessGates = []v1.PodReadinessGate{{ConditionType: "example.com/load-balancer"}}
+				return p
+			},
+			expectedStatus: "NotReady",
+			expectedReady:  "1/2",
+		},
+		{
+			name: "init_containers_in_progress",
+			pod: func() v1.Pod {
+				return pod(v1.PodPending, []v1.ContainerStatus{container("app", waiting("PodInitializing"), false)}, []v1.ContainerStatus{
+					container("schema", terminated("Completed", 0, 0), false),
+					container("seed", running, false),
+					container("warm", waiting("PodInitializing"), false),
+				})
+			},
+			expectedStatus: "Init:1/3",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "init_container_crash_loop",
+			pod: func() v1.Pod {
+				return pod(v1.PodPending, nil, []v1.ContainerStatus{container("schema", waiting("CrashLoopBackOff"), false)})
+			},
+			expectedStatus: "Init:CrashLoopBackOff",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "init_container_failed_with_exit_code",
+			pod: func() v1.Pod {
+				return pod(v1.PodPending, nil, []v1.ContainerStatus{container("schema", terminated("", 3, 0), false)})
+			},
+			expectedStatus: "Init:ExitCode:3",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "started_sidecar_counts_as_a_container",
+			pod: func() v1.Pod {
+				sidecar := container("mesh", running, true)
+				sidecar.Started = &started
+				p := pod(v1.PodRunning, []v1.ContainerStatus{container("app", running, true)}, []v1.ContainerStatus{sidecar},
+					condition(v1.PodInitialized, v1.ConditionTrue), condition(v1.PodReady, v1.ConditionTrue))
+				p.Spec.InitContainers[0].RestartPolicy = &always
+				return p
+			},
+			expectedStatus: "Running",
+			expectedReady:  "2/2",
+		},
+		{
+			name: "terminating",
+			pod: func() v1.Pod {
+				p := pod(v1.PodRunning, []v1.ContainerStatus{container("app", running, true)}, nil)
+				p.DeletionTimestamp = &now
+				return p
+			},
+			expectedStatus: "Terminating",
+			expectedReady:  "1/1",
+		},
+		{
+			name: "deleted_after_completing_keeps_its_status",
+			pod: func() v1.Pod {
+				p := pod(v1.PodSucceeded, []v1.ContainerStatus{container("app", terminated("Completed", 0, 0), false)}, nil)
+				p.DeletionTimestamp = &now
+				return p
+			},
+			expectedStatus: "Completed",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "node_lost",
+			pod: func() v1.Pod {
+				p := pod(v1.PodRunning, nil, nil)
+				p.Status.Reason = "NodeLost"
+				return p
+			},
+			expectedStatus: "NodeLost",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "node_lost_while_terminating",
+			pod: func() v1.Pod {
+				p := pod(v1.PodRunning, nil, nil)
+				p.Status.Reason = "NodeLost"
+				p.DeletionTimestamp = &now
+				return p
+			},
+			expectedStatus: "Unknown",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "evicted",
+			pod: func() v1.Pod {
+				p := pod(v1.PodFailed, nil, nil)
+				p.Status.Reason = "Evicted"
+				return p
+			},
+			expectedStatus: "Evicted",
+			expectedReady:  "0/1",
+		},
+		{
+			name: "scheduling_gated",
+			pod: func() v1.Pod {
+				p := pod(v1.PodPending, nil, nil)
+				p.Status.Conditions = []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionFalse, Reason: v1.PodReasonSchedulingGated}}
+				return p
+			},
+			expectedStatus: "SchedulingGated",
+			expectedReady:  "0/1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			model := ToPodModel(test.pod())
+			assert.Equal(t, test.expectedStatus, model.Status)
+			assert.Equal(t, test.expectedReady, model.Ready)
+		})
+	}
+
+	t.Run("should_recognise_error_statuses", func(t *testing.T) {
+		for status, expected := range map[string]bool{
+			"CrashLoopBackOff":      true,
+			"Init:ImagePullBackOff": true,
+			"ExitCode:1":            true,
+			"Init:Signal:9":         true,
+			"NodeLost":              true,
+			"Running":               false,
+			"Init:1/3":              false,
+			"Terminating":           false,
+			"Completed":             false,
+		} {
+			assert.Equal(t, expected, IsPodErrorStatus(status), status)
+		}
+	})
+}
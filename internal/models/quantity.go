@@ -0,0 +1,34 @@
+package models
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// FormatCPU formats a CPU quantity in cores, using millicores below one core
+func FormatCPU(q resource.Quantity) string {
+	milli := q.MilliValue()
+	if milli%1000 == 0 {
+		return fmt.Sprintf("%d", milli/1000)
+	}
+	return fmt.Sprintf("%dm", milli)
+}
+
+// FormatMemory formats a memory quantity using the largest binary unit that keeps the value at least 1
+func FormatMemory(q resource.Quantity) string {
+	bytes := float64(q.Value())
+	units := []string{"Ki", "Mi", "Gi", "Ti"}
+	if bytes < 1024 {
+		return fmt.Sprintf("%.0f", bytes)
+	}
+	unit := ""
+	for _, u := range units {
+		if bytes < 1024 {
+			break
+		}
+		bytes /= 1024
+		unit = u
+	}
+	return fmt.Sprintf("%.1f%s", bytes, unit)
+}
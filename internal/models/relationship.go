@@ -0,0 +1,502 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// maxOwnerDepth bounds the walk up owner references, guarding against reference cycles
+const maxOwnerDepth = 10
+
+// ResourceRef identifies a namespaced Kubernetes object by kind, namespace and name
+type ResourceRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String formats the reference as Kind/name
+func (r ResourceRef) String() string {
+	return r.Kind + "/" + r.Name
+}
+
+// Health summarises whether a resource in a relationship tree is working
+type Health int
+
+const (
+	// HealthUnknown is a resource whose health cannot be judged, such as a config map
+	HealthUnknown Health = iota
+	// HealthHealthy is a resource that is working as intended
+	HealthHealthy
+	// HealthDegraded is a resource that is partially working or still progressing
+	HealthDegraded
+	// HealthFailed is a resource that has failed or is missing
+	HealthFailed
+)
+
+// RelationshipNode is a resource in a relationship tree together with the resources it owns or uses
+type RelationshipNode struct {
+	Ref ResourceRef
+	// Relation describes how the node relates to its parent: "owns", "selects", "mounts" or "references"
+	Relation string
+	// Status is a short summary of the resource's state, such as "2/3 ready" or "Bound"
+	Status   string
+	Health   Health
+	Children []*RelationshipNode
+}
+
+// Find returns the node for ref in the tree rooted at n, or nil when the tree does not contain it
+func (n *RelationshipNode) Find(ref ResourceRef) *RelationshipNode {
+	if n.Ref == ref {
+		return n
+	}
+	for _, child := range n.Children {
+		if found := child.Find(ref); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// relationshipResolver builds relationship trees within one namespace.
+// The pods, replica sets and jobs of the namespace are listed once and shared by every lookup.
+type relationshipResolver struct {
+	clientset   *kubernetes.Clientset
+	ctx         context.Context
+	namespace   string
+	pods        []v1.Pod
+	replicaSets []appsv1.ReplicaSet
+	jobs        []batchv1.Job
+}
+
+// ResolveRelationships walks the owner references of the given resource up to its top-level owner and returns
+// the tree of everything below that owner: Deployment → ReplicaSet → Pod, CronJob → Job → Pod,
+// Service → EndpointSlice → Pod and Pod → PersistentVolumeClaim/ConfigMap/Secret
+func ResolveRelationships(clientset *kubernetes.Clientset, ref ResourceRef) (*RelationshipNode, error) {
+	r := &relationshipResolver{
+		clientset: clientset,
+		ctx:       context.TODO(),
+		namespace: ref.Namespace,
+	}
+
+	pods, err := clientset.CoreV1().Pods(ref.Namespace).List(r.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods in namespace %s: %w", ref.Namespace, err)
+	}
+	r.pods = pods.Items
+	replicaSets, err := clientset.AppsV1().ReplicaSets(ref.Namespace).List(r.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list replica sets in namespace %s: %w", ref.Namespace, err)
+	}
+	r.replicaSets = replicaSets.Items
+	jobs, err := clientset.BatchV1().Jobs(ref.Namespace).List(r.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list jobs in namespace %s: %w", ref.Namespace, err)
+	}
+	r.jobs = jobs.Items
+
+	root, err := r.rootOwner(ref)
+	if err != nil {
+		return nil, err
+	}
+	return r.resolve(root, "")
+}
+
+// rootOwner follows controller owner references up from ref while the owner is a kind the resolver understands
+func (r *relationshipResolver) rootOwner(ref ResourceRef) (ResourceRef, error) {
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		object, err := r.objectMeta(ref)
+		if err != nil {
+			return ResourceRef{}, err
+		}
+		owner := metav1.GetControllerOf(object)
+		if owner == nil || !r.supports(owner.Kind) {
+			return ref, nil
+		}
+		ref = ResourceRef{Kind: owner.Kind, Namespace: ref.Namespace, Name: owner.Name}
+	}
+	return ref, nil
+}
+
+// supports reports whether the resolver can fetch and expand the given kind
+func (r *relationshipResolver) supports(kind string) bool {
+	switch kind {
+	case "Deployment", "ReplicaSet", "StatefulSet", "DaemonSet", "CronJob", "Job", "Pod", "Service",
+		"PersistentVolumeClaim", "ConfigMap", "Secret":
+		return true
+	}
+	return false
+}
+
+// objectMeta fetches the metadata of the referenced object
+func (r *relationshipResolver) objectMeta(ref ResourceRef) (metav1.Object, error) {
+	var object metav1.Object
+	var err error
+	opts := metav1.GetOptions{}
+	switch ref.Kind {
+	case "Deployment":
+		object, err = r.clientset.AppsV1().Deployments(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "ReplicaSet":
+		object, err = r.clientset.AppsV1().ReplicaSets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "StatefulSet":
+		object, err = r.clientset.AppsV1().StatefulSets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "DaemonSet":
+		object, err = r.clientset.AppsV1().DaemonSets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "CronJob":
+		object, err = r.clientset.BatchV1().CronJobs(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "Job":
+		object, err = r.clientset.BatchV1().Jobs(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "Pod":
+		object, err = r.clientset.CoreV1().Pods(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "Service":
+		object, err = r.clientset.CoreV1().Services(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "PersistentVolumeClaim":
+		object, err = r.clientset.CoreV1().PersistentVolumeClaims(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "ConfigMap":
+		object, err = r.clientset.CoreV1().ConfigMaps(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	case "Secret":
+		object, err = r.clientset.CoreV1().Secrets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+	default:
+		return nil, fmt.Errorf("relationships of %s are not supported", ref.Kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s in namespace %s: %w", ref, ref.Namespace, err)
+	}
+	return object, nil
+}
+
+// resolve builds the node for ref and, recursively, the nodes of everything it owns or uses
+func (r *relationshipResolver) resolve(ref ResourceRef, relation string) (*RelationshipNode, error) {
+	node := &RelationshipNode{Ref: ref, Relation: relation}
+	opts := metav1.GetOptions{}
+
+	switch ref.Kind {
+	case "Deployment":
+		d, err := r.clientset.AppsV1().Deployments(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = replicaHealth(d.Status.ReadyReplicas, desiredReplicas(d.Spec.Replicas))
+		for _, rs := range r.replicaSets {
+			if isOwnedBy(rs.OwnerReferences, d.UID) {
+				if err := r.addChild(node, ResourceRef{Kind: "ReplicaSet", Namespace: ref.Namespace, Name: rs.Name}, "owns"); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case "ReplicaSet":
+		rs, err := r.clientset.AppsV1().ReplicaSets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = replicaHealth(rs.Status.ReadyReplicas, desiredReplicas(rs.Spec.Replicas))
+		if err := r.addOwnedPods(node, rs.UID); err != nil {
+			return nil, err
+		}
+	case "StatefulSet":
+		s, err := r.clientset.AppsV1().StatefulSets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = replicaHealth(s.Status.ReadyReplicas, desiredReplicas(s.Spec.Replicas))
+		if err := r.addOwnedPods(node, s.UID); err != nil {
+			return nil, err
+		}
+	case "DaemonSet":
+		d, err := r.clientset.AppsV1().DaemonSets(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = replicaHealth(d.Status.NumberReady, d.Status.DesiredNumberScheduled)
+		if err := r.addOwnedPods(node, d.UID); err != nil {
+			return nil, err
+		}
+	case "CronJob":
+		c, err := r.clientset.BatchV1().CronJobs(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = fmt.Sprintf("%s, %d active", c.Spec.Schedule, len(c.Status.Active)), HealthHealthy
+		if c.Spec.Suspend != nil && *c.Spec.Suspend {
+			node.Status, node.Health = "Suspended", HealthDegraded
+		}
+		for _, job := range r.jobs {
+			if isOwnedBy(job.OwnerReferences, c.UID) {
+				if err := r.addChild(node, ResourceRef{Kind: "Job", Namespace: ref.Namespace, Name: job.Name}, "owns"); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case "Job":
+		j, err := r.clientset.BatchV1().Jobs(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		job := ToJobModel(*j)
+		node.Status, node.Health = job.Status, jobHealth(job.Status)
+		if err := r.addOwnedPods(node, j.UID); err != nil {
+			return nil, err
+		}
+	case "Pod":
+		p, err := r.clientset.CoreV1().Pods(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = podHealth(*p)
+		for _, used := range podReferences(*p) {
+			if err := r.addChild(node, used.ref, used.relation); err != nil {
+				return nil, err
+			}
+		}
+	case "Service":
+		s, err := r.clientset.CoreV1().Services(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = string(s.Spec.Type), HealthHealthy
+		if err := r.addEndpointSlices(node); err != nil {
+			return nil, err
+		}
+		if len(s.Spec.Selector) > 0 && len(node.Children) == 0 {
+			node.Status, node.Health = string(s.Spec.Type)+", no endpoints", HealthDegraded
+		}
+	case "PersistentVolumeClaim":
+		c, err := r.clientset.CoreV1().PersistentVolumeClaims(ref.Namespace).Get(r.ctx, ref.Name, opts)
+		if err != nil {
+			return r.missing(node, err)
+		}
+		node.Status = string(c.Status.Phase)
+		switch c.Status.Phase {
+		case v1.ClaimBound:
+			node.Health = HealthHealthy
+		case v1.ClaimPending:
+			node.Health = HealthDegraded
+		default:
+			node.Health = HealthFailed
+		}
+	case "ConfigMap":
+		if _, err := r.clientset.CoreV1().ConfigMaps(ref.Namespace).Get(r.ctx, ref.Name, opts); err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = "Present", HealthHealthy
+	case "Secret":
+		if _, err := r.clientset.CoreV1().Secrets(ref.Namespace).Get(r.ctx, ref.Name, opts); err != nil {
+			return r.missing(node, err)
+		}
+		node.Status, node.Health = "Present", HealthHealthy
+	default:
+		node.Status = "Unsupported kind"
+	}
+	return node, nil
+}
+
+// missing marks a node whose resource does not exist as failed. Other errors abort the resolution.
+func (r *relationshipResolver) missing(node *RelationshipNode, err error) (*RelationshipNode, error) {
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("could not get %s in namespace %s: %w", node.Ref, node.Ref.Namespace, err)
+	}
+	node.Status, node.Health = "Missing", HealthFailed
+	return node, nil
+}
+
+// addChild resolves ref and appends it to node's children
+func (r *relationshipResolver) addChild(node *RelationshipNode, ref ResourceRef, relation string) error {
+	child, err := r.resolve(ref, relation)
+	if err != nil {
+		return err
+	}
+	node.Children = append(node.Children, child)
+	return nil
+}
+
+// addOwnedPods appends the pods whose owner references point at uid
+func (r *relationshipResolver) addOwnedPods(node *RelationshipNode, uid types.UID) error {
+	for _, pod := range r.pods {
+		if isOwnedBy(pod.OwnerReferences, uid) {
+			if err := r.addChild(node, ResourceRef{Kind: "Pod", Namespace: r.namespace, Name: pod.Name}, "owns"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addEndpointSlices appends the endpoint slices of a service, each with the pods its endpoints target
+func (r *relationshipResolver) addEndpointSlices(node *RelationshipNode) error {
+	slices, err := r.clientset.DiscoveryV1().EndpointSlices(r.namespace).List(r.ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + node.Ref.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("could not list endpoint slices for service %s in namespace %s: %w", node.Ref.Name, r.namespace, err)
+	}
+	sort.Slice(slices.Items, func(i, j int) bool { return slices.Items[i].Name < slices.Items[j].Name })
+
+	for _, slice := range slices.Items {
+		ready := 0
+		sliceNode := &RelationshipNode{
+			Ref:      ResourceRef{Kind: "EndpointSlice", Namespace: r.namespace, Name: slice.Name},
+			Relation: "owns",
+		}
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				ready++
+			}
+			if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" {
+				continue
+			}
+			podRef := ResourceRef{Kind: "Pod", Namespace: r.namespace, Name: endpoint.TargetRef.Name}
+			if err := r.addChild(sliceNode, podRef, "selects"); err != nil {
+				return err
+			}
+		}
+		sliceNode.Status, sliceNode.Health = replicaHealth(int32(ready), int32(len(slice.Endpoints)))
+		node.Children = append(node.Children, sliceNode)
+	}
+	return nil
+}
+
+// podReference is a resource a pod uses together with how it uses it
+type podReference struct {
+	ref      ResourceRef
+	relation string
+}
+
+// podReferences returns the claims, config maps and secrets a pod mounts or reads into its environment, without duplicates
+func podReferences(p v1.Pod) []podReference {
+	var references []podReference
+	seen := make(map[ResourceRef]bool)
+	add := func(kind, name, relation string) {
+		ref := ResourceRef{Kind: kind, Namespace: p.Namespace, Name: name}
+		if name == "" || seen[ref] {
+			return
+		}
+		seen[ref] = true
+		references = append(references, podReference{ref: ref, relation: relation})
+	}
+
+	for _, volume := range p.Spec.Volumes {
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			add("PersistentVolumeClaim", volume.PersistentVolumeClaim.ClaimName, "mounts")
+		case volume.ConfigMap != nil:
+			add("ConfigMap", volume.ConfigMap.Name, "mounts")
+		case volume.Secret != nil:
+			add("Secret", volume.Secret.SecretName, "mounts")
+		case volume.Projected != nil:
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					add("ConfigMap", source.ConfigMap.Name, "mounts")
+				}
+				if source.Secret != nil {
+					add("Secret", source.Secret.Name, "mounts")
+				}
+			}
+		}
+	}
+
+	containers := append(append([]v1.Container{}, p.Spec.InitContainers...), p.Spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add("ConfigMap", envFrom.ConfigMapRef.Name, "references")
+			}
+			if envFrom.SecretRef != nil {
+				add("Secret", envFrom.SecretRef.Name, "references")
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				add("ConfigMap", env.ValueFrom.ConfigMapKeyRef.Name, "references")
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				add("Secret", env.ValueFrom.SecretKeyRef.Name, "references")
+			}
+		}
+	}
+	for _, pullSecret := range p.Spec.ImagePullSecrets {
+		add("Secret", pullSecret.Name, "references")
+	}
+	return references
+}
+
+// isOwnedBy reports whether the owner references include uid
+func isOwnedBy(owners []metav1.OwnerReference, uid types.UID) bool {
+	for _, owner := range owners {
+		if owner.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// desiredReplicas returns the replica count of a spec, which defaults to 1 when unset
+func desiredReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// replicaHealth summarises ready against desired replicas: all ready is healthy, none ready failed, some ready degraded
+func replicaHealth(ready, desired int32) (string, Health) {
+	status := fmt.Sprintf("%d/%d ready", ready, desired)
+	switch {
+	case ready >= desired:
+		return status, HealthHealthy
+	case ready == 0:
+		return status, HealthFailed
+	default:
+		return status, HealthDegraded
+	}
+}
+
+// jobHealth maps a job status to a health
+func jobHealth(status string) Health {
+	switch status {
+	case "Complete", "Running":
+		return HealthHealthy
+	case "Failed":
+		return HealthFailed
+	default:
+		return HealthDegraded
+	}
+}
+
+// podHealth summarises a pod's status, as kubectl shows it, and container readiness. An error status such as
+// CrashLoopBackOff fails the pod.
+func podHealth(p v1.Pod) (string, Health) {
+	pod := ToPodModel(p)
+	if IsPodErrorStatus(pod.Status) {
+		return pod.Status, HealthFailed
+	}
+
+	status := fmt.Sprintf("%s, %s ready", pod.Status, pod.Ready)
+	switch {
+	case p.Status.Phase == v1.PodSucceeded:
+		return pod.Status, HealthHealthy
+	case p.Status.Phase == v1.PodFailed:
+		return pod.Status, HealthFailed
+	case p.Status.Phase == v1.PodRunning && pod.Status == string(v1.PodRunning):
+		for _, cs := range p.Status.ContainerStatuses {
+			if !cs.Ready {
+				return status, HealthDegraded
+			}
+		}
+		return status, HealthHealthy
+	default:
+		return status, HealthDegraded
+	}
+}
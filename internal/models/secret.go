@@ -0,0 +1,123 @@
+package models
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Secret represents a Kubernetes secret. Values are kept base64-encoded, as served by the API,
+// so they are only decoded when the user explicitly reveals one.
+type Secret struct {
+	Name      string
+	Namespace string
+	Type      string
+	Data      map[string]string // key -> base64-encoded value
+	Immutable bool
+	Age       time.Duration
+
+	// Object is the secret the model was converted from, without its data, for custom columns. Values, and the
+	// last-applied annotation holding a copy of them, are left out so that a column cannot show one the user has
+	// not revealed.
+	Object *v1.Secret
+}
+
+// GetSecret fetches a single secret by name and namespace
+func GetSecret(clientset *kubernetes.Clientset, namespace, name string) (*Secret, error) {
+	k8sSecret, err := clientset.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get secret %s in namespace %s: %w", name, namespace, err)
+	}
+
+	secret := ToSecretModel(*k8sSecret)
+	return &secret, nil
+}
+
+// ToSecretModel converts a Kubernetes API secret object to our internal Secret model
+func ToSecretModel(s v1.Secret) Secret {
+	data := make(map[string]string, len(s.Data))
+	for key, value := range s.Data {
+		data[key] = base64.StdEncoding.EncodeToString(value)
+	}
+
+	return Secret{
+		Name:      s.Name,
+		Namespace: s.Namespace,
+		Type:      string(s.Type),
+		Data:      data,
+		Immutable: s.Immutable != nil && *s.Immutable,
+		Age:       time.Since(s.CreationTimestamp.Time),
+		Object:    withoutData(s),
+	}
+}
+
+// lastAppliedAnnotation is written by kubectl apply and holds the whole manifest, values included
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// withoutData returns a copy of a secret without its values or the last-applied manifest carrying them
+func withoutData(s v1.Secret) *v1.Secret {
+	s.Data = nil
+	s.StringData = nil
+	if _, ok := s.Annotations[lastAppliedAnnotation]; ok {
+		annotations := make(map[string]string, len(s.Annotations)-1)
+		for key, value := range s.Annotations {
+			if key != lastAppliedAnnotation {
+				annotations[key] = value
+			}
+		}
+		s.Annotations = annotations
+	}
+	return &s
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (s Secret) FormatAge() string {
+	return formatAge(s.Age)
+}
+
+// KeyCount returns the number of data keys
+func (s Secret) KeyCount() int {
+	return len(s.Data)
+}
+
+// Keys returns the data keys sorted by name, with the decoded size of each value
+func (s Secret) Keys() []DataKey {
+	keys := make([]DataKey, 0, len(s.Data))
+	for name, value := range s.Data {
+		keys = append(keys, DataKey{Name: name, Size: base64.StdEncoding.DecodedLen(len(value)) - paddingLen(value)})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys
+}
+
+// paddingLen counts the trailing padding characters of a base64 string
+func paddingLen(encoded string) int {
+	n := 0
+	for i := len(encoded) - 1; i >= 0 && encoded[i] == '='; i-- {
+		n++
+	}
+	return n
+}
+
+// RevealSecretValue base64-decodes the value of a secret key, describing binary values rather than returning them
+func (s Secret) RevealSecretValue(key string) (string, error) {
+	encoded, ok := s.Data[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s in namespace %s has no key %s", s.Name, s.Namespace, key)
+	}
+	value, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("could not decode key %s of secret %s in namespace %s: %w", key, s.Name, s.Namespace, err)
+	}
+	if !utf8.Valid(value) {
+		return fmt.Sprintf("<binary data, %d bytes>", len(value)), nil
+	}
+	return string(value), nil
+}
@@ -0,0 +1,259 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ServicePort is a port exposed by a service
+type ServicePort struct {
+	Name       string
+	Protocol   string
+	Port       int32
+	TargetPort string
+	NodePort   int32
+}
+
+// Service represents a Kubernetes service
+type Service struct {
+	Name        string
+	Namespace   string
+	Type        string
+	ClusterIP   string
+	ExternalIPs []string
+	// LoadBalancerPending is true for a LoadBalancer service that has not been assigned an address yet
+	LoadBalancerPending bool
+	Ports               []ServicePort
+	Selector            map[string]string
+	SessionAffinity     string
+	Age                 time.Duration
+
+	// Object is the service the model was converted from, which custom columns are filled from
+	Object *v1.Service
+}
+
+// ServiceEndpoint is an address backing a service, resolved from its EndpointSlices
+type ServiceEndpoint struct {
+	Address     string
+	Ready       bool
+	Terminating bool
+	NodeName    string
+	// PodName and PodNamespace identify the pod behind the address; empty when the endpoint is not a pod
+	PodName      string
+	PodNamespace string
+	Ports        []string
+}
+
+// GetService fetches a single service by name and namespace
+func GetService(clientset *kubernetes.Clientset, namespace, name string) (*Service, error) {
+	k8sService, err := clientset.CoreV1().Services(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get service %s in namespace %s: %w", name, namespace, err)
+	}
+
+	service := ToServiceModel(*k8sService)
+	return &service, nil
+}
+
+// ToServiceModel converts a Kubernetes API service object to our internal Service model
+func ToServiceModel(s v1.Service) Service {
+	externalIPs := append([]string(nil), s.Spec.ExternalIPs...)
+	for _, ingress := range s.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			externalIPs = append(externalIPs, ingress.IP)
+		} else if ingress.Hostname != "" {
+			externalIPs = append(externalIPs, ingress.Hostname)
+		}
+	}
+
+	var ports []ServicePort
+	for _, p := range s.Spec.Ports {
+		ports = append(ports, ServicePort{
+			Name:       p.Name,
+			Protocol:   string(p.Protocol),
+			Port:       p.Port,
+			TargetPort: p.TargetPort.String(),
+			NodePort:   p.NodePort,
+		})
+	}
+
+	return Service{
+		Name:                s.Name,
+		Namespace:           s.Namespace,
+		Type:                string(s.Spec.Type),
+		ClusterIP:           s.Spec.ClusterIP,
+		ExternalIPs:         externalIPs,
+		LoadBalancerPending: s.Spec.Type == v1.ServiceTypeLoadBalancer && len(s.Status.LoadBalancer.Ingress) == 0,
+		Ports:               ports,
+		Selector:            s.Spec.Selector,
+		SessionAffinity:     string(s.Spec.SessionAffinity),
+		Age:                 time.Since(s.CreationTimestamp.Time),
+		Object:              &s,
+	}
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (s Service) FormatAge() string {
+	return formatAge(s.Age)
+}
+
+// FormatExternalIPs formats the external addresses the way kubectl does
+func (s Service) FormatExternalIPs() string {
+	if len(s.ExternalIPs) > 0 {
+		return strings.Join(s.ExternalIPs, ",")
+	}
+	if s.LoadBalancerPending {
+		return "<pending>"
+	}
+	return "<none>"
+}
+
+// FormatPorts formats the ports the way kubectl does, e.g. 80:30080/TCP,443/TCP
+func (s Service) FormatPorts() string {
+	if len(s.Ports) == 0 {
+		return "<none>"
+	}
+	var ports []string
+	for _, p := range s.Ports {
+		port := fmt.Sprintf("%d", p.Port)
+		if p.NodePort != 0 {
+			port += fmt.Sprintf(":%d", p.NodePort)
+		}
+		ports = append(ports, port+"/"+p.Protocol)
+	}
+	return strings.Join(ports, ",")
+}
+
+// FormatSelector formats the pod selector as a sorted label selector
+func (s Service) FormatSelector() string {
+	if len(s.Selector) == 0 {
+		return "<none>"
+	}
+	return labels.SelectorFromSet(s.Selector).String()
+}
+
+// GetServiceEndpoints resolves the EndpointSlices of a service into its addresses, ready ones first
+func GetServiceEndpoints(clientset *kubernetes.Clientset, namespace, name string) ([]ServiceEndpoint, error) {
+	slices, err := clientset.DiscoveryV1().EndpointSlices(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list endpoint slices of service %s in namespace %s: %w", name, namespace, err)
+	}
+
+	var endpoints []ServiceEndpoint
+	for _, slice := range slices.Items {
+		var ports []string
+		for _, p := range slice.Ports {
+			if p.Port == nil {
+				continue
+			}
+			port := fmt.Sprintf("%d", *p.Port)
+			if p.Protocol != nil {
+				port += "/" + string(*p.Protocol)
+			}
+			ports = append(ports, port)
+		}
+
+		for _, e := range slice.Endpoints {
+			endpoint := ServiceEndpoint{
+				// A nil ready condition means ready, per the EndpointSlice API
+				Ready:       e.Conditions.Ready == nil || *e.Conditions.Ready,
+				Terminating: e.Conditions.Terminating != nil && *e.Conditions.Terminating,
+				Ports:       ports,
+			}
+			if e.NodeName != nil {
+				endpoint.NodeName = *e.NodeName
+			}
+			if e.TargetRef != nil && e.TargetRef.Kind == "Pod" {
+				endpoint.PodName = e.TargetRef.Name
+				endpoint.PodNamespace = e.TargetRef.Namespace
+				if endpoint.PodNamespace == "" {
+					endpoint.PodNamespace = namespace
+				}
+			}
+			for _, address := range e.Addresses {
+				endpoint.Address = address
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].Ready != endpoints[j].Ready {
+			return endpoints[i].Ready
+		}
+		return endpoints[i].Address < endpoints[j].Address
+	})
+	return endpoints, nil
+}
+
+// CountReadyEndpoints returns the number of ready endpoints
+func CountReadyEndpoints(endpoints []ServiceEndpoint) int {
+	ready := 0
+	for _, e := range endpoints {
+		if e.Ready {
+			ready++
+		}
+	}
+	return ready
+}
+
+// DiagnoseServiceEndpoints explains why a service has no ready endpoints, returning nothing when it has some
+func DiagnoseServiceEndpoints(clientset *kubernetes.Clientset, service *Service, endpoints []ServiceEndpoint) ([]string, error) {
+	if CountReadyEndpoints(endpoints) > 0 {
+		return nil, nil
+	}
+
+	switch {
+	case service.Type == string(v1.ServiceTypeExternalName):
+		return []string{"ExternalName services resolve through DNS and have no endpoints"}, nil
+	case len(service.Selector) == 0:
+		return []string{"Service has no selector, so its endpoints must be managed manually"}, nil
+	}
+
+	pods, err := clientset.CoreV1().Pods(service.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: service.FormatSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods of service %s in namespace %s: %w", service.Name, service.Namespace, err)
+	}
+
+	if len(pods.Items) == 0 {
+		return []string{fmt.Sprintf("No pods in namespace %s match selector %s", service.Namespace, service.FormatSelector())}, nil
+	}
+
+	var hints []string
+	notReady := 0
+	for _, pod := range pods.Items {
+		if !isPodReady(pod) {
+			notReady++
+		}
+	}
+	if notReady > 0 {
+		hints = append(hints, fmt.Sprintf("%d of %d pods matching the selector are not ready", notReady, len(pods.Items)))
+	}
+	if len(endpoints) == 0 {
+		hints = append(hints, "No EndpointSlices have been published for the service yet")
+	}
+	return hints, nil
+}
+
+// isPodReady reports whether the pod's Ready condition is true
+func isPodReady(pod v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,237 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// VolumeClaimTemplate is a PersistentVolumeClaim template stamped out for each StatefulSet pod
+type VolumeClaimTemplate struct {
+	Name         string
+	StorageClass string
+	AccessModes  []string
+	Storage      string
+}
+
+// StatefulSet represents a Kubernetes stateful set
+type StatefulSet struct {
+	Name                 string
+	Namespace            string
+	Replicas             int
+	ReadyReplicas        int
+	CurrentReplicas      int
+	UpdatedReplicas      int
+	ServiceName          string
+	PodManagementPolicy  string
+	UpdateStrategy       string
+	Partition            int
+	CurrentRevision      string
+	UpdateRevision       string
+	VolumeClaimTemplates []VolumeClaimTemplate
+	// Selector is the label selector matching the stateful set's pods
+	Selector string
+	Image    string
+	Age      time.Duration
+
+	// Object is the stateful set the model was converted from, which custom columns are filled from
+	Object *appsv1.StatefulSet
+}
+
+// StatefulSetPod is the state of the pod with a given ordinal of a stateful set
+type StatefulSetPod struct {
+	Ordinal int
+	Name    string
+	// Exists is false for an ordinal whose pod has not been created (yet)
+	Exists   bool
+	Phase    string
+	Ready    bool
+	Revision string
+}
+
+// GetStatefulSet fetches a single stateful set by name and namespace
+func GetStatefulSet(clientset *kubernetes.Clientset, namespace, name string) (*StatefulSet, error) {
+	k8sStatefulSet, err := clientset.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get stateful set %s in namespace %s: %w", name, namespace, err)
+	}
+
+	statefulSet := ToStatefulSetModel(*k8sStatefulSet)
+	return &statefulSet, nil
+}
+
+// ToStatefulSetModel converts a Kubernetes API stateful set object to our internal StatefulSet model
+func ToStatefulSetModel(s appsv1.StatefulSet) StatefulSet {
+	replicas := 1
+	if s.Spec.Replicas != nil {
+		replicas = int(*s.Spec.Replicas)
+	}
+
+	strategy := string(s.Spec.UpdateStrategy.Type)
+	if strategy == "" {
+		strategy = string(appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+	partition := 0
+	if s.Spec.UpdateStrategy.RollingUpdate != nil && s.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+		partition = int(*s.Spec.UpdateStrategy.RollingUpdate.Partition)
+	}
+
+	var templates []VolumeClaimTemplate
+	for _, pvc := range s.Spec.VolumeClaimTemplates {
+		template := VolumeClaimTemplate{Name: pvc.Name}
+		if pvc.Spec.StorageClassName != nil {
+			template.StorageClass = *pvc.Spec.StorageClassName
+		}
+		for _, mode := range pvc.Spec.AccessModes {
+			template.AccessModes = append(template.AccessModes, string(mode))
+		}
+		if storage, ok := pvc.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+			template.Storage = storage.String()
+		}
+		templates = append(templates, template)
+	}
+
+	selector := ""
+	if s.Spec.Selector != nil {
+		if labelSelector, err := metav1.LabelSelectorAsSelector(s.Spec.Selector); err == nil {
+			selector = labelSelector.String()
+		}
+	}
+
+	image := "N/A"
+	if len(s.Spec.Template.Spec.Containers) > 0 {
+		image = s.Spec.Template.Spec.Containers[0].Image
+	}
+
+	return StatefulSet{
+		Name:                 s.Name,
+		Namespace:            s.Namespace,
+		Replicas:             replicas,
+		ReadyReplicas:        int(s.Status.ReadyReplicas),
+		CurrentReplicas:      int(s.Status.CurrentReplicas),
+		UpdatedReplicas:      int(s.Status.UpdatedReplicas),
+		ServiceName:          s.Spec.ServiceName,
+		PodManagementPolicy:  string(s.Spec.PodManagementPolicy),
+		UpdateStrategy:       strategy,
+		Partition:            partition,
+		CurrentRevision:      s.Status.CurrentRevision,
+		UpdateRevision:       s.Status.UpdateRevision,
+		VolumeClaimTemplates: templates,
+		Selector:             selector,
+		Image:                image,
+		Age:                  time.Since(s.CreationTimestamp.Time),
+		Object:               &s,
+	}
+}
+
+// FormatAge formats the age duration to a human-readable string
+func (s StatefulSet) FormatAge() string {
+	return formatAge(s.Age)
+}
+
+// FormatReady formats the ready replicas against the desired replicas
+func (s StatefulSet) FormatReady() string {
+	return fmt.Sprintf("%d/%d", s.ReadyReplicas, s.Replicas)
+}
+
+// RolloutInProgress reports whether pods are still to be moved to the update revision
+func (s StatefulSet) RolloutInProgress() bool {
+	return s.UpdateRevision != "" && s.CurrentRevision != s.UpdateRevision
+}
+
+// FormatRollout describes how far a rolling update has progressed, including the ordinals a partition holds back
+func (s StatefulSet) FormatRollout() string {
+	if s.UpdateStrategy == string(appsv1.OnDeleteStatefulSetStrategyType) {
+		return fmt.Sprintf("%d/%d updated, pods are only updated when deleted", s.UpdatedReplicas, s.Replicas)
+	}
+	if !s.RolloutInProgress() {
+		return "Complete"
+	}
+	if s.Partition > 0 {
+		// Only ordinals at or above the partition are updated
+		target := s.Replicas - s.Partition
+		if target < 0 {
+			target = 0
+		}
+		return fmt.Sprintf("%d/%d of ordinals >= %d updated, ordinals < %d held at the current revision by the partition", s.UpdatedReplicas, target, s.Partition, s.Partition)
+	}
+	return fmt.Sprintf("%d/%d updated", s.UpdatedReplicas, s.Replicas)
+}
+
+// FormatAccessModes formats the access modes of a volume claim template
+func (t VolumeClaimTemplate) FormatAccessModes() string {
+	if len(t.AccessModes) == 0 {
+		return "<none>"
+	}
+	return strings.Join(t.AccessModes, ",")
+}
+
+// GetStatefulSetPods returns the pod of each ordinal of a stateful set, including ordinals with no pod
+func GetStatefulSetPods(clientset *kubernetes.Clientset, statefulSet *StatefulSet) ([]StatefulSetPod, error) {
+	pods, err := clientset.CoreV1().Pods(statefulSet.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: statefulSet.Selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods of stateful set %s in namespace %s: %w", statefulSet.Name, statefulSet.Namespace, err)
+	}
+
+	byOrdinal := make(map[int]StatefulSetPod)
+	for _, pod := range pods.Items {
+		if !isControlledBy(&pod, "StatefulSet", statefulSet.Name) {
+			continue
+		}
+		ordinal, ok := statefulSetPodOrdinal(statefulSet.Name, pod.Name)
+		if !ok {
+			continue
+		}
+		byOrdinal[ordinal] = StatefulSetPod{
+			Ordinal:  ordinal,
+			Name:     pod.Name,
+			Exists:   true,
+			Phase:    string(pod.Status.Phase),
+			Ready:    isPodReady(pod),
+			Revision: pod.Labels[appsv1.StatefulSetRevisionLabel],
+		}
+	}
+
+	// Every ordinal below the desired replicas is listed, plus any pods above it still being scaled down
+	for ordinal := 0; ordinal < statefulSet.Replicas; ordinal++ {
+		if _, ok := byOrdinal[ordinal]; !ok {
+			byOrdinal[ordinal] = StatefulSetPod{Ordinal: ordinal, Name: fmt.Sprintf("%s-%d", statefulSet.Name, ordinal)}
+		}
+	}
+
+	result := make([]StatefulSetPod, 0, len(byOrdinal))
+	for _, pod := range byOrdinal {
+		result = append(result, pod)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Ordinal < result[j].Ordinal })
+	return result, nil
+}
+
+// statefulSetPodOrdinal parses the ordinal from a stateful set pod name of the form <set>-<ordinal>
+func statefulSetPodOrdinal(statefulSetName, podName string) (int, bool) {
+	suffix, found := strings.CutPrefix(podName, statefulSetName+"-")
+	if !found {
+		return 0, false
+	}
+	ordinal, err := strconv.Atoi(suffix)
+	if err != nil || ordinal < 0 {
+		return 0, false
+	}
+	return ordinal, true
+}
+
+// isControlledBy reports whether the controller of obj is of the given kind and name
+func isControlledBy(obj metav1.Object, kind, name string) bool {
+	controllerRef := metav1.GetControllerOf(obj)
+	return controllerRef != nil && controllerRef.Kind == kind && controllerRef.Name == name
+}
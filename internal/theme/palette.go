@@ -0,0 +1,235 @@
+package theme
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultThemeName is the theme used when none is chosen
+const DefaultThemeName = "cyberpunk"
+
+// Palette is the definition of a theme: its name and colors, as written in a theme file
+type Palette struct {
+	Name string `json:"name,omitempty"`
+	// Extends names a built-in theme whose colors are used for any the palette leaves out
+	Extends string `json:"extends,omitempty"`
+	Colors  Colors `json:"colors,omitempty"`
+	// Resource usage thresholds, as a percentage of requests, limits or allocatable
+	UsageWarningPercent  int `json:"usageWarningPercent,omitempty"`
+	UsageCriticalPercent int `json:"usageCriticalPercent,omitempty"`
+}
+
+// Colors are the colors of a palette. Each is a hex color ("#rrggbb" or "#rgb") or an ANSI color number ("0" to "255").
+type Colors struct {
+	Primary   string `json:"primary,omitempty"`
+	Secondary string `json:"secondary,omitempty"`
+	Accent    string `json:"accent,omitempty"`
+	Warning   string `json:"warning,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Success   string `json:"success,omitempty"`
+	Purple    string `json:"purple,omitempty"`
+
+	BgPrimary   string `json:"bgPrimary,omitempty"`
+	BgSecondary string `json:"bgSecondary,omitempty"`
+	BgTertiary  string `json:"bgTertiary,omitempty"`
+
+	TextPrimary   string `json:"textPrimary,omitempty"`
+	TextSecondary string `json:"textSecondary,omitempty"`
+	TextMuted     string `json:"textMuted,omitempty"`
+	TextInverse   string `json:"textInverse,omitempty"`
+}
+
+// builtinPalettes are the themes that ship with vigilant, keyed by name
+var builtinPalettes = map[string]Palette{
+	"cyberpunk": {
+		Name: "cyberpunk",
+		Colors: Colors{
+			Primary:       "#00ff88", // Bright cyan-green
+			Secondary:     "#ff0088", // Hot pink
+			Accent:        "#ffaa00", // Orange
+			Warning:       "#ff4400", // Red-orange
+			Error:         "#ff0044", // Red
+			Success:       "#00ff44", // Green
+			Purple:        "#bd93f9", // A nice purple from the Dracula theme
+			BgPrimary:     "#0a0a0a", // Dark background
+			BgSecondary:   "#1a1a1a", // Slightly lighter
+			BgTertiary:    "#2a2a2a", // Even lighter
+			TextPrimary:   "#ffffff", // White
+			TextSecondary: "#cccccc", // Light gray
+			TextMuted:     "#888888", // Gray
+			TextInverse:   "#000000", // Black
+		},
+		UsageWarningPercent:  70,
+		UsageCriticalPercent: 90,
+	},
+	"dark": {
+		Name: "dark",
+		Colors: Colors{
+			Primary:       "#61afef",
+			Secondary:     "#c678dd",
+			Accent:        "#e5c07b",
+			Warning:       "#d19a66",
+			Error:         "#e06c75",
+			Success:       "#98c379",
+			Purple:        "#c678dd",
+			BgPrimary:     "#282c34",
+			BgSecondary:   "#2f343f",
+			BgTertiary:    "#3e4451",
+			TextPrimary:   "#dcdfe4",
+			TextSecondary: "#abb2bf",
+			TextMuted:     "#5c6370",
+			TextInverse:   "#282c34",
+		},
+		UsageWarningPercent:  70,
+		UsageCriticalPercent: 90,
+	},
+	"light": {
+		Name: "light",
+		Colors: Colors{
+			Primary:       "#0969da",
+			Secondary:     "#bf3989",
+			Accent:        "#9a6700",
+			Warning:       "#bc4c00",
+			Error:         "#cf222e",
+			Success:       "#1a7f37",
+			Purple:        "#8250df",
+			BgPrimary:     "#ffffff",
+			BgSecondary:   "#f6f8fa",
+			BgTertiary:    "#eaeef2",
+			TextPrimary:   "#1f2328",
+			TextSecondary: "#424a53",
+			TextMuted:     "#6e7781",
+			TextInverse:   "#ffffff",
+		},
+		UsageWarningPercent:  70,
+		UsageCriticalPercent: 90,
+	},
+	// high-contrast uses the 16 standard ANSI colors, so it looks the same on every terminal
+	"high-contrast": {
+		Name: "high-contrast",
+		Colors: Colors{
+			Primary:       "11", // Bright yellow
+			Secondary:     "14", // Bright cyan
+			Accent:        "13", // Bright magenta
+			Warning:       "11", // Bright yellow
+			Error:         "9",  // Bright red
+			Success:       "10", // Bright green
+			Purple:        "13", // Bright magenta
+			BgPrimary:     "0",  // Black
+			BgSecondary:   "0",  // Black
+			BgTertiary:    "8",  // Bright black
+			TextPrimary:   "15", // Bright white
+			TextSecondary: "15", // Bright white
+			TextMuted:     "7",  // White
+			TextInverse:   "0",  // Black
+		},
+		UsageWarningPercent:  70,
+		UsageCriticalPercent: 90,
+	},
+	"solarized": {
+		Name: "solarized",
+		Colors: Colors{
+			Primary:       "#268bd2", // Blue
+			Secondary:     "#d33682", // Magenta
+			Accent:        "#b58900", // Yellow
+			Warning:       "#cb4b16", // Orange
+			Error:         "#dc322f", // Red
+			Success:       "#859900", // Green
+			Purple:        "#6c71c4", // Violet
+			BgPrimary:     "#002b36", // Base03
+			BgSecondary:   "#073642", // Base02
+			BgTertiary:    "#0e4553",
+			TextPrimary:   "#93a1a1", // Base1
+			TextSecondary: "#839496", // Base0
+			TextMuted:     "#586e75", // Base01
+			TextInverse:   "#002b36", // Base03
+		},
+		UsageWarningPercent:  70,
+		UsageCriticalPercent: 90,
+	},
+}
+
+// hexColorPattern matches "#rgb" and "#rrggbb" colors
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// validColor reports whether color is a hex color or an ANSI color number
+func validColor(color string) bool {
+	if hexColorPattern.MatchString(color) {
+		return true
+	}
+	number, err := strconv.Atoi(color)
+	return err == nil && number >= 0 && number <= 255
+}
+
+// resolve fills the colors and thresholds the palette leaves out from the built-in theme it extends
+func (p Palette) resolve() (Palette, []string) {
+	if p.Extends == "" {
+		return p, nil
+	}
+	base, ok := builtinPalettes[p.Extends]
+	if !ok {
+		return p, []string{fmt.Sprintf("extends: unknown built-in theme %q (expected one of: %s)", p.Extends, strings.Join(BuiltinNames(), ", "))}
+	}
+
+	resolved := p
+	resolvedColors := reflect.ValueOf(&resolved.Colors).Elem()
+	baseColors := reflect.ValueOf(base.Colors)
+	for i := 0; i < resolvedColors.NumField(); i++ {
+		if resolvedColors.Field(i).String() == "" {
+			resolvedColors.Field(i).SetString(baseColors.Field(i).String())
+		}
+	}
+	if resolved.UsageWarningPercent == 0 {
+		resolved.UsageWarningPercent = base.UsageWarningPercent
+	}
+	if resolved.UsageCriticalPercent == 0 {
+		resolved.UsageCriticalPercent = base.UsageCriticalPercent
+	}
+	return resolved, nil
+}
+
+// validate checks that a resolved palette is complete and its colors and thresholds are valid,
+// returning a description of each problem prefixed with its field
+func (p Palette) validate() []string {
+	var problems []string
+	if p.Name == "" {
+		problems = append(problems, "name: must not be empty")
+	}
+
+	colors := reflect.ValueOf(p.Colors)
+	colorsType := colors.Type()
+	for i := 0; i < colors.NumField(); i++ {
+		field := "colors." + strings.Split(colorsType.Field(i).Tag.Get("json"), ",")[0]
+		color := colors.Field(i).String()
+		switch {
+		case color == "":
+			problems = append(problems, fmt.Sprintf("%s: required (or set extends to inherit it from a built-in theme)", field))
+		case !validColor(color):
+			problems = append(problems, fmt.Sprintf("%s: %q is not a color (expected #rrggbb, #rgb or an ANSI color number 0-255)", field, color))
+		}
+	}
+
+	if p.UsageWarningPercent <= 0 || p.UsageWarningPercent > 100 {
+		problems = append(problems, fmt.Sprintf("usageWarningPercent: must be between 1 and 100, got %d", p.UsageWarningPercent))
+	}
+	if p.UsageCriticalPercent <= 0 || p.UsageCriticalPercent > 100 {
+		problems = append(problems, fmt.Sprintf("usageCriticalPercent: must be between 1 and 100, got %d", p.UsageCriticalPercent))
+	} else if p.UsageCriticalPercent < p.UsageWarningPercent {
+		problems = append(problems, fmt.Sprintf("usageCriticalPercent: must not be below usageWarningPercent (%d), got %d", p.UsageWarningPercent, p.UsageCriticalPercent))
+	}
+	return problems
+}
+
+// BuiltinNames returns the names of the built-in themes in sorted order
+func BuiltinNames() []string {
+	names := make([]string, 0, len(builtinPalettes))
+	for name := range builtinPalettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,166 @@
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"sigs.k8s.io/yaml"
+)
+
+// Registry holds the built-in themes and those loaded from theme files, and builds them for a terminal color profile
+type Registry struct {
+	palettes map[string]Palette
+	profile  termenv.Profile
+}
+
+// NewRegistry creates a registry of the built-in themes, building them for the color profile
+func NewRegistry(profile termenv.Profile) *Registry {
+	palettes := make(map[string]Palette, len(builtinPalettes))
+	for name, palette := range builtinPalettes {
+		palettes[name] = palette
+	}
+	return &Registry{palettes: palettes, profile: profile}
+}
+
+// LoadError lists the problems found in theme files, so they can all be fixed in one pass
+type LoadError struct {
+	Problems []string
+}
+
+// Error formats the problems one per line
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("invalid themes:\n  - %s", strings.Join(e.Problems, "\n  - "))
+}
+
+// LoadDir loads the theme files (.yaml, .yml and .toml) in dir. A theme is named by its name field, or by its
+// file name without the extension, and replaces a built-in theme of the same name. A missing directory is not an
+// error. Valid themes are loaded even when others are not; the problems with the rest are returned as a *LoadError.
+func (r *Registry) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read themes %s: %w", dir, err)
+	}
+
+	var problems []string
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".toml") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		palette, fileProblems := loadPalette(path)
+		for _, problem := range fileProblems {
+			problems = append(problems, fmt.Sprintf("%s: %s", path, problem))
+		}
+		if len(fileProblems) == 0 {
+			r.palettes[palette.Name] = palette
+		}
+	}
+	if len(problems) > 0 {
+		return &LoadError{Problems: problems}
+	}
+	return nil
+}
+
+// loadPalette reads and validates the theme file at path
+func loadPalette(path string) (Palette, []string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Palette{}, []string{err.Error()}
+	}
+
+	var jsonData []byte
+	if strings.ToLower(filepath.Ext(path)) == ".toml" {
+		values, err := parseTOML(data)
+		if err == nil {
+			jsonData, err = json.Marshal(values)
+		}
+		if err != nil {
+			return Palette{}, []string{err.Error()}
+		}
+	} else {
+		jsonData, err = yaml.YAMLToJSONStrict(data)
+		if err != nil {
+			return Palette{}, []string{strings.TrimPrefix(err.Error(), "error converting YAML to JSON: ")}
+		}
+	}
+
+	// Unknown keys are rejected so that typos in color names do not go unnoticed
+	var palette Palette
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&palette); err != nil {
+		return Palette{}, []string{strings.TrimPrefix(err.Error(), "json: ")}
+	}
+	if palette.Name == "" {
+		palette.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	palette, problems := palette.resolve()
+	if len(problems) > 0 {
+		return Palette{}, problems
+	}
+	return palette, palette.validate()
+}
+
+// Names returns the names of the available themes in sorted order
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.palettes))
+	for name := range r.palettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get builds the named theme for the registry's color profile
+func (r *Registry) Get(name string) (*Theme, error) {
+	palette, ok := r.palettes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q (expected one of: %s)", name, strings.Join(r.Names(), ", "))
+	}
+	return NewTheme(palette, r.profile), nil
+}
+
+// Profile returns the color profile themes are built for
+func (r *Registry) Profile() termenv.Profile {
+	return r.profile
+}
+
+// ColorProfiles are the names accepted by ParseColorProfile
+var ColorProfiles = []string{"auto", "truecolor", "256", "16", "none"}
+
+// DetectColorProfile returns the color profile of the terminal, which is the no-color profile when NO_COLOR is set.
+// It must be called before a profile is set with lipgloss.SetColorProfile, which replaces the detected profile.
+func DetectColorProfile() termenv.Profile {
+	return lipgloss.ColorProfile()
+}
+
+// ParseColorProfile returns the terminal color profile with the name. "auto" (or "") is the detected profile.
+func ParseColorProfile(name string, detected termenv.Profile) (termenv.Profile, error) {
+	switch name {
+	case "", "auto":
+		return detected, nil
+	case "truecolor":
+		return termenv.TrueColor, nil
+	case "256":
+		return termenv.ANSI256, nil
+	case "16":
+		return termenv.ANSI, nil
+	case "none":
+		return termenv.Ascii, nil
+	default:
+		return termenv.Ascii, fmt.Errorf("unknown color profile %q (expected one of: %s)", name, strings.Join(ColorProfiles, ", "))
+	}
+}
@@ -0,0 +1,215 @@
+package theme
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("should_provide_the_built_in_themes", func(t *testing.T) {
+		registry := NewRegistry(termenv.TrueColor)
+		assert.Equal(t, []string{"cyberpunk", "dark", "high-contrast", "light", "solarized"}, registry.Names())
+
+		for _, name := range registry.Names() {
+			assert.Empty(t, builtinPalettes[name].validate(), name)
+			theme, err := registry.Get(name)
+			require.NoError(t, err)
+			assert.Equal(t, name, theme.Name)
+		}
+	})
+
+	t.Run("should_build_the_default_theme_from_the_cyberpunk_palette", func(t *testing.T) {
+		theme := NewDefaultTheme()
+		assert.Equal(t, "cyberpunk", theme.Name)
+		assert.Equal(t, lipgloss.Color("#00ff88"), theme.Primary)
+		assert.Equal(t, 70, theme.UsageWarningPercent)
+	})
+
+	t.Run("should_load_themes_from_yaml_and_toml", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTheme(t, dir, "ocean.yaml", `
+extends: dark
+colors:
+  primary: "#0088cc"
+  error: "196"
+`)
+		writeTheme(t, dir, "forest.toml", `
+# A green theme
+name = "forest"
+extends = 'light'
+usageWarningPercent = 60
+
+[colors]
+primary = "#228b22" # forest green
+success = "#2e8b57"
+`)
+		registry := NewRegistry(termenv.TrueColor)
+		require.NoError(t, registry.LoadDir(dir))
+		assert.Contains(t, registry.Names(), "ocean")
+		assert.Contains(t, registry.Names(), "forest")
+
+		ocean, err := registry.Get("ocean")
+		require.NoError(t, err)
+		assert.Equal(t, lipgloss.Color("#0088cc"), ocean.Primary)
+		assert.Equal(t, lipgloss.Color("196"), ocean.Error)
+		assert.Equal(t, lipgloss.Color(builtinPalettes["dark"].Colors.Success), ocean.Success)
+
+		forest, err := registry.Get("forest")
+		require.NoError(t, err)
+		assert.Equal(t, lipgloss.Color("#228b22"), forest.Primary)
+		assert.Equal(t, lipgloss.Color(builtinPalettes["light"].Colors.BgPrimary), forest.BgPrimary)
+		assert.Equal(t, 60, forest.UsageWarningPercent)
+		assert.Equal(t, 90, forest.UsageCriticalPercent)
+	})
+
+	t.Run("should_replace_a_built_in_theme_with_a_file_of_the_same_name", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTheme(t, dir, "dark.yml", `
+extends: dark
+colors:
+  primary: "#ff00ff"
+`)
+		registry := NewRegistry(termenv.TrueColor)
+		require.NoError(t, registry.LoadDir(dir))
+
+		dark, err := registry.Get("dark")
+		require.NoError(t, err)
+		assert.Equal(t, lipgloss.Color("#ff00ff"), dark.Primary)
+	})
+
+	t.Run("should_report_every_problem_and_load_the_valid_themes", func(t *testing.T) {
+		dir := t.TempDir()
+		writeTheme(t, dir, "good.yaml", "extends: solarized\n")
+		writeTheme(t, dir, "bad-colors.yaml", `
+extends: dark
+colors:
+  primary: red
+  accent: "256"
+usageCriticalPercent: 50
+usageWarningPercent: 80
+`)
+		writeTheme(t, dir, "typo.yaml", `
+extends: dark
+colours:
+  primary: "#ffffff"
+`)
+		writeTheme(t, dir, "incomplete.toml", `
+[colors]
+primary = "#ffffff"
+`)
+		writeTheme(t, dir, "unknown-base.yaml", "extends: midnight\n")
+		writeTheme(t, dir, "notes.txt", "not a theme")
+
+		registry := NewRegistry(termenv.TrueColor)
+		err := registry.LoadDir(dir)
+		require.Error(t, err)
+		assert.Contains(t, registry.Names(), "good")
+		assert.NotContains(t, registry.Names(), "bad-colors")
+
+		var loadErr *LoadError
+		require.ErrorAs(t, err, &loadErr)
+		path := func(name string) string { return filepath.Join(dir, name) }
+		assert.Contains(t, loadErr.Problems, path("bad-colors.yaml")+`: colors.primary: "red" is not a color (expected #rrggbb, #rgb or an ANSI color number 0-255)`)
+		assert.Contains(t, loadErr.Problems, path("bad-colors.yaml")+`: colors.accent: "256" is not a color (expected #rrggbb, #rgb or an ANSI color number 0-255)`)
+		assert.Contains(t, loadErr.Problems, path("bad-colors.yaml")+`: usageCriticalPercent: must not be below usageWarningPercent (80), got 50`)
+		assert.Contains(t, loadErr.Problems, path("typo.yaml")+`: unknown field "colours"`)
+		assert.Contains(t, loadErr.Problems, path("incomplete.toml")+`: colors.secondary: required (or set extends to inherit it from a built-in theme)`)
+		assert.Contains(t, loadErr.Problems, path("unknown-base.yaml")+`: extends: unknown built-in theme "midnight" (expected one of: cyberpunk, dark, high-contrast, light, solarized)`)
+		assert.Len(t, loadErr.Problems, 20)
+	})
+
+	t.Run("should_ignore_a_missing_themes_directory", func(t *testing.T) {
+		registry := NewRegistry(termenv.TrueColor)
+		assert.NoError(t, registry.LoadDir(filepath.Join(t.TempDir(), "themes")))
+	})
+
+	t.Run("should_reject_an_unknown_theme", func(t *testing.T) {
+		_, err := NewRegistry(termenv.TrueColor).Get("midnight")
+		assert.EqualError(t, err, `unknown theme "midnight" (expected one of: cyberpunk, dark, high-contrast, light, solarized)`)
+	})
+
+	t.Run("should_use_reverse_video_for_the_header_and_selection_without_color", func(t *testing.T) {
+		colored, err := NewRegistry(termenv.TrueColor).Get("dark")
+		require.NoError(t, err)
+		assert.False(t, colored.TableSelectedStyle.GetReverse())
+
+		plain, err := NewRegistry(termenv.Ascii).Get("dark")
+		require.NoError(t, err)
+		assert.Equal(t, termenv.Ascii, plain.Profile)
+		assert.True(t, plain.TableSelectedStyle.GetReverse())
+		assert.True(t, plain.HeaderStyle.GetReverse())
+	})
+
+	t.Run("should_parse_color_profiles", func(t *testing.T) {
+		for name, expected := range map[string]termenv.Profile{
+			"auto":      termenv.ANSI256,
+			"":          termenv.ANSI256,
+			"truecolor": termenv.TrueColor,
+			"256":       termenv.ANSI256,
+			"16":        termenv.ANSI,
+			"none":      termenv.Ascii,
+		} {
+			profile, err := ParseColorProfile(name, termenv.ANSI256)
+			require.NoError(t, err)
+			assert.Equal(t, expected, profile, name)
+		}
+
+		_, err := ParseColorProfile("mono", termenv.ANSI256)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseTOML(t *testing.T) {
+	t.Run("should_parse_strings_integers_booleans_and_tables", func(t *testing.T) {
+		values, err := parseTOML([]byte(`
+title = "say \"hi\"" # a comment
+path = 'C:\themes'
+count = 1_000
+enabled = true
+
+[outer.inner]
+"quoted key" = -5
+`))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"title":   `say "hi"`,
+			"path":    `C:\themes`,
+			"count":   int64(1000),
+			"enabled": true,
+			"outer": map[string]any{
+				"inner": map[string]any{"quoted key": int64(-5)},
+			},
+		}, values)
+	})
+
+	t.Run("should_report_the_line_of_an_error", func(t *testing.T) {
+		for input, expected := range map[string]string{
+			"name = \"dark\"\nname = \"light\"":    "line 2: name is defined more than once",
+			"\nsizes = [1, 2]":                     `line 2: sizes: unsupported value "[1, 2]" (expected a string, integer or boolean)`,
+			"name = \"dark":                        `line 1: name: unterminated string "dark`,
+			"just some text":                       `line 1: expected key = value, got "just some text"`,
+			"name = \"dark\"\n[name]":              "line 2: name is already defined as a value",
+			"[[themes]]":                           `line 1: invalid table header "[[themes]]"`,
+			"colors.primary = \"#fff\"":            `line 1: invalid key "colors.primary"`,
+			"name = \"dark\" trailing":             `line 1: name: unexpected "trailing" after string`,
+			"ratio = 0.5":                          `line 1: ratio: unsupported value "0.5" (expected a string, integer or boolean)`,
+			"name =":                               "line 1: name: missing value",
+			"[colors]\nprimary = \"#fff\" # ok\n=": `line 3: invalid key ""`,
+		} {
+			_, err := parseTOML([]byte(input))
+			assert.EqualError(t, err, expected, input)
+		}
+	})
+}
+
+// writeTheme writes a theme file with the contents to dir
+func writeTheme(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+}
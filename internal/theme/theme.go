@@ -1,9 +1,20 @@
 package theme
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/muesli/termenv"
+)
 
 // Theme represents a complete UI theme with all colors and styles
 type Theme struct {
+	// Name is the name the theme is selected by, such as "cyberpunk"
+	Name string
+	// Profile is the terminal color profile the styles were built for
+	Profile termenv.Profile
+
 	// Colors
 	Primary   lipgloss.Color
 	Secondary lipgloss.Color
@@ -38,30 +49,44 @@ type Theme struct {
 	StatusSucceededStyle lipgloss.Style
 	BorderStyle          lipgloss.Style
 	StatusBarStyle       lipgloss.Style
+
+	// Resource usage thresholds, as a percentage of requests, limits or allocatable
+	UsageWarningPercent  int
+	UsageCriticalPercent int
 }
 
 // NewDefaultTheme creates a new theme with the default cyberpunk colors
 func NewDefaultTheme() *Theme {
+	return NewTheme(builtinPalettes[DefaultThemeName], termenv.TrueColor)
+}
+
+// NewTheme creates a theme from a complete palette, with styles built for the terminal color profile.
+// Without color, the header and selected row are shown in reverse video so that they stand out.
+func NewTheme(palette Palette, profile termenv.Profile) *Theme {
+	colors := palette.Colors
 	theme := &Theme{
-		// Colors - Cyberpunk theme
-		Primary:   lipgloss.Color("#00ff88"), // Bright cyan-green
-		Secondary: lipgloss.Color("#ff0088"), // Hot pink
-		Accent:    lipgloss.Color("#ffaa00"), // Orange
-		Warning:   lipgloss.Color("#ff4400"), // Red-orange
-		Error:     lipgloss.Color("#ff0044"), // Red
-		Success:   lipgloss.Color("#00ff44"), // Green
-		Purple:    lipgloss.Color("#bd93f9"), // A nice purple from the Dracula theme
-
-		// Background colors
-		BgPrimary:   lipgloss.Color("#0a0a0a"), // Dark background
-		BgSecondary: lipgloss.Color("#1a1a1a"), // Slightly lighter
-		BgTertiary:  lipgloss.Color("#2a2a2a"), // Even lighter
-
-		// Text colors
-		TextPrimary:   lipgloss.Color("#ffffff"), // White
-		TextSecondary: lipgloss.Color("#cccccc"), // Light gray
-		TextMuted:     lipgloss.Color("#888888"), // Gray
-		TextInverse:   lipgloss.Color("#000000"), // Black
+		Name:    palette.Name,
+		Profile: profile,
+
+		Primary:   lipgloss.Color(colors.Primary),
+		Secondary: lipgloss.Color(colors.Secondary),
+		Accent:    lipgloss.Color(colors.Accent),
+		Warning:   lipgloss.Color(colors.Warning),
+		Error:     lipgloss.Color(colors.Error),
+		Success:   lipgloss.Color(colors.Success),
+		Purple:    lipgloss.Color(colors.Purple),
+
+		BgPrimary:   lipgloss.Color(colors.BgPrimary),
+		BgSecondary: lipgloss.Color(colors.BgSecondary),
+		BgTertiary:  lipgloss.Color(colors.BgTertiary),
+
+		TextPrimary:   lipgloss.Color(colors.TextPrimary),
+		TextSecondary: lipgloss.Color(colors.TextSecondary),
+		TextMuted:     lipgloss.Color(colors.TextMuted),
+		TextInverse:   lipgloss.Color(colors.TextInverse),
+
+		UsageWarningPercent:  palette.UsageWarningPercent,
+		UsageCriticalPercent: palette.UsageCriticalPercent,
 	}
 
 	// Initialize styles
@@ -127,25 +152,62 @@ func NewDefaultTheme() *Theme {
 		Foreground(theme.TextSecondary).
 		Padding(0, 1)
 
+	if profile == termenv.Ascii {
+		// Backgrounds are dropped without color, which would leave the header and selection invisible
+		theme.HeaderStyle = theme.HeaderStyle.Reverse(true)
+		theme.TableSelectedStyle = theme.TableSelectedStyle.Reverse(true)
+	}
+
 	return theme
 }
 
-// GetStatusStyle returns the appropriate style for a pod status
+// GetStatusStyle returns the appropriate style for a pod, deployment, node, job, volume or namespace status.
+// Pod statuses are those kubectl shows: the reasons containers are waiting or terminated, init container progress
+// such as Init:1/3, and Terminating.
 func (t *Theme) GetStatusStyle(status string) lipgloss.Style {
+	reason := strings.TrimPrefix(status, "Init:")
+	switch {
+	case failedStatus(reason):
+		return t.StatusFailedStyle
+	case reason != status:
+		// Init containers are still running, such as Init:1/3
+		return t.StatusPendingStyle
+	}
+
 	switch status {
-	case "Running":
+	case "Running", "Ready", "Bound", "Available", "Active":
 		return t.StatusRunningStyle
-	case "Pending":
+	case "Pending", "Suspended", "Released", "Terminating", "ContainerCreating", "PodInitializing", "SchedulingGated":
 		return t.StatusPendingStyle
-	case "Failed":
-		return t.StatusFailedStyle
-	case "Succeeded":
+	case "Succeeded", "Complete", "Completed":
 		return t.StatusSucceededStyle
 	default:
 		return lipgloss.NewStyle().Foreground(t.TextMuted)
 	}
 }
 
+// failedStatus reports whether a status is a failure: a pod error, such as CrashLoopBackOff or ExitCode:1, or a node
+// or volume that is not ready or lost
+func failedStatus(status string) bool {
+	switch status {
+	case "NotReady", "Lost":
+		return true
+	}
+	return models.IsPodErrorStatus(status)
+}
+
+// GetUsageStyle returns the style for a resource usage percentage, colored by the theme's usage thresholds
+func (t *Theme) GetUsageStyle(percent int) lipgloss.Style {
+	switch {
+	case percent >= t.UsageCriticalPercent:
+		return lipgloss.NewStyle().Foreground(t.Error).Bold(true)
+	case percent >= t.UsageWarningPercent:
+		return lipgloss.NewStyle().Foreground(t.Warning)
+	default:
+		return lipgloss.NewStyle().Foreground(t.Success)
+	}
+}
+
 // Legacy compatibility - keeping the old global variables for now
 // These will be removed in a future update
 
@@ -0,0 +1,40 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStatusStyle(t *testing.T) {
+	theme := NewDefaultTheme()
+	muted := lipgloss.NewStyle().Foreground(theme.TextMuted)
+
+	tests := []struct {
+		status   string
+		expected lipgloss.Style
+	}{
+		{status: "Running", expected: theme.StatusRunningStyle},
+		{status: "Pending", expected: theme.StatusPendingStyle},
+		{status: "ContainerCreating", expected: theme.StatusPendingStyle},
+		{status: "Terminating", expected: theme.StatusPendingStyle},
+		{status: "Init:1/3", expected: theme.StatusPendingStyle},
+		{status: "CrashLoopBackOff", expected: theme.StatusFailedStyle},
+		{status: "ImagePullBackOff", expected: theme.StatusFailedStyle},
+		{status: "OOMKilled", expected: theme.StatusFailedStyle},
+		{status: "ExitCode:1", expected: theme.StatusFailedStyle},
+		{status: "Signal:9", expected: theme.StatusFailedStyle},
+		{status: "Init:CrashLoopBackOff", expected: theme.StatusFailedStyle},
+		{status: "Init:ExitCode:3", expected: theme.StatusFailedStyle},
+		{status: "NodeLost", expected: theme.StatusFailedStyle},
+		{status: "Evicted", expected: theme.StatusFailedStyle},
+		{status: "Completed", expected: theme.StatusSucceededStyle},
+		{status: "Unknown", expected: muted},
+	}
+	for _, test := range tests {
+		t.Run(test.status, func(t *testing.T) {
+			assert.Equal(t, test.expected.GetForeground(), theme.GetStatusStyle(test.status).GetForeground())
+		})
+	}
+}
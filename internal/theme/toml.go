@@ -0,0 +1,156 @@
+package theme
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// bareKeyPattern matches TOML bare keys
+var bareKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// parseTOML parses the subset of TOML used by theme files: comments, [table] and [table.subtable] headers, and
+// key = value pairs whose values are strings, integers or booleans. Arrays, inline tables, dotted keys, floats
+// and dates are not needed to describe a theme and are rejected.
+func parseTOML(data []byte) (map[string]any, error) {
+	root := make(map[string]any)
+	table := root
+	for i, line := range strings.Split(string(data), "\n") {
+		lineNumber := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			header := strings.TrimSpace(stripComment(line))
+			if !strings.HasSuffix(header, "]") || strings.HasPrefix(header, "[[") {
+				return nil, fmt.Errorf("line %d: invalid table header %q", lineNumber, line)
+			}
+			var err error
+			table, err = tomlTable(root, strings.TrimSpace(header[1:len(header)-1]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			continue
+		}
+
+		key, rawValue, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value, got %q", lineNumber, line)
+		}
+		key, err := tomlKey(strings.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		value, err := tomlValue(strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %w", lineNumber, key, err)
+		}
+		if _, exists := table[key]; exists {
+			return nil, fmt.Errorf("line %d: %s is defined more than once", lineNumber, key)
+		}
+		table[key] = value
+	}
+	return root, nil
+}
+
+// tomlTable returns the table named by a dotted header, creating it and its parents as needed
+func tomlTable(root map[string]any, header string) (map[string]any, error) {
+	table := root
+	for _, part := range strings.Split(header, ".") {
+		key, err := tomlKey(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		child, exists := table[key]
+		if !exists {
+			child = make(map[string]any)
+			table[key] = child
+		}
+		childTable, ok := child.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s is already defined as a value", key)
+		}
+		table = childTable
+	}
+	return table, nil
+}
+
+// tomlKey returns a bare or quoted key
+func tomlKey(key string) (string, error) {
+	if strings.HasPrefix(key, `"`) || strings.HasPrefix(key, `'`) {
+		value, rest, err := tomlString(key)
+		if err != nil || rest != "" {
+			return "", fmt.Errorf("invalid key %s", key)
+		}
+		return value, nil
+	}
+	if !bareKeyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return key, nil
+}
+
+// tomlValue parses a string, integer or boolean value, which may be followed by a comment
+func tomlValue(raw string) (any, error) {
+	if strings.HasPrefix(raw, `"`) || strings.HasPrefix(raw, `'`) {
+		value, rest, err := tomlString(raw)
+		if err != nil {
+			return nil, err
+		}
+		if rest != "" && !strings.HasPrefix(rest, "#") {
+			return nil, fmt.Errorf("unexpected %q after string", rest)
+		}
+		return value, nil
+	}
+
+	raw = strings.TrimSpace(stripComment(raw))
+	switch raw {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "":
+		return nil, fmt.Errorf("missing value")
+	}
+	number, err := strconv.ParseInt(strings.ReplaceAll(raw, "_", ""), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported value %q (expected a string, integer or boolean)", raw)
+	}
+	return number, nil
+}
+
+// tomlString parses the basic ("...") or literal ('...') string at the start of raw and returns it with the
+// trimmed remainder of raw
+func tomlString(raw string) (string, string, error) {
+	quote := raw[0]
+	for i := 1; i < len(raw); i++ {
+		if quote == '"' && raw[i] == '\\' {
+			i++
+			continue
+		}
+		if raw[i] != quote {
+			continue
+		}
+		rest := strings.TrimSpace(raw[i+1:])
+		if quote == '\'' {
+			return raw[1:i], rest, nil
+		}
+		value, err := strconv.Unquote(raw[:i+1])
+		if err != nil {
+			return "", "", fmt.Errorf("invalid string %s", raw[:i+1])
+		}
+		return value, rest, nil
+	}
+	return "", "", fmt.Errorf("unterminated string %s", raw)
+}
+
+// stripComment removes a trailing comment from a line that contains no strings
+func stripComment(line string) string {
+	if index := strings.Index(line, "#"); index >= 0 {
+		return line[:index]
+	}
+	return line
+}
@@ -0,0 +1,159 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// ColumnChoices are the columns a column chooser edits, implemented by ColumnSet
+type ColumnChoices interface {
+	Len() int
+	Entry(index int) (name string, width int, shown bool)
+	Toggle(index int)
+	Move(index, offset int) int
+	Resize(index, delta int)
+}
+
+// ColumnChooserView represents the dialog listing every column of a list view, where columns are shown or hidden,
+// reordered and resized
+type ColumnChooserView struct {
+	columns  ColumnChoices
+	selected int
+	keyHelp  []HelpEntry
+	width    int
+	height   int
+	theme    *theme.Theme
+}
+
+// NewColumnChooserView creates a new column chooser of the columns
+func NewColumnChooserView(columns ColumnChoices, theme *theme.Theme) *ColumnChooserView {
+	return &ColumnChooserView{
+		columns: columns,
+		theme:   theme,
+	}
+}
+
+// Columns returns the columns the chooser edits
+func (cv *ColumnChooserView) Columns() ColumnChoices {
+	return cv.columns
+}
+
+// SetSize sets the view dimensions
+func (cv *ColumnChooserView) SetSize(width, height int) {
+	cv.width = width
+	cv.height = height
+}
+
+// SetKeyHelp sets the keys listed under the columns
+func (cv *ColumnChooserView) SetKeyHelp(entries []HelpEntry) {
+	cv.keyHelp = entries
+}
+
+// SelectNext moves selection to the next column
+func (cv *ColumnChooserView) SelectNext() {
+	if cv.selected < cv.columns.Len()-1 {
+		cv.selected++
+	}
+}
+
+// SelectPrev moves selection to the previous column
+func (cv *ColumnChooserView) SelectPrev() {
+	if cv.selected > 0 {
+		cv.selected--
+	}
+}
+
+// Selected returns the index of the selected column
+func (cv *ColumnChooserView) Selected() int {
+	return cv.selected
+}
+
+// Toggle shows or hides the selected column
+func (cv *ColumnChooserView) Toggle() {
+	cv.columns.Toggle(cv.selected)
+}
+
+// MoveUp moves the selected column one place left in the table, keeping it selected
+func (cv *ColumnChooserView) MoveUp() {
+	cv.selected = cv.columns.Move(cv.selected, -1)
+}
+
+// MoveDown moves the selected column one place right in the table, keeping it selected
+func (cv *ColumnChooserView) MoveDown() {
+	cv.selected = cv.columns.Move(cv.selected, 1)
+}
+
+// Widen widens the selected column by one character
+func (cv *ColumnChooserView) Widen() {
+	cv.columns.Resize(cv.selected, 1)
+}
+
+// Narrow narrows the selected column by one character
+func (cv *ColumnChooserView) Narrow() {
+	cv.columns.Resize(cv.selected, -1)
+}
+
+// Render renders the columns in a bordered box, one per line with whether it is shown and its width
+func (cv *ColumnChooserView) Render() string {
+	if cv.width == 0 || cv.height == 0 {
+		return ""
+	}
+
+	nameWidth := 0
+	for i := 0; i < cv.columns.Len(); i++ {
+		name, _, _ := cv.columns.Entry(i)
+		nameWidth = max(nameWidth, lipgloss.Width(name))
+	}
+
+	headingStyle := lipgloss.NewStyle().Foreground(cv.theme.Primary).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(cv.theme.TextMuted)
+
+	var keyHelp string
+	reserved := 4 // border and heading
+	if len(cv.keyHelp) > 0 {
+		hints := make([]string, len(cv.keyHelp))
+		for i, entry := range cv.keyHelp {
+			hints[i] = fmt.Sprintf("%s %s", entry.Keys, strings.ToLower(entry.Help))
+		}
+		keyHelp = mutedStyle.Width(max(nameWidth+12, 40)).Render(strings.Join(hints, " | "))
+		reserved += 1 + lipgloss.Height(keyHelp)
+	}
+
+	// Longer lists scroll to keep the selected column in view
+	first, last := 0, cv.columns.Len()
+	if available := cv.height - reserved; available > 0 && last > available {
+		first = min(max(cv.selected-available/2, 0), last-available)
+		last = first + available
+	}
+
+	lines := []string{headingStyle.Render("Columns"), ""}
+	for i := first; i < last; i++ {
+		name, width, shown := cv.columns.Entry(i)
+		size := "auto"
+		if width > 0 {
+			size = fmt.Sprintf("%d", width)
+		}
+		line := fmt.Sprintf("%s %-*s  %4s", checkbox(shown), nameWidth, name, size)
+		if i == cv.selected {
+			line = cv.theme.TableSelectedStyle.UnsetPadding().Render(line)
+		} else if !shown {
+			line = mutedStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	if keyHelp != "" {
+		lines = append(lines, "", keyHelp)
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(cv.theme.Primary).
+		Padding(0, 1).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.Place(cv.width, cv.height, lipgloss.Center, lipgloss.Center, box)
+}
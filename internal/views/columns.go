@@ -0,0 +1,442 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/kevholditch/vigilant/internal/config"
+	"github.com/kevholditch/vigilant/internal/expression"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// noValue is shown in a custom column when the resource has no value for it, as kubectl does
+const noValue = "<none>"
+
+// clusterColumn is the column naming the cluster of a row, which the multi-cluster views show first
+const clusterColumn = "CLUSTER"
+
+// errorPreviewWidth truncates the error shown in a cell whose expression failed, when its column is sized to its
+// contents, so that one failing column does not push the others off screen
+const errorPreviewWidth = 40
+
+// Column is a column a resource list view can show
+type Column[T any] struct {
+	Name string
+	// Value returns the text of the cell for a row
+	Value func(T) string
+	// Expression fills the cells of a custom column from the row's object, in place of Value
+	Expression *expression.Expression
+	// Style returns a style layered over the row style when the cell is colored by its value, such as a status
+	Style func(T, *theme.Theme) (lipgloss.Style, bool)
+	// Width fixes the width of the column, truncating longer values; 0 sizes it to its contents
+	Width int
+	// Metrics columns are only shown while metrics-server is available
+	Metrics bool
+}
+
+// ColumnCatalog describes the columns of a resource list view: every built-in column, those shown by default, and
+// how to reach the Kubernetes object of a row for columns filled from labels, annotations and JSONPath
+type ColumnCatalog[T any] struct {
+	// Resource names the list view as the command bar and config file do, such as "pods"
+	Resource string
+	// Columns are the built-in columns, in the order the column chooser lists the hidden ones
+	Columns []Column[T]
+	// Default names the columns shown when the config does not choose them, in order
+	Default []string
+	// Object returns the Kubernetes object of a row, or nil when it is not known
+	Object func(T) metav1.Object
+}
+
+// ColumnExpressionHelp documents the expression languages custom columns are filled from in the help overlay
+var ColumnExpressionHelp = HelpSection{
+	Title: "Column expressions (columns.<resource>[].jsonPath or .cel in the config)",
+	Entries: []HelpEntry{
+		{Keys: ".spec.nodeName", Help: "jsonPath: a field of the object, as kubectl custom-columns"},
+		{Keys: ".spec.containers[*].image", Help: "jsonPath: every match, separated by spaces"},
+		{Keys: "metadata.labels['team']", Help: "cel: a map entry; an error when it is missing"},
+		{Keys: "has(metadata.labels.team) ? metadata.labels.team : '-'", Help: "cel: has() tests for a field"},
+		{Keys: "spec.containers.map(c, c.image).join(',')", Help: "cel: map, filter, exists, size, join and more"},
+		{Keys: "object, metadata, spec, status, data", Help: "cel: the object and its top-level fields"},
+		{Keys: "error: ...", Help: "A cell shows why its expression failed for that row"},
+	},
+}
+
+// columnCatalogs holds the catalog of every list view with configurable columns, keyed by resource
+var columnCatalogs = make(map[string]columnValidator)
+
+// columnLayouts are the columns chosen in the config file, keyed by resource
+var columnLayouts map[string][]config.Column
+
+// columnValidator checks configured columns against a catalog
+type columnValidator interface {
+	validate(field string, columns []config.Column) []string
+}
+
+// registerColumnCatalog registers the catalog of a list view so that configured columns can be checked against it
+func registerColumnCatalog[T any](catalog *ColumnCatalog[T]) *ColumnCatalog[T] {
+	columnCatalogs[catalog.Resource] = catalog
+	return catalog
+}
+
+// ColumnError lists every configured column that does not fit the list views
+type ColumnError struct {
+	Problems []string
+}
+
+// Error formats the problems one per line
+func (e *ColumnError) Error() string {
+	return fmt.Sprintf("invalid columns:\n  - %s", strings.Join(e.Problems, "\n  - "))
+}
+
+// ConfigurableColumnResources returns the resources whose list views have configurable columns, in sorted order
+func ConfigurableColumnResources() []string {
+	resources := make([]string, 0, len(columnCatalogs))
+	for resource := range columnCatalogs {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+// SetColumnLayouts sets the columns of the list views created from now on, keyed by resource. If any column does not
+// fit its view nothing is changed and every problem is returned as a *ColumnError.
+func SetColumnLayouts(layouts map[string][]config.Column) error {
+	var problems []string
+	resources := make([]string, 0, len(layouts))
+	for resource := range layouts {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		field := "columns." + resource
+		catalog, ok := columnCatalogs[resource]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: columns are not configurable for %s (expected one of: %s)", field, resource, strings.Join(ConfigurableColumnResources(), ", ")))
+			continue
+		}
+		problems = append(problems, catalog.validate(field, layouts[resource])...)
+	}
+	if len(problems) > 0 {
+		return &ColumnError{Problems: problems}
+	}
+	columnLayouts = layouts
+	return nil
+}
+
+// validate checks that configured built-in columns exist and that custom columns can be built
+func (c *ColumnCatalog[T]) validate(field string, columns []config.Column) []string {
+	var problems []string
+	for i, spec := range columns {
+		if _, err := c.column(spec); err != nil {
+			problems = append(problems, fmt.Sprintf("%s[%d]: %v", field, i, err))
+		}
+	}
+	return problems
+}
+
+// builtin returns the built-in column with the name, matched case-insensitively
+func (c *ColumnCatalog[T]) builtin(name string) (Column[T], bool) {
+	for _, column := range c.Columns {
+		if strings.EqualFold(column.Name, strings.TrimSpace(name)) {
+			return column, true
+		}
+	}
+	return Column[T]{}, false
+}
+
+// column builds the column a config entry describes: a built-in column, with its width overridden when one is set,
+// or a custom column whose expression is compiled once here
+func (c *ColumnCatalog[T]) column(spec config.Column) (Column[T], error) {
+	if !spec.Custom() {
+		column, ok := c.builtin(spec.Name)
+		if !ok {
+			names := make([]string, len(c.Columns))
+			for i, column := range c.Columns {
+				names[i] = column.Name
+			}
+			return Column[T]{}, fmt.Errorf("unknown column %q (expected one of: %s, or a label, annotation, jsonPath or cel column)", spec.Name, strings.Join(names, ", "))
+		}
+		if spec.Width > 0 {
+			column.Width = spec.Width
+		}
+		return column, nil
+	}
+
+	column := Column[T]{Name: strings.ToUpper(strings.TrimSpace(spec.Name)), Width: spec.Width}
+	switch {
+	case spec.Label != "":
+		column.Value = c.metadataValue(func(object metav1.Object) map[string]string { return object.GetLabels() }, spec.Label)
+	case spec.Annotation != "":
+		column.Value = c.metadataValue(func(object metav1.Object) map[string]string { return object.GetAnnotations() }, spec.Annotation)
+	default:
+		language, source, _ := spec.Expression()
+		compiled, err := expression.Compile(language, source)
+		if err != nil {
+			return Column[T]{}, fmt.Errorf("%s: %v", language, err)
+		}
+		column.Expression = compiled
+	}
+	return column, nil
+}
+
+// metadataValue returns a cell function reading the value of a key from the labels or annotations of a row's object
+func (c *ColumnCatalog[T]) metadataValue(values func(metav1.Object) map[string]string, key string) func(T) string {
+	return func(row T) string {
+		object := c.object(row)
+		if object == nil {
+			return noValue
+		}
+		if value, ok := values(object)[key]; ok && value != "" {
+			return value
+		}
+		return noValue
+	}
+}
+
+// object returns the Kubernetes object of a row, or nil when the catalog cannot reach it
+func (c *ColumnCatalog[T]) object(row T) metav1.Object {
+	if c.Object == nil {
+		return nil
+	}
+	return c.Object(row)
+}
+
+// NewColumnSet creates the columns of a new list view, as configured or by default
+func (c *ColumnCatalog[T]) NewColumnSet() *ColumnSet[T] {
+	set := &ColumnSet[T]{object: c.object}
+	shown := make(map[string]bool)
+	if specs, ok := columnLayouts[c.Resource]; ok {
+		for _, spec := range specs {
+			// Layouts are validated when they are set, so every column builds
+			if column, err := c.column(spec); err == nil {
+				set.entries = append(set.entries, columnEntry[T]{column: column, shown: true})
+				shown[strings.ToUpper(column.Name)] = true
+			}
+		}
+	} else {
+		for _, name := range c.Default {
+			column, _ := c.builtin(name)
+			set.entries = append(set.entries, columnEntry[T]{column: column, shown: true})
+			shown[column.Name] = true
+		}
+	}
+
+	// The remaining built-in columns are offered by the column chooser
+	for _, column := range c.Columns {
+		if !shown[column.Name] {
+			set.entries = append(set.entries, columnEntry[T]{column: column})
+		}
+	}
+	return set
+}
+
+// columnEntry is a column the column chooser lists, and whether it is shown
+type columnEntry[T any] struct {
+	column Column[T]
+	shown  bool
+}
+
+// ColumnSet is the columns a list view can show, in the order they are shown, and which of them are shown
+type ColumnSet[T any] struct {
+	entries []columnEntry[T]
+	// object returns the Kubernetes object of a row, which expression columns are evaluated against
+	object func(T) metav1.Object
+}
+
+// Shown returns the columns shown, in order
+func (cs *ColumnSet[T]) Shown() []Column[T] {
+	var columns []Column[T]
+	for _, entry := range cs.entries {
+		if entry.shown {
+			columns = append(columns, entry.column)
+		}
+	}
+	return columns
+}
+
+// Visible returns the columns shown, in order, leaving out the metrics columns when metrics are unavailable
+func (cs *ColumnSet[T]) Visible(metricsAvailable bool) []Column[T] {
+	var columns []Column[T]
+	for _, column := range cs.Shown() {
+		if !column.Metrics || metricsAvailable {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// Headers returns the headers of the columns visible
+func (cs *ColumnSet[T]) Headers(metricsAvailable bool) []string {
+	var headers []string
+	for _, column := range cs.Visible(metricsAvailable) {
+		headers = append(headers, column.Name)
+	}
+	return headers
+}
+
+// ShowFirst shows the named column first, unless the columns chosen already show it
+func (cs *ColumnSet[T]) ShowFirst(name string) {
+	for i, entry := range cs.entries {
+		if entry.column.Name != name {
+			continue
+		}
+		if entry.shown {
+			return
+		}
+		entry.shown = true
+		cs.entries = append(append([]columnEntry[T]{entry}, cs.entries[:i]...), cs.entries[i+1:]...)
+		return
+	}
+}
+
+// Len returns the number of columns the chooser lists
+func (cs *ColumnSet[T]) Len() int {
+	return len(cs.entries)
+}
+
+// Entry returns the name, width and visibility of the column at index in the chooser
+func (cs *ColumnSet[T]) Entry(index int) (name string, width int, shown bool) {
+	entry := cs.entries[index]
+	return entry.column.Name, entry.column.Width, entry.shown
+}
+
+// Toggle shows or hides the column at index. The last column shown cannot be hidden.
+func (cs *ColumnSet[T]) Toggle(index int) {
+	if cs.entries[index].shown && len(cs.Shown()) == 1 {
+		return
+	}
+	cs.entries[index].shown = !cs.entries[index].shown
+}
+
+// Move moves the column at index by offset places and returns its new index
+func (cs *ColumnSet[T]) Move(index, offset int) int {
+	target := index + offset
+	if target < 0 || target >= len(cs.entries) {
+		return index
+	}
+	cs.entries[index], cs.entries[target] = cs.entries[target], cs.entries[index]
+	return target
+}
+
+// Resize changes the fixed width of the column at index by delta. A column sized to its contents starts from the
+// width of its header; shrinking to the header width sizes it to its contents again.
+func (cs *ColumnSet[T]) Resize(index, delta int) {
+	column := &cs.entries[index].column
+	width := column.Width
+	if width == 0 {
+		width = lipgloss.Width(column.Name)
+	}
+	width += delta
+	if width <= lipgloss.Width(column.Name) {
+		width = 0
+	}
+	column.Width = width
+}
+
+// cells returns the text of a row's cells in columns, and which of them hold the error of an expression that failed.
+// The row's object is converted for expressions once, however many columns use it.
+func (cs *ColumnSet[T]) cells(row T, columns []Column[T]) ([]string, []bool) {
+	values := make([]string, len(columns))
+	failed := make([]bool, len(columns))
+
+	var object map[string]interface{}
+	var objectErr error
+	converted, found := false, false
+	for i, column := range columns {
+		if column.Expression == nil {
+			values[i] = column.Value(row)
+			continue
+		}
+		if !converted {
+			converted = true
+			if typed := cs.object(row); typed != nil {
+				found = true
+				object, objectErr = expression.ToUnstructured(typed)
+			}
+		}
+		if !found {
+			values[i] = noValue
+			continue
+		}
+
+		value, err := "", objectErr
+		if err == nil {
+			value, err = column.Expression.Evaluate(object)
+		}
+		switch {
+		case err != nil:
+			values[i], failed[i] = "error: "+strings.Join(strings.Fields(err.Error()), " "), true
+		case value == "":
+			values[i] = noValue
+		default:
+			values[i] = value
+		}
+	}
+	return values, failed
+}
+
+// renderColumnTable renders rows in the columns visible, styled as the other resource tables are
+func renderColumnTable[T any](columns *ColumnSet[T], rows []T, selected, height int, metricsAvailable bool, t *theme.Theme) string {
+	shown := columns.Visible(metricsAvailable)
+
+	cells := make([][]string, 0, len(rows))
+	failed := make([][]bool, 0, len(rows))
+	for _, row := range rows {
+		rowCells, rowFailed := columns.cells(row, shown)
+		for i, column := range shown {
+			switch {
+			case column.Width > 0:
+				rowCells[i] = valuePreview(rowCells[i], column.Width)
+			case rowFailed[i]:
+				rowCells[i] = valuePreview(rowCells[i], errorPreviewWidth)
+			}
+		}
+		cells = append(cells, rowCells)
+		failed = append(failed, rowFailed)
+	}
+
+	resourceTable := table.New().
+		Headers(columns.Headers(metricsAvailable)...).
+		Rows(cells...).
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(t.Primary)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			isSelected := row == selected
+
+			var style lipgloss.Style
+			if isSelected {
+				style = t.TableSelectedStyle
+			} else if (row-1)%2 == 1 {
+				// Alternate row style
+				style = t.TableRowAltStyle
+			} else {
+				style = t.TableRowStyle
+			}
+
+			column := shown[col]
+			if column.Width > 0 {
+				style = style.Width(column.Width + style.GetHorizontalPadding())
+			}
+
+			// Color cells by their value, such as a status, and cells whose expression failed as errors
+			if !isSelected && row >= 0 && row < len(rows) {
+				if failed[row][col] {
+					style = style.Foreground(t.Error)
+				} else if column.Style != nil {
+					if cellStyle, ok := column.Style(rows[row], t); ok {
+						style = style.Inherit(cellStyle)
+					}
+				}
+			}
+
+			return style
+		})
+	resourceTable.Height(height)
+
+	return resourceTable.Render()
+}
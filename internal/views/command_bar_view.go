@@ -2,6 +2,7 @@ package views
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -19,6 +20,8 @@ type CommandBarView struct {
 	suggestions        []string
 	selectedSuggestion int
 	availableResources []string
+	// commands maps the names of commands that take an argument to the arguments offered as completions
+	commands map[string][]string
 }
 
 // NewCommandBarView creates a new command bar view
@@ -31,9 +34,15 @@ func NewCommandBarView(theme *theme.Theme, availableResources []string) *Command
 		suggestions:        []string{},
 		selectedSuggestion: 0,
 		availableResources: availableResources,
+		commands:           make(map[string][]string),
 	}
 }
 
+// SetCommand sets the arguments offered as completions for the command name
+func (cbv *CommandBarView) SetCommand(name string, arguments []string) {
+	cbv.commands[name] = arguments
+}
+
 // SetSize sets the view dimensions
 func (cbv *CommandBarView) SetSize(width, height int) {
 	cbv.width = width
@@ -148,6 +157,10 @@ func (cbv *CommandBarView) TabComplete() {
 	}
 
 	selected := cbv.suggestions[cbv.selectedSuggestion]
+	if _, isCommand := cbv.commands[selected]; isCommand {
+		// Lead straight into the argument completions
+		selected += " "
+	}
 	cbv.input = selected
 	cbv.cursor = len(selected)
 	cbv.updateSuggestions()
@@ -177,12 +190,37 @@ func (cbv *CommandBarView) updateSuggestions() {
 		return
 	}
 
-	// Filter resources that match the input
+	// Once a command name is typed, suggest its arguments
+	if name, arg, ok := strings.Cut(cbv.input, " "); ok {
+		for _, argument := range cbv.commands[name] {
+			if strings.HasPrefix(strings.ToLower(argument), strings.ToLower(arg)) {
+				cbv.suggestions = append(cbv.suggestions, name+" "+argument)
+			}
+		}
+		return
+	}
+
+	// Filter resources and commands that match the input
 	for _, resource := range cbv.availableResources {
 		if strings.HasPrefix(strings.ToLower(resource), strings.ToLower(cbv.input)) {
 			cbv.suggestions = append(cbv.suggestions, resource)
 		}
 	}
+	for _, name := range cbv.commandNames() {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(cbv.input)) {
+			cbv.suggestions = append(cbv.suggestions, name)
+		}
+	}
+}
+
+// commandNames returns the names of the commands in sorted order
+func (cbv *CommandBarView) commandNames() []string {
+	names := make([]string, 0, len(cbv.commands))
+	for name := range cbv.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // Render renders the command bar view
@@ -242,10 +280,7 @@ func (cbv *CommandBarView) renderSuggestions() string {
 	for i, suggestion := range cbv.suggestions {
 		var style lipgloss.Style
 		if i == cbv.selectedSuggestion {
-			style = lipgloss.NewStyle().
-				Foreground(cbv.theme.TextInverse).
-				Background(cbv.theme.Primary).
-				Bold(true)
+			style = cbv.theme.TableSelectedStyle.UnsetPadding()
 		} else {
 			style = lipgloss.NewStyle().
 				Foreground(cbv.theme.TextSecondary)
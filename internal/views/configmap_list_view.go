@@ -0,0 +1,160 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigMapListView represents the config map list view
+type ConfigMapListView struct {
+	configMaps  []models.ConfigMap
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.ConfigMap]
+}
+
+// NewConfigMapListView creates a new config map list view
+func NewConfigMapListView(configMaps []models.ConfigMap, theme *theme.Theme, clusterName string) *ConfigMapListView {
+	return &ConfigMapListView{
+		configMaps:  configMaps,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     configMapColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (cmlv *ConfigMapListView) SetSize(width, height int) {
+	cmlv.width = width
+	cmlv.height = height
+}
+
+// SelectNext moves selection to next config map
+func (cmlv *ConfigMapListView) SelectNext() {
+	if cmlv.selected < len(cmlv.configMaps)-1 {
+		cmlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous config map
+func (cmlv *ConfigMapListView) SelectPrev() {
+	if cmlv.selected > 0 {
+		cmlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected config map
+func (cmlv *ConfigMapListView) GetSelected() *models.ConfigMap {
+	if len(cmlv.configMaps) == 0 {
+		return nil
+	}
+	return &cmlv.configMaps[cmlv.selected]
+}
+
+// UpdateConfigMaps updates the config maps data
+func (cmlv *ConfigMapListView) UpdateConfigMaps(configMaps []models.ConfigMap) {
+	cmlv.configMaps = configMaps
+	// Reset selection if current selection is out of bounds
+	if cmlv.selected >= len(cmlv.configMaps) {
+		cmlv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (cmlv *ConfigMapListView) OpenColumnChooser() *ColumnChooserView {
+	cmlv.chooser = NewColumnChooserView(cmlv.columns, cmlv.theme)
+	return cmlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (cmlv *ConfigMapListView) CloseColumnChooser() {
+	cmlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (cmlv *ConfigMapListView) ColumnChooser() *ColumnChooserView {
+	return cmlv.chooser
+}
+
+// Render renders the complete config map list view
+func (cmlv *ConfigMapListView) Render() string {
+	if cmlv.width == 0 || cmlv.height == 0 {
+		return ""
+	}
+
+	// Config map table
+	var table string
+	if cmlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		cmlv.chooser.SetSize(cmlv.width, cmlv.height-1)
+		table = cmlv.chooser.Render()
+	} else {
+		table = cmlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := cmlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the config map table
+func (cmlv *ConfigMapListView) renderTable() string {
+	if len(cmlv.configMaps) == 0 {
+		return lipgloss.NewStyle().Foreground(cmlv.theme.TextMuted).Render("No config maps found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := cmlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(cmlv.columns, cmlv.configMaps, cmlv.selected, tableHeight, false, cmlv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (cmlv *ConfigMapListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d config maps | Press 'd' or 'enter' to describe | Press 'x' for x-ray", len(cmlv.configMaps))
+	return cmlv.theme.StatusBarStyle.Width(cmlv.width).Render(statusText)
+}
+
+// ConfigMaps returns the list of config maps (for testing)
+func (cmlv *ConfigMapListView) ConfigMaps() []models.ConfigMap {
+	return cmlv.configMaps
+}
+
+// configMapColumns are the columns the config map list can show
+var configMapColumns = registerColumnCatalog(&ColumnCatalog[models.ConfigMap]{
+	Resource: "configmaps",
+	Columns: []Column[models.ConfigMap]{
+		{Name: "NAME", Value: func(c models.ConfigMap) string { return c.Name }},
+		{Name: "NAMESPACE", Value: func(c models.ConfigMap) string { return c.Namespace }},
+		{Name: "DATA", Value: func(c models.ConfigMap) string { return fmt.Sprintf("%d", c.KeyCount()) }},
+		{Name: "AGE", Value: func(c models.ConfigMap) string { return c.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "DATA", "AGE"},
+	Object: func(c models.ConfigMap) metav1.Object {
+		if c.Object == nil {
+			return nil
+		}
+		return c.Object
+	},
+})
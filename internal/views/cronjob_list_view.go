@@ -0,0 +1,181 @@
+package views
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CronJobListView represents the cron job list view
+type CronJobListView struct {
+	cronJobs    []models.CronJob
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	message     string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.CronJob]
+}
+
+// NewCronJobListView creates a new cron job list view
+func NewCronJobListView(cronJobs []models.CronJob, theme *theme.Theme, clusterName string) *CronJobListView {
+	return &CronJobListView{
+		cronJobs:    cronJobs,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     cronJobColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (cjlv *CronJobListView) SetSize(width, height int) {
+	cjlv.width = width
+	cjlv.height = height
+}
+
+// SelectNext moves selection to next cron job
+func (cjlv *CronJobListView) SelectNext() {
+	if cjlv.selected < len(cjlv.cronJobs)-1 {
+		cjlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous cron job
+func (cjlv *CronJobListView) SelectPrev() {
+	if cjlv.selected > 0 {
+		cjlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected cron job
+func (cjlv *CronJobListView) GetSelected() *models.CronJob {
+	if len(cjlv.cronJobs) == 0 {
+		return nil
+	}
+	return &cjlv.cronJobs[cjlv.selected]
+}
+
+// UpdateCronJobs updates the cron jobs data
+func (cjlv *CronJobListView) UpdateCronJobs(cronJobs []models.CronJob) {
+	cjlv.cronJobs = cronJobs
+	// Reset selection if current selection is out of bounds
+	if cjlv.selected >= len(cjlv.cronJobs) {
+		cjlv.selected = 0
+	}
+}
+
+// SetStatusMessage sets the message shown in the status bar, such as the outcome of a trigger
+func (cjlv *CronJobListView) SetStatusMessage(message string) {
+	cjlv.message = message
+}
+
+// StatusMessage returns the message shown in the status bar (for testing)
+func (cjlv *CronJobListView) StatusMessage() string {
+	return cjlv.message
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (cjlv *CronJobListView) OpenColumnChooser() *ColumnChooserView {
+	cjlv.chooser = NewColumnChooserView(cjlv.columns, cjlv.theme)
+	return cjlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (cjlv *CronJobListView) CloseColumnChooser() {
+	cjlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (cjlv *CronJobListView) ColumnChooser() *ColumnChooserView {
+	return cjlv.chooser
+}
+
+// Render renders the complete cron job list view
+func (cjlv *CronJobListView) Render() string {
+	if cjlv.width == 0 || cjlv.height == 0 {
+		return ""
+	}
+
+	// Cron job table
+	var table string
+	if cjlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		cjlv.chooser.SetSize(cjlv.width, cjlv.height-1)
+		table = cjlv.chooser.Render()
+	} else {
+		table = cjlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := cjlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the cron job table
+func (cjlv *CronJobListView) renderTable() string {
+	if len(cjlv.cronJobs) == 0 {
+		return lipgloss.NewStyle().Foreground(cjlv.theme.TextMuted).Render("No cron jobs found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := cjlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(cjlv.columns, cjlv.cronJobs, cjlv.selected, tableHeight, false, cjlv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (cjlv *CronJobListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d cron jobs | 'd' describe | 't' trigger now | 's' suspend/resume | 'l' latest job's logs | 'x' x-ray", len(cjlv.cronJobs))
+	if cjlv.message != "" {
+		statusText += " | " + cjlv.message
+	}
+	return cjlv.theme.StatusBarStyle.Width(cjlv.width).Render(statusText)
+}
+
+// Cron jobs returns the list of cron jobs (for testing)
+func (cjlv *CronJobListView) CronJobs() []models.CronJob {
+	return cjlv.cronJobs
+}
+
+// cronJobColumns are the columns the cron job list can show
+var cronJobColumns = registerColumnCatalog(&ColumnCatalog[models.CronJob]{
+	Resource: "cronjobs",
+	Columns: []Column[models.CronJob]{
+		{Name: "NAME", Value: func(c models.CronJob) string { return c.Name }},
+		{Name: "NAMESPACE", Value: func(c models.CronJob) string { return c.Namespace }},
+		{Name: "SCHEDULE", Value: func(c models.CronJob) string { return c.Schedule }},
+		{Name: "SUSPEND", Value: func(c models.CronJob) string { return c.FormatSuspend() }, Style: func(c models.CronJob, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.StatusPendingStyle, c.Suspend
+		}},
+		{Name: "ACTIVE", Value: func(c models.CronJob) string { return fmt.Sprintf("%d", len(c.Active)) }},
+		{Name: "LAST SCHEDULE", Value: func(c models.CronJob) string { return c.FormatLastSchedule() }},
+		{Name: "NEXT RUN", Value: func(c models.CronJob) string { return c.FormatNextRun(time.Now()) }},
+		{Name: "AGE", Value: func(c models.CronJob) string { return c.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "SCHEDULE", "SUSPEND", "ACTIVE", "LAST SCHEDULE", "NEXT RUN", "AGE"},
+	Object: func(c models.CronJob) metav1.Object {
+		if c.Object == nil {
+			return nil
+		}
+		return c.Object
+	},
+})
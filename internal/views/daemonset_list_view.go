@@ -0,0 +1,172 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DaemonSetListView represents the daemon set list view
+type DaemonSetListView struct {
+	daemonSets  []models.DaemonSet
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.DaemonSet]
+}
+
+// NewDaemonSetListView creates a new daemon set list view
+func NewDaemonSetListView(daemonSets []models.DaemonSet, theme *theme.Theme, clusterName string) *DaemonSetListView {
+	return &DaemonSetListView{
+		daemonSets:  daemonSets,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     daemonSetColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (dslv *DaemonSetListView) SetSize(width, height int) {
+	dslv.width = width
+	dslv.height = height
+}
+
+// SelectNext moves selection to next daemon set
+func (dslv *DaemonSetListView) SelectNext() {
+	if dslv.selected < len(dslv.daemonSets)-1 {
+		dslv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous daemon set
+func (dslv *DaemonSetListView) SelectPrev() {
+	if dslv.selected > 0 {
+		dslv.selected--
+	}
+}
+
+// GetSelected returns the currently selected daemon set
+func (dslv *DaemonSetListView) GetSelected() *models.DaemonSet {
+	if len(dslv.daemonSets) == 0 {
+		return nil
+	}
+	return &dslv.daemonSets[dslv.selected]
+}
+
+// UpdateDaemonSets updates the daemon sets data
+func (dslv *DaemonSetListView) UpdateDaemonSets(daemonSets []models.DaemonSet) {
+	dslv.daemonSets = daemonSets
+	// Reset selection if current selection is out of bounds
+	if dslv.selected >= len(dslv.daemonSets) {
+		dslv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (dslv *DaemonSetListView) OpenColumnChooser() *ColumnChooserView {
+	dslv.chooser = NewColumnChooserView(dslv.columns, dslv.theme)
+	return dslv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (dslv *DaemonSetListView) CloseColumnChooser() {
+	dslv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (dslv *DaemonSetListView) ColumnChooser() *ColumnChooserView {
+	return dslv.chooser
+}
+
+// Render renders the complete daemon set list view
+func (dslv *DaemonSetListView) Render() string {
+	if dslv.width == 0 || dslv.height == 0 {
+		return ""
+	}
+
+	// Daemon set table
+	var table string
+	if dslv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		dslv.chooser.SetSize(dslv.width, dslv.height-1)
+		table = dslv.chooser.Render()
+	} else {
+		table = dslv.renderTable()
+	}
+
+	// Status bar
+	statusBar := dslv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the daemon set table
+func (dslv *DaemonSetListView) renderTable() string {
+	if len(dslv.daemonSets) == 0 {
+		return lipgloss.NewStyle().Foreground(dslv.theme.TextMuted).Render("No daemon sets found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := dslv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(dslv.columns, dslv.daemonSets, dslv.selected, tableHeight, false, dslv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dslv *DaemonSetListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d daemon sets | Press 'd' to describe | Press 'enter' to view the daemon set's pods | Press 'x' for x-ray", len(dslv.daemonSets))
+	return dslv.theme.StatusBarStyle.Width(dslv.width).Render(statusText)
+}
+
+// DaemonSets returns the list of daemon sets (for testing)
+func (dslv *DaemonSetListView) DaemonSets() []models.DaemonSet {
+	return dslv.daemonSets
+}
+
+// daemonSetColumns are the columns the daemon set list can show
+var daemonSetColumns = registerColumnCatalog(&ColumnCatalog[models.DaemonSet]{
+	Resource: "daemonsets",
+	Columns: []Column[models.DaemonSet]{
+		{Name: "NAME", Value: func(d models.DaemonSet) string { return d.Name }},
+		{Name: "NAMESPACE", Value: func(d models.DaemonSet) string { return d.Namespace }},
+		{Name: "DESIRED", Value: func(d models.DaemonSet) string { return fmt.Sprintf("%d", d.Desired) }},
+		// Flag daemon sets not yet running on every node they should
+		{Name: "CURRENT", Value: func(d models.DaemonSet) string { return fmt.Sprintf("%d", d.Current) }, Style: func(d models.DaemonSet, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.StatusPendingStyle, d.Current < d.Desired
+		}},
+		{Name: "READY", Value: func(d models.DaemonSet) string { return fmt.Sprintf("%d", d.Ready) }},
+		{Name: "UP-TO-DATE", Value: func(d models.DaemonSet) string { return fmt.Sprintf("%d", d.UpToDate) }},
+		{Name: "AVAILABLE", Value: func(d models.DaemonSet) string { return fmt.Sprintf("%d", d.Available) }},
+		// Flag daemon pods running on nodes they should not
+		{Name: "MISSCHEDULED", Value: func(d models.DaemonSet) string { return fmt.Sprintf("%d", d.Misscheduled) }, Style: func(d models.DaemonSet, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.StatusFailedStyle, d.Misscheduled > 0
+		}},
+		{Name: "NODE SELECTOR", Value: func(d models.DaemonSet) string { return d.FormatNodeSelector() }},
+		{Name: "AGE", Value: func(d models.DaemonSet) string { return d.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "DESIRED", "CURRENT", "READY", "UP-TO-DATE", "AVAILABLE", "MISSCHEDULED", "NODE SELECTOR", "AGE"},
+	Object: func(d models.DaemonSet) metav1.Object {
+		if d.Object == nil {
+			return nil
+		}
+		return d.Object
+	},
+})
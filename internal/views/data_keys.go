@@ -0,0 +1,75 @@
+package views
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// renderDataKeyTable renders the keys of a config map or secret as a selectable table of KEY, SIZE and VALUE,
+// with valueStyle styling the VALUE cell of unselected rows
+func renderDataKeyTable(keys []models.DataKey, values []string, selected, height int, t *theme.Theme, valueStyle func(index int) lipgloss.Style) string {
+	if len(keys) == 0 {
+		return lipgloss.NewStyle().Foreground(t.TextMuted).Render("No keys")
+	}
+
+	headers := []string{"KEY", "SIZE", "VALUE"}
+
+	var rows [][]string
+	for i, key := range keys {
+		rows = append(rows, []string{key.Name, models.FormatSize(key.Size), values[i]})
+	}
+
+	keyTable := table.New().
+		Headers(headers...).
+		Rows(rows...).
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(t.Primary)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+
+			isSelected := row == selected
+
+			var style lipgloss.Style
+			if isSelected {
+				style = t.TableSelectedStyle
+			} else if (row-1)%2 == 1 {
+				// Alternate row style
+				style = t.TableRowAltStyle
+			} else {
+				style = t.TableRowStyle
+			}
+
+			// Style the value column (col 2)
+			if col == 2 && !isSelected && row >= 0 && row < len(keys) {
+				style = style.Inherit(valueStyle(row))
+			}
+
+			return style
+		})
+
+	// table overhead is border and header
+	tableHeight := height - 3
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	keyTable.Height(tableHeight)
+
+	return keyTable.Render()
+}
+
+// valuePreview shortens a value to its first line for display in a table cell
+func valuePreview(value string, width int) string {
+	preview := value
+	for i, r := range value {
+		if r == '\n' {
+			preview = value[:i] + " …"
+			break
+		}
+	}
+	runes := []rune(preview)
+	if width > 1 && len(runes) > width {
+		preview = string(runes[:width-1]) + "…"
+	}
+	return preview
+}
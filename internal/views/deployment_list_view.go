@@ -2,11 +2,12 @@ package views
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/lipgloss/table"
 	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeploymentListView represents the deployment list view
@@ -17,6 +18,10 @@ type DeploymentListView struct {
 	height      int
 	theme       *theme.Theme
 	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Deployment]
 }
 
 // NewDeploymentListView creates a new deployment list view
@@ -26,9 +31,18 @@ func NewDeploymentListView(deployments []models.Deployment, theme *theme.Theme,
 		selected:    0,
 		theme:       theme,
 		clusterName: clusterName,
+		columns:     deploymentColumns.NewColumnSet(),
 	}
 }
 
+// NewClusterDeploymentListView creates a deployment list view for the multi-cluster views, showing the cluster of
+// each deployment first
+func NewClusterDeploymentListView(deployments []models.Deployment, theme *theme.Theme) *DeploymentListView {
+	view := NewDeploymentListView(deployments, theme, "")
+	view.columns.ShowFirst(clusterColumn)
+	return view
+}
+
 // SetSize sets the view dimensions
 func (dlv *DeploymentListView) SetSize(width, height int) {
 	dlv.width = width
@@ -66,6 +80,22 @@ func (dlv *DeploymentListView) UpdateDeployments(deployments []models.Deployment
 	}
 }
 
+// OpenColumnChooser opens the column chooser in place of the table
+func (dlv *DeploymentListView) OpenColumnChooser() *ColumnChooserView {
+	dlv.chooser = NewColumnChooserView(dlv.columns, dlv.theme)
+	return dlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (dlv *DeploymentListView) CloseColumnChooser() {
+	dlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (dlv *DeploymentListView) ColumnChooser() *ColumnChooserView {
+	return dlv.chooser
+}
+
 // Render renders the complete deployment list view
 func (dlv *DeploymentListView) Render() string {
 	if dlv.width == 0 || dlv.height == 0 {
@@ -73,7 +103,14 @@ func (dlv *DeploymentListView) Render() string {
 	}
 
 	// Deployment table
-	table := dlv.renderTable()
+	var table string
+	if dlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		dlv.chooser.SetSize(dlv.width, dlv.height-1)
+		table = dlv.chooser.Render()
+	} else {
+		table = dlv.renderTable()
+	}
 
 	// Status bar
 	statusBar := dlv.renderStatusBar()
@@ -94,69 +131,17 @@ func (dlv *DeploymentListView) renderTable() string {
 		return lipgloss.NewStyle().Foreground(dlv.theme.TextMuted).Render("No deployments found")
 	}
 
-	// Create table headers
-	headers := []string{"NAME", "NAMESPACE", "STATUS", "READY", "UP-TO-DATE", "AVAILABLE", "AGE", "STRATEGY", "IMAGE"}
-
-	// Create table rows
-	var rows [][]string
-	for _, deployment := range dlv.deployments {
-		row := []string{
-			deployment.Name,
-			deployment.Namespace,
-			deployment.Status,
-			deployment.Ready,
-			fmt.Sprintf("%d", deployment.UpToDate),
-			fmt.Sprintf("%d", deployment.Available),
-			deployment.FormatAge(),
-			deployment.Strategy,
-			deployment.Image,
-		}
-		rows = append(rows, row)
-	}
-
-	// Create the table
-	t := table.New().
-		Headers(headers...).
-		Rows(rows...).
-		Border(lipgloss.RoundedBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(dlv.theme.Primary)).
-		StyleFunc(func(row, col int) lipgloss.Style {
-
-			isSelected := row == dlv.selected
-
-			var style lipgloss.Style
-			if isSelected {
-				style = dlv.theme.TableSelectedStyle
-			} else if (row-1)%2 == 1 {
-				// Alternate row style
-				style = dlv.theme.TableRowAltStyle
-			} else {
-				style = dlv.theme.TableRowStyle
-			}
-
-			// Apply status styling for status column (col 2)
-			deploymentIndex := row - 1
-			if col == 2 && !isSelected && deploymentIndex >= 0 && deploymentIndex < len(dlv.deployments) {
-				deployment := dlv.deployments[deploymentIndex]
-				style = style.Inherit(dlv.theme.GetStatusStyle(deployment.Status))
-			}
-
-			return style
-		})
-
 	// available height for table is parent height - status bar height - table border - table header
 	tableHeight := dlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
 	if tableHeight < 0 {
 		tableHeight = 0
 	}
-	t.Height(tableHeight)
-
-	return t.Render()
+	return renderColumnTable(dlv.columns, dlv.deployments, dlv.selected, tableHeight, false, dlv.theme)
 }
 
 // renderStatusBar renders the status bar at the bottom
 func (dlv *DeploymentListView) renderStatusBar() string {
-	statusText := fmt.Sprintf("Total: %d deployments | Press 'd' to describe", len(dlv.deployments))
+	statusText := fmt.Sprintf("Total: %d deployments | Press 'd' to describe | Press 'enter' to view pods | Press 'x' for x-ray", len(dlv.deployments))
 	return dlv.theme.StatusBarStyle.Width(dlv.width).Render(statusText)
 }
 
@@ -164,3 +149,31 @@ func (dlv *DeploymentListView) renderStatusBar() string {
 func (dlv *DeploymentListView) Deployments() []models.Deployment {
 	return dlv.deployments
 }
+
+// deploymentColumns are the columns the deployment list can show
+var deploymentColumns = registerColumnCatalog(&ColumnCatalog[models.Deployment]{
+	Resource: "deployments",
+	Columns: []Column[models.Deployment]{
+		{Name: "NAME", Value: func(d models.Deployment) string { return d.Name }},
+		{Name: "NAMESPACE", Value: func(d models.Deployment) string { return d.Namespace }},
+		{Name: clusterColumn, Value: func(d models.Deployment) string { return orNone(d.Cluster) }},
+		{Name: "STATUS", Value: func(d models.Deployment) string { return d.Status }, Style: func(d models.Deployment, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(d.Status), true
+		}},
+		{Name: "READY", Value: func(d models.Deployment) string { return d.Ready }},
+		{Name: "UP-TO-DATE", Value: func(d models.Deployment) string { return fmt.Sprintf("%d", d.UpToDate) }},
+		{Name: "AVAILABLE", Value: func(d models.Deployment) string { return fmt.Sprintf("%d", d.Available) }},
+		{Name: "AGE", Value: func(d models.Deployment) string { return d.FormatAge() }},
+		{Name: "STRATEGY", Value: func(d models.Deployment) string { return d.Strategy }},
+		{Name: "IMAGE", Value: func(d models.Deployment) string { return d.Image }},
+		{Name: "CONTAINERS", Value: func(d models.Deployment) string { return strings.Join(d.Containers, ",") }},
+		{Name: "SELECTOR", Value: func(d models.Deployment) string { return orNone(d.Selector) }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "STATUS", "READY", "UP-TO-DATE", "AVAILABLE", "AGE", "STRATEGY", "IMAGE"},
+	Object: func(d models.Deployment) metav1.Object {
+		if d.Object == nil {
+			return nil
+		}
+		return d.Object
+	},
+})
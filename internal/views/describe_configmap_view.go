@@ -0,0 +1,129 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// valuePreviewWidth bounds the value shown in a key table cell; the full value opens in the value viewer
+const valuePreviewWidth = 60
+
+// DescribeConfigMapView represents the describe config map view: details above a selectable key table
+type DescribeConfigMapView struct {
+	configMap *models.ConfigMap
+	keys      []models.DataKey
+	selected  int
+	theme     *theme.Theme
+	width     int
+	height    int
+}
+
+// NewDescribeConfigMapView creates a new describe config map view
+func NewDescribeConfigMapView(configMap *models.ConfigMap, theme *theme.Theme) *DescribeConfigMapView {
+	view := &DescribeConfigMapView{theme: theme}
+	view.UpdateConfigMap(configMap)
+	return view
+}
+
+// SetSize sets the view dimensions
+func (dcv *DescribeConfigMapView) SetSize(width, height int) {
+	dcv.width = width
+	dcv.height = height
+}
+
+// UpdateConfigMap updates the config map data
+func (dcv *DescribeConfigMapView) UpdateConfigMap(configMap *models.ConfigMap) {
+	dcv.configMap = configMap
+	dcv.keys = configMap.Keys()
+	// Reset selection if current selection is out of bounds
+	if dcv.selected >= len(dcv.keys) {
+		dcv.selected = 0
+	}
+}
+
+// SelectNext moves selection to the next key
+func (dcv *DescribeConfigMapView) SelectNext() {
+	if dcv.selected < len(dcv.keys)-1 {
+		dcv.selected++
+	}
+}
+
+// SelectPrev moves selection to the previous key
+func (dcv *DescribeConfigMapView) SelectPrev() {
+	if dcv.selected > 0 {
+		dcv.selected--
+	}
+}
+
+// GetSelectedKey returns the currently selected key
+func (dcv *DescribeConfigMapView) GetSelectedKey() *models.DataKey {
+	if len(dcv.keys) == 0 {
+		return nil
+	}
+	return &dcv.keys[dcv.selected]
+}
+
+// Keys returns the keys shown (for testing)
+func (dcv *DescribeConfigMapView) Keys() []models.DataKey {
+	return dcv.keys
+}
+
+// Render renders the describe config map view
+func (dcv *DescribeConfigMapView) Render() string {
+	if dcv.width == 0 || dcv.height == 0 {
+		return ""
+	}
+
+	details := dcv.renderDetails()
+	statusBar := dcv.renderStatusBar()
+	tableHeight := dcv.height - lipgloss.Height(details) - 1
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		details,
+		dcv.renderKeys(tableHeight),
+		statusBar,
+	)
+}
+
+// renderDetails renders the config map details
+func (dcv *DescribeConfigMapView) renderDetails() string {
+	c := dcv.configMap
+	heading := lipgloss.NewStyle().Foreground(dcv.theme.Primary).Bold(true)
+
+	basicInfo := fmt.Sprintf(`Name:      %s
+Namespace: %s
+Immutable: %t
+Keys:      %d
+Age:       %s`, c.Name, c.Namespace, c.Immutable, c.KeyCount(), c.FormatAge())
+
+	return strings.Join([]string{heading.Render("Basic Information"), basicInfo, heading.Render("Data")}, "\n\n")
+}
+
+// renderKeys renders the key table with a preview of each value
+func (dcv *DescribeConfigMapView) renderKeys(height int) string {
+	values := make([]string, len(dcv.keys))
+	for i, key := range dcv.keys {
+		if key.Binary {
+			values[i] = fmt.Sprintf("<binary data, %d bytes>", key.Size)
+		} else {
+			values[i] = valuePreview(dcv.configMap.Data[key.Name], valuePreviewWidth)
+		}
+	}
+	return renderDataKeyTable(dcv.keys, values, dcv.selected, height, dcv.theme, func(index int) lipgloss.Style {
+		if dcv.keys[index].Binary {
+			return lipgloss.NewStyle().Foreground(dcv.theme.TextMuted)
+		}
+		return lipgloss.NewStyle()
+	})
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dcv *DescribeConfigMapView) renderStatusBar() string {
+	statusText := "Press 'enter' to view the selected key's value"
+	return dcv.theme.StatusBarStyle.Width(dcv.width).Render(statusText)
+}
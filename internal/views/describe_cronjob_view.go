@@ -0,0 +1,245 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// nextRunCount is the number of upcoming scheduled runs the describe cron job view lists
+const nextRunCount = 5
+
+// DescribeCronJobView represents the describe cron job view
+type DescribeCronJobView struct {
+	cronJob *models.CronJob
+	jobs    []models.Job
+	theme   *theme.Theme
+	width   int
+	height  int
+	scrollY int
+	message string
+}
+
+// NewDescribeCronJobView creates a new describe cron job view
+func NewDescribeCronJobView(cronJob *models.CronJob, jobs []models.Job, theme *theme.Theme) *DescribeCronJobView {
+	return &DescribeCronJobView{
+		cronJob: cronJob,
+		jobs:    jobs,
+		theme:   theme,
+		scrollY: 0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dcjv *DescribeCronJobView) SetSize(width, height int) {
+	dcjv.width = width
+	dcjv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (dcjv *DescribeCronJobView) ScrollUp() {
+	if dcjv.scrollY > 0 {
+		dcjv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (dcjv *DescribeCronJobView) ScrollDown() {
+	dcjv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (dcjv *DescribeCronJobView) ScrollPageUp() {
+	dcjv.scrollY -= dcjv.height / 2
+	if dcjv.scrollY < 0 {
+		dcjv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (dcjv *DescribeCronJobView) ScrollPageDown() {
+	dcjv.scrollY += dcjv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (dcjv *DescribeCronJobView) ScrollToTop() {
+	dcjv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (dcjv *DescribeCronJobView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateCronJob updates the cron job and the jobs it created
+func (dcjv *DescribeCronJobView) UpdateCronJob(cronJob *models.CronJob, jobs []models.Job) {
+	dcjv.cronJob = cronJob
+	dcjv.jobs = jobs
+}
+
+// CronJob returns the cron job shown (for testing)
+func (dcjv *DescribeCronJobView) CronJob() *models.CronJob {
+	return dcjv.cronJob
+}
+
+// Jobs returns the jobs shown (for testing)
+func (dcjv *DescribeCronJobView) Jobs() []models.Job {
+	return dcjv.jobs
+}
+
+// SetStatusMessage sets the message shown in the status bar, such as the outcome of a trigger
+func (dcjv *DescribeCronJobView) SetStatusMessage(message string) {
+	dcjv.message = message
+}
+
+// StatusMessage returns the message shown in the status bar (for testing)
+func (dcjv *DescribeCronJobView) StatusMessage() string {
+	return dcjv.message
+}
+
+// Render renders the describe cron job view
+func (dcjv *DescribeCronJobView) Render() string {
+	if dcjv.width == 0 || dcjv.height == 0 {
+		return ""
+	}
+
+	content := dcjv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Leave room for the status bar
+	contentHeight := dcjv.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+
+	// Calculate max scroll
+	maxScroll := len(lines) - contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if dcjv.scrollY > maxScroll {
+		dcjv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := dcjv.scrollY
+	end := start + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	visible := lipgloss.NewStyle().Height(contentHeight).Render(strings.Join(lines[start:end], "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, visible, dcjv.renderStatusBar())
+}
+
+// renderContent renders the full cron job description content
+func (dcjv *DescribeCronJobView) renderContent() string {
+	if dcjv.cronJob == nil {
+		return lipgloss.NewStyle().Foreground(dcjv.theme.Error).Render("No cron job data available")
+	}
+
+	c := dcjv.cronJob
+	heading := lipgloss.NewStyle().Foreground(dcjv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	suspend := c.FormatSuspend()
+	if c.Suspend {
+		suspend = dcjv.theme.StatusPendingStyle.Render(suspend)
+	}
+	basicInfo := fmt.Sprintf(`Name:              %s
+Namespace:         %s
+Suspend:           %s
+Concurrency:       %s
+Age:               %s`, c.Name, c.Namespace, suspend, c.ConcurrencyPolicy, c.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	sections = append(sections, heading.Render("Schedule"), dcjv.renderSchedule(c))
+
+	lastSuccessful := "<none>"
+	if c.LastSuccessfulTime != nil {
+		lastSuccessful = formatJobTime(c.LastSuccessfulTime)
+	}
+	history := fmt.Sprintf(`Last Schedule:     %s
+Last Successful:   %s`, c.FormatLastSchedule(), lastSuccessful)
+	sections = append(sections, heading.Render("History"), history)
+
+	sections = append(sections, heading.Render("Active Jobs"), dcjv.renderActiveJobs(c))
+	sections = append(sections, heading.Render("Jobs"), dcjv.renderJobs())
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderSchedule renders the schedule, its rendering in words and the next times it fires
+func (dcjv *DescribeCronJobView) renderSchedule(c *models.CronJob) string {
+	timeZone := c.TimeZone
+	if timeZone == "" {
+		timeZone = "<controller time zone, usually UTC>"
+	}
+	lines := []string{
+		fmt.Sprintf("Schedule:          %s", c.Schedule),
+		fmt.Sprintf("Time Zone:         %s", timeZone),
+	}
+
+	schedule, err := c.ParseSchedule()
+	if err != nil {
+		lines = append(lines, lipgloss.NewStyle().Foreground(dcjv.theme.Error).Render(fmt.Sprintf("✗ Invalid schedule: %v", err)))
+		return strings.Join(lines, "\n")
+	}
+	lines = append(lines, fmt.Sprintf("Runs:              %s", schedule.Describe()))
+
+	if c.Suspend {
+		lines = append(lines, dcjv.theme.StatusPendingStyle.Render("Next Runs:         none while suspended"))
+		return strings.Join(lines, "\n")
+	}
+	now := time.Now()
+	runs := c.NextRuns(now, nextRunCount)
+	if len(runs) == 0 {
+		lines = append(lines, "Next Runs:         <none>")
+	}
+	for i, run := range runs {
+		label := ""
+		if i == 0 {
+			label = "Next Runs:"
+		}
+		lines = append(lines, fmt.Sprintf("%-19s%s (in %s)", label, run.Format("Mon 2006-01-02 15:04 MST"), models.FormatDuration(run.Sub(now))))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderActiveJobs renders the names of the cron job's running jobs
+func (dcjv *DescribeCronJobView) renderActiveJobs(c *models.CronJob) string {
+	if len(c.Active) == 0 {
+		return lipgloss.NewStyle().Foreground(dcjv.theme.TextMuted).Render("<none>")
+	}
+	return strings.Join(c.Active, "\n")
+}
+
+// renderJobs renders the jobs the cron job created, newest first
+func (dcjv *DescribeCronJobView) renderJobs() string {
+	if len(dcjv.jobs) == 0 {
+		return lipgloss.NewStyle().Foreground(dcjv.theme.TextMuted).Render("<none>")
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%-40s %-10s %-12s %-10s %s", "NAME", "STATUS", "COMPLETIONS", "DURATION", "AGE"))}
+	for _, job := range dcjv.jobs {
+		status := dcjv.theme.GetStatusStyle(job.Status).Render(fmt.Sprintf("%-10s", job.Status))
+		lines = append(lines, fmt.Sprintf("%-40s %s %-12s %-10s %s", job.Name, status, job.FormatCompletions(), job.FormatDuration(), job.FormatAge()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dcjv *DescribeCronJobView) renderStatusBar() string {
+	statusText := "'t' trigger now | 's' suspend/resume | 'l' latest job's logs"
+	if dcjv.message != "" {
+		statusText += " | " + dcjv.message
+	}
+	return dcjv.theme.StatusBarStyle.Width(dcjv.width).Render(statusText)
+}
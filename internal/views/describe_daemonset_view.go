@@ -0,0 +1,167 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeDaemonSetView represents the describe daemon set view
+type DescribeDaemonSetView struct {
+	daemonSet       *models.DaemonSet
+	nodesWithoutPod []string
+	theme           *theme.Theme
+	width           int
+	height          int
+	scrollY         int
+}
+
+// NewDescribeDaemonSetView creates a new describe daemon set view
+func NewDescribeDaemonSetView(daemonSet *models.DaemonSet, nodesWithoutPod []string, theme *theme.Theme) *DescribeDaemonSetView {
+	return &DescribeDaemonSetView{
+		daemonSet:       daemonSet,
+		nodesWithoutPod: nodesWithoutPod,
+		theme:           theme,
+		scrollY:         0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (ddsv *DescribeDaemonSetView) SetSize(width, height int) {
+	ddsv.width = width
+	ddsv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (ddsv *DescribeDaemonSetView) ScrollUp() {
+	if ddsv.scrollY > 0 {
+		ddsv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (ddsv *DescribeDaemonSetView) ScrollDown() {
+	ddsv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (ddsv *DescribeDaemonSetView) ScrollPageUp() {
+	ddsv.scrollY -= ddsv.height / 2
+	if ddsv.scrollY < 0 {
+		ddsv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (ddsv *DescribeDaemonSetView) ScrollPageDown() {
+	ddsv.scrollY += ddsv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (ddsv *DescribeDaemonSetView) ScrollToTop() {
+	ddsv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (ddsv *DescribeDaemonSetView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateDaemonSet updates the daemon set and the nodes lacking a daemon pod
+func (ddsv *DescribeDaemonSetView) UpdateDaemonSet(daemonSet *models.DaemonSet, nodesWithoutPod []string) {
+	ddsv.daemonSet = daemonSet
+	ddsv.nodesWithoutPod = nodesWithoutPod
+}
+
+// NodesWithoutPod returns the nodes lacking a daemon pod shown (for testing)
+func (ddsv *DescribeDaemonSetView) NodesWithoutPod() []string {
+	return ddsv.nodesWithoutPod
+}
+
+// Render renders the describe daemon set view
+func (ddsv *DescribeDaemonSetView) Render() string {
+	if ddsv.width == 0 || ddsv.height == 0 {
+		return ""
+	}
+
+	content := ddsv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Calculate max scroll
+	maxScroll := len(lines) - ddsv.height
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if ddsv.scrollY > maxScroll {
+		ddsv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := ddsv.scrollY
+	end := start + ddsv.height
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	if start >= len(lines) {
+		return lipgloss.NewStyle().Foreground(ddsv.theme.TextMuted).Render("No content to display")
+	}
+
+	visibleLines := lines[start:end]
+	return strings.Join(visibleLines, "\n")
+}
+
+// renderContent renders the full daemon set description content
+func (ddsv *DescribeDaemonSetView) renderContent() string {
+	if ddsv.daemonSet == nil {
+		return lipgloss.NewStyle().Foreground(ddsv.theme.Error).Render("No daemon set data available")
+	}
+
+	d := ddsv.daemonSet
+	heading := lipgloss.NewStyle().Foreground(ddsv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	// Basic information
+	basicInfo := fmt.Sprintf(`Name:          %s
+Namespace:     %s
+Node Selector: %s
+Strategy:      %s
+Image:         %s
+Age:           %s`, d.Name, d.Namespace, d.FormatNodeSelector(), d.UpdateStrategy, d.Image, d.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	// Pod counts
+	podInfo := fmt.Sprintf(`Desired:       %d
+Current:       %d
+Ready:         %d
+Up-to-date:    %d
+Available:     %d
+Misscheduled:  %d`, d.Desired, d.Current, d.Ready, d.UpToDate, d.Available, d.Misscheduled)
+	if d.Misscheduled > 0 {
+		podInfo += "\n" + lipgloss.NewStyle().Foreground(ddsv.theme.Error).Render(fmt.Sprintf("✗ %d daemon pods run on nodes they should not", d.Misscheduled))
+	}
+	sections = append(sections, heading.Render("Pods"), podInfo)
+
+	sections = append(sections, heading.Render("Nodes Without a Pod"), ddsv.renderNodesWithoutPod())
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderNodesWithoutPod renders the eligible nodes that have no daemon pod
+func (ddsv *DescribeDaemonSetView) renderNodesWithoutPod() string {
+	if len(ddsv.nodesWithoutPod) == 0 {
+		return lipgloss.NewStyle().Foreground(ddsv.theme.Success).Render("✓ Every eligible node runs a daemon pod")
+	}
+
+	var lines []string
+	for _, node := range ddsv.nodesWithoutPod {
+		lines = append(lines, lipgloss.NewStyle().Foreground(ddsv.theme.Error).Render("✗ "+node))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -108,6 +108,11 @@ func (ddv *DescribeDeploymentView) Render() string {
 	return strings.Join(visibleLines, "\n")
 }
 
+// Content renders the whole description of the deployment without scrolling, for output outside the TUI
+func (ddv *DescribeDeploymentView) Content() string {
+	return ddv.renderContent()
+}
+
 // renderContent renders the full deployment description content
 func (ddv *DescribeDeploymentView) renderContent() string {
 	if ddv.deployment == nil {
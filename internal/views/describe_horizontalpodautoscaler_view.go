@@ -0,0 +1,190 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeHorizontalPodAutoscalerView represents the describe horizontal pod autoscaler view
+type DescribeHorizontalPodAutoscalerView struct {
+	autoscaler *models.HorizontalPodAutoscaler
+	theme      *theme.Theme
+	width      int
+	height     int
+	scrollY    int
+}
+
+// NewDescribeHorizontalPodAutoscalerView creates a new describe horizontal pod autoscaler view
+func NewDescribeHorizontalPodAutoscalerView(autoscaler *models.HorizontalPodAutoscaler, theme *theme.Theme) *DescribeHorizontalPodAutoscalerView {
+	return &DescribeHorizontalPodAutoscalerView{
+		autoscaler: autoscaler,
+		theme:      theme,
+		scrollY:    0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dhv *DescribeHorizontalPodAutoscalerView) SetSize(width, height int) {
+	dhv.width = width
+	dhv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (dhv *DescribeHorizontalPodAutoscalerView) ScrollUp() {
+	if dhv.scrollY > 0 {
+		dhv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (dhv *DescribeHorizontalPodAutoscalerView) ScrollDown() {
+	dhv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (dhv *DescribeHorizontalPodAutoscalerView) ScrollPageUp() {
+	dhv.scrollY -= dhv.height / 2
+	if dhv.scrollY < 0 {
+		dhv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (dhv *DescribeHorizontalPodAutoscalerView) ScrollPageDown() {
+	dhv.scrollY += dhv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (dhv *DescribeHorizontalPodAutoscalerView) ScrollToTop() {
+	dhv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (dhv *DescribeHorizontalPodAutoscalerView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateHorizontalPodAutoscaler updates the horizontal pod autoscaler shown
+func (dhv *DescribeHorizontalPodAutoscalerView) UpdateHorizontalPodAutoscaler(autoscaler *models.HorizontalPodAutoscaler) {
+	dhv.autoscaler = autoscaler
+}
+
+// HorizontalPodAutoscaler returns the horizontal pod autoscaler shown (for testing)
+func (dhv *DescribeHorizontalPodAutoscalerView) HorizontalPodAutoscaler() *models.HorizontalPodAutoscaler {
+	return dhv.autoscaler
+}
+
+// Render renders the describe horizontal pod autoscaler view
+func (dhv *DescribeHorizontalPodAutoscalerView) Render() string {
+	if dhv.width == 0 || dhv.height == 0 {
+		return ""
+	}
+
+	content := dhv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Leave room for the status bar
+	contentHeight := dhv.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+
+	// Calculate max scroll
+	maxScroll := len(lines) - contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if dhv.scrollY > maxScroll {
+		dhv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := dhv.scrollY
+	end := start + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	visible := lipgloss.NewStyle().Height(contentHeight).Render(strings.Join(lines[start:end], "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, visible, dhv.renderStatusBar())
+}
+
+// renderContent renders the full horizontal pod autoscaler description content
+func (dhv *DescribeHorizontalPodAutoscalerView) renderContent() string {
+	if dhv.autoscaler == nil {
+		return lipgloss.NewStyle().Foreground(dhv.theme.Error).Render("No horizontal pod autoscaler data available")
+	}
+
+	h := dhv.autoscaler
+	heading := lipgloss.NewStyle().Foreground(dhv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	basicInfo := fmt.Sprintf(`Name:              %s
+Namespace:         %s
+Reference:         %s
+Min Replicas:      %d
+Max Replicas:      %d
+Current Replicas:  %d
+Desired Replicas:  %d
+Last Scale:        %s
+Age:               %s`, h.Name, h.Namespace, h.FormatTarget(), h.MinReplicas, h.MaxReplicas, h.CurrentReplicas, h.DesiredReplicas, h.FormatLastScaleTime(), h.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	sections = append(sections, heading.Render("Metrics"), dhv.renderMetrics())
+	sections = append(sections, heading.Render("Conditions"), dhv.renderConditions())
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderMetrics renders each metric's current value against its target
+func (dhv *DescribeHorizontalPodAutoscalerView) renderMetrics() string {
+	if len(dhv.autoscaler.Metrics) == 0 {
+		return lipgloss.NewStyle().Foreground(dhv.theme.TextMuted).Render("<none>")
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%-40s %-18s %-18s %s", "METRIC", "CURRENT", "TARGET", "TYPE"))}
+	for _, m := range dhv.autoscaler.Metrics {
+		current := fmt.Sprintf("%-18s", m.Current)
+		if m.Current == "<unknown>" {
+			current = dhv.theme.StatusPendingStyle.Render(current)
+		}
+		lines = append(lines, fmt.Sprintf("%-40s %s %-18s %s", m.Name, current, m.Target, m.Type))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderConditions renders the autoscaler's conditions. AbleToScale or ScalingActive being False is an error,
+// ScalingLimited being True a warning that the replica count is pinned at min or max.
+func (dhv *DescribeHorizontalPodAutoscalerView) renderConditions() string {
+	if len(dhv.autoscaler.Conditions) == 0 {
+		return lipgloss.NewStyle().Foreground(dhv.theme.TextMuted).Render("<none>")
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%-16s %-7s %-28s %s", "TYPE", "STATUS", "REASON", "MESSAGE"))}
+	for _, c := range dhv.autoscaler.Conditions {
+		status := fmt.Sprintf("%-7s", c.Status)
+		switch {
+		case c.Type == "ScalingLimited" && c.Status == "True":
+			status = dhv.theme.StatusPendingStyle.Render(status)
+		case c.Type != "ScalingLimited" && c.Status == "False":
+			status = dhv.theme.StatusFailedStyle.Render(status)
+		case c.Status == "True":
+			status = dhv.theme.StatusRunningStyle.Render(status)
+		}
+		lines = append(lines, fmt.Sprintf("%-16s %s %-28s %s", c.Type, status, c.Reason, c.Message))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dhv *DescribeHorizontalPodAutoscalerView) renderStatusBar() string {
+	statusText := "Press 't' to describe the scale target"
+	return dhv.theme.StatusBarStyle.Width(dhv.width).Render(statusText)
+}
@@ -0,0 +1,224 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// ingressRoute is a selectable leaf of the rule tree
+type ingressRoute struct {
+	host    string
+	path    string
+	backend models.IngressBackend
+}
+
+// DescribeIngressView represents the describe ingress view: ingress details above a host → path → backend rule tree
+type DescribeIngressView struct {
+	ingress  *models.Ingress
+	health   map[string]models.BackendHealth // keyed by service name
+	routes   []ingressRoute
+	selected int
+	theme    *theme.Theme
+	width    int
+	height   int
+}
+
+// NewDescribeIngressView creates a new describe ingress view
+func NewDescribeIngressView(ingress *models.Ingress, health map[string]models.BackendHealth, theme *theme.Theme) *DescribeIngressView {
+	view := &DescribeIngressView{theme: theme}
+	view.UpdateIngress(ingress, health)
+	return view
+}
+
+// SetSize sets the view dimensions
+func (div *DescribeIngressView) SetSize(width, height int) {
+	div.width = width
+	div.height = height
+}
+
+// UpdateIngress updates the ingress and the health of its backends
+func (div *DescribeIngressView) UpdateIngress(ingress *models.Ingress, health map[string]models.BackendHealth) {
+	div.ingress = ingress
+	div.health = health
+	div.routes = ingressRoutes(ingress)
+	// Reset selection if current selection is out of bounds
+	if div.selected >= len(div.routes) {
+		div.selected = 0
+	}
+}
+
+// ingressRoutes flattens the rule tree in display order: the default backend first, then each host's paths
+func ingressRoutes(ingress *models.Ingress) []ingressRoute {
+	if ingress == nil {
+		return nil
+	}
+	var routes []ingressRoute
+	if ingress.DefaultBackend != nil {
+		routes = append(routes, ingressRoute{backend: *ingress.DefaultBackend})
+	}
+	for _, rule := range ingress.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		for _, p := range rule.Paths {
+			routes = append(routes, ingressRoute{host: host, path: p.Path, backend: p.Backend})
+		}
+	}
+	return routes
+}
+
+// SelectNext moves selection to the next backend
+func (div *DescribeIngressView) SelectNext() {
+	if div.selected < len(div.routes)-1 {
+		div.selected++
+	}
+}
+
+// SelectPrev moves selection to the previous backend
+func (div *DescribeIngressView) SelectPrev() {
+	if div.selected > 0 {
+		div.selected--
+	}
+}
+
+// GetSelectedBackend returns the backend of the currently selected path
+func (div *DescribeIngressView) GetSelectedBackend() *models.IngressBackend {
+	if len(div.routes) == 0 {
+		return nil
+	}
+	return &div.routes[div.selected].backend
+}
+
+// Health returns the backend health shown (for testing)
+func (div *DescribeIngressView) Health() map[string]models.BackendHealth {
+	return div.health
+}
+
+// Render renders the describe ingress view
+func (div *DescribeIngressView) Render() string {
+	if div.width == 0 || div.height == 0 {
+		return ""
+	}
+	if div.ingress == nil {
+		return lipgloss.NewStyle().Foreground(div.theme.Error).Render("No ingress data available")
+	}
+
+	lines, selectedLine := div.renderLines()
+
+	// Scroll so that the selected backend stays visible above the status bar
+	contentHeight := div.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+	offset := 0
+	if selectedLine >= contentHeight {
+		offset = selectedLine - contentHeight + 1
+	}
+	end := offset + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	content := lipgloss.NewStyle().Height(contentHeight).Render(strings.Join(lines[offset:end], "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, content, div.renderStatusBar())
+}
+
+// renderLines renders every line of the view, returning the index of the selected backend's line
+func (div *DescribeIngressView) renderLines() ([]string, int) {
+	i := div.ingress
+	heading := lipgloss.NewStyle().Foreground(div.theme.Primary).Bold(true)
+	muted := lipgloss.NewStyle().Foreground(div.theme.TextMuted)
+
+	var lines []string
+
+	// Basic information
+	lines = append(lines, heading.Render("Basic Information"))
+	lines = append(lines, strings.Split(fmt.Sprintf(`Name:      %s
+Namespace: %s
+Class:     %s
+Address:   %s
+Age:       %s`, i.Name, i.Namespace, i.FormatClass(), i.FormatAddresses(), i.FormatAge()), "\n")...)
+
+	// TLS
+	lines = append(lines, "", heading.Render("TLS"))
+	if len(i.TLS) == 0 {
+		lines = append(lines, muted.Render("<none>"))
+	}
+	for _, t := range i.TLS {
+		secret := t.SecretName
+		if secret == "" {
+			secret = "<default certificate>"
+		}
+		hosts := "*"
+		if len(t.Hosts) > 0 {
+			hosts = strings.Join(t.Hosts, ",")
+		}
+		lines = append(lines, fmt.Sprintf("%s terminates %s", secret, hosts))
+	}
+
+	// Rule tree
+	lines = append(lines, "", heading.Render("Rules"))
+	if len(div.routes) == 0 {
+		lines = append(lines, muted.Render("<none>"))
+	}
+	selectedLine := 0
+	for index, route := range div.routes {
+		if index == 0 || route.host != div.routes[index-1].host {
+			host := route.host
+			if host == "" {
+				host = "<default backend>"
+			}
+			lines = append(lines, lipgloss.NewStyle().Bold(true).Render(host))
+		}
+
+		branch := "├── "
+		if index == len(div.routes)-1 || div.routes[index+1].host != route.host {
+			branch = "└── "
+		}
+		path := route.path
+		if path == "" && route.host != "" {
+			path = "/"
+		}
+		leaf := route.backend.String()
+		if path != "" {
+			leaf = path + " → " + leaf
+		}
+
+		if index == div.selected {
+			selectedLine = len(lines)
+			leaf = div.theme.TableSelectedStyle.Padding(0).Render(leaf)
+		}
+		lines = append(lines, muted.Render(branch)+leaf+"  "+div.renderHealth(route.backend))
+	}
+
+	return lines, selectedLine
+}
+
+// renderHealth describes the service behind a backend, in the theme's error color when it cannot serve traffic
+func (div *DescribeIngressView) renderHealth(backend models.IngressBackend) string {
+	if backend.ServiceName == "" {
+		return lipgloss.NewStyle().Foreground(div.theme.TextMuted).Render("resource backend")
+	}
+	health, checked := div.health[backend.ServiceName]
+	switch {
+	case !checked:
+		return ""
+	case !health.ServiceExists:
+		return lipgloss.NewStyle().Foreground(div.theme.Error).Render("✗ service not found")
+	case health.ReadyEndpoints == 0:
+		return lipgloss.NewStyle().Foreground(div.theme.Error).Render("✗ no ready endpoints")
+	default:
+		return lipgloss.NewStyle().Foreground(div.theme.Success).Render(fmt.Sprintf("✓ %d ready", health.ReadyEndpoints))
+	}
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (div *DescribeIngressView) renderStatusBar() string {
+	statusText := "Press 'enter' to describe the selected backend's service"
+	return div.theme.StatusBarStyle.Width(div.width).Render(statusText)
+}
@@ -0,0 +1,231 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeJobView represents the describe job view
+type DescribeJobView struct {
+	job      *models.Job
+	failures []models.JobPodFailure
+	theme    *theme.Theme
+	width    int
+	height   int
+	scrollY  int
+	message  string
+}
+
+// NewDescribeJobView creates a new describe job view
+func NewDescribeJobView(job *models.Job, failures []models.JobPodFailure, theme *theme.Theme) *DescribeJobView {
+	return &DescribeJobView{
+		job:      job,
+		failures: failures,
+		theme:    theme,
+		scrollY:  0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (djv *DescribeJobView) SetSize(width, height int) {
+	djv.width = width
+	djv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (djv *DescribeJobView) ScrollUp() {
+	if djv.scrollY > 0 {
+		djv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (djv *DescribeJobView) ScrollDown() {
+	djv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (djv *DescribeJobView) ScrollPageUp() {
+	djv.scrollY -= djv.height / 2
+	if djv.scrollY < 0 {
+		djv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (djv *DescribeJobView) ScrollPageDown() {
+	djv.scrollY += djv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (djv *DescribeJobView) ScrollToTop() {
+	djv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (djv *DescribeJobView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateJob updates the job and the failures of its pods
+func (djv *DescribeJobView) UpdateJob(job *models.Job, failures []models.JobPodFailure) {
+	djv.job = job
+	djv.failures = failures
+}
+
+// Failures returns the pod failures shown (for testing)
+func (djv *DescribeJobView) Failures() []models.JobPodFailure {
+	return djv.failures
+}
+
+// SetStatusMessage sets the message shown in the status bar, such as why there are no logs to show
+func (djv *DescribeJobView) SetStatusMessage(message string) {
+	djv.message = message
+}
+
+// StatusMessage returns the message shown in the status bar (for testing)
+func (djv *DescribeJobView) StatusMessage() string {
+	return djv.message
+}
+
+// Render renders the describe job view
+func (djv *DescribeJobView) Render() string {
+	if djv.width == 0 || djv.height == 0 {
+		return ""
+	}
+
+	content := djv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Leave room for the status bar
+	contentHeight := djv.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+
+	// Calculate max scroll
+	maxScroll := len(lines) - contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if djv.scrollY > maxScroll {
+		djv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := djv.scrollY
+	end := start + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	visible := lipgloss.NewStyle().Height(contentHeight).Render(strings.Join(lines[start:end], "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, visible, djv.renderStatusBar())
+}
+
+// renderContent renders the full job description content
+func (djv *DescribeJobView) renderContent() string {
+	if djv.job == nil {
+		return lipgloss.NewStyle().Foreground(djv.theme.Error).Render("No job data available")
+	}
+
+	j := djv.job
+	heading := lipgloss.NewStyle().Foreground(djv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	cronJob := j.CronJob
+	if cronJob == "" {
+		cronJob = "<none>"
+	}
+	basicInfo := fmt.Sprintf(`Name:              %s
+Namespace:         %s
+Status:            %s
+Cron Job:          %s
+Image:             %s
+Age:               %s`, j.Name, j.Namespace, djv.theme.GetStatusStyle(j.Status).Render(j.Status), cronJob, j.Image, j.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	completions := fmt.Sprintf(`Completions:       %s
+Parallelism:       %d
+Active:            %d
+Succeeded:         %d
+Started:           %s
+Finished:          %s
+Duration:          %s`, j.FormatCompletions(), j.Parallelism, j.Active, j.Succeeded, formatJobTime(j.StartTime), formatJobTime(j.FinishTime), j.FormatDuration())
+	sections = append(sections, heading.Render("Completions"), completions)
+
+	sections = append(sections, heading.Render("Backoff"), djv.renderBackoff(j))
+	sections = append(sections, heading.Render("Failure Reasons"), djv.renderFailures(j))
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderBackoff renders the failed pods against the backoff limit, warning as the limit nears
+func (djv *DescribeJobView) renderBackoff(j *models.Job) string {
+	text := fmt.Sprintf("%s pods failed of a backoff limit of %d", j.FormatBackoff(), j.BackoffLimit)
+	switch {
+	case j.Failed == 0:
+		return lipgloss.NewStyle().Foreground(djv.theme.Success).Render("✓ " + text)
+	case j.Failed > j.BackoffLimit || j.FailureReason == "BackoffLimitExceeded":
+		return lipgloss.NewStyle().Foreground(djv.theme.Error).Render("✗ " + text)
+	default:
+		return lipgloss.NewStyle().Foreground(djv.theme.Warning).Render("⚠ " + text)
+	}
+}
+
+// renderFailures renders why the job failed followed by the failures of its pods
+func (djv *DescribeJobView) renderFailures(j *models.Job) string {
+	errorStyle := lipgloss.NewStyle().Foreground(djv.theme.Error)
+
+	var lines []string
+	if j.FailureReason != "" {
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("Job failed: %s", j.FailureReason)))
+		if j.FailureMessage != "" {
+			lines = append(lines, "  "+j.FailureMessage)
+		}
+	}
+	for _, failure := range djv.failures {
+		source := failure.PodName
+		if failure.Container != "" {
+			source += "/" + failure.Container
+		}
+		reason := failure.Reason
+		if failure.ExitCode != 0 {
+			reason = fmt.Sprintf("%s (exit code %d)", reason, failure.ExitCode)
+		}
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("✗ %-40s %s", source, reason)))
+		if failure.Message != "" {
+			lines = append(lines, "  "+strings.TrimSpace(failure.Message))
+		}
+	}
+
+	if len(lines) == 0 {
+		return lipgloss.NewStyle().Foreground(djv.theme.TextMuted).Render("<none>")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (djv *DescribeJobView) renderStatusBar() string {
+	statusText := "Press 'p' to view the job's pods | Press 'l' to view the latest pod's logs"
+	if djv.message != "" {
+		statusText += " | " + djv.message
+	}
+	return djv.theme.StatusBarStyle.Width(djv.width).Render(statusText)
+}
+
+// formatJobTime formats when a job started or finished
+func formatJobTime(t *time.Time) string {
+	if t == nil {
+		return "<none>"
+	}
+	return t.Local().Format("2006-01-02 15:04:05 MST")
+}
@@ -0,0 +1,221 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeNamespaceView represents the describe namespace view
+type DescribeNamespaceView struct {
+	namespace *models.Namespace
+	// blockers are the finalized objects holding up deletion, only looked up while the namespace is terminating
+	blockers []models.NamespaceBlocker
+	theme    *theme.Theme
+	width    int
+	height   int
+	scrollY  int
+}
+
+// NewDescribeNamespaceView creates a new describe namespace view
+func NewDescribeNamespaceView(namespace *models.Namespace, blockers []models.NamespaceBlocker, theme *theme.Theme) *DescribeNamespaceView {
+	return &DescribeNamespaceView{
+		namespace: namespace,
+		blockers:  blockers,
+		theme:     theme,
+		scrollY:   0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dnv *DescribeNamespaceView) SetSize(width, height int) {
+	dnv.width = width
+	dnv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (dnv *DescribeNamespaceView) ScrollUp() {
+	if dnv.scrollY > 0 {
+		dnv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (dnv *DescribeNamespaceView) ScrollDown() {
+	dnv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (dnv *DescribeNamespaceView) ScrollPageUp() {
+	dnv.scrollY -= dnv.height / 2
+	if dnv.scrollY < 0 {
+		dnv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (dnv *DescribeNamespaceView) ScrollPageDown() {
+	dnv.scrollY += dnv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (dnv *DescribeNamespaceView) ScrollToTop() {
+	dnv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (dnv *DescribeNamespaceView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateNamespace updates the namespace and deletion blockers shown
+func (dnv *DescribeNamespaceView) UpdateNamespace(namespace *models.Namespace, blockers []models.NamespaceBlocker) {
+	dnv.namespace = namespace
+	dnv.blockers = blockers
+}
+
+// Namespace returns the namespace shown (for testing)
+func (dnv *DescribeNamespaceView) Namespace() *models.Namespace {
+	return dnv.namespace
+}
+
+// Blockers returns the deletion blockers shown (for testing)
+func (dnv *DescribeNamespaceView) Blockers() []models.NamespaceBlocker {
+	return dnv.blockers
+}
+
+// Render renders the describe namespace view
+func (dnv *DescribeNamespaceView) Render() string {
+	if dnv.width == 0 || dnv.height == 0 {
+		return ""
+	}
+
+	content := dnv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Leave room for the status bar
+	contentHeight := dnv.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+
+	// Calculate max scroll
+	maxScroll := len(lines) - contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if dnv.scrollY > maxScroll {
+		dnv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := dnv.scrollY
+	end := start + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	visible := lipgloss.NewStyle().Height(contentHeight).Render(strings.Join(lines[start:end], "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, visible, dnv.renderStatusBar())
+}
+
+// renderContent renders the full namespace description content
+func (dnv *DescribeNamespaceView) renderContent() string {
+	if dnv.namespace == nil {
+		return lipgloss.NewStyle().Foreground(dnv.theme.Error).Render("No namespace data available")
+	}
+
+	n := dnv.namespace
+	heading := lipgloss.NewStyle().Foreground(dnv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	basicInfo := fmt.Sprintf(`Name:        %s
+Status:      %s
+Finalizers:  %s
+Age:         %s`, n.Name, dnv.theme.GetStatusStyle(n.Status).Render(n.Status), n.FormatFinalizers(), n.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	if n.IsTerminating() {
+		sections = append(sections, heading.Render("Blocking Deletion"), dnv.renderBlockers())
+	}
+
+	sections = append(sections, heading.Render("Pods"), dnv.renderPods())
+	sections = append(sections, heading.Render("Resource Quotas"), dnv.renderQuotas())
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderPods renders the number of pods in each phase
+func (dnv *DescribeNamespaceView) renderPods() string {
+	pods := dnv.namespace.Usage.Pods
+	return fmt.Sprintf(`Total:      %d
+Running:    %s
+Pending:    %s
+Succeeded:  %d
+Failed:     %s
+Unknown:    %d`, pods.Total(),
+		dnv.theme.StatusRunningStyle.Render(fmt.Sprintf("%d", pods.Running)),
+		dnv.theme.StatusPendingStyle.Render(fmt.Sprintf("%d", pods.Pending)),
+		pods.Succeeded,
+		dnv.theme.StatusFailedStyle.Render(fmt.Sprintf("%d", pods.Failed)),
+		pods.Unknown)
+}
+
+// renderQuotas renders each resource quota limit with its usage, colored by how close it is to the hard limit
+func (dnv *DescribeNamespaceView) renderQuotas() string {
+	if len(dnv.namespace.Usage.Quotas) == 0 {
+		return lipgloss.NewStyle().Foreground(dnv.theme.TextMuted).Render("<none>")
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%-20s %-24s %-12s %-12s %s", "QUOTA", "RESOURCE", "USED", "HARD", "USAGE"))}
+	for _, q := range dnv.namespace.Usage.Quotas {
+		percent := dnv.theme.GetUsageStyle(int(q.Fraction * 100)).Render(q.FormatPercent())
+		lines = append(lines, fmt.Sprintf("%-20s %-24s %-12s %-12s %s", q.Quota, q.Resource, q.Used, q.Hard, percent))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderBlockers renders what keeps a terminating namespace from being removed: its own finalizers, the
+// conditions the namespace controller reports and the objects left behind that still carry finalizers
+func (dnv *DescribeNamespaceView) renderBlockers() string {
+	warning := lipgloss.NewStyle().Foreground(dnv.theme.Warning)
+	muted := lipgloss.NewStyle().Foreground(dnv.theme.TextMuted)
+
+	lines := []string{fmt.Sprintf("Finalizers:  %s", warning.Render(dnv.namespace.FormatFinalizers()))}
+
+	lines = append(lines, "", lipgloss.NewStyle().Bold(true).Render("Conditions:"))
+	if len(dnv.namespace.Conditions) == 0 {
+		lines = append(lines, muted.Render("  <none>"))
+	}
+	for _, c := range dnv.namespace.Conditions {
+		lines = append(lines, fmt.Sprintf("  %s: %s", c.Type, warning.Render(c.Reason)))
+		if c.Message != "" {
+			lines = append(lines, "    "+c.Message)
+		}
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().Bold(true).Render("Remaining Resources With Finalizers:"))
+	if len(dnv.blockers) == 0 {
+		lines = append(lines, muted.Render("  <none>"))
+	}
+	for _, b := range dnv.blockers {
+		if b.Message != "" {
+			lines = append(lines, fmt.Sprintf("  %s: %s", b.Kind, warning.Render(b.Message)))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  %s/%s: %s", b.Kind, b.Name, warning.Render(strings.Join(b.Finalizers, ", "))))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dnv *DescribeNamespaceView) renderStatusBar() string {
+	statusText := "Press 'enter' to scope views to this namespace"
+	return dnv.theme.StatusBarStyle.Width(dnv.width).Render(statusText)
+}
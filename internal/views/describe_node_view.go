@@ -0,0 +1,211 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeNodeView represents the describe node view
+type DescribeNodeView struct {
+	node    *models.Node
+	theme   *theme.Theme
+	width   int
+	height  int
+	scrollY int
+}
+
+// NewDescribeNodeView creates a new describe node view
+func NewDescribeNodeView(node *models.Node, theme *theme.Theme) *DescribeNodeView {
+	return &DescribeNodeView{
+		node:    node,
+		theme:   theme,
+		scrollY: 0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dnv *DescribeNodeView) SetSize(width, height int) {
+	dnv.width = width
+	dnv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (dnv *DescribeNodeView) ScrollUp() {
+	if dnv.scrollY > 0 {
+		dnv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (dnv *DescribeNodeView) ScrollDown() {
+	dnv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (dnv *DescribeNodeView) ScrollPageUp() {
+	dnv.scrollY -= dnv.height / 2
+	if dnv.scrollY < 0 {
+		dnv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (dnv *DescribeNodeView) ScrollPageDown() {
+	dnv.scrollY += dnv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (dnv *DescribeNodeView) ScrollToTop() {
+	dnv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (dnv *DescribeNodeView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateNode updates the node data
+func (dnv *DescribeNodeView) UpdateNode(node *models.Node) {
+	dnv.node = node
+}
+
+// Render renders the describe node view
+func (dnv *DescribeNodeView) Render() string {
+	if dnv.width == 0 || dnv.height == 0 {
+		return ""
+	}
+
+	content := dnv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Calculate max scroll
+	maxScroll := len(lines) - dnv.height
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if dnv.scrollY > maxScroll {
+		dnv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := dnv.scrollY
+	end := start + dnv.height
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	if start >= len(lines) {
+		return lipgloss.NewStyle().Foreground(dnv.theme.TextMuted).Render("No content to display")
+	}
+
+	visibleLines := lines[start:end]
+	return strings.Join(visibleLines, "\n")
+}
+
+// Content renders the whole description of the node without scrolling, for output outside the TUI
+func (dnv *DescribeNodeView) Content() string {
+	return dnv.renderContent()
+}
+
+// renderContent renders the full node description content
+func (dnv *DescribeNodeView) renderContent() string {
+	if dnv.node == nil {
+		return lipgloss.NewStyle().Foreground(dnv.theme.Error).Render("No node data available")
+	}
+
+	n := dnv.node
+	heading := lipgloss.NewStyle().Foreground(dnv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	// Basic information
+	basicInfo := fmt.Sprintf(`Name:         %s
+Status:       %s
+Roles:        %s
+Age:          %s
+Version:      %s
+OS Image:     %s
+Runtime:      %s`, n.Name, n.DisplayStatus(), n.DisplayRoles(), n.FormatAge(), n.Version, n.OSImage, n.Runtime)
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	// Capacity information
+	capacityInfo := fmt.Sprintf(`              Allocatable  Capacity
+CPU:          %-12s %s
+Memory:       %-12s %s
+Pods:         %-12d %s`, n.CPUAllocatable, n.CPUCapacity, n.MemoryAllocatable, n.MemoryCapacity, n.Pods, n.PodCapacity)
+	sections = append(sections, heading.Render("Capacity"), capacityInfo)
+
+	// Usage is only known when metrics-server is running
+	if n.Usage != nil {
+		sections = append(sections, heading.Render("Usage"), dnv.renderUsage(n))
+	}
+
+	sections = append(sections, heading.Render("Conditions"), dnv.renderConditions(n))
+	sections = append(sections, heading.Render("Taints"), dnv.renderTaints(n))
+	sections = append(sections, heading.Render("Addresses"), dnv.renderAddresses(n))
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderUsage renders the node's CPU and memory usage against allocatable, colored by the theme's thresholds
+func (dnv *DescribeNodeView) renderUsage(n *models.Node) string {
+	line := func(label, used string, usedValue, allocatable int64) string {
+		text := fmt.Sprintf("%-14s%-12s %s of allocatable", label, used, models.FormatUsagePercent(usedValue, allocatable))
+		if percent, ok := models.UsagePercent(usedValue, allocatable); ok {
+			return dnv.theme.GetUsageStyle(percent).Render(text)
+		}
+		return text
+	}
+	return strings.Join([]string{
+		line("CPU:", n.Usage.FormatCPU(), n.Usage.CPUMilli, n.CPUAllocatableMilli),
+		line("Memory:", n.Usage.FormatMemory(), n.Usage.MemoryBytes, n.MemoryAllocatableBytes),
+	}, "\n")
+}
+
+// renderConditions renders the node conditions, highlighting those not in their healthy state
+func (dnv *DescribeNodeView) renderConditions(n *models.Node) string {
+	if len(n.Conditions) == 0 {
+		return lipgloss.NewStyle().Foreground(dnv.theme.TextMuted).Render("No conditions reported")
+	}
+
+	var lines []string
+	for _, c := range n.Conditions {
+		line := fmt.Sprintf("%-20s %-8s %s", c.Type, c.Status, c.Reason)
+		if c.Message != "" {
+			line += " - " + c.Message
+		}
+		if c.Healthy {
+			lines = append(lines, lipgloss.NewStyle().Foreground(dnv.theme.Success).Render("✓ "+line))
+		} else {
+			lines = append(lines, lipgloss.NewStyle().Foreground(dnv.theme.Error).Render("✗ "+line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderTaints renders the node taints
+func (dnv *DescribeNodeView) renderTaints(n *models.Node) string {
+	if len(n.Taints) == 0 {
+		return lipgloss.NewStyle().Foreground(dnv.theme.TextMuted).Render("<none>")
+	}
+	return lipgloss.NewStyle().Foreground(dnv.theme.Warning).Render(strings.Join(n.Taints, "\n"))
+}
+
+// renderAddresses renders the node addresses
+func (dnv *DescribeNodeView) renderAddresses(n *models.Node) string {
+	if len(n.Addresses) == 0 {
+		return lipgloss.NewStyle().Foreground(dnv.theme.TextMuted).Render("<none>")
+	}
+
+	var lines []string
+	for _, a := range n.Addresses {
+		lines = append(lines, fmt.Sprintf("%-14s%s", a.Type+":", a.Address))
+	}
+	return strings.Join(lines, "\n")
+}
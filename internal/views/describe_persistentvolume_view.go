@@ -0,0 +1,159 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribePersistentVolumeView represents the describe persistent volume view
+type DescribePersistentVolumeView struct {
+	volume  *models.PersistentVolume
+	theme   *theme.Theme
+	width   int
+	height  int
+	scrollY int
+}
+
+// NewDescribePersistentVolumeView creates a new describe persistent volume view
+func NewDescribePersistentVolumeView(volume *models.PersistentVolume, theme *theme.Theme) *DescribePersistentVolumeView {
+	return &DescribePersistentVolumeView{
+		volume:  volume,
+		theme:   theme,
+		scrollY: 0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dpvv *DescribePersistentVolumeView) SetSize(width, height int) {
+	dpvv.width = width
+	dpvv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (dpvv *DescribePersistentVolumeView) ScrollUp() {
+	if dpvv.scrollY > 0 {
+		dpvv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (dpvv *DescribePersistentVolumeView) ScrollDown() {
+	dpvv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (dpvv *DescribePersistentVolumeView) ScrollPageUp() {
+	dpvv.scrollY -= dpvv.height / 2
+	if dpvv.scrollY < 0 {
+		dpvv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (dpvv *DescribePersistentVolumeView) ScrollPageDown() {
+	dpvv.scrollY += dpvv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (dpvv *DescribePersistentVolumeView) ScrollToTop() {
+	dpvv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (dpvv *DescribePersistentVolumeView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdatePersistentVolume updates the volume shown
+func (dpvv *DescribePersistentVolumeView) UpdatePersistentVolume(volume *models.PersistentVolume) {
+	dpvv.volume = volume
+}
+
+// PersistentVolume returns the volume shown (for testing)
+func (dpvv *DescribePersistentVolumeView) PersistentVolume() *models.PersistentVolume {
+	return dpvv.volume
+}
+
+// Render renders the describe persistent volume view
+func (dpvv *DescribePersistentVolumeView) Render() string {
+	if dpvv.width == 0 || dpvv.height == 0 {
+		return ""
+	}
+
+	content := dpvv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Leave room for the status bar
+	contentHeight := dpvv.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+
+	// Calculate max scroll
+	maxScroll := len(lines) - contentHeight
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if dpvv.scrollY > maxScroll {
+		dpvv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := dpvv.scrollY
+	end := start + contentHeight
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	visible := lipgloss.NewStyle().Height(contentHeight).Render(strings.Join(lines[start:end], "\n"))
+	return lipgloss.JoinVertical(lipgloss.Left, visible, dpvv.renderStatusBar())
+}
+
+// renderContent renders the full persistent volume description content
+func (dpvv *DescribePersistentVolumeView) renderContent() string {
+	if dpvv.volume == nil {
+		return lipgloss.NewStyle().Foreground(dpvv.theme.Error).Render("No persistent volume data available")
+	}
+
+	v := dpvv.volume
+	heading := lipgloss.NewStyle().Foreground(dpvv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	volumeMode := v.VolumeMode
+	if volumeMode == "" {
+		volumeMode = "Filesystem"
+	}
+	basicInfo := fmt.Sprintf(`Name:             %s
+Status:           %s
+Capacity:         %s
+Access Modes:     %s
+Reclaim Policy:   %s
+Storage Class:    %s
+Volume Mode:      %s
+Age:              %s`, v.Name, dpvv.theme.GetStatusStyle(v.Status).Render(v.Status), v.Capacity, v.FormatAccessModes(), v.ReclaimPolicy, v.FormatStorageClass(), volumeMode, v.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	sections = append(sections, heading.Render("Claim"), v.FormatClaim())
+	sections = append(sections, heading.Render("Source"), v.Source)
+
+	if v.Reason != "" || v.Message != "" {
+		problem := fmt.Sprintf(`Reason:           %s
+Message:          %s`, v.Reason, v.Message)
+		sections = append(sections, heading.Render("Problem"), lipgloss.NewStyle().Foreground(dpvv.theme.Error).Render(problem))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dpvv *DescribePersistentVolumeView) renderStatusBar() string {
+	statusText := "Press 'c' to describe the bound claim"
+	return dpvv.theme.StatusBarStyle.Width(dpvv.width).Render(statusText)
+}
@@ -0,0 +1,228 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribePersistentVolumeClaimView represents the describe persistent volume claim view: claim details,
+// provisioning events while pending, and a selectable table of the pods mounting the claim
+type DescribePersistentVolumeClaimView struct {
+	claim    *models.PersistentVolumeClaim
+	pods     []models.ClaimPod
+	events   []models.Event // events recorded against the claim, shown while it is pending
+	selected int
+	theme    *theme.Theme
+	width    int
+	height   int
+}
+
+// NewDescribePersistentVolumeClaimView creates a new describe persistent volume claim view
+func NewDescribePersistentVolumeClaimView(claim *models.PersistentVolumeClaim, pods []models.ClaimPod, events []models.Event, theme *theme.Theme) *DescribePersistentVolumeClaimView {
+	return &DescribePersistentVolumeClaimView{
+		claim:  claim,
+		pods:   pods,
+		events: events,
+		theme:  theme,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dpvcv *DescribePersistentVolumeClaimView) SetSize(width, height int) {
+	dpvcv.width = width
+	dpvcv.height = height
+}
+
+// UpdatePersistentVolumeClaim updates the claim, the pods mounting it and its events
+func (dpvcv *DescribePersistentVolumeClaimView) UpdatePersistentVolumeClaim(claim *models.PersistentVolumeClaim, pods []models.ClaimPod, events []models.Event) {
+	dpvcv.claim = claim
+	dpvcv.pods = pods
+	dpvcv.events = events
+	// Reset selection if current selection is out of bounds
+	if dpvcv.selected >= len(dpvcv.pods) {
+		dpvcv.selected = 0
+	}
+}
+
+// SelectNext moves selection to the next pod
+func (dpvcv *DescribePersistentVolumeClaimView) SelectNext() {
+	if dpvcv.selected < len(dpvcv.pods)-1 {
+		dpvcv.selected++
+	}
+}
+
+// SelectPrev moves selection to the previous pod
+func (dpvcv *DescribePersistentVolumeClaimView) SelectPrev() {
+	if dpvcv.selected > 0 {
+		dpvcv.selected--
+	}
+}
+
+// GetSelectedPod returns the currently selected pod
+func (dpvcv *DescribePersistentVolumeClaimView) GetSelectedPod() *models.ClaimPod {
+	if len(dpvcv.pods) == 0 {
+		return nil
+	}
+	return &dpvcv.pods[dpvcv.selected]
+}
+
+// PersistentVolumeClaim returns the claim shown (for testing)
+func (dpvcv *DescribePersistentVolumeClaimView) PersistentVolumeClaim() *models.PersistentVolumeClaim {
+	return dpvcv.claim
+}
+
+// Pods returns the pods mounting the claim (for testing)
+func (dpvcv *DescribePersistentVolumeClaimView) Pods() []models.ClaimPod {
+	return dpvcv.pods
+}
+
+// Events returns the events shown (for testing)
+func (dpvcv *DescribePersistentVolumeClaimView) Events() []models.Event {
+	return dpvcv.events
+}
+
+// Render renders the describe persistent volume claim view
+func (dpvcv *DescribePersistentVolumeClaimView) Render() string {
+	if dpvcv.width == 0 || dpvcv.height == 0 {
+		return ""
+	}
+	if dpvcv.claim == nil {
+		return lipgloss.NewStyle().Foreground(dpvcv.theme.Error).Render("No persistent volume claim data available")
+	}
+
+	details := dpvcv.renderDetails()
+	statusBar := dpvcv.renderStatusBar()
+	tableHeight := dpvcv.height - lipgloss.Height(details) - 1
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		details,
+		dpvcv.renderPods(tableHeight),
+		statusBar,
+	)
+}
+
+// renderDetails renders the claim details, provisioning events while pending and the mount summary
+func (dpvcv *DescribePersistentVolumeClaimView) renderDetails() string {
+	c := dpvcv.claim
+	heading := lipgloss.NewStyle().Foreground(dpvcv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	volume := c.Volume
+	if volume == "" {
+		volume = "<none>"
+	}
+	volumeMode := c.VolumeMode
+	if volumeMode == "" {
+		volumeMode = "Filesystem"
+	}
+	basicInfo := fmt.Sprintf(`Name:             %s
+Namespace:        %s
+Status:           %s
+Volume:           %s
+Capacity:         %s
+Access Modes:     %s
+Storage Class:    %s
+Volume Mode:      %s
+Age:              %s`, c.Name, c.Namespace, dpvcv.theme.GetStatusStyle(c.Status).Render(c.Status), volume, c.FormatCapacity(), c.FormatAccessModes(), c.FormatStorageClass(), volumeMode, c.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	if c.IsPending() {
+		sections = append(sections, heading.Render("Provisioning Events"), dpvcv.renderEvents())
+	}
+
+	summary := fmt.Sprintf("%d pods", len(dpvcv.pods))
+	if len(dpvcv.pods) == 0 {
+		summary = lipgloss.NewStyle().Foreground(dpvcv.theme.TextMuted).Render("Not mounted by any pod")
+	}
+	sections = append(sections, heading.Render("Mounted By"), summary)
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderEvents renders the events recorded against the claim, warnings in the theme's warning color
+func (dpvcv *DescribePersistentVolumeClaimView) renderEvents() string {
+	if len(dpvcv.events) == 0 {
+		return lipgloss.NewStyle().Foreground(dpvcv.theme.TextMuted).Render("<none>")
+	}
+
+	var lines []string
+	for _, e := range dpvcv.events {
+		line := fmt.Sprintf("%-10s %-22s %s", e.FormatLastSeen(), e.Reason, e.Message)
+		if e.Count > 1 {
+			line += fmt.Sprintf(" (x%d)", e.Count)
+		}
+		if e.IsWarning() {
+			line = lipgloss.NewStyle().Foreground(dpvcv.theme.Warning).Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderPods renders the selectable table of pods mounting the claim
+func (dpvcv *DescribePersistentVolumeClaimView) renderPods(height int) string {
+	if len(dpvcv.pods) == 0 {
+		return ""
+	}
+
+	headers := []string{"POD", "PHASE", "NODE", "VOLUME"}
+
+	var rows [][]string
+	for _, p := range dpvcv.pods {
+		node := p.Node
+		if node == "" {
+			node = "<none>"
+		}
+		rows = append(rows, []string{p.Name, p.Phase, node, p.Volume})
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(rows...).
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(dpvcv.theme.Primary)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+
+			isSelected := row == dpvcv.selected
+
+			var style lipgloss.Style
+			if isSelected {
+				style = dpvcv.theme.TableSelectedStyle
+			} else if (row-1)%2 == 1 {
+				// Alternate row style
+				style = dpvcv.theme.TableRowAltStyle
+			} else {
+				style = dpvcv.theme.TableRowStyle
+			}
+
+			// Color the phase column (col 1)
+			podIndex := row - 1
+			if col == 1 && !isSelected && podIndex >= 0 && podIndex < len(dpvcv.pods) {
+				style = style.Inherit(dpvcv.theme.GetStatusStyle(dpvcv.pods[podIndex].Phase))
+			}
+
+			return style
+		})
+
+	// table overhead is border and header
+	tableHeight := height - 3
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	t.Height(tableHeight)
+
+	return t.Render()
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dpvcv *DescribePersistentVolumeClaimView) renderStatusBar() string {
+	statusText := "Press 'enter' to describe the selected pod | Press 'v' to describe the bound volume"
+	return dpvcv.theme.StatusBarStyle.Width(dpvcv.width).Render(statusText)
+}
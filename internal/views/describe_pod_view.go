@@ -16,8 +16,15 @@ type DescribePodView struct {
 	width   int
 	height  int
 	scrollY int
+
+	// Usage history per container, shown as sparklines when metrics-server is available
+	usageHistory     map[string][]models.UsageSample
+	metricsAvailable bool
 }
 
+// usageChartHeight is the number of rows each usage sparkline takes
+const usageChartHeight = 3
+
 // NewDescribePodView creates a new describe pod view
 func NewDescribePodView(pod *models.Pod, theme *theme.Theme) *DescribePodView {
 	return &DescribePodView{
@@ -38,6 +45,12 @@ func (dpv *DescribePodView) UpdatePod(pod *models.Pod) {
 	dpv.pod = pod
 }
 
+// SetUsageHistory updates the per-container usage history and whether metrics-server is available
+func (dpv *DescribePodView) SetUsageHistory(history map[string][]models.UsageSample, available bool) {
+	dpv.usageHistory = history
+	dpv.metricsAvailable = available
+}
+
 // ScrollUp scrolls the view up
 func (dpv *DescribePodView) ScrollUp() {
 	if dpv.scrollY > 0 {
@@ -108,6 +121,11 @@ func (dpv *DescribePodView) Render() string {
 	return strings.Join(visibleLines, "\n")
 }
 
+// Content renders the whole description of the pod without scrolling, for output outside the TUI
+func (dpv *DescribePodView) Content() string {
+	return dpv.renderContent()
+}
+
 // renderContent renders the full pod description content
 func (dpv *DescribePodView) renderContent() string {
 	if dpv.pod == nil {
@@ -132,6 +150,9 @@ Node:         %s`, p.Name, p.Namespace, p.Status, p.FormatAge(), p.IP, p.Node)
 Restarts:     %d`, p.Ready, p.Restarts)
 	sections = append(sections, lipgloss.NewStyle().Foreground(dpv.theme.Primary).Bold(true).Render("Container Information"), containerInfo)
 
+	// Resource usage history
+	sections = append(sections, lipgloss.NewStyle().Foreground(dpv.theme.Primary).Bold(true).Render("Resource Usage"), dpv.renderUsage(p))
+
 	// Status details
 	statusDetails := dpv.renderStatusDetails(p)
 	if statusDetails != "" {
@@ -146,20 +167,92 @@ Node:         %s`, p.IP, p.Node)
 	return strings.Join(sections, "\n\n")
 }
 
+// renderUsage renders CPU and memory sparklines for each container against its limit
+func (dpv *DescribePodView) renderUsage(p *models.Pod) string {
+	muted := lipgloss.NewStyle().Foreground(dpv.theme.TextMuted)
+	if !dpv.metricsAvailable {
+		return muted.Render("Usage unavailable: metrics-server is not installed")
+	}
+
+	// Leave room for the indent
+	maxSamples := dpv.width - 4
+	var blocks []string
+	for _, container := range p.Containers {
+		samples := dpv.usageHistory[container.Name]
+		if len(samples) == 0 {
+			blocks = append(blocks, container.Name+"\n"+muted.Render("  Waiting for metrics samples..."))
+			continue
+		}
+		if maxSamples > 0 && len(samples) > maxSamples {
+			samples = samples[len(samples)-maxSamples:]
+		}
+
+		cpu := make([]int64, len(samples))
+		memory := make([]int64, len(samples))
+		for i, sample := range samples {
+			cpu[i] = sample.Usage.CPUMilli
+			memory[i] = sample.Usage.MemoryBytes
+		}
+
+		formatCPU := func(v int64) string { return models.ResourceUsage{CPUMilli: v}.FormatCPU() }
+		formatMemory := func(v int64) string { return models.ResourceUsage{MemoryBytes: v}.FormatMemory() }
+
+		blocks = append(blocks, strings.Join([]string{
+			container.Name,
+			dpv.renderUsageChart("CPU", cpu, container.CPULimitMilli, formatCPU),
+			dpv.renderUsageChart("Memory", memory, container.MemoryLimitBytes, formatMemory),
+		}, "\n"))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// renderUsageChart renders a labelled sparkline annotated with its current, minimum, maximum and limit values
+func (dpv *DescribePodView) renderUsageChart(label string, values []int64, limit int64, format func(int64) string) string {
+	current, low, high := values[len(values)-1], values[0], values[0]
+	for _, v := range values {
+		low = min(low, v)
+		high = max(high, v)
+	}
+
+	limitText := "none"
+	if limit > 0 {
+		limitText = format(limit)
+	}
+	annotation := fmt.Sprintf("  %-8s now %s  min %s  max %s  limit %s", label, format(current), format(low), format(high), limitText)
+	if percent, ok := models.UsagePercent(current, limit); ok {
+		annotation += dpv.theme.GetUsageStyle(percent).Render(fmt.Sprintf(" (%d%%)", percent))
+	}
+
+	chart := sparkline(values, limit, usageChartHeight, dpv.theme)
+	lines := strings.Split(chart, "\n")
+	for i := range lines {
+		lines[i] = "  " + lines[i]
+	}
+	return annotation + "\n" + strings.Join(lines, "\n")
+}
+
 // renderStatusDetails renders detailed status information
 func (dpv *DescribePodView) renderStatusDetails(p *models.Pod) string {
 	var details []string
 
-	switch p.Status {
-	case "Running":
+	switch {
+	case p.Status == "Running":
 		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Success).Render("✓ Pod is running and healthy"))
-	case "Pending":
+	case p.Status == "Pending", p.Status == "ContainerCreating", p.Status == "SchedulingGated":
 		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Warning).Render("⏳ Pod is pending - waiting for resources or scheduling"))
-	case "Succeeded":
+	case p.Status == "Succeeded", p.Status == "Completed":
 		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Accent).Render("✓ Pod completed successfully"))
-	case "Failed":
+	case p.Status == "Terminating":
+		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Warning).Render("⏳ Pod is terminating"))
+	case p.Status == "NotReady":
+		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Warning).Render("⚠ Pod is running but not ready"))
+	case p.Status == "Failed":
 		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Error).Render("✗ Pod failed to run"))
-	case "Unknown":
+	case models.IsPodErrorStatus(p.Status):
+		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Error).Render(fmt.Sprintf("✗ Pod is failing: %s", p.Status)))
+	case strings.HasPrefix(p.Status, "Init:"):
+		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.Warning).Render(fmt.Sprintf("⏳ Pod is initializing (%s)", p.Status)))
+	case p.Status == "Unknown":
 		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.TextMuted).Render("? Pod status is unknown"))
 	default:
 		details = append(details, lipgloss.NewStyle().Foreground(dpv.theme.TextMuted).Render("? Unknown status"))
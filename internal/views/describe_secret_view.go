@@ -0,0 +1,164 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// secretMask is shown in place of a secret value that has not been revealed
+const secretMask = "••••••••"
+
+// DescribeSecretView represents the describe secret view: details above a selectable key table with masked values
+type DescribeSecretView struct {
+	secret        *models.Secret
+	keys          []models.DataKey
+	revealed      map[string]string // key -> decoded value, for the keys currently revealed
+	selected      int
+	statusMessage string
+	theme         *theme.Theme
+	width         int
+	height        int
+}
+
+// NewDescribeSecretView creates a new describe secret view
+func NewDescribeSecretView(secret *models.Secret, theme *theme.Theme) *DescribeSecretView {
+	view := &DescribeSecretView{
+		theme:    theme,
+		revealed: make(map[string]string),
+	}
+	view.UpdateSecret(secret)
+	return view
+}
+
+// SetSize sets the view dimensions
+func (dsv *DescribeSecretView) SetSize(width, height int) {
+	dsv.width = width
+	dsv.height = height
+}
+
+// UpdateSecret updates the secret data, masking every value again since revealed values may be stale
+func (dsv *DescribeSecretView) UpdateSecret(secret *models.Secret) {
+	dsv.secret = secret
+	dsv.keys = secret.Keys()
+	dsv.revealed = make(map[string]string)
+	// Reset selection if current selection is out of bounds
+	if dsv.selected >= len(dsv.keys) {
+		dsv.selected = 0
+	}
+}
+
+// SelectNext moves selection to the next key
+func (dsv *DescribeSecretView) SelectNext() {
+	if dsv.selected < len(dsv.keys)-1 {
+		dsv.selected++
+	}
+}
+
+// SelectPrev moves selection to the previous key
+func (dsv *DescribeSecretView) SelectPrev() {
+	if dsv.selected > 0 {
+		dsv.selected--
+	}
+}
+
+// GetSelectedKey returns the currently selected key
+func (dsv *DescribeSecretView) GetSelectedKey() *models.DataKey {
+	if len(dsv.keys) == 0 {
+		return nil
+	}
+	return &dsv.keys[dsv.selected]
+}
+
+// Reveal shows the decoded value of a key in place of the mask
+func (dsv *DescribeSecretView) Reveal(key, value string) {
+	dsv.revealed[key] = value
+}
+
+// Hide masks the value of a key again
+func (dsv *DescribeSecretView) Hide(key string) {
+	delete(dsv.revealed, key)
+}
+
+// IsRevealed reports whether the value of a key is shown
+func (dsv *DescribeSecretView) IsRevealed(key string) bool {
+	_, revealed := dsv.revealed[key]
+	return revealed
+}
+
+// Revealed returns the values currently shown, keyed by key (for testing)
+func (dsv *DescribeSecretView) Revealed() map[string]string {
+	return dsv.revealed
+}
+
+// SetStatusMessage shows a message in the status bar, e.g. why a reveal was refused
+func (dsv *DescribeSecretView) SetStatusMessage(message string) {
+	dsv.statusMessage = message
+}
+
+// StatusMessage returns the status bar message (for testing)
+func (dsv *DescribeSecretView) StatusMessage() string {
+	return dsv.statusMessage
+}
+
+// Render renders the describe secret view
+func (dsv *DescribeSecretView) Render() string {
+	if dsv.width == 0 || dsv.height == 0 {
+		return ""
+	}
+
+	details := dsv.renderDetails()
+	statusBar := dsv.renderStatusBar()
+	tableHeight := dsv.height - lipgloss.Height(details) - 1
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		details,
+		dsv.renderKeys(tableHeight),
+		statusBar,
+	)
+}
+
+// renderDetails renders the secret details
+func (dsv *DescribeSecretView) renderDetails() string {
+	s := dsv.secret
+	heading := lipgloss.NewStyle().Foreground(dsv.theme.Primary).Bold(true)
+
+	basicInfo := fmt.Sprintf(`Name:      %s
+Namespace: %s
+Type:      %s
+Immutable: %t
+Keys:      %d
+Age:       %s`, s.Name, s.Namespace, s.Type, s.Immutable, s.KeyCount(), s.FormatAge())
+
+	return strings.Join([]string{heading.Render("Basic Information"), basicInfo, heading.Render("Data")}, "\n\n")
+}
+
+// renderKeys renders the key table, masking every value that has not been revealed
+func (dsv *DescribeSecretView) renderKeys(height int) string {
+	values := make([]string, len(dsv.keys))
+	for i, key := range dsv.keys {
+		values[i] = secretMask
+		if value, revealed := dsv.revealed[key.Name]; revealed {
+			values[i] = valuePreview(value, valuePreviewWidth)
+		}
+	}
+	return renderDataKeyTable(dsv.keys, values, dsv.selected, height, dsv.theme, func(index int) lipgloss.Style {
+		if dsv.IsRevealed(dsv.keys[index].Name) {
+			return lipgloss.NewStyle().Foreground(dsv.theme.Warning)
+		}
+		return lipgloss.NewStyle().Foreground(dsv.theme.TextMuted)
+	})
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dsv *DescribeSecretView) renderStatusBar() string {
+	statusText := "Press 'v' to reveal the selected key"
+	if dsv.statusMessage != "" {
+		statusText = dsv.statusMessage
+	}
+	return dsv.theme.StatusBarStyle.Width(dsv.width).Render(statusText)
+}
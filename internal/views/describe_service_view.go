@@ -0,0 +1,237 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeServiceView represents the describe service view: service details above a selectable endpoint table
+type DescribeServiceView struct {
+	service   *models.Service
+	endpoints []models.ServiceEndpoint
+	hints     []string // explanations of why the service has no ready endpoints
+	selected  int
+	theme     *theme.Theme
+	width     int
+	height    int
+}
+
+// NewDescribeServiceView creates a new describe service view
+func NewDescribeServiceView(service *models.Service, endpoints []models.ServiceEndpoint, hints []string, theme *theme.Theme) *DescribeServiceView {
+	return &DescribeServiceView{
+		service:   service,
+		endpoints: endpoints,
+		hints:     hints,
+		theme:     theme,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dsv *DescribeServiceView) SetSize(width, height int) {
+	dsv.width = width
+	dsv.height = height
+}
+
+// UpdateService updates the service, its endpoints and the endpoint diagnosis
+func (dsv *DescribeServiceView) UpdateService(service *models.Service, endpoints []models.ServiceEndpoint, hints []string) {
+	dsv.service = service
+	dsv.endpoints = endpoints
+	dsv.hints = hints
+	// Reset selection if current selection is out of bounds
+	if dsv.selected >= len(dsv.endpoints) {
+		dsv.selected = 0
+	}
+}
+
+// SelectNext moves selection to the next endpoint
+func (dsv *DescribeServiceView) SelectNext() {
+	if dsv.selected < len(dsv.endpoints)-1 {
+		dsv.selected++
+	}
+}
+
+// SelectPrev moves selection to the previous endpoint
+func (dsv *DescribeServiceView) SelectPrev() {
+	if dsv.selected > 0 {
+		dsv.selected--
+	}
+}
+
+// GetSelectedEndpoint returns the currently selected endpoint
+func (dsv *DescribeServiceView) GetSelectedEndpoint() *models.ServiceEndpoint {
+	if len(dsv.endpoints) == 0 {
+		return nil
+	}
+	return &dsv.endpoints[dsv.selected]
+}
+
+// Endpoints returns the endpoints shown (for testing)
+func (dsv *DescribeServiceView) Endpoints() []models.ServiceEndpoint {
+	return dsv.endpoints
+}
+
+// Hints returns the endpoint diagnosis shown (for testing)
+func (dsv *DescribeServiceView) Hints() []string {
+	return dsv.hints
+}
+
+// Render renders the describe service view
+func (dsv *DescribeServiceView) Render() string {
+	if dsv.width == 0 || dsv.height == 0 {
+		return ""
+	}
+	if dsv.service == nil {
+		return lipgloss.NewStyle().Foreground(dsv.theme.Error).Render("No service data available")
+	}
+
+	details := dsv.renderDetails()
+	statusBar := dsv.renderStatusBar()
+	tableHeight := dsv.height - lipgloss.Height(details) - 1
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		details,
+		dsv.renderEndpoints(tableHeight),
+		statusBar,
+	)
+}
+
+// renderDetails renders the service details, ports and endpoint summary
+func (dsv *DescribeServiceView) renderDetails() string {
+	s := dsv.service
+	heading := lipgloss.NewStyle().Foreground(dsv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	// Basic information
+	basicInfo := fmt.Sprintf(`Name:             %s
+Namespace:        %s
+Type:             %s
+Cluster IP:       %s
+External IPs:     %s
+Selector:         %s
+Session Affinity: %s
+Age:              %s`, s.Name, s.Namespace, s.Type, s.ClusterIP, s.FormatExternalIPs(), s.FormatSelector(), s.SessionAffinity, s.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	// Ports
+	sections = append(sections, heading.Render("Ports"), dsv.renderPorts())
+
+	// Endpoint summary, explaining an empty service
+	ready := models.CountReadyEndpoints(dsv.endpoints)
+	summary := fmt.Sprintf("%d ready, %d not ready", ready, len(dsv.endpoints)-ready)
+	if ready == 0 {
+		summary = lipgloss.NewStyle().Foreground(dsv.theme.Error).Render(summary)
+	} else {
+		summary = lipgloss.NewStyle().Foreground(dsv.theme.Success).Render(summary)
+	}
+	for _, hint := range dsv.hints {
+		summary += "\n" + lipgloss.NewStyle().Foreground(dsv.theme.Warning).Render("! "+hint)
+	}
+	sections = append(sections, heading.Render("Endpoints"), summary)
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderPorts renders one line per service port
+func (dsv *DescribeServiceView) renderPorts() string {
+	if len(dsv.service.Ports) == 0 {
+		return lipgloss.NewStyle().Foreground(dsv.theme.TextMuted).Render("<none>")
+	}
+
+	var lines []string
+	for _, p := range dsv.service.Ports {
+		name := p.Name
+		if name == "" {
+			name = "<unnamed>"
+		}
+		line := fmt.Sprintf("%-16s %d/%s -> %s", name, p.Port, p.Protocol, p.TargetPort)
+		if p.NodePort != 0 {
+			line += fmt.Sprintf(" (node port %d)", p.NodePort)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderEndpoints renders the selectable endpoint table
+func (dsv *DescribeServiceView) renderEndpoints(height int) string {
+	if len(dsv.endpoints) == 0 {
+		return ""
+	}
+
+	headers := []string{"ADDRESS", "STATE", "PORTS", "NODE", "POD"}
+
+	var rows [][]string
+	for _, e := range dsv.endpoints {
+		pod := "<none>"
+		if e.PodName != "" {
+			pod = e.PodNamespace + "/" + e.PodName
+		}
+		rows = append(rows, []string{e.Address, endpointState(e), strings.Join(e.Ports, ","), e.NodeName, pod})
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(rows...).
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(dsv.theme.Primary)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+
+			isSelected := row == dsv.selected
+
+			var style lipgloss.Style
+			if isSelected {
+				style = dsv.theme.TableSelectedStyle
+			} else if (row-1)%2 == 1 {
+				// Alternate row style
+				style = dsv.theme.TableRowAltStyle
+			} else {
+				style = dsv.theme.TableRowStyle
+			}
+
+			// Apply readiness styling for the state column (col 1)
+			endpointIndex := row - 1
+			if col == 1 && !isSelected && endpointIndex >= 0 && endpointIndex < len(dsv.endpoints) {
+				if dsv.endpoints[endpointIndex].Ready {
+					style = style.Inherit(dsv.theme.StatusRunningStyle)
+				} else {
+					style = style.Inherit(dsv.theme.StatusFailedStyle)
+				}
+			}
+
+			return style
+		})
+
+	// table overhead is border and header
+	tableHeight := height - 3
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	t.Height(tableHeight)
+
+	return t.Render()
+}
+
+// endpointState describes the readiness of an endpoint
+func endpointState(e models.ServiceEndpoint) string {
+	switch {
+	case e.Terminating:
+		return "Terminating"
+	case e.Ready:
+		return "Ready"
+	default:
+		return "NotReady"
+	}
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dsv *DescribeServiceView) renderStatusBar() string {
+	statusText := "Press 'enter' to describe the endpoint's pod | Press 'p' to view pods matching the selector"
+	return dsv.theme.StatusBarStyle.Width(dsv.width).Render(statusText)
+}
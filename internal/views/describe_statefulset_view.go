@@ -0,0 +1,207 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DescribeStatefulSetView represents the describe stateful set view
+type DescribeStatefulSetView struct {
+	statefulSet *models.StatefulSet
+	pods        []models.StatefulSetPod
+	theme       *theme.Theme
+	width       int
+	height      int
+	scrollY     int
+}
+
+// NewDescribeStatefulSetView creates a new describe stateful set view
+func NewDescribeStatefulSetView(statefulSet *models.StatefulSet, pods []models.StatefulSetPod, theme *theme.Theme) *DescribeStatefulSetView {
+	return &DescribeStatefulSetView{
+		statefulSet: statefulSet,
+		pods:        pods,
+		theme:       theme,
+		scrollY:     0,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dssv *DescribeStatefulSetView) SetSize(width, height int) {
+	dssv.width = width
+	dssv.height = height
+}
+
+// ScrollUp scrolls the view up
+func (dssv *DescribeStatefulSetView) ScrollUp() {
+	if dssv.scrollY > 0 {
+		dssv.scrollY--
+	}
+}
+
+// ScrollDown scrolls the view down
+func (dssv *DescribeStatefulSetView) ScrollDown() {
+	dssv.scrollY++
+}
+
+// ScrollPageUp scrolls the view up by a page
+func (dssv *DescribeStatefulSetView) ScrollPageUp() {
+	dssv.scrollY -= dssv.height / 2
+	if dssv.scrollY < 0 {
+		dssv.scrollY = 0
+	}
+}
+
+// ScrollPageDown scrolls the view down by a page
+func (dssv *DescribeStatefulSetView) ScrollPageDown() {
+	dssv.scrollY += dssv.height / 2
+}
+
+// ScrollToTop scrolls to the top of the view
+func (dssv *DescribeStatefulSetView) ScrollToTop() {
+	dssv.scrollY = 0
+}
+
+// ScrollToBottom scrolls to the bottom of the view
+func (dssv *DescribeStatefulSetView) ScrollToBottom() {
+	// This will be calculated in the render method
+}
+
+// UpdateStatefulSet updates the stateful set and the pod of each ordinal
+func (dssv *DescribeStatefulSetView) UpdateStatefulSet(statefulSet *models.StatefulSet, pods []models.StatefulSetPod) {
+	dssv.statefulSet = statefulSet
+	dssv.pods = pods
+}
+
+// Pods returns the ordinal pods shown (for testing)
+func (dssv *DescribeStatefulSetView) Pods() []models.StatefulSetPod {
+	return dssv.pods
+}
+
+// Render renders the describe stateful set view
+func (dssv *DescribeStatefulSetView) Render() string {
+	if dssv.width == 0 || dssv.height == 0 {
+		return ""
+	}
+
+	content := dssv.renderContent()
+	lines := strings.Split(content, "\n")
+
+	// Calculate max scroll
+	maxScroll := len(lines) - dssv.height
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+
+	// Clamp scroll position
+	if dssv.scrollY > maxScroll {
+		dssv.scrollY = maxScroll
+	}
+
+	// Get visible lines
+	start := dssv.scrollY
+	end := start + dssv.height
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	if start >= len(lines) {
+		return lipgloss.NewStyle().Foreground(dssv.theme.TextMuted).Render("No content to display")
+	}
+
+	visibleLines := lines[start:end]
+	return strings.Join(visibleLines, "\n")
+}
+
+// renderContent renders the full stateful set description content
+func (dssv *DescribeStatefulSetView) renderContent() string {
+	if dssv.statefulSet == nil {
+		return lipgloss.NewStyle().Foreground(dssv.theme.Error).Render("No stateful set data available")
+	}
+
+	s := dssv.statefulSet
+	heading := lipgloss.NewStyle().Foreground(dssv.theme.Primary).Bold(true)
+
+	var sections []string
+
+	// Basic information
+	basicInfo := fmt.Sprintf(`Name:              %s
+Namespace:         %s
+Ready:             %s
+Service:           %s
+Pod Management:    %s
+Image:             %s
+Age:               %s`, s.Name, s.Namespace, s.FormatReady(), s.ServiceName, s.PodManagementPolicy, s.Image, s.FormatAge())
+	sections = append(sections, heading.Render("Basic Information"), basicInfo)
+
+	sections = append(sections, heading.Render("Rollout"), dssv.renderRollout(s))
+	sections = append(sections, heading.Render("Pods"), dssv.renderPods(s))
+	sections = append(sections, heading.Render("Volume Claim Templates"), dssv.renderVolumeClaimTemplates(s))
+
+	return strings.Join(sections, "\n\n")
+}
+
+// renderRollout renders the update strategy and the progress of a rolling update
+func (dssv *DescribeStatefulSetView) renderRollout(s *models.StatefulSet) string {
+	info := fmt.Sprintf(`Strategy:          %s
+Partition:         %d
+Current Revision:  %s
+Update Revision:   %s`, s.UpdateStrategy, s.Partition, s.CurrentRevision, s.UpdateRevision)
+
+	progress := lipgloss.NewStyle().Foreground(dssv.theme.Success).Render("✓ " + s.FormatRollout())
+	if s.RolloutInProgress() {
+		progress = lipgloss.NewStyle().Foreground(dssv.theme.Warning).Render("⟳ " + s.FormatRollout())
+	}
+	return info + "\n" + progress
+}
+
+// renderPods renders one line per ordinal with the pod's phase, readiness and revision
+func (dssv *DescribeStatefulSetView) renderPods(s *models.StatefulSet) string {
+	if len(dssv.pods) == 0 {
+		return lipgloss.NewStyle().Foreground(dssv.theme.TextMuted).Render("<none>")
+	}
+
+	var lines []string
+	for _, pod := range dssv.pods {
+		if !pod.Exists {
+			lines = append(lines, lipgloss.NewStyle().Foreground(dssv.theme.Error).Render(fmt.Sprintf("✗ %-4d %-30s not created", pod.Ordinal, pod.Name)))
+			continue
+		}
+
+		revision := "current revision"
+		if s.UpdateRevision != "" && pod.Revision == s.UpdateRevision {
+			revision = "update revision"
+		}
+		if s.Partition > 0 && pod.Ordinal < s.Partition {
+			revision += ", held by partition"
+		}
+		line := fmt.Sprintf("%-4d %-30s %-10s %s", pod.Ordinal, pod.Name, pod.Phase, revision)
+
+		if pod.Ready {
+			lines = append(lines, lipgloss.NewStyle().Foreground(dssv.theme.Success).Render("✓ "+line))
+		} else {
+			lines = append(lines, lipgloss.NewStyle().Foreground(dssv.theme.Warning).Render("⚠ "+line))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderVolumeClaimTemplates renders the claim templates each pod gets a PersistentVolumeClaim from
+func (dssv *DescribeStatefulSetView) renderVolumeClaimTemplates(s *models.StatefulSet) string {
+	if len(s.VolumeClaimTemplates) == 0 {
+		return lipgloss.NewStyle().Foreground(dssv.theme.TextMuted).Render("<none>")
+	}
+
+	var lines []string
+	for _, template := range s.VolumeClaimTemplates {
+		storageClass := template.StorageClass
+		if storageClass == "" {
+			storageClass = "<default>"
+		}
+		lines = append(lines, fmt.Sprintf("%-20s %-10s %-20s class %s", template.Name, template.Storage, template.FormatAccessModes(), storageClass))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,222 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// DrainPhase is the stage a node drain is in
+type DrainPhase int
+
+const (
+	// DrainConfirming waits for the user to review the options and start the drain
+	DrainConfirming DrainPhase = iota
+	// DrainRunning is cordoning the node and evicting its pods
+	DrainRunning
+	// DrainFinished has no pods left in progress
+	DrainFinished
+)
+
+// DrainNodeView represents the live progress panel of a node drain
+type DrainNodeView struct {
+	nodeName string
+	options  models.DrainOptions
+	phase    DrainPhase
+	pods     []models.PodDrainStatus
+	err      error
+	width    int
+	height   int
+	theme    *theme.Theme
+}
+
+// NewDrainNodeView creates a new drain node view
+func NewDrainNodeView(nodeName string, options models.DrainOptions, theme *theme.Theme) *DrainNodeView {
+	return &DrainNodeView{
+		nodeName: nodeName,
+		options:  options,
+		phase:    DrainConfirming,
+		theme:    theme,
+	}
+}
+
+// SetSize sets the view dimensions
+func (dv *DrainNodeView) SetSize(width, height int) {
+	dv.width = width
+	dv.height = height
+}
+
+// SetOptions updates the drain options shown
+func (dv *DrainNodeView) SetOptions(options models.DrainOptions) {
+	dv.options = options
+}
+
+// SetPhase updates the drain phase
+func (dv *DrainNodeView) SetPhase(phase DrainPhase) {
+	dv.phase = phase
+}
+
+// SetError records an error that stopped the drain before any pod was evicted
+func (dv *DrainNodeView) SetError(err error) {
+	dv.err = err
+}
+
+// Err returns the error shown, nil when there is none (for testing)
+func (dv *DrainNodeView) Err() error {
+	return dv.err
+}
+
+// UpdatePods updates the per-pod drain progress
+func (dv *DrainNodeView) UpdatePods(pods []models.PodDrainStatus) {
+	dv.pods = pods
+}
+
+// Pods returns the per-pod drain progress (for testing)
+func (dv *DrainNodeView) Pods() []models.PodDrainStatus {
+	return dv.pods
+}
+
+// Render renders the drain panel
+func (dv *DrainNodeView) Render() string {
+	if dv.width == 0 || dv.height == 0 {
+		return ""
+	}
+
+	summary := dv.renderSummary()
+	statusBar := dv.renderStatusBar()
+	tableHeight := dv.height - lipgloss.Height(summary) - 1
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		summary,
+		dv.renderTable(tableHeight),
+		statusBar,
+	)
+}
+
+// renderSummary renders the options and the overall progress
+func (dv *DrainNodeView) renderSummary() string {
+	heading := lipgloss.NewStyle().Foreground(dv.theme.Primary).Bold(true)
+	muted := lipgloss.NewStyle().Foreground(dv.theme.TextMuted)
+
+	lines := []string{
+		heading.Render("Drain node " + dv.nodeName),
+		fmt.Sprintf("%s Delete emptyDir data (e)", checkbox(dv.options.DeleteEmptyDirData)),
+		fmt.Sprintf("%s Force pods not managed by a controller (f)", checkbox(dv.options.Force)),
+	}
+
+	switch {
+	case dv.err != nil:
+		lines = append(lines, lipgloss.NewStyle().Foreground(dv.theme.Error).Render(dv.err.Error()))
+	case dv.phase == DrainConfirming:
+		lines = append(lines, muted.Render("The node will be cordoned and its pods evicted, honouring PodDisruptionBudgets. DaemonSet pods are skipped."))
+	default:
+		lines = append(lines, dv.renderProgress())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderProgress renders pod counts by drain state
+func (dv *DrainNodeView) renderProgress() string {
+	counts := make(map[models.PodDrainState]int)
+	for _, pod := range dv.pods {
+		counts[pod.State]++
+	}
+	inProgress := len(dv.pods) - counts[models.PodDrainEvicted] - counts[models.PodDrainSkipped] - counts[models.PodDrainFailed]
+
+	progress := fmt.Sprintf("Evicted: %d | Skipped: %d | Failed: %d | In progress: %d",
+		counts[models.PodDrainEvicted], counts[models.PodDrainSkipped], counts[models.PodDrainFailed], inProgress)
+
+	if dv.phase == DrainFinished {
+		if counts[models.PodDrainFailed] > 0 {
+			return lipgloss.NewStyle().Foreground(dv.theme.Error).Render(progress + " | Drain incomplete, node remains cordoned")
+		}
+		return lipgloss.NewStyle().Foreground(dv.theme.Success).Render(progress + " | Drain complete")
+	}
+	return progress
+}
+
+// renderTable renders the per-pod progress table
+func (dv *DrainNodeView) renderTable(height int) string {
+	if len(dv.pods) == 0 {
+		if dv.phase == DrainFinished {
+			return lipgloss.NewStyle().Foreground(dv.theme.TextMuted).Render("No pods on node")
+		}
+		return ""
+	}
+
+	headers := []string{"NAMESPACE", "NAME", "STATE", "MESSAGE"}
+
+	var rows [][]string
+	for _, pod := range dv.pods {
+		rows = append(rows, []string{pod.Namespace, pod.Name, string(pod.State), pod.Message})
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(rows...).
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(dv.theme.Primary)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := dv.theme.TableRowStyle
+			if (row-1)%2 == 1 {
+				style = dv.theme.TableRowAltStyle
+			}
+
+			// Colour the state column by outcome
+			podIndex := row - 1
+			if col == 2 && podIndex >= 0 && podIndex < len(dv.pods) {
+				style = style.Inherit(dv.drainStateStyle(dv.pods[podIndex].State))
+			}
+			return style
+		})
+
+	// table overhead is border and header
+	tableHeight := height - 3
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	t.Height(tableHeight)
+
+	return t.Render()
+}
+
+// drainStateStyle returns the style for a pod drain state
+func (dv *DrainNodeView) drainStateStyle(state models.PodDrainState) lipgloss.Style {
+	switch state {
+	case models.PodDrainEvicted:
+		return dv.theme.StatusRunningStyle
+	case models.PodDrainEvicting, models.PodDrainWaitingForPDB, models.PodDrainTerminating:
+		return dv.theme.StatusPendingStyle
+	case models.PodDrainFailed:
+		return dv.theme.StatusFailedStyle
+	default:
+		return lipgloss.NewStyle().Foreground(dv.theme.TextMuted)
+	}
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (dv *DrainNodeView) renderStatusBar() string {
+	var statusText string
+	switch dv.phase {
+	case DrainConfirming:
+		statusText = "Press 'enter' to drain | 'e'/'f' toggle options | 'esc' to cancel"
+	case DrainRunning:
+		statusText = "Draining... | Press 'esc' to go back while the drain carries on"
+	case DrainFinished:
+		statusText = "Drain finished | Press 'esc' to go back"
+	}
+	return dv.theme.StatusBarStyle.Width(dv.width).Render(statusText)
+}
+
+// checkbox renders a toggle state
+func checkbox(checked bool) string {
+	if checked {
+		return "[x]"
+	}
+	return "[ ]"
+}
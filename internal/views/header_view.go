@@ -2,6 +2,7 @@ package views
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/kevholditch/vigilant/internal/models"
@@ -26,8 +27,8 @@ func (h *HeaderView) SetSize(width int) {
 	h.width = width
 }
 
-// Render renders the header view using the HeaderModel and viewText
-func (h *HeaderView) Render(model *models.HeaderModel, viewText string) string {
+// Render renders the header view using the HeaderModel, viewText and the navigation breadcrumbs
+func (h *HeaderView) Render(model *models.HeaderModel, viewText string, breadcrumbs []string) string {
 	// --- Styles ---
 	separator := lipgloss.NewStyle().
 		Foreground(h.theme.TextMuted).
@@ -41,6 +42,15 @@ func (h *HeaderView) Render(model *models.HeaderModel, viewText string) string {
 		Background(h.theme.BgSecondary).
 		SetString(fmt.Sprintf("☸️ %s", model.ClusterName)).String()
 
+	namespace := model.Namespace
+	if namespace == "" {
+		namespace = "all"
+	}
+	namespaceInfo := lipgloss.NewStyle().
+		Foreground(h.theme.TextMuted).
+		Background(h.theme.BgSecondary).
+		SetString(fmt.Sprintf("ns: %s", namespace)).String()
+
 	k8sInfo := lipgloss.NewStyle().
 		Foreground(h.theme.TextPrimary).
 		Background(h.theme.BgSecondary).
@@ -66,6 +76,8 @@ func (h *HeaderView) Render(model *models.HeaderModel, viewText string) string {
 		lipgloss.Bottom,
 		clusterInfo,
 		separator,
+		namespaceInfo,
+		separator,
 		viewTextStyled,
 		separator,
 		k8sInfo,
@@ -74,6 +86,17 @@ func (h *HeaderView) Render(model *models.HeaderModel, viewText string) string {
 		separator,
 		workerInfo,
 	)
+	// A multi-cluster view shows the health of each of its clusters in place of the current cluster's details
+	if len(model.Clusters) > 0 {
+		content = lipgloss.JoinHorizontal(
+			lipgloss.Bottom,
+			h.renderClusters(model.Clusters),
+			separator,
+			namespaceInfo,
+			separator,
+			viewTextStyled,
+		)
+	}
 
 	// --- Layout ---
 	bar := lipgloss.NewStyle().
@@ -84,5 +107,45 @@ func (h *HeaderView) Render(model *models.HeaderModel, viewText string) string {
 		Padding(0, 1).
 		Render(content)
 
-	return bar
+	return lipgloss.JoinVertical(lipgloss.Left, bar, h.renderBreadcrumbs(breadcrumbs))
+}
+
+// renderClusters renders the connection health of each cluster: ● when connected, ◌ while connecting and ✕ when
+// unreachable
+func (h *HeaderView) renderClusters(clusters []models.ClusterHealth) string {
+	base := lipgloss.NewStyle().Background(h.theme.BgSecondary)
+	parts := []string{base.Foreground(h.theme.TextMuted).Render("☸️")}
+	for _, cluster := range clusters {
+		symbol, color := "◌", h.theme.Warning
+		switch cluster.State {
+		case models.ClusterConnected:
+			symbol, color = "●", h.theme.Success
+		case models.ClusterUnreachable:
+			symbol, color = "✕", h.theme.Error
+		}
+		parts = append(parts, base.Foreground(color).Render(symbol)+base.Foreground(h.theme.TextPrimary).Render(" "+cluster.Name))
+	}
+	return strings.Join(parts, base.Render(" "))
+}
+
+// renderBreadcrumbs renders the navigation trail from the root view to the current view
+func (h *HeaderView) renderBreadcrumbs(breadcrumbs []string) string {
+	separator := lipgloss.NewStyle().
+		Foreground(h.theme.TextMuted).
+		SetString(" › ").
+		String()
+
+	var parts []string
+	for i, crumb := range breadcrumbs {
+		style := lipgloss.NewStyle().Foreground(h.theme.TextSecondary)
+		if i == len(breadcrumbs)-1 {
+			style = style.Foreground(h.theme.Primary).Bold(true)
+		}
+		parts = append(parts, style.Render(crumb))
+	}
+
+	return lipgloss.NewStyle().
+		Width(h.width).
+		Padding(0, 1).
+		Render(strings.Join(parts, separator))
 }
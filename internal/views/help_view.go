@@ -0,0 +1,86 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// HelpEntry is one line of the help overlay, the keys of an action and what it does
+type HelpEntry struct {
+	Keys string
+	Help string
+}
+
+// HelpSection is a titled group of entries in the help overlay, such as the keys of the current view
+type HelpSection struct {
+	Title   string
+	Entries []HelpEntry
+}
+
+// HelpView represents the help overlay listing the keys available in the current view
+type HelpView struct {
+	sections []HelpSection
+	width    int
+	height   int
+	theme    *theme.Theme
+}
+
+// NewHelpView creates a new help view of the sections
+func NewHelpView(sections []HelpSection, theme *theme.Theme) *HelpView {
+	return &HelpView{
+		sections: sections,
+		theme:    theme,
+	}
+}
+
+// SetSize sets the view dimensions
+func (hv *HelpView) SetSize(width, height int) {
+	hv.width = width
+	hv.height = height
+}
+
+// Render renders the sections in a bordered box, with the keys aligned in a column
+func (hv *HelpView) Render() string {
+	if hv.width == 0 || hv.height == 0 {
+		return ""
+	}
+
+	keyWidth := 0
+	for _, section := range hv.sections {
+		for _, entry := range section.Entries {
+			keyWidth = max(keyWidth, lipgloss.Width(entry.Keys))
+		}
+	}
+
+	headingStyle := lipgloss.NewStyle().Foreground(hv.theme.Primary).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(hv.theme.Accent).Width(keyWidth + 2)
+	helpStyle := lipgloss.NewStyle().Foreground(hv.theme.TextPrimary)
+	mutedStyle := lipgloss.NewStyle().Foreground(hv.theme.TextMuted)
+
+	var lines []string
+	for i, section := range hv.sections {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, headingStyle.Render(section.Title))
+		for _, entry := range section.Entries {
+			lines = append(lines, keyStyle.Render(entry.Keys)+helpStyle.Render(entry.Help))
+		}
+	}
+
+	// The border and padding take four lines, and the footer two more
+	if available := hv.height - 6; available > 0 && len(lines) > available {
+		lines = append(lines[:available-1], mutedStyle.Render("…"))
+	}
+	lines = append(lines, "", mutedStyle.Render("Press any key to close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(hv.theme.Primary).
+		Padding(1, 2).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.Place(hv.width, hv.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,173 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HorizontalPodAutoscalerListView represents the horizontal pod autoscaler list view
+type HorizontalPodAutoscalerListView struct {
+	horizontalPodAutoscalers []models.HorizontalPodAutoscaler
+	selected                 int
+	width                    int
+	height                   int
+	theme                    *theme.Theme
+	clusterName              string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.HorizontalPodAutoscaler]
+}
+
+// NewHorizontalPodAutoscalerListView creates a new horizontal pod autoscaler list view
+func NewHorizontalPodAutoscalerListView(horizontalPodAutoscalers []models.HorizontalPodAutoscaler, theme *theme.Theme, clusterName string) *HorizontalPodAutoscalerListView {
+	return &HorizontalPodAutoscalerListView{
+		horizontalPodAutoscalers: horizontalPodAutoscalers,
+		selected:                 0,
+		theme:                    theme,
+		clusterName:              clusterName,
+		columns:                  horizontalPodAutoscalerColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (hlv *HorizontalPodAutoscalerListView) SetSize(width, height int) {
+	hlv.width = width
+	hlv.height = height
+}
+
+// SelectNext moves selection to next horizontal pod autoscaler
+func (hlv *HorizontalPodAutoscalerListView) SelectNext() {
+	if hlv.selected < len(hlv.horizontalPodAutoscalers)-1 {
+		hlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous horizontal pod autoscaler
+func (hlv *HorizontalPodAutoscalerListView) SelectPrev() {
+	if hlv.selected > 0 {
+		hlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected horizontal pod autoscaler
+func (hlv *HorizontalPodAutoscalerListView) GetSelected() *models.HorizontalPodAutoscaler {
+	if len(hlv.horizontalPodAutoscalers) == 0 {
+		return nil
+	}
+	return &hlv.horizontalPodAutoscalers[hlv.selected]
+}
+
+// UpdateHorizontalPodAutoscalers updates the horizontal pod autoscalers data
+func (hlv *HorizontalPodAutoscalerListView) UpdateHorizontalPodAutoscalers(horizontalPodAutoscalers []models.HorizontalPodAutoscaler) {
+	hlv.horizontalPodAutoscalers = horizontalPodAutoscalers
+	// Reset selection if current selection is out of bounds
+	if hlv.selected >= len(hlv.horizontalPodAutoscalers) {
+		hlv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (hlv *HorizontalPodAutoscalerListView) OpenColumnChooser() *ColumnChooserView {
+	hlv.chooser = NewColumnChooserView(hlv.columns, hlv.theme)
+	return hlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (hlv *HorizontalPodAutoscalerListView) CloseColumnChooser() {
+	hlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (hlv *HorizontalPodAutoscalerListView) ColumnChooser() *ColumnChooserView {
+	return hlv.chooser
+}
+
+// Render renders the complete horizontal pod autoscaler list view
+func (hlv *HorizontalPodAutoscalerListView) Render() string {
+	if hlv.width == 0 || hlv.height == 0 {
+		return ""
+	}
+
+	// Horizontal pod autoscaler table
+	var table string
+	if hlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		hlv.chooser.SetSize(hlv.width, hlv.height-1)
+		table = hlv.chooser.Render()
+	} else {
+		table = hlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := hlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the horizontal pod autoscaler table
+func (hlv *HorizontalPodAutoscalerListView) renderTable() string {
+	if len(hlv.horizontalPodAutoscalers) == 0 {
+		return lipgloss.NewStyle().Foreground(hlv.theme.TextMuted).Render("No horizontal pod autoscalers found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := hlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(hlv.columns, hlv.horizontalPodAutoscalers, hlv.selected, tableHeight, false, hlv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (hlv *HorizontalPodAutoscalerListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d horizontal pod autoscalers | Press 'd' to describe | Press 't' to describe the scale target", len(hlv.horizontalPodAutoscalers))
+	return hlv.theme.StatusBarStyle.Width(hlv.width).Render(statusText)
+}
+
+// HorizontalPodAutoscalers returns the list of horizontal pod autoscalers (for testing)
+func (hlv *HorizontalPodAutoscalerListView) HorizontalPodAutoscalers() []models.HorizontalPodAutoscaler {
+	return hlv.horizontalPodAutoscalers
+}
+
+// horizontalPodAutoscalerColumns are the columns the horizontal pod autoscaler list can show
+var horizontalPodAutoscalerColumns = registerColumnCatalog(&ColumnCatalog[models.HorizontalPodAutoscaler]{
+	Resource: "hpa",
+	Columns: []Column[models.HorizontalPodAutoscaler]{
+		{Name: "NAME", Value: func(h models.HorizontalPodAutoscaler) string { return h.Name }},
+		{Name: "NAMESPACE", Value: func(h models.HorizontalPodAutoscaler) string { return h.Namespace }},
+		{Name: "REFERENCE", Value: func(h models.HorizontalPodAutoscaler) string { return h.FormatTarget() }},
+		// Flag autoscalers that cannot read their metrics, and those held at a replica limit
+		{Name: "TARGETS", Value: func(h models.HorizontalPodAutoscaler) string { return h.FormatMetrics() }, Style: func(h models.HorizontalPodAutoscaler, t *theme.Theme) (lipgloss.Style, bool) {
+			switch {
+			case h.IsUnhealthy():
+				return t.StatusFailedStyle, true
+			case h.IsScalingLimited():
+				return t.StatusPendingStyle, true
+			}
+			return lipgloss.Style{}, false
+		}},
+		{Name: "MINPODS", Value: func(h models.HorizontalPodAutoscaler) string { return fmt.Sprintf("%d", h.MinReplicas) }},
+		{Name: "MAXPODS", Value: func(h models.HorizontalPodAutoscaler) string { return fmt.Sprintf("%d", h.MaxReplicas) }},
+		{Name: "REPLICAS", Value: func(h models.HorizontalPodAutoscaler) string { return h.FormatReplicas() }},
+		{Name: "AGE", Value: func(h models.HorizontalPodAutoscaler) string { return h.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "REFERENCE", "TARGETS", "MINPODS", "MAXPODS", "REPLICAS", "AGE"},
+	Object: func(h models.HorizontalPodAutoscaler) metav1.Object {
+		if h.Object == nil {
+			return nil
+		}
+		return h.Object
+	},
+})
@@ -0,0 +1,166 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IngressListView represents the ingress list view
+type IngressListView struct {
+	ingresses   []models.Ingress
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Ingress]
+}
+
+// NewIngressListView creates a new ingress list view
+func NewIngressListView(ingresses []models.Ingress, theme *theme.Theme, clusterName string) *IngressListView {
+	return &IngressListView{
+		ingresses:   ingresses,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     ingressColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (ilv *IngressListView) SetSize(width, height int) {
+	ilv.width = width
+	ilv.height = height
+}
+
+// SelectNext moves selection to next ingress
+func (ilv *IngressListView) SelectNext() {
+	if ilv.selected < len(ilv.ingresses)-1 {
+		ilv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous ingress
+func (ilv *IngressListView) SelectPrev() {
+	if ilv.selected > 0 {
+		ilv.selected--
+	}
+}
+
+// GetSelected returns the currently selected ingress
+func (ilv *IngressListView) GetSelected() *models.Ingress {
+	if len(ilv.ingresses) == 0 {
+		return nil
+	}
+	return &ilv.ingresses[ilv.selected]
+}
+
+// UpdateIngresses updates the ingresses data
+func (ilv *IngressListView) UpdateIngresses(ingresses []models.Ingress) {
+	ilv.ingresses = ingresses
+	// Reset selection if current selection is out of bounds
+	if ilv.selected >= len(ilv.ingresses) {
+		ilv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (ilv *IngressListView) OpenColumnChooser() *ColumnChooserView {
+	ilv.chooser = NewColumnChooserView(ilv.columns, ilv.theme)
+	return ilv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (ilv *IngressListView) CloseColumnChooser() {
+	ilv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (ilv *IngressListView) ColumnChooser() *ColumnChooserView {
+	return ilv.chooser
+}
+
+// Render renders the complete ingress list view
+func (ilv *IngressListView) Render() string {
+	if ilv.width == 0 || ilv.height == 0 {
+		return ""
+	}
+
+	// Ingress table
+	var table string
+	if ilv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		ilv.chooser.SetSize(ilv.width, ilv.height-1)
+		table = ilv.chooser.Render()
+	} else {
+		table = ilv.renderTable()
+	}
+
+	// Status bar
+	statusBar := ilv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the ingress table
+func (ilv *IngressListView) renderTable() string {
+	if len(ilv.ingresses) == 0 {
+		return lipgloss.NewStyle().Foreground(ilv.theme.TextMuted).Render("No ingresses found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := ilv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(ilv.columns, ilv.ingresses, ilv.selected, tableHeight, false, ilv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (ilv *IngressListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d ingresses | Press 'd' or 'enter' to describe", len(ilv.ingresses))
+	return ilv.theme.StatusBarStyle.Width(ilv.width).Render(statusText)
+}
+
+// Ingresses returns the list of ingresses (for testing)
+func (ilv *IngressListView) Ingresses() []models.Ingress {
+	return ilv.ingresses
+}
+
+// ingressColumns are the columns the ingress list can show
+var ingressColumns = registerColumnCatalog(&ColumnCatalog[models.Ingress]{
+	Resource: "ingresses",
+	Columns: []Column[models.Ingress]{
+		{Name: "NAME", Value: func(i models.Ingress) string { return i.Name }},
+		{Name: "NAMESPACE", Value: func(i models.Ingress) string { return i.Namespace }},
+		{Name: "CLASS", Value: func(i models.Ingress) string { return i.FormatClass() }},
+		{Name: "HOSTS", Value: func(i models.Ingress) string { return i.FormatHosts() }},
+		// Flag ingresses the controller has not given an address yet
+		{Name: "ADDRESS", Value: func(i models.Ingress) string { return i.FormatAddresses() }, Style: func(i models.Ingress, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.StatusPendingStyle, len(i.Addresses) == 0
+		}},
+		{Name: "TLS", Value: func(i models.Ingress) string { return i.FormatTLS() }},
+		{Name: "AGE", Value: func(i models.Ingress) string { return i.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "CLASS", "HOSTS", "ADDRESS", "TLS", "AGE"},
+	Object: func(i models.Ingress) metav1.Object {
+		if i.Object == nil {
+			return nil
+		}
+		return i.Object
+	},
+})
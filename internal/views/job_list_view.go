@@ -0,0 +1,165 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobListView represents the job list view
+type JobListView struct {
+	jobs        []models.Job
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Job]
+}
+
+// NewJobListView creates a new job list view
+func NewJobListView(jobs []models.Job, theme *theme.Theme, clusterName string) *JobListView {
+	return &JobListView{
+		jobs:        jobs,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     jobColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (jlv *JobListView) SetSize(width, height int) {
+	jlv.width = width
+	jlv.height = height
+}
+
+// SelectNext moves selection to next job
+func (jlv *JobListView) SelectNext() {
+	if jlv.selected < len(jlv.jobs)-1 {
+		jlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous job
+func (jlv *JobListView) SelectPrev() {
+	if jlv.selected > 0 {
+		jlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected job
+func (jlv *JobListView) GetSelected() *models.Job {
+	if len(jlv.jobs) == 0 {
+		return nil
+	}
+	return &jlv.jobs[jlv.selected]
+}
+
+// UpdateJobs updates the jobs data
+func (jlv *JobListView) UpdateJobs(jobs []models.Job) {
+	jlv.jobs = jobs
+	// Reset selection if current selection is out of bounds
+	if jlv.selected >= len(jlv.jobs) {
+		jlv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (jlv *JobListView) OpenColumnChooser() *ColumnChooserView {
+	jlv.chooser = NewColumnChooserView(jlv.columns, jlv.theme)
+	return jlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (jlv *JobListView) CloseColumnChooser() {
+	jlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (jlv *JobListView) ColumnChooser() *ColumnChooserView {
+	return jlv.chooser
+}
+
+// Render renders the complete job list view
+func (jlv *JobListView) Render() string {
+	if jlv.width == 0 || jlv.height == 0 {
+		return ""
+	}
+
+	// Job table
+	var table string
+	if jlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		jlv.chooser.SetSize(jlv.width, jlv.height-1)
+		table = jlv.chooser.Render()
+	} else {
+		table = jlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := jlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the job table
+func (jlv *JobListView) renderTable() string {
+	if len(jlv.jobs) == 0 {
+		return lipgloss.NewStyle().Foreground(jlv.theme.TextMuted).Render("No jobs found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := jlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(jlv.columns, jlv.jobs, jlv.selected, tableHeight, false, jlv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (jlv *JobListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d jobs | Press 'd' to describe | Press 'enter' to view the job's pods | Press 'x' for x-ray", len(jlv.jobs))
+	return jlv.theme.StatusBarStyle.Width(jlv.width).Render(statusText)
+}
+
+// Jobs returns the list of jobs (for testing)
+func (jlv *JobListView) Jobs() []models.Job {
+	return jlv.jobs
+}
+
+// jobColumns are the columns the job list can show
+var jobColumns = registerColumnCatalog(&ColumnCatalog[models.Job]{
+	Resource: "jobs",
+	Columns: []Column[models.Job]{
+		{Name: "NAME", Value: func(j models.Job) string { return j.Name }},
+		{Name: "NAMESPACE", Value: func(j models.Job) string { return j.Namespace }},
+		{Name: "STATUS", Value: func(j models.Job) string { return j.Status }, Style: func(j models.Job, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(j.Status), true
+		}},
+		{Name: "COMPLETIONS", Value: func(j models.Job) string { return j.FormatCompletions() }},
+		{Name: "DURATION", Value: func(j models.Job) string { return j.FormatDuration() }},
+		{Name: "BACKOFF", Value: func(j models.Job) string { return j.FormatBackoff() }},
+		{Name: "AGE", Value: func(j models.Job) string { return j.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "STATUS", "COMPLETIONS", "DURATION", "BACKOFF", "AGE"},
+	Object: func(j models.Job) metav1.Object {
+		if j.Object == nil {
+			return nil
+		}
+		return j.Object
+	},
+})
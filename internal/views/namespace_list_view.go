@@ -0,0 +1,198 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NamespaceListView represents the namespace list view
+type NamespaceListView struct {
+	namespaces  []models.Namespace
+	active      string // namespace the resource views are scoped to, empty for all namespaces
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[namespaceRow]
+}
+
+// NewNamespaceListView creates a new namespace list view
+func NewNamespaceListView(namespaces []models.Namespace, active string, theme *theme.Theme, clusterName string) *NamespaceListView {
+	return &NamespaceListView{
+		namespaces:  namespaces,
+		active:      active,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     namespaceColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (nlv *NamespaceListView) SetSize(width, height int) {
+	nlv.width = width
+	nlv.height = height
+}
+
+// SelectNext moves selection to next namespace
+func (nlv *NamespaceListView) SelectNext() {
+	if nlv.selected < len(nlv.namespaces)-1 {
+		nlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous namespace
+func (nlv *NamespaceListView) SelectPrev() {
+	if nlv.selected > 0 {
+		nlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected namespace
+func (nlv *NamespaceListView) GetSelected() *models.Namespace {
+	if len(nlv.namespaces) == 0 {
+		return nil
+	}
+	return &nlv.namespaces[nlv.selected]
+}
+
+// UpdateNamespaces updates the namespaces data
+func (nlv *NamespaceListView) UpdateNamespaces(namespaces []models.Namespace) {
+	nlv.namespaces = namespaces
+	// Reset selection if current selection is out of bounds
+	if nlv.selected >= len(nlv.namespaces) {
+		nlv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (nlv *NamespaceListView) OpenColumnChooser() *ColumnChooserView {
+	nlv.chooser = NewColumnChooserView(nlv.columns, nlv.theme)
+	return nlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (nlv *NamespaceListView) CloseColumnChooser() {
+	nlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (nlv *NamespaceListView) ColumnChooser() *ColumnChooserView {
+	return nlv.chooser
+}
+
+// Render renders the complete namespace list view
+func (nlv *NamespaceListView) Render() string {
+	if nlv.width == 0 || nlv.height == 0 {
+		return ""
+	}
+
+	// Namespace table
+	var table string
+	if nlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		nlv.chooser.SetSize(nlv.width, nlv.height-1)
+		table = nlv.chooser.Render()
+	} else {
+		table = nlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := nlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the namespace table
+func (nlv *NamespaceListView) renderTable() string {
+	if len(nlv.namespaces) == 0 {
+		return lipgloss.NewStyle().Foreground(nlv.theme.TextMuted).Render("No namespaces found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := nlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(nlv.columns, nlv.rows(), nlv.selected, tableHeight, false, nlv.theme)
+}
+
+// rows returns the namespaces as the table shows them
+func (nlv *NamespaceListView) rows() []namespaceRow {
+	rows := make([]namespaceRow, 0, len(nlv.namespaces))
+	for _, namespace := range nlv.namespaces {
+		rows = append(rows, namespaceRow{Namespace: namespace, active: namespace.Name == nlv.active})
+	}
+	return rows
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (nlv *NamespaceListView) renderStatusBar() string {
+	scope := "all namespaces"
+	if nlv.active != "" {
+		scope = nlv.active
+	}
+	statusText := fmt.Sprintf("Total: %d namespaces | Scope: %s | Press 'enter' to scope views to the selected namespace | Press 'a' for all namespaces | Press 'd' to describe", len(nlv.namespaces), scope)
+	return nlv.theme.StatusBarStyle.Width(nlv.width).Render(statusText)
+}
+
+// Namespaces returns the list of namespaces (for testing)
+func (nlv *NamespaceListView) Namespaces() []models.Namespace {
+	return nlv.namespaces
+}
+
+// namespaceRow is a namespace as the namespace list shows it, marked when the resource views are scoped to it
+type namespaceRow struct {
+	models.Namespace
+	active bool
+}
+
+// namespaceColumns are the columns the namespace list can show
+var namespaceColumns = registerColumnCatalog(&ColumnCatalog[namespaceRow]{
+	Resource: "namespaces",
+	Columns: []Column[namespaceRow]{
+		{Name: "NAME", Value: func(n namespaceRow) string {
+			if n.active {
+				return n.Name + " (active)"
+			}
+			return n.Name
+		}},
+		{Name: "STATUS", Value: func(n namespaceRow) string { return n.Status }, Style: func(n namespaceRow, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(n.Status), true
+		}},
+		{Name: "PODS", Value: func(n namespaceRow) string { return fmt.Sprintf("%d", n.Usage.Pods.Total()) }},
+		{Name: "RUNNING", Value: func(n namespaceRow) string { return fmt.Sprintf("%d", n.Usage.Pods.Running) }},
+		{Name: "PENDING", Value: func(n namespaceRow) string { return fmt.Sprintf("%d", n.Usage.Pods.Pending) }},
+		{Name: "FAILED", Value: func(n namespaceRow) string { return fmt.Sprintf("%d", n.Usage.Pods.Failed) }},
+		// Color the quota by its most consumed resource
+		{Name: "QUOTA", Value: func(n namespaceRow) string { return n.FormatQuota() }, Style: func(n namespaceRow, t *theme.Theme) (lipgloss.Style, bool) {
+			if top := n.Usage.TopQuota(); top != nil {
+				return t.GetUsageStyle(int(top.Fraction * 100)), true
+			}
+			return lipgloss.Style{}, false
+		}},
+		{Name: "AGE", Value: func(n namespaceRow) string { return n.FormatAge() }},
+	},
+	Default: []string{"NAME", "STATUS", "PODS", "RUNNING", "PENDING", "FAILED", "QUOTA", "AGE"},
+	Object: func(n namespaceRow) metav1.Object {
+		if n.Object == nil {
+			return nil
+		}
+		return n.Object
+	},
+})
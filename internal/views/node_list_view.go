@@ -0,0 +1,243 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeListView represents the node list view
+type NodeListView struct {
+	nodes       []models.Node
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	message     string // outcome of the last node action, shown in the status bar
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Node]
+	// metricsAvailable shows the usage columns; they are hidden when metrics-server is absent
+	metricsAvailable bool
+}
+
+// NewNodeListView creates a new node list view
+func NewNodeListView(nodes []models.Node, theme *theme.Theme, clusterName string) *NodeListView {
+	return &NodeListView{
+		nodes:       nodes,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     nodeColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (nlv *NodeListView) SetSize(width, height int) {
+	nlv.width = width
+	nlv.height = height
+}
+
+// SelectNext moves selection to next node
+func (nlv *NodeListView) SelectNext() {
+	if nlv.selected < len(nlv.nodes)-1 {
+		nlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous node
+func (nlv *NodeListView) SelectPrev() {
+	if nlv.selected > 0 {
+		nlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected node
+func (nlv *NodeListView) GetSelected() *models.Node {
+	if len(nlv.nodes) == 0 {
+		return nil
+	}
+	return &nlv.nodes[nlv.selected]
+}
+
+// UpdateNodes updates the nodes data
+func (nlv *NodeListView) UpdateNodes(nodes []models.Node) {
+	nlv.nodes = nodes
+	// Reset selection if current selection is out of bounds
+	if nlv.selected >= len(nlv.nodes) {
+		nlv.selected = 0
+	}
+}
+
+// SetMetricsAvailable shows or hides the CPU and memory usage columns
+func (nlv *NodeListView) SetMetricsAvailable(available bool) {
+	nlv.metricsAvailable = available
+}
+
+// MetricsAvailable reports whether the usage columns are shown
+func (nlv *NodeListView) MetricsAvailable() bool {
+	return nlv.metricsAvailable
+}
+
+// SetStatusMessage sets the message shown in the status bar, such as the outcome of a cordon
+func (nlv *NodeListView) SetStatusMessage(message string) {
+	nlv.message = message
+}
+
+// StatusMessage returns the message shown in the status bar (for testing)
+func (nlv *NodeListView) StatusMessage() string {
+	return nlv.message
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (nlv *NodeListView) OpenColumnChooser() *ColumnChooserView {
+	nlv.chooser = NewColumnChooserView(nlv.columns, nlv.theme)
+	return nlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (nlv *NodeListView) CloseColumnChooser() {
+	nlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (nlv *NodeListView) ColumnChooser() *ColumnChooserView {
+	return nlv.chooser
+}
+
+// Render renders the complete node list view
+func (nlv *NodeListView) Render() string {
+	if nlv.width == 0 || nlv.height == 0 {
+		return ""
+	}
+
+	// Node table
+	var table string
+	if nlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		nlv.chooser.SetSize(nlv.width, nlv.height-1)
+		table = nlv.chooser.Render()
+	} else {
+		table = nlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := nlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the node table
+func (nlv *NodeListView) renderTable() string {
+	if len(nlv.nodes) == 0 {
+		return lipgloss.NewStyle().Foreground(nlv.theme.TextMuted).Render("No nodes found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := nlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(nlv.columns, nlv.nodes, nlv.selected, tableHeight, nlv.metricsAvailable, nlv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (nlv *NodeListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d nodes | 'd' describe | 'enter' pods on node | 'c'/'u' cordon/uncordon | 'D' drain", len(nlv.nodes))
+	if nlv.message != "" {
+		statusText += " | " + nlv.message
+	}
+	return nlv.theme.StatusBarStyle.Width(nlv.width).Render(statusText)
+}
+
+// Nodes returns the list of nodes (for testing)
+func (nlv *NodeListView) Nodes() []models.Node {
+	return nlv.nodes
+}
+
+// nodeColumns are the columns the node list can show. The usage columns show CPU and memory usage and their
+// percentage of the node's allocatable resources.
+var nodeColumns = registerColumnCatalog(&ColumnCatalog[models.Node]{
+	Resource: "nodes",
+	Columns: []Column[models.Node]{
+		{Name: "NAME", Value: func(n models.Node) string { return n.Name }},
+		{Name: "STATUS", Value: func(n models.Node) string { return n.DisplayStatus() }, Style: func(n models.Node, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(n.Status), true
+		}},
+		{Name: "PRESSURE", Value: func(n models.Node) string {
+			if len(n.Pressure) == 0 {
+				return "-"
+			}
+			return strings.Join(n.Pressure, ",")
+		}, Style: func(n models.Node, t *theme.Theme) (lipgloss.Style, bool) {
+			// Flag nodes under pressure
+			return t.StatusPendingStyle, len(n.Pressure) > 0
+		}},
+		{Name: "ROLES", Value: func(n models.Node) string { return n.DisplayRoles() }},
+		{Name: "VERSION", Value: func(n models.Node) string { return n.Version }},
+		{Name: "AGE", Value: func(n models.Node) string { return n.FormatAge() }},
+		{Name: "CPU", Value: func(n models.Node) string { return n.CPUAllocatable }},
+		{Name: "MEMORY", Value: func(n models.Node) string { return n.MemoryAllocatable }},
+		{Name: "PODS", Value: func(n models.Node) string { return fmt.Sprintf("%d/%s", n.Pods, n.PodCapacity) }},
+		{Name: "INTERNAL-IP", Value: func(n models.Node) string { return orNone(n.InternalIP) }},
+		{Name: "OS-IMAGE", Value: func(n models.Node) string { return n.OSImage }},
+		{Name: "CONTAINER-RUNTIME", Value: func(n models.Node) string { return n.Runtime }},
+		{Name: "TAINTS", Value: func(n models.Node) string { return orNone(strings.Join(n.Taints, ",")) }},
+		nodeUsageColumn("CPU USED", func(n models.Node) string { return n.Usage.FormatCPU() }, nil),
+		nodeUsageColumn("%CPU", nil, func(n models.Node) (int64, int64) { return n.Usage.CPUMilli, n.CPUAllocatableMilli }),
+		nodeUsageColumn("MEM USED", func(n models.Node) string { return n.Usage.FormatMemory() }, nil),
+		nodeUsageColumn("%MEM", nil, func(n models.Node) (int64, int64) { return n.Usage.MemoryBytes, n.MemoryAllocatableBytes }),
+	},
+	Default: []string{"NAME", "STATUS", "PRESSURE", "ROLES", "VERSION", "AGE", "CPU", "MEMORY", "PODS", "CPU USED", "%CPU", "MEM USED", "%MEM"},
+	Object: func(n models.Node) metav1.Object {
+		if n.Object == nil {
+			return nil
+		}
+		return n.Object
+	},
+})
+
+// nodeUsageColumn returns a usage column, showing either a usage value or a usage as a percentage of allocatable,
+// colored by the theme's thresholds. Nodes without a metrics sample show "-".
+func nodeUsageColumn(name string, value func(models.Node) string, percentOf func(models.Node) (int64, int64)) Column[models.Node] {
+	column := Column[models.Node]{Name: name, Metrics: true}
+	if percentOf == nil {
+		column.Value = func(n models.Node) string {
+			if n.Usage == nil {
+				return "-"
+			}
+			return value(n)
+		}
+		return column
+	}
+	column.Value = func(n models.Node) string {
+		if n.Usage == nil {
+			return "-"
+		}
+		return models.FormatUsagePercent(percentOf(n))
+	}
+	column.Style = func(n models.Node, t *theme.Theme) (lipgloss.Style, bool) {
+		if n.Usage == nil {
+			return lipgloss.Style{}, false
+		}
+		percent, ok := models.UsagePercent(percentOf(n))
+		if !ok {
+			return lipgloss.Style{}, false
+		}
+		return t.GetUsageStyle(percent), true
+	}
+	return column
+}
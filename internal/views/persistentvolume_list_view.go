@@ -0,0 +1,166 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PersistentVolumeListView represents the persistent volume list view
+type PersistentVolumeListView struct {
+	persistentVolumes []models.PersistentVolume
+	selected          int
+	width             int
+	height            int
+	theme             *theme.Theme
+	clusterName       string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.PersistentVolume]
+}
+
+// NewPersistentVolumeListView creates a new persistent volume list view
+func NewPersistentVolumeListView(persistentVolumes []models.PersistentVolume, theme *theme.Theme, clusterName string) *PersistentVolumeListView {
+	return &PersistentVolumeListView{
+		persistentVolumes: persistentVolumes,
+		selected:          0,
+		theme:             theme,
+		clusterName:       clusterName,
+		columns:           persistentVolumeColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (pvlv *PersistentVolumeListView) SetSize(width, height int) {
+	pvlv.width = width
+	pvlv.height = height
+}
+
+// SelectNext moves selection to next persistent volume
+func (pvlv *PersistentVolumeListView) SelectNext() {
+	if pvlv.selected < len(pvlv.persistentVolumes)-1 {
+		pvlv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous persistent volume
+func (pvlv *PersistentVolumeListView) SelectPrev() {
+	if pvlv.selected > 0 {
+		pvlv.selected--
+	}
+}
+
+// GetSelected returns the currently selected persistent volume
+func (pvlv *PersistentVolumeListView) GetSelected() *models.PersistentVolume {
+	if len(pvlv.persistentVolumes) == 0 {
+		return nil
+	}
+	return &pvlv.persistentVolumes[pvlv.selected]
+}
+
+// UpdatePersistentVolumes updates the persistent volumes data
+func (pvlv *PersistentVolumeListView) UpdatePersistentVolumes(persistentVolumes []models.PersistentVolume) {
+	pvlv.persistentVolumes = persistentVolumes
+	// Reset selection if current selection is out of bounds
+	if pvlv.selected >= len(pvlv.persistentVolumes) {
+		pvlv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (pvlv *PersistentVolumeListView) OpenColumnChooser() *ColumnChooserView {
+	pvlv.chooser = NewColumnChooserView(pvlv.columns, pvlv.theme)
+	return pvlv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (pvlv *PersistentVolumeListView) CloseColumnChooser() {
+	pvlv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (pvlv *PersistentVolumeListView) ColumnChooser() *ColumnChooserView {
+	return pvlv.chooser
+}
+
+// Render renders the complete persistent volume list view
+func (pvlv *PersistentVolumeListView) Render() string {
+	if pvlv.width == 0 || pvlv.height == 0 {
+		return ""
+	}
+
+	// Persistent volume table
+	var table string
+	if pvlv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		pvlv.chooser.SetSize(pvlv.width, pvlv.height-1)
+		table = pvlv.chooser.Render()
+	} else {
+		table = pvlv.renderTable()
+	}
+
+	// Status bar
+	statusBar := pvlv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the persistent volume table
+func (pvlv *PersistentVolumeListView) renderTable() string {
+	if len(pvlv.persistentVolumes) == 0 {
+		return lipgloss.NewStyle().Foreground(pvlv.theme.TextMuted).Render("No persistent volumes found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := pvlv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(pvlv.columns, pvlv.persistentVolumes, pvlv.selected, tableHeight, false, pvlv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (pvlv *PersistentVolumeListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d persistent volumes | Press 'd' to describe | Press 'c' to describe the bound claim", len(pvlv.persistentVolumes))
+	return pvlv.theme.StatusBarStyle.Width(pvlv.width).Render(statusText)
+}
+
+// Persistent volumes returns the list of persistent volumes (for testing)
+func (pvlv *PersistentVolumeListView) PersistentVolumes() []models.PersistentVolume {
+	return pvlv.persistentVolumes
+}
+
+// persistentVolumeColumns are the columns the persistent volume list can show
+var persistentVolumeColumns = registerColumnCatalog(&ColumnCatalog[models.PersistentVolume]{
+	Resource: "pv",
+	Columns: []Column[models.PersistentVolume]{
+		{Name: "NAME", Value: func(p models.PersistentVolume) string { return p.Name }},
+		{Name: "CAPACITY", Value: func(p models.PersistentVolume) string { return p.Capacity }},
+		{Name: "ACCESS MODES", Value: func(p models.PersistentVolume) string { return p.FormatAccessModes() }},
+		{Name: "RECLAIM POLICY", Value: func(p models.PersistentVolume) string { return p.ReclaimPolicy }},
+		{Name: "STATUS", Value: func(p models.PersistentVolume) string { return p.Status }, Style: func(p models.PersistentVolume, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(p.Status), true
+		}},
+		{Name: "CLAIM", Value: func(p models.PersistentVolume) string { return p.FormatClaim() }},
+		{Name: "STORAGECLASS", Value: func(p models.PersistentVolume) string { return p.FormatStorageClass() }},
+		{Name: "AGE", Value: func(p models.PersistentVolume) string { return p.FormatAge() }},
+	},
+	Default: []string{"NAME", "CAPACITY", "ACCESS MODES", "RECLAIM POLICY", "STATUS", "CLAIM", "STORAGECLASS", "AGE"},
+	Object: func(p models.PersistentVolume) metav1.Object {
+		if p.Object == nil {
+			return nil
+		}
+		return p.Object
+	},
+})
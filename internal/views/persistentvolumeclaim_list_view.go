@@ -0,0 +1,166 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PersistentVolumeClaimListView represents the persistent volume claim list view
+type PersistentVolumeClaimListView struct {
+	persistentVolumeClaims []models.PersistentVolumeClaim
+	selected               int
+	width                  int
+	height                 int
+	theme                  *theme.Theme
+	clusterName            string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.PersistentVolumeClaim]
+}
+
+// NewPersistentVolumeClaimListView creates a new persistent volume claim list view
+func NewPersistentVolumeClaimListView(persistentVolumeClaims []models.PersistentVolumeClaim, theme *theme.Theme, clusterName string) *PersistentVolumeClaimListView {
+	return &PersistentVolumeClaimListView{
+		persistentVolumeClaims: persistentVolumeClaims,
+		selected:               0,
+		theme:                  theme,
+		clusterName:            clusterName,
+		columns:                persistentVolumeClaimColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (pvclv *PersistentVolumeClaimListView) SetSize(width, height int) {
+	pvclv.width = width
+	pvclv.height = height
+}
+
+// SelectNext moves selection to next persistent volume claim
+func (pvclv *PersistentVolumeClaimListView) SelectNext() {
+	if pvclv.selected < len(pvclv.persistentVolumeClaims)-1 {
+		pvclv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous persistent volume claim
+func (pvclv *PersistentVolumeClaimListView) SelectPrev() {
+	if pvclv.selected > 0 {
+		pvclv.selected--
+	}
+}
+
+// GetSelected returns the currently selected persistent volume claim
+func (pvclv *PersistentVolumeClaimListView) GetSelected() *models.PersistentVolumeClaim {
+	if len(pvclv.persistentVolumeClaims) == 0 {
+		return nil
+	}
+	return &pvclv.persistentVolumeClaims[pvclv.selected]
+}
+
+// UpdatePersistentVolumeClaims updates the persistent volume claims data
+func (pvclv *PersistentVolumeClaimListView) UpdatePersistentVolumeClaims(persistentVolumeClaims []models.PersistentVolumeClaim) {
+	pvclv.persistentVolumeClaims = persistentVolumeClaims
+	// Reset selection if current selection is out of bounds
+	if pvclv.selected >= len(pvclv.persistentVolumeClaims) {
+		pvclv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (pvclv *PersistentVolumeClaimListView) OpenColumnChooser() *ColumnChooserView {
+	pvclv.chooser = NewColumnChooserView(pvclv.columns, pvclv.theme)
+	return pvclv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (pvclv *PersistentVolumeClaimListView) CloseColumnChooser() {
+	pvclv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (pvclv *PersistentVolumeClaimListView) ColumnChooser() *ColumnChooserView {
+	return pvclv.chooser
+}
+
+// Render renders the complete persistent volume claim list view
+func (pvclv *PersistentVolumeClaimListView) Render() string {
+	if pvclv.width == 0 || pvclv.height == 0 {
+		return ""
+	}
+
+	// Persistent volume claim table
+	var table string
+	if pvclv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		pvclv.chooser.SetSize(pvclv.width, pvclv.height-1)
+		table = pvclv.chooser.Render()
+	} else {
+		table = pvclv.renderTable()
+	}
+
+	// Status bar
+	statusBar := pvclv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the persistent volume claim table
+func (pvclv *PersistentVolumeClaimListView) renderTable() string {
+	if len(pvclv.persistentVolumeClaims) == 0 {
+		return lipgloss.NewStyle().Foreground(pvclv.theme.TextMuted).Render("No persistent volume claims found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := pvclv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(pvclv.columns, pvclv.persistentVolumeClaims, pvclv.selected, tableHeight, false, pvclv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (pvclv *PersistentVolumeClaimListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d persistent volume claims | Press 'd' to describe | Press 'v' to describe the bound volume | Press 'x' for x-ray", len(pvclv.persistentVolumeClaims))
+	return pvclv.theme.StatusBarStyle.Width(pvclv.width).Render(statusText)
+}
+
+// Persistent volume claims returns the list of persistent volume claims (for testing)
+func (pvclv *PersistentVolumeClaimListView) PersistentVolumeClaims() []models.PersistentVolumeClaim {
+	return pvclv.persistentVolumeClaims
+}
+
+// persistentVolumeClaimColumns are the columns the persistent volume claim list can show
+var persistentVolumeClaimColumns = registerColumnCatalog(&ColumnCatalog[models.PersistentVolumeClaim]{
+	Resource: "pvc",
+	Columns: []Column[models.PersistentVolumeClaim]{
+		{Name: "NAME", Value: func(c models.PersistentVolumeClaim) string { return c.Name }},
+		{Name: "NAMESPACE", Value: func(c models.PersistentVolumeClaim) string { return c.Namespace }},
+		{Name: "STATUS", Value: func(c models.PersistentVolumeClaim) string { return c.Status }, Style: func(c models.PersistentVolumeClaim, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(c.Status), true
+		}},
+		{Name: "VOLUME", Value: func(c models.PersistentVolumeClaim) string { return c.Volume }},
+		{Name: "CAPACITY", Value: func(c models.PersistentVolumeClaim) string { return c.Capacity }},
+		{Name: "ACCESS MODES", Value: func(c models.PersistentVolumeClaim) string { return c.FormatAccessModes() }},
+		{Name: "STORAGECLASS", Value: func(c models.PersistentVolumeClaim) string { return c.FormatStorageClass() }},
+		{Name: "AGE", Value: func(c models.PersistentVolumeClaim) string { return c.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "STATUS", "VOLUME", "CAPACITY", "ACCESS MODES", "STORAGECLASS", "AGE"},
+	Object: func(c models.PersistentVolumeClaim) metav1.Object {
+		if c.Object == nil {
+			return nil
+		}
+		return c.Object
+	},
+})
@@ -0,0 +1,65 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+)
+
+// plainTableGap separates the columns of a plain table, as kubectl separates them
+const plainTableGap = "   "
+
+// PodTable renders pods in the configured pod columns as a plain text table, for output outside the TUI
+func PodTable(pods []models.Pod) string {
+	return renderPlainTable(podColumns.NewColumnSet(), pods)
+}
+
+// DeploymentTable renders deployments in the configured deployment columns as a plain text table
+func DeploymentTable(deployments []models.Deployment) string {
+	return renderPlainTable(deploymentColumns.NewColumnSet(), deployments)
+}
+
+// NodeTable renders nodes in the configured node columns as a plain text table
+func NodeTable(nodes []models.Node) string {
+	return renderPlainTable(nodeColumns.NewColumnSet(), nodes)
+}
+
+// renderPlainTable renders rows as kubectl get does: a header line and a line per row, with the cells of each column
+// padded to the widest, and no borders or colors. Metrics columns are left out as no usage is fetched.
+func renderPlainTable[T any](columns *ColumnSet[T], rows []T) string {
+	shown := columns.Visible(false)
+
+	lines := [][]string{columns.Headers(false)}
+	for _, row := range rows {
+		cells, _ := columns.cells(row, shown)
+		for i, column := range shown {
+			if column.Width > 0 {
+				cells[i] = valuePreview(cells[i], column.Width)
+			}
+		}
+		lines = append(lines, cells)
+	}
+
+	widths := make([]int, len(shown))
+	for _, line := range lines {
+		for i, cell := range line {
+			widths[i] = max(widths[i], lipgloss.Width(cell))
+		}
+	}
+
+	var out strings.Builder
+	for _, line := range lines {
+		var text strings.Builder
+		for i, cell := range line {
+			if i > 0 {
+				text.WriteString(plainTableGap)
+			}
+			text.WriteString(cell)
+			text.WriteString(strings.Repeat(" ", widths[i]-lipgloss.Width(cell)))
+		}
+		out.WriteString(strings.TrimRight(text.String(), " "))
+		out.WriteString("\n")
+	}
+	return out.String()
+}
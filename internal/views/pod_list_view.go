@@ -2,11 +2,12 @@ package views
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/lipgloss/table"
 	"github.com/kevholditch/vigilant/internal/models"
 	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PodListView represents the pod list view
@@ -17,6 +18,12 @@ type PodListView struct {
 	height      int
 	theme       *theme.Theme
 	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Pod]
+	// metricsAvailable shows the usage columns; they are hidden when metrics-server is absent
+	metricsAvailable bool
 }
 
 // NewPodListView creates a new pod list view
@@ -26,9 +33,17 @@ func NewPodListView(pods []models.Pod, theme *theme.Theme, clusterName string) *
 		selected:    0,
 		theme:       theme,
 		clusterName: clusterName,
+		columns:     podColumns.NewColumnSet(),
 	}
 }
 
+// NewClusterPodListView creates a pod list view for the multi-cluster views, showing the cluster of each pod first
+func NewClusterPodListView(pods []models.Pod, theme *theme.Theme) *PodListView {
+	view := NewPodListView(pods, theme, "")
+	view.columns.ShowFirst(clusterColumn)
+	return view
+}
+
 // SetSize sets the view dimensions
 func (plv *PodListView) SetSize(width, height int) {
 	plv.width = width
@@ -57,6 +72,16 @@ func (plv *PodListView) GetSelected() *models.Pod {
 	return &plv.pods[plv.selected]
 }
 
+// SetMetricsAvailable shows or hides the CPU and memory usage columns
+func (plv *PodListView) SetMetricsAvailable(available bool) {
+	plv.metricsAvailable = available
+}
+
+// MetricsAvailable reports whether the usage columns are shown
+func (plv *PodListView) MetricsAvailable() bool {
+	return plv.metricsAvailable
+}
+
 // UpdatePods updates the pods data
 func (plv *PodListView) UpdatePods(pods []models.Pod) {
 	plv.pods = pods
@@ -66,6 +91,22 @@ func (plv *PodListView) UpdatePods(pods []models.Pod) {
 	}
 }
 
+// OpenColumnChooser opens the column chooser in place of the table
+func (plv *PodListView) OpenColumnChooser() *ColumnChooserView {
+	plv.chooser = NewColumnChooserView(plv.columns, plv.theme)
+	return plv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (plv *PodListView) CloseColumnChooser() {
+	plv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (plv *PodListView) ColumnChooser() *ColumnChooserView {
+	return plv.chooser
+}
+
 // Render renders the complete pod list view
 func (plv *PodListView) Render() string {
 	if plv.width == 0 || plv.height == 0 {
@@ -73,7 +114,14 @@ func (plv *PodListView) Render() string {
 	}
 
 	// Pod table
-	table := plv.renderTable()
+	var table string
+	if plv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		plv.chooser.SetSize(plv.width, plv.height-1)
+		table = plv.chooser.Render()
+	} else {
+		table = plv.renderTable()
+	}
 
 	// Status bar
 	statusBar := plv.renderStatusBar()
@@ -94,68 +142,17 @@ func (plv *PodListView) renderTable() string {
 		return lipgloss.NewStyle().Foreground(plv.theme.TextMuted).Render("No pods found")
 	}
 
-	// Create table headers
-	headers := []string{"NAME", "NAMESPACE", "STATUS", "READY", "RESTARTS", "AGE", "IP", "NODE"}
-
-	// Create table rows
-	var rows [][]string
-	for _, pod := range plv.pods {
-		row := []string{
-			pod.Name,
-			pod.Namespace,
-			pod.Status,
-			pod.Ready,
-			fmt.Sprintf("%d", pod.Restarts),
-			pod.FormatAge(),
-			pod.IP,
-			pod.Node,
-		}
-		rows = append(rows, row)
-	}
-
-	// Create the table
-	t := table.New().
-		Headers(headers...).
-		Rows(rows...).
-		Border(lipgloss.RoundedBorder()).
-		BorderStyle(lipgloss.NewStyle().Foreground(plv.theme.Primary)).
-		StyleFunc(func(row, col int) lipgloss.Style {
-
-			isSelected := row == plv.selected
-
-			var style lipgloss.Style
-			if isSelected {
-				style = plv.theme.TableSelectedStyle
-			} else if (row-1)%2 == 1 {
-				// Alternate row style
-				style = plv.theme.TableRowAltStyle
-			} else {
-				style = plv.theme.TableRowStyle
-			}
-
-			// Apply status styling for status column (col 2)
-			podIndex := row - 1
-			if col == 2 && !isSelected && podIndex >= 0 && podIndex < len(plv.pods) {
-				pod := plv.pods[podIndex]
-				style = style.Inherit(plv.theme.GetStatusStyle(pod.Status))
-			}
-
-			return style
-		})
-
 	// available height for table is parent height - status bar height - table border - table header
 	tableHeight := plv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
 	if tableHeight < 0 {
 		tableHeight = 0
 	}
-	t.Height(tableHeight)
-
-	return t.Render()
+	return renderColumnTable(plv.columns, plv.pods, plv.selected, tableHeight, plv.metricsAvailable, plv.theme)
 }
 
 // renderStatusBar renders the status bar at the bottom
 func (plv *PodListView) renderStatusBar() string {
-	statusText := fmt.Sprintf("Total: %d pods | Press 'd' to describe | Press 'l' to view logs", len(plv.pods))
+	statusText := fmt.Sprintf("Total: %d pods | Press 'd' to describe | Press 'l' to view logs | Press 'x' for x-ray", len(plv.pods))
 	return plv.theme.StatusBarStyle.Width(plv.width).Render(statusText)
 }
 
@@ -163,3 +160,86 @@ func (plv *PodListView) renderStatusBar() string {
 func (plv *PodListView) Pods() []models.Pod {
 	return plv.pods
 }
+
+// podColumns are the columns the pod list can show. The usage columns show CPU and memory usage and their
+// percentage of the pod's requests and limits.
+var podColumns = registerColumnCatalog(&ColumnCatalog[models.Pod]{
+	Resource: "pods",
+	Columns: []Column[models.Pod]{
+		{Name: "NAME", Value: func(p models.Pod) string { return p.Name }},
+		{Name: "NAMESPACE", Value: func(p models.Pod) string { return p.Namespace }},
+		{Name: clusterColumn, Value: func(p models.Pod) string { return orNone(p.Cluster) }},
+		{Name: "STATUS", Value: func(p models.Pod) string { return p.Status }, Style: func(p models.Pod, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.GetStatusStyle(p.Status), true
+		}},
+		{Name: "READY", Value: func(p models.Pod) string { return p.Ready }},
+		{Name: "RESTARTS", Value: func(p models.Pod) string { return fmt.Sprintf("%d", p.Restarts) }},
+		{Name: "AGE", Value: func(p models.Pod) string { return p.FormatAge() }},
+		{Name: "IP", Value: func(p models.Pod) string { return p.IP }},
+		{Name: "NODE", Value: func(p models.Pod) string { return p.Node }},
+		{Name: "CONTAINERS", Value: func(p models.Pod) string {
+			names := make([]string, len(p.Containers))
+			for i, container := range p.Containers {
+				names[i] = container.Name
+			}
+			return strings.Join(names, ",")
+		}},
+		{Name: "IMAGE", Value: func(p models.Pod) string { return strings.Join(p.Images, ",") }},
+		{Name: "QOS", Value: func(p models.Pod) string { return p.QOSClass }},
+		{Name: "NOMINATED NODE", Value: func(p models.Pod) string { return orNone(p.NominatedNode) }},
+		{Name: "READINESS GATES", Value: func(p models.Pod) string { return orNone(p.ReadinessGates) }},
+		podUsageColumn("CPU", func(p models.Pod) string { return p.Usage.FormatCPU() }, nil),
+		podUsageColumn("%CPU/R", nil, func(p models.Pod) (int64, int64) { return p.Usage.CPUMilli, p.CPURequestMilli }),
+		podUsageColumn("%CPU/L", nil, func(p models.Pod) (int64, int64) { return p.Usage.CPUMilli, p.CPULimitMilli }),
+		podUsageColumn("MEM", func(p models.Pod) string { return p.Usage.FormatMemory() }, nil),
+		podUsageColumn("%MEM/R", nil, func(p models.Pod) (int64, int64) { return p.Usage.MemoryBytes, p.MemoryRequestBytes }),
+		podUsageColumn("%MEM/L", nil, func(p models.Pod) (int64, int64) { return p.Usage.MemoryBytes, p.MemoryLimitBytes }),
+	},
+	Default: []string{"NAME", "NAMESPACE", "STATUS", "READY", "RESTARTS", "AGE", "IP", "NODE", "CPU", "%CPU/R", "%CPU/L", "MEM", "%MEM/R", "%MEM/L"},
+	Object: func(p models.Pod) metav1.Object {
+		if p.Object == nil {
+			return nil
+		}
+		return p.Object
+	},
+})
+
+// podUsageColumn returns a usage column, showing either a usage value or a usage as a percentage of a request or
+// limit, colored by the theme's thresholds. Pods without a metrics sample show "-".
+func podUsageColumn(name string, value func(models.Pod) string, percentOf func(models.Pod) (int64, int64)) Column[models.Pod] {
+	column := Column[models.Pod]{Name: name, Metrics: true}
+	if percentOf == nil {
+		column.Value = func(p models.Pod) string {
+			if p.Usage == nil {
+				return "-"
+			}
+			return value(p)
+		}
+		return column
+	}
+	column.Value = func(p models.Pod) string {
+		if p.Usage == nil {
+			return "-"
+		}
+		return models.FormatUsagePercent(percentOf(p))
+	}
+	column.Style = func(p models.Pod, t *theme.Theme) (lipgloss.Style, bool) {
+		if p.Usage == nil {
+			return lipgloss.Style{}, false
+		}
+		percent, ok := models.UsagePercent(percentOf(p))
+		if !ok {
+			return lipgloss.Style{}, false
+		}
+		return t.GetUsageStyle(percent), true
+	}
+	return column
+}
+
+// orNone returns the value, or <none> when it is empty, as kubectl shows unset optional fields
+func orNone(value string) string {
+	if value == "" {
+		return noValue
+	}
+	return value
+}
@@ -0,0 +1,162 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretListView represents the secret list view
+type SecretListView struct {
+	secrets     []models.Secret
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Secret]
+}
+
+// NewSecretListView creates a new secret list view
+func NewSecretListView(secrets []models.Secret, theme *theme.Theme, clusterName string) *SecretListView {
+	return &SecretListView{
+		secrets:     secrets,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     secretColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (slv *SecretListView) SetSize(width, height int) {
+	slv.width = width
+	slv.height = height
+}
+
+// SelectNext moves selection to next secret
+func (slv *SecretListView) SelectNext() {
+	if slv.selected < len(slv.secrets)-1 {
+		slv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous secret
+func (slv *SecretListView) SelectPrev() {
+	if slv.selected > 0 {
+		slv.selected--
+	}
+}
+
+// GetSelected returns the currently selected secret
+func (slv *SecretListView) GetSelected() *models.Secret {
+	if len(slv.secrets) == 0 {
+		return nil
+	}
+	return &slv.secrets[slv.selected]
+}
+
+// UpdateSecrets updates the secrets data
+func (slv *SecretListView) UpdateSecrets(secrets []models.Secret) {
+	slv.secrets = secrets
+	// Reset selection if current selection is out of bounds
+	if slv.selected >= len(slv.secrets) {
+		slv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (slv *SecretListView) OpenColumnChooser() *ColumnChooserView {
+	slv.chooser = NewColumnChooserView(slv.columns, slv.theme)
+	return slv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (slv *SecretListView) CloseColumnChooser() {
+	slv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (slv *SecretListView) ColumnChooser() *ColumnChooserView {
+	return slv.chooser
+}
+
+// Render renders the complete secret list view
+func (slv *SecretListView) Render() string {
+	if slv.width == 0 || slv.height == 0 {
+		return ""
+	}
+
+	// Secret table
+	var table string
+	if slv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		slv.chooser.SetSize(slv.width, slv.height-1)
+		table = slv.chooser.Render()
+	} else {
+		table = slv.renderTable()
+	}
+
+	// Status bar
+	statusBar := slv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the secret table
+func (slv *SecretListView) renderTable() string {
+	if len(slv.secrets) == 0 {
+		return lipgloss.NewStyle().Foreground(slv.theme.TextMuted).Render("No secrets found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := slv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(slv.columns, slv.secrets, slv.selected, tableHeight, false, slv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (slv *SecretListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d secrets | Press 'd' or 'enter' to describe | Press 'x' for x-ray", len(slv.secrets))
+	return slv.theme.StatusBarStyle.Width(slv.width).Render(statusText)
+}
+
+// Secrets returns the list of secrets (for testing)
+func (slv *SecretListView) Secrets() []models.Secret {
+	return slv.secrets
+}
+
+// secretColumns are the columns the secret list can show
+var secretColumns = registerColumnCatalog(&ColumnCatalog[models.Secret]{
+	Resource: "secrets",
+	Columns: []Column[models.Secret]{
+		{Name: "NAME", Value: func(s models.Secret) string { return s.Name }},
+		{Name: "NAMESPACE", Value: func(s models.Secret) string { return s.Namespace }},
+		{Name: "TYPE", Value: func(s models.Secret) string { return s.Type }},
+		{Name: "DATA", Value: func(s models.Secret) string { return fmt.Sprintf("%d", s.KeyCount()) }},
+		{Name: "AGE", Value: func(s models.Secret) string { return s.FormatAge() }},
+		{Name: "IMMUTABLE", Value: func(s models.Secret) string { return fmt.Sprintf("%t", s.Immutable) }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "TYPE", "DATA", "AGE"},
+	Object: func(s models.Secret) metav1.Object {
+		if s.Object == nil {
+			return nil
+		}
+		return s.Object
+	},
+})
@@ -0,0 +1,168 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceListView represents the service list view
+type ServiceListView struct {
+	services    []models.Service
+	selected    int
+	width       int
+	height      int
+	theme       *theme.Theme
+	clusterName string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.Service]
+}
+
+// NewServiceListView creates a new service list view
+func NewServiceListView(services []models.Service, theme *theme.Theme, clusterName string) *ServiceListView {
+	return &ServiceListView{
+		services:    services,
+		selected:    0,
+		theme:       theme,
+		clusterName: clusterName,
+		columns:     serviceColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (slv *ServiceListView) SetSize(width, height int) {
+	slv.width = width
+	slv.height = height
+}
+
+// SelectNext moves selection to next service
+func (slv *ServiceListView) SelectNext() {
+	if slv.selected < len(slv.services)-1 {
+		slv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous service
+func (slv *ServiceListView) SelectPrev() {
+	if slv.selected > 0 {
+		slv.selected--
+	}
+}
+
+// GetSelected returns the currently selected service
+func (slv *ServiceListView) GetSelected() *models.Service {
+	if len(slv.services) == 0 {
+		return nil
+	}
+	return &slv.services[slv.selected]
+}
+
+// UpdateServices updates the services data
+func (slv *ServiceListView) UpdateServices(services []models.Service) {
+	slv.services = services
+	// Reset selection if current selection is out of bounds
+	if slv.selected >= len(slv.services) {
+		slv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (slv *ServiceListView) OpenColumnChooser() *ColumnChooserView {
+	slv.chooser = NewColumnChooserView(slv.columns, slv.theme)
+	return slv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (slv *ServiceListView) CloseColumnChooser() {
+	slv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (slv *ServiceListView) ColumnChooser() *ColumnChooserView {
+	return slv.chooser
+}
+
+// Render renders the complete service list view
+func (slv *ServiceListView) Render() string {
+	if slv.width == 0 || slv.height == 0 {
+		return ""
+	}
+
+	// Service table
+	var table string
+	if slv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		slv.chooser.SetSize(slv.width, slv.height-1)
+		table = slv.chooser.Render()
+	} else {
+		table = slv.renderTable()
+	}
+
+	// Status bar
+	statusBar := slv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the service table
+func (slv *ServiceListView) renderTable() string {
+	if len(slv.services) == 0 {
+		return lipgloss.NewStyle().Foreground(slv.theme.TextMuted).Render("No services found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := slv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(slv.columns, slv.services, slv.selected, tableHeight, false, slv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (slv *ServiceListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d services | Press 'd' to describe | Press 'enter' to view pods matching the selector | Press 'x' for x-ray", len(slv.services))
+	return slv.theme.StatusBarStyle.Width(slv.width).Render(statusText)
+}
+
+// Services returns the list of services (for testing)
+func (slv *ServiceListView) Services() []models.Service {
+	return slv.services
+}
+
+// serviceColumns are the columns the service list can show
+var serviceColumns = registerColumnCatalog(&ColumnCatalog[models.Service]{
+	Resource: "services",
+	Columns: []Column[models.Service]{
+		{Name: "NAME", Value: func(s models.Service) string { return s.Name }},
+		{Name: "NAMESPACE", Value: func(s models.Service) string { return s.Namespace }},
+		{Name: "TYPE", Value: func(s models.Service) string { return s.Type }},
+		{Name: "CLUSTER-IP", Value: func(s models.Service) string { return s.ClusterIP }},
+		// Flag load balancers still waiting for an address
+		{Name: "EXTERNAL-IP", Value: func(s models.Service) string { return s.FormatExternalIPs() }, Style: func(s models.Service, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.StatusPendingStyle, s.LoadBalancerPending
+		}},
+		{Name: "PORTS", Value: func(s models.Service) string { return s.FormatPorts() }},
+		{Name: "SELECTOR", Value: func(s models.Service) string { return s.FormatSelector() }},
+		{Name: "AGE", Value: func(s models.Service) string { return s.FormatAge() }},
+		{Name: "SESSION-AFFINITY", Value: func(s models.Service) string { return s.SessionAffinity }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORTS", "SELECTOR", "AGE"},
+	Object: func(s models.Service) metav1.Object {
+		if s.Object == nil {
+			return nil
+		}
+		return s.Object
+	},
+})
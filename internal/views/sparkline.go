@@ -0,0 +1,76 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// sparkBlocks are the eighth-height block characters used to draw bars, from empty to full
+var sparkBlocks = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// sparkThreshold is drawn across the chart at the level of the limit
+const sparkThreshold = '┈'
+
+// sparkline renders values as a bar chart height rows tall, one column per value.
+// Bars are scaled against the larger of the highest value and limit; when limit is positive it is drawn
+// as a threshold line and each bar is colored by its percentage of the limit using the theme's thresholds.
+func sparkline(values []int64, limit int64, height int, t *theme.Theme) string {
+	if len(values) == 0 || height <= 0 {
+		return ""
+	}
+
+	scale := limit
+	for _, v := range values {
+		if v > scale {
+			scale = v
+		}
+	}
+	if scale <= 0 {
+		scale = 1
+	}
+
+	// Heights are measured in eighths of a row
+	levels := make([]int64, len(values))
+	for i, v := range values {
+		levels[i] = v * int64(height) * 8 / scale
+		if v > 0 && levels[i] == 0 {
+			// Keep non-zero values visible
+			levels[i] = 1
+		}
+	}
+	thresholdRow := -1
+	if limit > 0 {
+		thresholdRow = int((limit*int64(height)*8/scale - 1) / 8)
+	}
+
+	barStyles := make([]lipgloss.Style, len(values))
+	for i, v := range values {
+		barStyles[i] = lipgloss.NewStyle().Foreground(t.Primary)
+		if limit > 0 {
+			barStyles[i] = t.GetUsageStyle(int(v * 100 / limit))
+		}
+	}
+	thresholdStyle := lipgloss.NewStyle().Foreground(t.Error)
+
+	rows := make([]string, 0, height)
+	for row := height - 1; row >= 0; row-- {
+		var line strings.Builder
+		for i, level := range levels {
+			fill := level - int64(row)*8
+			switch {
+			case fill >= 8:
+				line.WriteString(barStyles[i].Render(string(sparkBlocks[8])))
+			case fill > 0:
+				line.WriteString(barStyles[i].Render(string(sparkBlocks[fill])))
+			case row == thresholdRow:
+				line.WriteString(thresholdStyle.Render(string(sparkThreshold)))
+			default:
+				line.WriteRune(sparkBlocks[0])
+			}
+		}
+		rows = append(rows, line.String())
+	}
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,166 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// SplitOrientation is how the two panes of a split are laid out
+type SplitOrientation int
+
+const (
+	// SplitHorizontal places the panes side by side, the first on the left
+	SplitHorizontal SplitOrientation = iota
+	// SplitVertical stacks the panes, the first on top
+	SplitVertical
+)
+
+const (
+	// defaultSplitRatio is the share of the split, as a percentage, given to the first pane
+	defaultSplitRatio = 50
+	// splitRatioStep is how much growing or shrinking a pane changes its share
+	splitRatioStep = 5
+	// minSplitRatio keeps each pane at least this share, so that neither can be shrunk out of sight
+	minSplitRatio = 20
+)
+
+// SplitPane is the rendered content of a pane and the title shown above it
+type SplitPane struct {
+	Title   string
+	Content string
+}
+
+// SplitView lays out two panes side by side or stacked, each under a title bar that shows which pane has focus
+type SplitView struct {
+	theme       *theme.Theme
+	orientation SplitOrientation
+	// ratio is the share of the split, as a percentage, given to the first pane
+	ratio int
+	// focus is the index of the focused pane, 0 or 1
+	focus  int
+	width  int
+	height int
+}
+
+// NewSplitView creates a split view with the panes laid out as orientation, sharing the space equally
+func NewSplitView(orientation SplitOrientation, theme *theme.Theme) *SplitView {
+	return &SplitView{
+		theme:       theme,
+		orientation: orientation,
+		ratio:       defaultSplitRatio,
+	}
+}
+
+// SetSize sets the view dimensions
+func (sv *SplitView) SetSize(width, height int) {
+	sv.width = width
+	sv.height = height
+}
+
+// Orientation returns how the panes are laid out
+func (sv *SplitView) Orientation() SplitOrientation {
+	return sv.orientation
+}
+
+// Rotate switches between placing the panes side by side and stacking them
+func (sv *SplitView) Rotate() {
+	if sv.orientation == SplitHorizontal {
+		sv.orientation = SplitVertical
+	} else {
+		sv.orientation = SplitHorizontal
+	}
+}
+
+// Focus returns the index of the focused pane, 0 or 1
+func (sv *SplitView) Focus() int {
+	return sv.focus
+}
+
+// FocusNext moves focus to the other pane
+func (sv *SplitView) FocusNext() {
+	sv.focus = 1 - sv.focus
+}
+
+// Ratio returns the share of the split, as a percentage, given to the first pane
+func (sv *SplitView) Ratio() int {
+	return sv.ratio
+}
+
+// Grow gives the focused pane a larger share of the split
+func (sv *SplitView) Grow() {
+	sv.resize(splitRatioStep)
+}
+
+// Shrink gives the focused pane a smaller share of the split
+func (sv *SplitView) Shrink() {
+	sv.resize(-splitRatioStep)
+}
+
+// resize changes the share of the focused pane by step, keeping both panes at least minSplitRatio
+func (sv *SplitView) resize(step int) {
+	if sv.focus == 1 {
+		step = -step
+	}
+	sv.ratio = min(max(sv.ratio+step, minSplitRatio), 100-minSplitRatio)
+}
+
+// PaneSize returns the size of the content of a pane, below its title bar
+func (sv *SplitView) PaneSize(pane int) (int, int) {
+	width, height := sv.paneBounds(pane)
+	return width, max(height-1, 1)
+}
+
+// paneBounds returns the size of a pane including its title bar. Side by side, a column between the panes
+// separates them.
+func (sv *SplitView) paneBounds(pane int) (int, int) {
+	if sv.orientation == SplitHorizontal {
+		available := max(sv.width-1, 2)
+		first := max(available*sv.ratio/100, 1)
+		if pane == 0 {
+			return first, sv.height
+		}
+		return max(available-first, 1), sv.height
+	}
+	first := max(sv.height*sv.ratio/100, 2)
+	if pane == 0 {
+		return sv.width, first
+	}
+	return sv.width, max(sv.height-first, 2)
+}
+
+// Render renders the panes, each clipped to its size under its title bar
+func (sv *SplitView) Render(first, second SplitPane) string {
+	if sv.width == 0 || sv.height == 0 {
+		return ""
+	}
+
+	rendered := []string{sv.renderPane(0, first), sv.renderPane(1, second)}
+	if sv.orientation == SplitVertical {
+		return lipgloss.JoinVertical(lipgloss.Left, rendered...)
+	}
+	separator := lipgloss.NewStyle().
+		Foreground(sv.theme.TextMuted).
+		Render(strings.TrimSuffix(strings.Repeat("│\n", sv.height), "\n"))
+	return lipgloss.JoinHorizontal(lipgloss.Top, rendered[0], separator, rendered[1])
+}
+
+// renderPane renders a pane's title bar, highlighted when the pane has focus, above its content
+func (sv *SplitView) renderPane(index int, pane SplitPane) string {
+	width, height := sv.PaneSize(index)
+
+	titleStyle := lipgloss.NewStyle().Foreground(sv.theme.TextMuted).Padding(0, 1)
+	if index == sv.focus {
+		titleStyle = sv.theme.TableSelectedStyle
+	}
+	title := titleStyle.Width(width).MaxWidth(width).MaxHeight(1).Render(pane.Title)
+
+	content := lipgloss.NewStyle().
+		Width(width).
+		Height(height).
+		MaxWidth(width).
+		MaxHeight(height).
+		Render(pane.Content)
+	return lipgloss.JoinVertical(lipgloss.Left, title, content)
+}
@@ -0,0 +1,167 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StatefulSetListView represents the stateful set list view
+type StatefulSetListView struct {
+	statefulSets []models.StatefulSet
+	selected     int
+	width        int
+	height       int
+	theme        *theme.Theme
+	clusterName  string
+	// chooser is the open column chooser, nil when it is closed
+	chooser *ColumnChooserView
+	// columns are the columns shown, chosen in the config file or with the column chooser
+	columns *ColumnSet[models.StatefulSet]
+}
+
+// NewStatefulSetListView creates a new stateful set list view
+func NewStatefulSetListView(statefulSets []models.StatefulSet, theme *theme.Theme, clusterName string) *StatefulSetListView {
+	return &StatefulSetListView{
+		statefulSets: statefulSets,
+		selected:     0,
+		theme:        theme,
+		clusterName:  clusterName,
+		columns:      statefulSetColumns.NewColumnSet(),
+	}
+}
+
+// SetSize sets the view dimensions
+func (sslv *StatefulSetListView) SetSize(width, height int) {
+	sslv.width = width
+	sslv.height = height
+}
+
+// SelectNext moves selection to next stateful set
+func (sslv *StatefulSetListView) SelectNext() {
+	if sslv.selected < len(sslv.statefulSets)-1 {
+		sslv.selected++
+	}
+}
+
+// SelectPrev moves selection to previous stateful set
+func (sslv *StatefulSetListView) SelectPrev() {
+	if sslv.selected > 0 {
+		sslv.selected--
+	}
+}
+
+// GetSelected returns the currently selected stateful set
+func (sslv *StatefulSetListView) GetSelected() *models.StatefulSet {
+	if len(sslv.statefulSets) == 0 {
+		return nil
+	}
+	return &sslv.statefulSets[sslv.selected]
+}
+
+// UpdateStatefulSets updates the stateful sets data
+func (sslv *StatefulSetListView) UpdateStatefulSets(statefulSets []models.StatefulSet) {
+	sslv.statefulSets = statefulSets
+	// Reset selection if current selection is out of bounds
+	if sslv.selected >= len(sslv.statefulSets) {
+		sslv.selected = 0
+	}
+}
+
+// OpenColumnChooser opens the column chooser in place of the table
+func (sslv *StatefulSetListView) OpenColumnChooser() *ColumnChooserView {
+	sslv.chooser = NewColumnChooserView(sslv.columns, sslv.theme)
+	return sslv.chooser
+}
+
+// CloseColumnChooser closes the column chooser, keeping the columns chosen
+func (sslv *StatefulSetListView) CloseColumnChooser() {
+	sslv.chooser = nil
+}
+
+// ColumnChooser returns the open column chooser, or nil when it is closed
+func (sslv *StatefulSetListView) ColumnChooser() *ColumnChooserView {
+	return sslv.chooser
+}
+
+// Render renders the complete stateful set list view
+func (sslv *StatefulSetListView) Render() string {
+	if sslv.width == 0 || sslv.height == 0 {
+		return ""
+	}
+
+	// Stateful set table
+	var table string
+	if sslv.chooser != nil {
+		// The column chooser takes the place of the table while it is open
+		sslv.chooser.SetSize(sslv.width, sslv.height-1)
+		table = sslv.chooser.Render()
+	} else {
+		table = sslv.renderTable()
+	}
+
+	// Status bar
+	statusBar := sslv.renderStatusBar()
+
+	// Combine all components
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		table,
+		statusBar,
+	)
+
+	return content
+}
+
+// renderTable renders the stateful set table
+func (sslv *StatefulSetListView) renderTable() string {
+	if len(sslv.statefulSets) == 0 {
+		return lipgloss.NewStyle().Foreground(sslv.theme.TextMuted).Render("No stateful sets found")
+	}
+
+	// available height for table is parent height - status bar height - table border - table header
+	tableHeight := sslv.height - 1 - 3 // 1 for status bar, 3 for table overhead(border+header)
+	if tableHeight < 0 {
+		tableHeight = 0
+	}
+	return renderColumnTable(sslv.columns, sslv.statefulSets, sslv.selected, tableHeight, false, sslv.theme)
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (sslv *StatefulSetListView) renderStatusBar() string {
+	statusText := fmt.Sprintf("Total: %d stateful sets | Press 'd' to describe | Press 'enter' to view the stateful set's pods | Press 'x' for x-ray", len(sslv.statefulSets))
+	return sslv.theme.StatusBarStyle.Width(sslv.width).Render(statusText)
+}
+
+// StatefulSets returns the list of stateful sets (for testing)
+func (sslv *StatefulSetListView) StatefulSets() []models.StatefulSet {
+	return sslv.statefulSets
+}
+
+// statefulSetColumns are the columns the stateful set list can show
+var statefulSetColumns = registerColumnCatalog(&ColumnCatalog[models.StatefulSet]{
+	Resource: "statefulsets",
+	Columns: []Column[models.StatefulSet]{
+		{Name: "NAME", Value: func(s models.StatefulSet) string { return s.Name }},
+		{Name: "NAMESPACE", Value: func(s models.StatefulSet) string { return s.Namespace }},
+		// Flag stateful sets with replicas not yet ready
+		{Name: "READY", Value: func(s models.StatefulSet) string { return s.FormatReady() }, Style: func(s models.StatefulSet, t *theme.Theme) (lipgloss.Style, bool) {
+			return t.StatusPendingStyle, s.ReadyReplicas < s.Replicas
+		}},
+		{Name: "UPDATED", Value: func(s models.StatefulSet) string { return fmt.Sprintf("%d/%d", s.UpdatedReplicas, s.Replicas) }},
+		{Name: "STRATEGY", Value: func(s models.StatefulSet) string { return s.UpdateStrategy }},
+		{Name: "PARTITION", Value: func(s models.StatefulSet) string { return fmt.Sprintf("%d", s.Partition) }},
+		{Name: "IMAGE", Value: func(s models.StatefulSet) string { return s.Image }},
+		{Name: "AGE", Value: func(s models.StatefulSet) string { return s.FormatAge() }},
+	},
+	Default: []string{"NAME", "NAMESPACE", "READY", "UPDATED", "STRATEGY", "PARTITION", "IMAGE", "AGE"},
+	Object: func(s models.StatefulSet) metav1.Object {
+		if s.Object == nil {
+			return nil
+		}
+		return s.Object
+	},
+})
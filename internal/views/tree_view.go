@@ -0,0 +1,247 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// TreeNode is a node of a TreeView
+type TreeNode struct {
+	// ID identifies the node across updates, so its expanded state and selection survive a refresh
+	ID string
+	// Marker is drawn in MarkerStyle before the label, such as a health dot
+	Marker      string
+	MarkerStyle lipgloss.Style
+	Label       string
+	// Detail is drawn muted after the label
+	Detail   string
+	Children []*TreeNode
+}
+
+// treeRow is a node visible in the tree together with the guide lines drawn before it
+type treeRow struct {
+	node   *TreeNode
+	prefix string
+	parent int // index of the parent row, -1 for the root
+}
+
+// TreeView renders a tree of nodes with expandable branches and a selected row.
+// Nodes start expanded; collapsing one hides its descendants.
+type TreeView struct {
+	root      *TreeNode
+	collapsed map[string]bool
+	selected  int
+	offset    int
+	theme     *theme.Theme
+	width     int
+	height    int
+}
+
+// NewTreeView creates a new tree view showing root
+func NewTreeView(root *TreeNode, theme *theme.Theme) *TreeView {
+	return &TreeView{
+		root:      root,
+		collapsed: make(map[string]bool),
+		theme:     theme,
+	}
+}
+
+// SetSize sets the view dimensions
+func (tv *TreeView) SetSize(width, height int) {
+	tv.width = width
+	tv.height = height
+}
+
+// SetRoot replaces the tree, keeping the selection on the node with the same ID when it still exists
+func (tv *TreeView) SetRoot(root *TreeNode) {
+	selectedID := ""
+	if selected := tv.Selected(); selected != nil {
+		selectedID = selected.ID
+	}
+	tv.root = root
+	if !tv.Select(selectedID) {
+		tv.clampSelection()
+	}
+}
+
+// Select selects the node with the given ID, expanding its ancestors so it is visible.
+// It reports whether the tree contains the node.
+func (tv *TreeView) Select(id string) bool {
+	path := findTreePath(tv.root, id)
+	if path == nil {
+		return false
+	}
+	for _, ancestor := range path[:len(path)-1] {
+		delete(tv.collapsed, ancestor.ID)
+	}
+	for i, row := range tv.rows() {
+		if row.node.ID == id {
+			tv.selected = i
+			return true
+		}
+	}
+	return false
+}
+
+// Selected returns the selected node, or nil when the tree is empty
+func (tv *TreeView) Selected() *TreeNode {
+	rows := tv.rows()
+	if tv.selected < 0 || tv.selected >= len(rows) {
+		return nil
+	}
+	return rows[tv.selected].node
+}
+
+// SelectNext moves the selection down one visible row
+func (tv *TreeView) SelectNext() {
+	if tv.selected < len(tv.rows())-1 {
+		tv.selected++
+	}
+}
+
+// SelectPrev moves the selection up one visible row
+func (tv *TreeView) SelectPrev() {
+	if tv.selected > 0 {
+		tv.selected--
+	}
+}
+
+// Toggle expands the selected node if it is collapsed and collapses it otherwise
+func (tv *TreeView) Toggle() {
+	selected := tv.Selected()
+	if selected == nil || len(selected.Children) == 0 {
+		return
+	}
+	tv.collapsed[selected.ID] = !tv.collapsed[selected.ID]
+}
+
+// Expand expands the selected node, or moves to its first child when it is already expanded
+func (tv *TreeView) Expand() {
+	selected := tv.Selected()
+	if selected == nil || len(selected.Children) == 0 {
+		return
+	}
+	if tv.collapsed[selected.ID] {
+		delete(tv.collapsed, selected.ID)
+		return
+	}
+	tv.SelectNext()
+}
+
+// Collapse collapses the selected node, or moves to its parent when it is already collapsed or is a leaf
+func (tv *TreeView) Collapse() {
+	rows := tv.rows()
+	if tv.selected < 0 || tv.selected >= len(rows) {
+		return
+	}
+	row := rows[tv.selected]
+	if len(row.node.Children) > 0 && !tv.collapsed[row.node.ID] {
+		tv.collapsed[row.node.ID] = true
+		return
+	}
+	if row.parent >= 0 {
+		tv.selected = row.parent
+	}
+}
+
+// clampSelection keeps the selection within the visible rows
+func (tv *TreeView) clampSelection() {
+	rows := tv.rows()
+	if tv.selected >= len(rows) {
+		tv.selected = len(rows) - 1
+	}
+	if tv.selected < 0 {
+		tv.selected = 0
+	}
+}
+
+// rows flattens the visible part of the tree in display order
+func (tv *TreeView) rows() []treeRow {
+	if tv.root == nil {
+		return nil
+	}
+	rows := []treeRow{{node: tv.root, parent: -1}}
+	tv.appendRows(&rows, tv.root, 0, "")
+	return rows
+}
+
+// appendRows appends the visible descendants of the node at index parent, drawing guides after indent
+func (tv *TreeView) appendRows(rows *[]treeRow, node *TreeNode, parent int, indent string) {
+	if tv.collapsed[node.ID] {
+		return
+	}
+	for i, child := range node.Children {
+		branch, childIndent := "├─ ", "│  "
+		if i == len(node.Children)-1 {
+			branch, childIndent = "└─ ", "   "
+		}
+		*rows = append(*rows, treeRow{node: child, prefix: indent + branch, parent: parent})
+		tv.appendRows(rows, child, len(*rows)-1, indent+childIndent)
+	}
+}
+
+// Render renders the visible rows that fit in the view's height, scrolled to keep the selection in view
+func (tv *TreeView) Render() string {
+	rows := tv.rows()
+	if len(rows) == 0 || tv.height <= 0 {
+		return lipgloss.NewStyle().Foreground(tv.theme.TextMuted).Render("Nothing to show")
+	}
+	tv.clampSelection()
+
+	// Scroll so the selection stays visible
+	if tv.selected < tv.offset {
+		tv.offset = tv.selected
+	}
+	if tv.selected >= tv.offset+tv.height {
+		tv.offset = tv.selected - tv.height + 1
+	}
+	end := tv.offset + tv.height
+	if end > len(rows) {
+		end = len(rows)
+	}
+
+	guideStyle := lipgloss.NewStyle().Foreground(tv.theme.TextMuted)
+	detailStyle := lipgloss.NewStyle().Foreground(tv.theme.TextMuted)
+
+	lines := make([]string, 0, end-tv.offset)
+	for i := tv.offset; i < end; i++ {
+		row := rows[i]
+		expander := "  "
+		if len(row.node.Children) > 0 {
+			expander = "▾ "
+			if tv.collapsed[row.node.ID] {
+				expander = "▸ "
+			}
+		}
+
+		if i == tv.selected {
+			// The selected row is drawn plain so the selection style covers the whole line
+			text := row.prefix + expander + row.node.Marker + " " + row.node.Label + "  " + row.node.Detail
+			lines = append(lines, tv.theme.TableSelectedStyle.Width(tv.width).MaxWidth(tv.width).Render(text))
+			continue
+		}
+		line := guideStyle.Render(row.prefix+expander) +
+			row.node.MarkerStyle.Render(row.node.Marker) + " " + row.node.Label + "  " +
+			detailStyle.Render(row.node.Detail)
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(tv.width).Render(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// findTreePath returns the nodes from root down to the node with the given ID, or nil when it is not in the tree
+func findTreePath(root *TreeNode, id string) []*TreeNode {
+	if root == nil {
+		return nil
+	}
+	if root.ID == id {
+		return []*TreeNode{root}
+	}
+	for _, child := range root.Children {
+		if path := findTreePath(child, id); path != nil {
+			return append([]*TreeNode{root}, path...)
+		}
+	}
+	return nil
+}
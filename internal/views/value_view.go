@@ -0,0 +1,136 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// ValueView represents a scrollable viewer for a single value, such as a config map key
+type ValueView struct {
+	title   string
+	content string
+	scrollY int
+	width   int
+	height  int
+	theme   *theme.Theme
+}
+
+// NewValueView creates a new value view
+func NewValueView(title, content string, theme *theme.Theme) *ValueView {
+	return &ValueView{
+		title:   title,
+		content: content,
+		theme:   theme,
+	}
+}
+
+// SetSize sets the view dimensions
+func (vv *ValueView) SetSize(width, height int) {
+	vv.width = width
+	vv.height = height
+}
+
+// availableHeight returns the number of content lines shown above the status bar
+func (vv *ValueView) availableHeight() int {
+	availableHeight := vv.height - 1 // 1 for status bar
+	if availableHeight <= 0 {
+		availableHeight = 1
+	}
+	return availableHeight
+}
+
+// maxScroll returns the scroll position showing the last page of content
+func (vv *ValueView) maxScroll() int {
+	maxScroll := len(strings.Split(vv.content, "\n")) - vv.availableHeight()
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	return maxScroll
+}
+
+// ScrollUp moves the view up
+func (vv *ValueView) ScrollUp() {
+	if vv.scrollY > 0 {
+		vv.scrollY--
+	}
+}
+
+// ScrollDown moves the view down
+func (vv *ValueView) ScrollDown() {
+	if vv.scrollY < vv.maxScroll() {
+		vv.scrollY++
+	}
+}
+
+// PageUp scrolls up by one page
+func (vv *ValueView) PageUp() {
+	vv.scrollY -= vv.availableHeight()
+	if vv.scrollY < 0 {
+		vv.scrollY = 0
+	}
+}
+
+// PageDown scrolls down by one page
+func (vv *ValueView) PageDown() {
+	vv.scrollY += vv.availableHeight()
+	if vv.scrollY > vv.maxScroll() {
+		vv.scrollY = vv.maxScroll()
+	}
+}
+
+// GoToStart scrolls to the top
+func (vv *ValueView) GoToStart() {
+	vv.scrollY = 0
+}
+
+// GoToEnd scrolls to the bottom
+func (vv *ValueView) GoToEnd() {
+	vv.scrollY = vv.maxScroll()
+}
+
+// UpdateContent updates the value, keeping the scroll position where possible
+func (vv *ValueView) UpdateContent(content string) {
+	vv.content = content
+	if vv.scrollY > vv.maxScroll() {
+		vv.scrollY = vv.maxScroll()
+	}
+}
+
+// Content returns the value shown (for testing)
+func (vv *ValueView) Content() string {
+	return vv.content
+}
+
+// Render renders the value view
+func (vv *ValueView) Render() string {
+	if vv.width == 0 || vv.height == 0 {
+		return ""
+	}
+
+	lines := strings.Split(vv.content, "\n")
+	if vv.scrollY > vv.maxScroll() {
+		vv.scrollY = vv.maxScroll()
+	}
+	end := vv.scrollY + vv.availableHeight()
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	content := lipgloss.NewStyle().
+		Foreground(vv.theme.TextPrimary).
+		Background(vv.theme.BgPrimary).
+		Width(vv.width).
+		Height(vv.availableHeight()).
+		Render(strings.Join(lines[vv.scrollY:end], "\n"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, content, vv.renderStatusBar())
+}
+
+// renderStatusBar renders the status bar at the bottom
+func (vv *ValueView) renderStatusBar() string {
+	statusText := fmt.Sprintf("%s | Line %d of %d | Use up/down arrows to scroll | PgUp/PgDn for page scroll | g/G for start/end", vv.title, vv.scrollY+1, len(strings.Split(vv.content, "\n")))
+	return vv.theme.StatusBarStyle.Width(vv.width).Render(statusText)
+}
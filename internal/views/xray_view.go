@@ -0,0 +1,190 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/kevholditch/vigilant/internal/models"
+	"github.com/kevholditch/vigilant/internal/theme"
+)
+
+// XRayView renders the relationship tree of a resource: what owns it, what it owns and what its pods use
+type XRayView struct {
+	root  *models.RelationshipNode
+	tree  *TreeView
+	theme *theme.Theme
+	// nodes maps tree node IDs back to the relationship nodes they were built from
+	nodes  map[string]*models.RelationshipNode
+	width  int
+	height int
+}
+
+// NewXRayView creates a new x-ray view with the node for focus selected
+func NewXRayView(root *models.RelationshipNode, focus models.ResourceRef, theme *theme.Theme) *XRayView {
+	xv := &XRayView{
+		theme: theme,
+		tree:  NewTreeView(nil, theme),
+	}
+	xv.UpdateRelationships(root)
+	if id := xv.idOf(focus); id != "" {
+		xv.tree.Select(id)
+	}
+	return xv
+}
+
+// SetSize sets the view dimensions
+func (xv *XRayView) SetSize(width, height int) {
+	xv.width = width
+	xv.height = height
+}
+
+// SelectNext moves the selection down
+func (xv *XRayView) SelectNext() {
+	xv.tree.SelectNext()
+}
+
+// SelectPrev moves the selection up
+func (xv *XRayView) SelectPrev() {
+	xv.tree.SelectPrev()
+}
+
+// Toggle expands or collapses the selected resource
+func (xv *XRayView) Toggle() {
+	xv.tree.Toggle()
+}
+
+// Expand expands the selected resource
+func (xv *XRayView) Expand() {
+	xv.tree.Expand()
+}
+
+// Collapse collapses the selected resource, or moves to its parent
+func (xv *XRayView) Collapse() {
+	xv.tree.Collapse()
+}
+
+// UpdateRelationships replaces the tree shown, keeping expanded state and selection where the resources remain
+func (xv *XRayView) UpdateRelationships(root *models.RelationshipNode) {
+	xv.root = root
+	xv.nodes = make(map[string]*models.RelationshipNode)
+	if root == nil {
+		xv.tree.SetRoot(nil)
+		return
+	}
+	xv.tree.SetRoot(xv.toTreeNode(root, ""))
+}
+
+// Relationships returns the relationship tree shown (for testing)
+func (xv *XRayView) Relationships() *models.RelationshipNode {
+	return xv.root
+}
+
+// GetSelected returns the selected resource, or nil when there is none
+func (xv *XRayView) GetSelected() *models.RelationshipNode {
+	selected := xv.tree.Selected()
+	if selected == nil {
+		return nil
+	}
+	return xv.nodes[selected.ID]
+}
+
+// toTreeNode converts a relationship node and its children. IDs are the path of references from the root,
+// as a pod can appear more than once, such as under its replica set and under a service's endpoint slice.
+func (xv *XRayView) toTreeNode(node *models.RelationshipNode, parentID string) *TreeNode {
+	id := parentID + "/" + node.Ref.String()
+	xv.nodes[id] = node
+
+	label := node.Ref.String()
+	if node.Relation != "" {
+		label = node.Relation + " " + label
+	}
+	treeNode := &TreeNode{
+		ID:          id,
+		Marker:      "●",
+		MarkerStyle: xv.healthStyle(node.Health),
+		Label:       label,
+		Detail:      node.Status,
+	}
+	for _, child := range node.Children {
+		treeNode.Children = append(treeNode.Children, xv.toTreeNode(child, id))
+	}
+	return treeNode
+}
+
+// idOf returns the ID of the first tree node for ref in display order, or "" when the tree does not contain it
+func (xv *XRayView) idOf(ref models.ResourceRef) string {
+	var walk func(node *models.RelationshipNode, parentID string) string
+	walk = func(node *models.RelationshipNode, parentID string) string {
+		id := parentID + "/" + node.Ref.String()
+		if node.Ref == ref {
+			return id
+		}
+		for _, child := range node.Children {
+			if found := walk(child, id); found != "" {
+				return found
+			}
+		}
+		return ""
+	}
+	if xv.root == nil {
+		return ""
+	}
+	return walk(xv.root, "")
+}
+
+// healthStyle returns the marker style for a health
+func (xv *XRayView) healthStyle(health models.Health) lipgloss.Style {
+	switch health {
+	case models.HealthHealthy:
+		return xv.theme.StatusRunningStyle
+	case models.HealthDegraded:
+		return xv.theme.StatusPendingStyle
+	case models.HealthFailed:
+		return xv.theme.StatusFailedStyle
+	default:
+		return lipgloss.NewStyle().Foreground(xv.theme.TextMuted)
+	}
+}
+
+// Render renders the x-ray view
+func (xv *XRayView) Render() string {
+	if xv.width == 0 || xv.height == 0 {
+		return ""
+	}
+
+	// Leave room for the status bar
+	contentHeight := xv.height - 1
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+
+	var content string
+	if xv.root == nil {
+		content = lipgloss.NewStyle().Foreground(xv.theme.Error).Render("No relationship data available")
+	} else {
+		xv.tree.SetSize(xv.width, contentHeight)
+		content = xv.tree.Render()
+	}
+
+	visible := lipgloss.NewStyle().Height(contentHeight).Render(content)
+	return lipgloss.JoinVertical(lipgloss.Left, visible, xv.renderStatusBar())
+}
+
+// renderStatusBar renders the status bar with the health counts across the tree
+func (xv *XRayView) renderStatusBar() string {
+	counts := make(map[models.Health]int)
+	var count func(node *models.RelationshipNode)
+	count = func(node *models.RelationshipNode) {
+		counts[node.Health]++
+		for _, child := range node.Children {
+			count(child)
+		}
+	}
+	if xv.root != nil {
+		count(xv.root)
+	}
+
+	statusText := fmt.Sprintf("Healthy: %d | Degraded: %d | Failed: %d | Press 'enter' to expand or collapse | Press 'd' to describe",
+		counts[models.HealthHealthy], counts[models.HealthDegraded], counts[models.HealthFailed])
+	return xv.theme.StatusBarStyle.Width(xv.width).Render(statusText)
+}
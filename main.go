@@ -1,14 +1,18 @@
 package main
 
 import (
-	"log"
+	"os"
 
 	"github.com/kevholditch/vigilant/internal/app"
+	"github.com/kevholditch/vigilant/internal/cli"
 )
 
 func main() {
-	app := app.NewApp()
-	if err := app.Run(); err != nil {
-		log.Fatal(err)
+	root := cli.NewRootCommand(func() error {
+		return app.NewApp().Run()
+	}, app.Resources())
+	// cobra reports the error
+	if err := root.Execute(); err != nil {
+		os.Exit(1)
 	}
 }